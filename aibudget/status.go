@@ -0,0 +1,126 @@
+package aibudget
+
+import (
+	"time"
+
+	aiendpoint "go.clever-cloud.dev/sdk/aiendpoint"
+	ids "go.clever-cloud.dev/sdk/ids"
+)
+
+// Sample is the consumption of a budget at a point in time
+type Sample struct {
+	At          time.Time
+	WindowStart time.Time
+	Usage       aiendpoint.Usage
+}
+
+// BurnRate is the consumption of a budget per hour
+type BurnRate struct {
+	TokensPerHour float64
+	CostPerHour   float64
+}
+
+// Status is the state of a budget computed from its latest samples
+type Status struct {
+	AIID       ids.AIID
+	EndpointID ids.EndpointID
+	Budget     aiendpoint.Budget
+	Sample     Sample
+	// WindowEnd is the time the budget resets or ends, zero when it never does
+	WindowEnd time.Time
+	// Ratio is the highest share of a limit consumed, 1 meaning exhausted
+	Ratio    float64
+	BurnRate BurnRate
+	// ExhaustedAt is when the total token or cost limit is projected to be
+	// reached at the current burn rate, nil when it is not reached before the
+	// window ends
+	ExhaustedAt *time.Time
+}
+
+// Key identifies the budget of a status across polls
+func (s Status) Key() string {
+	return string(s.EndpointID) + "/" + s.Budget.ID
+}
+
+// ratio returns the highest share of a limit consumed
+func ratio(limits aiendpoint.BudgetLimits, usage aiendpoint.Usage) float64 {
+	var r float64
+	for _, pair := range limitPairs(limits, usage) {
+		if pair.limit > 0 {
+			r = max(r, pair.used/pair.limit)
+		}
+	}
+	return r
+}
+
+// burnRate returns the consumption per hour between two samples of the same
+// window, or since the start of the window when there is no previous sample
+func burnRate(previous *Sample, current Sample) BurnRate {
+	from, base := current.WindowStart, aiendpoint.Usage{}
+	if previous != nil && previous.WindowStart.Equal(current.WindowStart) && previous.At.Before(current.At) {
+		from, base = previous.At, previous.Usage
+	}
+
+	hours := current.At.Sub(from).Hours()
+	if from.IsZero() || hours <= 0 {
+		return BurnRate{}
+	}
+	return BurnRate{
+		TokensPerHour: float64(current.Usage.TotalTokens-base.TotalTokens) / hours,
+		CostPerHour:   (current.Usage.TotalCost - base.TotalCost) / hours,
+	}
+}
+
+// exhaustion returns when the first limit is reached at the given burn rate,
+// nil if none is reached before windowEnd
+func exhaustion(limits aiendpoint.BudgetLimits, sample Sample, rate BurnRate, windowEnd time.Time) *time.Time {
+	var earliest *time.Time
+	project := func(limit *float64, used float64, perHour float64) {
+		if limit == nil || perHour <= 0 {
+			return
+		}
+		at := sample.At
+		if remaining := *limit - used; remaining > 0 {
+			at = at.Add(time.Duration(remaining / perHour * float64(time.Hour)))
+		}
+		if !windowEnd.IsZero() && at.After(windowEnd) {
+			return
+		}
+		if earliest == nil || at.Before(*earliest) {
+			earliest = &at
+		}
+	}
+
+	if limits.TotalTokens != nil {
+		limit := float64(*limits.TotalTokens)
+		project(&limit, float64(sample.Usage.TotalTokens), rate.TokensPerHour)
+	}
+	project(limits.TotalCost, sample.Usage.TotalCost, rate.CostPerHour)
+	return earliest
+}
+
+type limitPair struct {
+	used  float64
+	limit float64
+}
+
+func limitPairs(limits aiendpoint.BudgetLimits, usage aiendpoint.Usage) []limitPair {
+	var pairs []limitPair
+	tokens := func(limit *int64, used int64) {
+		if limit != nil {
+			pairs = append(pairs, limitPair{used: float64(used), limit: float64(*limit)})
+		}
+	}
+	cost := func(limit *float64, used float64) {
+		if limit != nil {
+			pairs = append(pairs, limitPair{used: used, limit: *limit})
+		}
+	}
+	tokens(limits.TotalTokens, usage.TotalTokens)
+	tokens(limits.InputTokens, usage.InputTokens)
+	tokens(limits.OutputTokens, usage.OutputTokens)
+	cost(limits.TotalCost, usage.TotalCost)
+	cost(limits.InputCost, usage.InputCost)
+	cost(limits.OutputCost, usage.OutputCost)
+	return pairs
+}
@@ -0,0 +1,371 @@
+// Package aibudget watches the budgets of AI add-on endpoints.
+//
+// A Watcher periodically collects the consumption of every budget of an AI
+// add-on, computes burn rates and projected exhaustion dates, calls an alert
+// function when thresholds are crossed and exports the values as
+// OpenTelemetry metrics.
+package aibudget
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	aiendpoint "go.clever-cloud.dev/sdk/aiendpoint"
+	ids "go.clever-cloud.dev/sdk/ids"
+	ai "go.clever-cloud.dev/sdk/services/ai"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// AlertKind tells why an alert was raised
+type AlertKind int
+
+const (
+	// AlertThreshold is raised when the consumed share of a budget crosses a threshold
+	AlertThreshold AlertKind = iota
+	// AlertForecast is raised when a budget is projected to be exhausted within the forecast horizon
+	AlertForecast
+)
+
+func (k AlertKind) String() string {
+	switch k {
+	case AlertThreshold:
+		return "threshold"
+	case AlertForecast:
+		return "forecast"
+	default:
+		return "unknown"
+	}
+}
+
+// Alert is raised once per budget, kind and threshold in each spending window
+type Alert struct {
+	Kind   AlertKind
+	Status Status
+	// Threshold is the crossed ratio, for threshold alerts
+	Threshold float64
+}
+
+// AlertFunc is called for every alert raised by a poll
+type AlertFunc func(ctx context.Context, alert Alert)
+
+// Watcher collects the budgets of the endpoints of an AI add-on
+type Watcher struct {
+	client *client.Client
+	tracer trace.Tracer
+	aiID   ids.AIID
+
+	interval   time.Duration
+	thresholds []float64
+	forecast   time.Duration
+	alert      AlertFunc
+	meter      metric.MeterProvider
+	now        func() time.Time
+
+	mu       sync.Mutex
+	statuses map[string]Status
+	raised   map[string]*raisedAlerts
+}
+
+// raisedAlerts records the alerts raised for a budget in its current window
+type raisedAlerts struct {
+	windowStart time.Time
+	ids         map[string]bool
+}
+
+// Option defines configuration options for the Watcher
+type Option func(*Watcher)
+
+// WithInterval sets the delay between two polls of Run
+func WithInterval(d time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// WithThresholds sets the consumed ratios raising an alert, 1 meaning exhausted
+func WithThresholds(thresholds ...float64) Option {
+	return func(w *Watcher) {
+		w.thresholds = thresholds
+	}
+}
+
+// WithForecast raises an alert when a budget is projected to be exhausted within d
+func WithForecast(d time.Duration) Option {
+	return func(w *Watcher) {
+		w.forecast = d
+	}
+}
+
+// WithAlertFunc sets the function called for alerts
+func WithAlertFunc(f AlertFunc) Option {
+	return func(w *Watcher) {
+		w.alert = f
+	}
+}
+
+// WithMeterProvider sets where metrics are exported, the global provider by default
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(w *Watcher) {
+		w.meter = mp
+	}
+}
+
+// New creates a Watcher for the budgets of an AI add-on
+func New(c *client.Client, tracer trace.Tracer, aiID ids.AIID, opts ...Option) (*Watcher, error) {
+	w := &Watcher{
+		client:     c,
+		tracer:     tracer,
+		aiID:       aiID,
+		interval:   5 * time.Minute,
+		thresholds: []float64{0.5, 0.8, 0.95, 1},
+		now:        time.Now,
+		statuses:   map[string]Status{},
+		raised:     map[string]*raisedAlerts{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.meter == nil {
+		w.meter = otel.GetMeterProvider()
+	}
+
+	if err := w.registerMetrics(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Run polls the budgets until the context is canceled.
+// Poll errors are recorded on the trace and do not stop the watcher.
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = w.Poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll collects the budgets of every endpoint of the add-on once, raises
+// alerts and returns their statuses. Budgets of endpoints that could not be
+// read are missing from the result and their errors are joined. Budgets no
+// longer listed, disabled or outside their spending window are forgotten,
+// with the alerts raised for them.
+func (w *Watcher) Poll(ctx context.Context) ([]Status, error) {
+	ctx, span := w.tracer.Start(ctx, "aibudget.Poll", trace.WithAttributes(attribute.String("aiId", string(w.aiID))))
+	defer span.End()
+
+	addon := ai.Getai(ctx, w.client, w.tracer, w.aiID)
+	if addon.HasError() {
+		span.RecordError(addon.Error())
+		return nil, addon.Error()
+	}
+	ownerID := ids.OwnerID(addon.Payload().OwnerID)
+
+	endpointIDs, err := aiendpoint.ListEndpointIDs(ctx, w.client, w.tracer, ownerID, w.aiID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var statuses []Status
+	var errs []error
+	active := map[string]bool{}
+	unread := map[ids.EndpointID]bool{}
+	for _, endpointID := range endpointIDs {
+		budgets, err := aiendpoint.GetBudgets(ctx, w.client, w.tracer, ownerID, w.aiID, endpointID)
+		if err != nil {
+			span.RecordError(err)
+			errs = append(errs, err)
+			unread[endpointID] = true
+			continue
+		}
+		for _, budget := range budgets {
+			if status, ok := w.observe(endpointID, budget); ok {
+				active[status.Key()] = true
+				statuses = append(statuses, status)
+			}
+		}
+	}
+
+	w.prune(active, unread)
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Key() < statuses[j].Key() })
+	for _, alert := range w.alerts(statuses) {
+		if w.alert != nil {
+			w.alert(ctx, alert)
+		}
+	}
+	return statuses, errors.Join(errs...)
+}
+
+// Statuses returns the latest status of every budget
+func (w *Watcher) Statuses() []Status {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	statuses := make([]Status, 0, len(w.statuses))
+	for _, status := range w.statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Key() < statuses[j].Key() })
+	return statuses
+}
+
+// observe records a new sample of a budget and returns its status.
+// Disabled budgets and budgets outside their spending window are skipped.
+func (w *Watcher) observe(endpointID ids.EndpointID, budget aiendpoint.Budget) (Status, bool) {
+	now := w.now()
+	start, end, ok := budget.Current(now)
+	if !budget.Enabled || !ok {
+		return Status{}, false
+	}
+
+	sample := Sample{At: now, WindowStart: start}
+	if budget.Consumption != nil {
+		sample.Usage = *budget.Consumption
+	}
+
+	status := Status{
+		AIID:       w.aiID,
+		EndpointID: endpointID,
+		Budget:     budget,
+		Sample:     sample,
+		WindowEnd:  end,
+		Ratio:      ratio(budget.Limits, sample.Usage),
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var previous *Sample
+	if last, ok := w.statuses[status.Key()]; ok {
+		previous = &last.Sample
+	}
+	status.BurnRate = burnRate(previous, sample)
+	status.ExhaustedAt = exhaustion(budget.Limits, sample, status.BurnRate, end)
+	w.statuses[status.Key()] = status
+	return status, true
+}
+
+// prune forgets the statuses and raised alerts of the budgets that are not
+// active anymore: deleted, disabled or outside their spending window.
+// Budgets of unread endpoints are kept until they can be read.
+func (w *Watcher) prune(active map[string]bool, unread map[ids.EndpointID]bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key, status := range w.statuses {
+		if !active[key] && !unread[status.EndpointID] {
+			delete(w.statuses, key)
+		}
+	}
+	for key := range w.raised {
+		if _, ok := w.statuses[key]; !ok {
+			delete(w.raised, key)
+		}
+	}
+}
+
+// alerts returns the alerts not raised yet in the current window of each budget
+func (w *Watcher) alerts(statuses []Status) []Alert {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var alerts []Alert
+	for _, status := range statuses {
+		raised, ok := w.raised[status.Key()]
+		if !ok || !raised.windowStart.Equal(status.Sample.WindowStart) {
+			// A new window starts with no alert raised
+			raised = &raisedAlerts{windowStart: status.Sample.WindowStart, ids: map[string]bool{}}
+			w.raised[status.Key()] = raised
+		}
+
+		raise := func(alert Alert, id string) {
+			if !raised.ids[id] {
+				raised.ids[id] = true
+				alerts = append(alerts, alert)
+			}
+		}
+		for _, threshold := range w.thresholds {
+			if status.Ratio >= threshold {
+				raise(Alert{Kind: AlertThreshold, Status: status, Threshold: threshold}, AlertThreshold.String()+":"+strconv.FormatFloat(threshold, 'g', -1, 64))
+			}
+		}
+		if w.forecast > 0 && status.ExhaustedAt != nil && status.ExhaustedAt.Sub(status.Sample.At) <= w.forecast {
+			raise(Alert{Kind: AlertForecast, Status: status}, AlertForecast.String())
+		}
+	}
+	return alerts
+}
+
+// registerMetrics exports the latest statuses as observable gauges
+func (w *Watcher) registerMetrics() error {
+	meter := w.meter.Meter("go.clever-cloud.dev/sdk/aibudget")
+
+	usage, err := meter.Float64ObservableGauge("ai.budget.usage.ratio",
+		metric.WithDescription("Highest share of a budget limit consumed in the current window"))
+	if err != nil {
+		return err
+	}
+	tokens, err := meter.Int64ObservableGauge("ai.budget.tokens.consumed",
+		metric.WithDescription("Tokens consumed in the current window"), metric.WithUnit("{token}"))
+	if err != nil {
+		return err
+	}
+	cost, err := meter.Float64ObservableGauge("ai.budget.cost.consumed",
+		metric.WithDescription("Cost consumed in the current window"))
+	if err != nil {
+		return err
+	}
+	tokenRate, err := meter.Float64ObservableGauge("ai.budget.tokens.burn_rate",
+		metric.WithDescription("Tokens consumed per hour"), metric.WithUnit("{token}/h"))
+	if err != nil {
+		return err
+	}
+	costRate, err := meter.Float64ObservableGauge("ai.budget.cost.burn_rate",
+		metric.WithDescription("Cost consumed per hour"), metric.WithUnit("1/h"))
+	if err != nil {
+		return err
+	}
+	exhaustion, err := meter.Float64ObservableGauge("ai.budget.exhaustion",
+		metric.WithDescription("Time left before the budget is projected to be exhausted"), metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		for _, status := range w.Statuses() {
+			attrs := metric.WithAttributes(
+				attribute.String("ai.id", string(status.AIID)),
+				attribute.String("ai.endpoint.id", string(status.EndpointID)),
+				attribute.String("ai.budget.id", status.Budget.ID),
+				attribute.String("ai.budget.name", status.Budget.Name),
+			)
+			o.ObserveFloat64(usage, status.Ratio, attrs)
+			o.ObserveInt64(tokens, status.Sample.Usage.TotalTokens, attrs)
+			o.ObserveFloat64(cost, status.Sample.Usage.TotalCost, attrs)
+			o.ObserveFloat64(tokenRate, status.BurnRate.TokensPerHour, attrs)
+			o.ObserveFloat64(costRate, status.BurnRate.CostPerHour, attrs)
+			if status.ExhaustedAt != nil {
+				o.ObserveFloat64(exhaustion, status.ExhaustedAt.Sub(status.Sample.At).Seconds(), attrs)
+			}
+		}
+		return nil
+	}, usage, tokens, cost, tokenRate, costRate, exhaustion)
+	return err
+}
@@ -0,0 +1,128 @@
+package aibudget
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	aiendpoint "go.clever-cloud.dev/sdk/aiendpoint"
+	ids "go.clever-cloud.dev/sdk/ids"
+	noop "go.opentelemetry.io/otel/metric/noop"
+)
+
+func TestObserveAndAlerts(t *testing.T) {
+	w, err := New(nil, nil, "ai_1", WithThresholds(0.5, 0.9), WithForecast(12*time.Hour), WithMeterProvider(noop.NewMeterProvider()))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	limit := int64(1000)
+	budget := aiendpoint.Budget{
+		ID:      "b1",
+		Enabled: true,
+		Limits:  aiendpoint.BudgetLimits{TokenBudget: aiendpoint.TokenBudget{TotalTokens: &limit}},
+		SpendingWindow: aiendpoint.SpendingWindow{
+			StartAt:  aiendpoint.Timestamp{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			Duration: aiendpoint.Millis(24 * time.Hour),
+		},
+	}
+	poll := func(at time.Time, tokens int64) ([]Alert, Status) {
+		w.now = func() time.Time { return at }
+		budget.Consumption = &aiendpoint.Usage{TotalTokens: tokens}
+		status, ok := w.observe("e1", budget)
+		if !ok {
+			t.Fatalf("budget skipped at %v", at)
+		}
+		return w.alerts([]Status{status}), status
+	}
+
+	// 400 tokens in 4 hours: 100/h, the remaining 600 last until 10:00
+	alerts, status := poll(time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC), 400)
+	if status.BurnRate.TokensPerHour != 100 {
+		t.Errorf("burn rate = %v, want 100/h", status.BurnRate.TokensPerHour)
+	}
+	if status.ExhaustedAt == nil || !status.ExhaustedAt.Equal(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("exhausted at = %v", status.ExhaustedAt)
+	}
+	if len(alerts) != 1 || alerts[0].Kind != AlertForecast {
+		t.Errorf("alerts = %+v, want a forecast alert", alerts)
+	}
+
+	// 200 more tokens in 1 hour: the rate comes from the previous sample
+	alerts, status = poll(time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC), 600)
+	if status.BurnRate.TokensPerHour != 200 {
+		t.Errorf("burn rate = %v, want 200/h", status.BurnRate.TokensPerHour)
+	}
+	if len(alerts) != 1 || alerts[0].Kind != AlertThreshold || alerts[0].Threshold != 0.5 {
+		t.Errorf("alerts = %+v, want the 0.5 threshold only", alerts)
+	}
+
+	if alerts, _ = poll(time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC), 650); len(alerts) != 0 {
+		t.Errorf("alerts raised twice in a window: %+v", alerts)
+	}
+
+	// The next window starts over
+	alerts, status = poll(time.Date(2026, 1, 2, 20, 0, 0, 0, time.UTC), 600)
+	if status.BurnRate.TokensPerHour != 30 {
+		t.Errorf("burn rate = %v, want 30/h since the window start", status.BurnRate.TokensPerHour)
+	}
+	if status.ExhaustedAt != nil {
+		t.Errorf("exhausted at = %v, want nil as the window ends first", status.ExhaustedAt)
+	}
+	if len(alerts) != 1 || alerts[0].Threshold != 0.5 {
+		t.Errorf("alerts = %+v, want the 0.5 threshold again", alerts)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	w, err := New(nil, nil, "ai_1", WithThresholds(0.5), WithMeterProvider(noop.NewMeterProvider()))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	w.now = func() time.Time { return time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC) }
+
+	limit := int64(1000)
+	observe := func(endpointID ids.EndpointID, budgetID string, enabled bool) bool {
+		budget := aiendpoint.Budget{
+			ID:          budgetID,
+			Enabled:     enabled,
+			Limits:      aiendpoint.BudgetLimits{TokenBudget: aiendpoint.TokenBudget{TotalTokens: &limit}},
+			Consumption: &aiendpoint.Usage{TotalTokens: 600},
+			SpendingWindow: aiendpoint.SpendingWindow{
+				StartAt:  aiendpoint.Timestamp{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+				Duration: aiendpoint.Millis(24 * time.Hour),
+			},
+		}
+		status, ok := w.observe(endpointID, budget)
+		if ok {
+			w.alerts([]Status{status})
+		}
+		return ok
+	}
+	observe("e1", "kept", true)
+	observe("e1", "deleted", true)
+	observe("e1", "disabled", true)
+	observe("e2", "unread", true)
+
+	// the next poll lists every budget of e1 but the deleted one, and the
+	// disabled one is not observed anymore
+	active := map[string]bool{}
+	for _, budgetID := range []string{"kept", "disabled"} {
+		if observe("e1", budgetID, budgetID != "disabled") {
+			active["e1/"+budgetID] = true
+		}
+	}
+	// e2 could not be read: its budget may still exist
+	w.prune(active, map[ids.EndpointID]bool{"e2": true})
+
+	var keys []string
+	for _, status := range w.Statuses() {
+		keys = append(keys, status.Key())
+	}
+	if !slices.Equal(keys, []string{"e1/kept", "e2/unread"}) {
+		t.Errorf("statuses = %q, want the deleted and the disabled budgets forgotten", keys)
+	}
+	if _, ok := w.raised["e1/disabled"]; ok || len(w.raised) != 2 {
+		t.Errorf("raised alerts of %d budgets, want those of the deleted and the disabled ones dropped", len(w.raised))
+	}
+}
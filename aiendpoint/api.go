@@ -0,0 +1,133 @@
+package aiendpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	ai "go.clever-cloud.dev/sdk/services/ai"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// decode decodes the payload of an AI operation, which the API describes
+// with inline schemas, into T
+func decode[T any](response client.Response[json.RawMessage]) (T, error) {
+	var v T
+	if response.HasError() {
+		return v, response.Error()
+	}
+	if payload := response.Payload(); payload != nil && len(*payload) > 0 {
+		if err := json.Unmarshal(*payload, &v); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// ListEndpointIDs returns the identifiers of the Otoroshi routes assigned to an AI add-on
+func ListEndpointIDs(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID) ([]ids.EndpointID, error) {
+	return decode[[]ids.EndpointID](ai.Listaiendpoints(ctx, c, tracer, ownerID, aiID))
+}
+
+// GetEndpoint returns an endpoint and the Otoroshi target it is deployed on
+func GetEndpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID) (Endpoint, string, error) {
+	response := ai.Getendpoint(ctx, c, tracer, ownerID, aiID, endpointID)
+	if response.HasError() {
+		return Endpoint{}, "", response.Error()
+	}
+
+	var endpoint Endpoint
+	if err := convert(response.Payload().Endpoint, &endpoint); err != nil {
+		return Endpoint{}, "", err
+	}
+	return endpoint, response.Payload().OtoroshiTarget, nil
+}
+
+// CreateEndpoint creates the endpoint described by request and returns it
+// along with its API key, nil when the request had none
+func CreateEndpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, request models.CreateEndpointRequest) (Endpoint, *APIKey, error) {
+	response := ai.Createendpoint(ctx, c, tracer, ownerID, aiID, &request)
+	if response.HasError() {
+		return Endpoint{}, nil, response.Error()
+	}
+	created := response.Payload()
+	if created.Error != nil {
+		return Endpoint{}, nil, errors.New(*created.Error)
+	}
+
+	var endpoint Endpoint
+	if err := convert(created.Endpoint, &endpoint); err != nil {
+		return Endpoint{}, nil, err
+	}
+	if len(created.APIKey) == 0 {
+		return endpoint, nil, nil
+	}
+	var key APIKey
+	if err := convert(created.APIKey, &key); err != nil {
+		return endpoint, nil, err
+	}
+	return endpoint, &key, nil
+}
+
+// GetAPIKeys returns the API keys of an endpoint
+func GetAPIKeys(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID) ([]APIKey, error) {
+	return decode[[]APIKey](ai.Getaiapikeys(ctx, c, tracer, ownerID, aiID, endpointID))
+}
+
+// GetAPIKey returns an API key of an endpoint
+func GetAPIKey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID, apikeyID ids.ApikeyID) (APIKey, error) {
+	return decode[APIKey](ai.Getaiapikey(ctx, c, tracer, ownerID, aiID, endpointID, apikeyID))
+}
+
+// CreateAPIKey adds an API key to an endpoint
+func CreateAPIKey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID, key APIKey) error {
+	payload, err := toMap(key)
+	if err != nil {
+		return err
+	}
+	response := ai.Createotoroshiapikey(ctx, c, tracer, ownerID, aiID, endpointID, &models.CreateApiKeyRequest{APIKey: &payload})
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
+
+// GetBudgets returns the budgets of an endpoint
+func GetBudgets(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID) ([]Budget, error) {
+	return decode[[]Budget](ai.Getendpointbudgets(ctx, c, tracer, ownerID, aiID, endpointID))
+}
+
+// GetBudget returns a budget of an endpoint
+func GetBudget(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID, endpointID ids.EndpointID, budgetID ids.BudgetID) (Budget, error) {
+	return decode[Budget](ai.Getbudget(ctx, c, tracer, ownerID, aiID, endpointID, budgetID))
+}
+
+// GetProviders returns the AI providers configured on the Otoroshi cluster of an add-on
+func GetProviders(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, aiID ids.AIID) ([]ProviderInfo, error) {
+	return decode[[]ProviderInfo](ai.Getproviderinfos(ctx, c, tracer, ownerID, aiID))
+}
+
+// convert decodes an Otoroshi payload forwarded as a map into a typed value
+func convert(payload map[string]any, v any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// toMap encodes a typed value into the map forwarded to Otoroshi
+func toMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]any{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
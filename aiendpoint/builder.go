@@ -0,0 +1,159 @@
+package aiendpoint
+
+import (
+	"errors"
+	"fmt"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// Otoroshi plugins used by the endpoint shapes of the Builder
+const (
+	// PluginOpenAICompatProxy serves the OpenAI API in front of AI providers
+	PluginOpenAICompatProxy = "cp:otoroshi_plugins.com.cloud.apim.otoroshi.extensions.aigateway.plugins.OpenAiCompatProxy"
+	// PluginAPIKeyCalls rejects calls without a valid API key and enforces its quotas
+	PluginAPIKeyCalls = "cp:otoroshi.next.plugins.ApikeyCalls"
+	// PluginOverrideHost forwards the endpoint host to the backend
+	PluginOverrideHost = "cp:otoroshi.next.plugins.OverrideHost"
+)
+
+// Builder assembles the request creating an endpoint
+type Builder struct {
+	endpoint Endpoint
+	apiKey   *APIKey
+	target   string
+	err      error
+}
+
+// NewEndpoint starts an endpoint with the given name, enabled and without plugins
+func NewEndpoint(name string) *Builder {
+	return &Builder{endpoint: Endpoint{Name: name, Enabled: true}}
+}
+
+// OpenAICompatible starts an endpoint serving the OpenAI API on domain
+// and routing calls to the given providers. Calls require an API key.
+func OpenAICompatible(name string, domain string, providerIDs ...string) *Builder {
+	b := NewEndpoint(name).Domain(domain)
+	if len(providerIDs) == 0 {
+		b.err = errors.New("aiendpoint: an OpenAI compatible endpoint needs at least one provider")
+	}
+	refs := make([]any, 0, len(providerIDs))
+	for _, id := range providerIDs {
+		refs = append(refs, id)
+	}
+	return b.
+		Plugin(PluginOverrideHost, nil).
+		Plugin(PluginOpenAICompatProxy, map[string]any{"refs": refs}).
+		APIKey(name)
+}
+
+// Domain adds a domain the endpoint answers on
+func (b *Builder) Domain(domain string) *Builder {
+	b.endpoint.Frontend.Domains = append(b.endpoint.Frontend.Domains, domain)
+	return b
+}
+
+// Description sets the description of the endpoint
+func (b *Builder) Description(description string) *Builder {
+	b.endpoint.Description = description
+	return b
+}
+
+// Tag adds tags to the endpoint
+func (b *Builder) Tag(tags ...string) *Builder {
+	b.endpoint.Tags = append(b.endpoint.Tags, tags...)
+	return b
+}
+
+// Metadata sets a metadata entry of the endpoint
+func (b *Builder) Metadata(key string, value string) *Builder {
+	if b.endpoint.Metadata == nil {
+		b.endpoint.Metadata = map[string]string{}
+	}
+	b.endpoint.Metadata[key] = value
+	return b
+}
+
+// Plugin enables a plugin, replacing its configuration if it is already enabled
+func (b *Builder) Plugin(id string, config map[string]any) *Builder {
+	for i, plugin := range b.endpoint.Plugins {
+		if plugin.Plugin == id {
+			b.endpoint.Plugins[i].Config = config
+			return b
+		}
+	}
+	b.endpoint.Plugins = append(b.endpoint.Plugins, Plugin{Plugin: id, Enabled: true, Config: config})
+	return b
+}
+
+// APIKey requires calls to carry an API key and creates one with the endpoint
+func (b *Builder) APIKey(clientName string) *Builder {
+	b.Plugin(PluginAPIKeyCalls, map[string]any{})
+	if b.apiKey == nil {
+		b.apiKey = &APIKey{Enabled: true}
+	}
+	b.apiKey.ClientName = clientName
+	return b
+}
+
+// RateLimit sets the quotas of the endpoint API key, adding a key if needed.
+// perSecond limits bursts, daily and monthly cap the number of calls; zero
+// leaves a quota to the Otoroshi default instead of allowing no call.
+func (b *Builder) RateLimit(perSecond int64, daily int64, monthly int64) *Builder {
+	if perSecond < 0 || daily < 0 || monthly < 0 {
+		b.err = fmt.Errorf("aiendpoint: invalid rate limit %d/s, %d/day, %d/month", perSecond, daily, monthly)
+		return b
+	}
+	if b.apiKey == nil {
+		b.APIKey(b.endpoint.Name)
+	}
+	b.apiKey.Quotas = Quotas{Throttling: quota(perSecond), Daily: quota(daily), Monthly: quota(monthly)}
+	return b
+}
+
+// quota returns a quota of n calls, or nil for the default quota when n is zero
+func quota(n int64) *int64 {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// Target selects the Otoroshi cluster the endpoint is deployed on
+func (b *Builder) Target(otoroshiTarget string) *Builder {
+	b.target = otoroshiTarget
+	return b
+}
+
+// Endpoint returns the endpoint as built so far
+func (b *Builder) Endpoint() Endpoint {
+	return b.endpoint
+}
+
+// Build validates the endpoint and returns the request creating it
+func (b *Builder) Build() (models.CreateEndpointRequest, error) {
+	if b.err != nil {
+		return models.CreateEndpointRequest{}, b.err
+	}
+	if b.endpoint.Name == "" {
+		return models.CreateEndpointRequest{}, errors.New("aiendpoint: the endpoint needs a name")
+	}
+	if len(b.endpoint.Frontend.Domains) == 0 {
+		return models.CreateEndpointRequest{}, errors.New("aiendpoint: the endpoint needs at least one domain")
+	}
+
+	endpoint, err := toMap(b.endpoint)
+	if err != nil {
+		return models.CreateEndpointRequest{}, err
+	}
+	request := models.CreateEndpointRequest{Endpoint: &endpoint, OtoroshiTarget: b.target}
+
+	if b.apiKey != nil {
+		key, err := toMap(b.apiKey)
+		if err != nil {
+			return models.CreateEndpointRequest{}, err
+		}
+		request.APIKey = &key
+	}
+	return request, nil
+}
@@ -0,0 +1,376 @@
+// Package aiendpoint provides typed models for the Otoroshi resources behind
+// AI add-ons: endpoints, API keys and their quotas, budgets and providers.
+//
+// The AI service forwards these payloads from Otoroshi untouched, so the
+// generated models expose them as map[string]any and several operations
+// return no payload at all. The types below cover the fields the SDK relies
+// on and keep every other field in Extra, so that a payload read, modified
+// and sent back loses nothing.
+package aiendpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Endpoint is an Otoroshi route exposing AI providers
+type Endpoint struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Enabled     bool              `json:"enabled"`
+	Frontend    Frontend          `json:"frontend"`
+	Backend     *Backend          `json:"backend,omitempty"`
+	Plugins     []Plugin          `json:"plugins,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Frontend is the public side of an endpoint
+type Frontend struct {
+	Domains   []string `json:"domains"`
+	StripPath bool     `json:"strip_path"`
+}
+
+// Backend is where Otoroshi forwards requests that no plugin answered
+type Backend struct {
+	Root    string   `json:"root,omitempty"`
+	Targets []Target `json:"targets"`
+}
+
+// Target is a backend server
+type Target struct {
+	Hostname string `json:"hostname"`
+	Port     int    `json:"port"`
+	TLS      bool   `json:"tls"`
+}
+
+// Plugin is a plugin enabled on an endpoint
+type Plugin struct {
+	Plugin  string         `json:"plugin"`
+	Enabled bool           `json:"enabled"`
+	Config  map[string]any `json:"config,omitempty"`
+}
+
+// Plugin returns the plugin with the given identifier
+func (e Endpoint) Plugin(id string) (Plugin, bool) {
+	for _, plugin := range e.Plugins {
+		if plugin.Plugin == id {
+			return plugin, true
+		}
+	}
+	return Plugin{}, false
+}
+
+// MarshalJSON encodes the endpoint along with its extra fields
+func (e Endpoint) MarshalJSON() ([]byte, error) {
+	type alias Endpoint
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
+// UnmarshalJSON decodes the endpoint, keeping unknown fields in Extra
+func (e *Endpoint) UnmarshalJSON(data []byte) error {
+	type alias Endpoint
+	var v alias
+	extra, err := unmarshalWithExtra(data, &v)
+	if err != nil {
+		return err
+	}
+	*e = Endpoint(v)
+	e.Extra = extra
+	return nil
+}
+
+// Quotas limits the calls made with an API key.
+// Zero allows no call; a nil quota is left out and Otoroshi applies its
+// default, the highest quota it accepts.
+type Quotas struct {
+	// Throttling is the number of calls allowed per second
+	Throttling *int64 `json:"throttlingQuota,omitempty"`
+	Daily      *int64 `json:"dailyQuota,omitempty"`
+	Monthly    *int64 `json:"monthlyQuota,omitempty"`
+}
+
+// APIKey is an Otoroshi API key granting access to endpoints
+type APIKey struct {
+	ClientID           string            `json:"clientId,omitempty"`
+	ClientSecret       string            `json:"clientSecret,omitempty"`
+	ClientName         string            `json:"clientName"`
+	Description        string            `json:"description,omitempty"`
+	AuthorizedEntities []string          `json:"authorizedEntities,omitempty"`
+	Enabled            bool              `json:"enabled"`
+	ReadOnly           bool              `json:"readOnly"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Tags               []string          `json:"tags,omitempty"`
+	Quotas
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes the API key along with its extra fields
+func (k APIKey) MarshalJSON() ([]byte, error) {
+	type alias APIKey
+	return marshalWithExtra(alias(k), k.Extra)
+}
+
+// UnmarshalJSON decodes the API key, keeping unknown fields in Extra
+func (k *APIKey) UnmarshalJSON(data []byte) error {
+	type alias APIKey
+	var v alias
+	extra, err := unmarshalWithExtra(data, &v)
+	if err != nil {
+		return err
+	}
+	*k = APIKey(v)
+	k.Extra = extra
+	return nil
+}
+
+// TokenBudget caps the number of tokens; nil leaves a count unbounded
+type TokenBudget struct {
+	TotalTokens  *int64 `json:"total_tokens,omitempty"`
+	InputTokens  *int64 `json:"input_tokens,omitempty"`
+	OutputTokens *int64 `json:"output_tokens,omitempty"`
+}
+
+// CostBudget caps spending; nil leaves a cost unbounded
+type CostBudget struct {
+	TotalCost  *float64 `json:"total_cost,omitempty"`
+	InputCost  *float64 `json:"input_cost,omitempty"`
+	OutputCost *float64 `json:"output_cost,omitempty"`
+}
+
+// BudgetLimits are the token and cost limits of a budget
+type BudgetLimits struct {
+	TokenBudget
+	CostBudget
+}
+
+// Usage is what has been consumed in the current spending window
+type Usage struct {
+	TotalTokens  int64   `json:"total_tokens"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	TotalCost    float64 `json:"total_cost"`
+	InputCost    float64 `json:"input_cost"`
+	OutputCost   float64 `json:"output_cost"`
+}
+
+// SpendingWindow is the period a budget applies to.
+// A budget with a duration starts over every Duration from StartAt.
+type SpendingWindow struct {
+	StartAt  Timestamp  `json:"start_at"`
+	EndAt    *Timestamp `json:"end_at,omitempty"`
+	Duration Millis     `json:"duration,omitempty"`
+}
+
+// Current returns the bounds of the window containing now.
+// ok is false before the start or after the end of the budget.
+func (w SpendingWindow) Current(now time.Time) (start time.Time, end time.Time, ok bool) {
+	start = w.StartAt.Time
+	if now.Before(start) || (w.EndAt != nil && !now.Before(w.EndAt.Time)) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	if d := time.Duration(w.Duration); d > 0 {
+		start = start.Add(now.Sub(start) / d * d)
+		end = start.Add(d)
+	}
+	if w.EndAt != nil && (end.IsZero() || end.After(w.EndAt.Time)) {
+		end = w.EndAt.Time
+	}
+	return start, end, true
+}
+
+// ActionOnExceed tells Otoroshi what to do once a budget is exhausted
+type ActionOnExceed struct {
+	Mode string `json:"mode"`
+}
+
+// Actions on exceed known to Otoroshi
+const (
+	ActionBlock = "block"
+	ActionAlert = "alert"
+)
+
+// Budget limits the tokens and cost spent through an endpoint
+type Budget struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description,omitempty"`
+	Enabled        bool            `json:"enabled"`
+	Limits         BudgetLimits    `json:"limits"`
+	ActionOnExceed *ActionOnExceed `json:"action_on_exceed,omitempty"`
+	Consumption    *Usage          `json:"consumption,omitempty"`
+	SpendingWindow
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes the budget along with its extra fields
+func (b Budget) MarshalJSON() ([]byte, error) {
+	type alias Budget
+	return marshalWithExtra(alias(b), b.Extra)
+}
+
+// UnmarshalJSON decodes the budget, keeping unknown fields in Extra
+func (b *Budget) UnmarshalJSON(data []byte) error {
+	type alias Budget
+	var v alias
+	extra, err := unmarshalWithExtra(data, &v)
+	if err != nil {
+		return err
+	}
+	*b = Budget(v)
+	b.Extra = extra
+	return nil
+}
+
+// ProviderInfo describes an AI provider configured on the Otoroshi cluster
+type ProviderInfo struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Provider    string   `json:"provider"`
+	Models      []string `json:"models,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes the provider along with its extra fields
+func (p ProviderInfo) MarshalJSON() ([]byte, error) {
+	type alias ProviderInfo
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON decodes the provider, keeping unknown fields in Extra
+func (p *ProviderInfo) UnmarshalJSON(data []byte) error {
+	type alias ProviderInfo
+	var v alias
+	extra, err := unmarshalWithExtra(data, &v)
+	if err != nil {
+		return err
+	}
+	*p = ProviderInfo(v)
+	p.Extra = extra
+	return nil
+}
+
+// Timestamp is a point in time sent by Otoroshi either as milliseconds
+// since the epoch or as an RFC 3339 string. It is encoded as milliseconds.
+type Timestamp struct {
+	time.Time
+}
+
+// MarshalJSON encodes the timestamp as milliseconds since the epoch
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.UnixMilli())
+}
+
+// UnmarshalJSON accepts milliseconds since the epoch or an RFC 3339 string
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var ms int64
+	if err := json.Unmarshal(data, &ms); err == nil {
+		t.Time = time.UnixMilli(ms)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("aiendpoint: invalid timestamp %s", data)
+	}
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("aiendpoint: invalid timestamp %q: %w", s, err)
+	}
+	t.Time = parsed
+	return nil
+}
+
+// Millis is a duration encoded as a number of milliseconds
+type Millis time.Duration
+
+// MarshalJSON encodes the duration in milliseconds
+func (m Millis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(m).Milliseconds())
+}
+
+// UnmarshalJSON decodes a number of milliseconds
+func (m *Millis) UnmarshalJSON(data []byte) error {
+	var ms int64
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return fmt.Errorf("aiendpoint: invalid duration %s", data)
+	}
+	*m = Millis(time.Duration(ms) * time.Millisecond)
+	return nil
+}
+
+// marshalWithExtra encodes v and adds the extra fields it does not define
+func marshalWithExtra(v any, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	known := jsonFields(reflect.TypeOf(v))
+	for k, raw := range extra {
+		if !known[k] {
+			fields[k] = raw
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// unmarshalWithExtra decodes data into v and returns the fields v does not define
+func unmarshalWithExtra(data []byte, v any) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k := range jsonFields(reflect.TypeOf(v).Elem()) {
+		delete(fields, k)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// jsonFields returns the JSON keys of a struct, including those of embedded structs
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for k := range jsonFields(field.Type) {
+				fields[k] = true
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+	return fields
+}
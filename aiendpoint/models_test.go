@@ -0,0 +1,118 @@
+package aiendpoint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyKeepsUnknownFields(t *testing.T) {
+	payload := `{"clientId":"abc","clientName":"ci","enabled":true,"readOnly":false,"throttlingQuota":10,"dailyQuota":1000,"monthlyQuota":0,"rotation":{"enabled":true}}`
+
+	var key APIKey
+	if err := json.Unmarshal([]byte(payload), &key); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if key.ClientID != "abc" || *key.Throttling != 10 || *key.Daily != 1000 || key.Monthly == nil || *key.Monthly != 0 {
+		t.Errorf("key = %+v", key)
+	}
+	if len(key.Extra) != 1 || string(key.Extra["rotation"]) != `{"enabled":true}` {
+		t.Errorf("extra = %v, want only rotation", key.Extra)
+	}
+
+	daily := int64(500)
+	key.Daily = &daily
+	data, err := json.Marshal(key)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["dailyQuota"] != float64(500) || fields["rotation"] == nil {
+		t.Errorf("encoded key = %s", data)
+	}
+}
+
+func TestBudgetWindow(t *testing.T) {
+	payload := `{"id":"b1","name":"monthly","enabled":true,"start_at":"2026-01-01T00:00:00Z","duration":86400000,
+		"limits":{"total_tokens":1000000,"total_cost":50.5},"consumption":{"total_tokens":1200,"total_cost":0.3}}`
+
+	var budget Budget
+	if err := json.Unmarshal([]byte(payload), &budget); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if budget.Limits.TotalTokens == nil || *budget.Limits.TotalTokens != 1000000 || budget.Limits.InputTokens != nil {
+		t.Errorf("token limits = %+v", budget.Limits.TokenBudget)
+	}
+	if budget.Limits.TotalCost == nil || *budget.Limits.TotalCost != 50.5 {
+		t.Errorf("cost limits = %+v", budget.Limits.CostBudget)
+	}
+	if budget.Consumption == nil || budget.Consumption.TotalTokens != 1200 {
+		t.Errorf("consumption = %+v", budget.Consumption)
+	}
+	if len(budget.Extra) != 0 {
+		t.Errorf("extra = %v, want none", budget.Extra)
+	}
+
+	now := time.Date(2026, 1, 3, 15, 0, 0, 0, time.UTC)
+	start, end, ok := budget.Current(now)
+	if !ok || !start.Equal(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Current = %v, %v, %v", start, end, ok)
+	}
+	if _, _, ok := budget.Current(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("Current before the start should not be ok")
+	}
+}
+
+func TestOpenAICompatibleBuilder(t *testing.T) {
+	request, err := OpenAICompatible("chat", "chat.example.com", "provider_1").
+		RateLimit(5, 10000, 0).
+		Target("otoroshi-1").
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if request.OtoroshiTarget != "otoroshi-1" || request.Endpoint == nil || request.APIKey == nil {
+		t.Fatalf("request = %+v", request)
+	}
+
+	var endpoint Endpoint
+	if err := convert(*request.Endpoint, &endpoint); err != nil {
+		t.Fatal(err)
+	}
+	proxy, ok := endpoint.Plugin(PluginOpenAICompatProxy)
+	if !ok || len(proxy.Config["refs"].([]any)) != 1 {
+		t.Errorf("proxy plugin = %+v", proxy)
+	}
+	if _, ok := endpoint.Plugin(PluginAPIKeyCalls); !ok {
+		t.Errorf("API key plugin missing from %+v", endpoint.Plugins)
+	}
+
+	var key APIKey
+	if err := convert(*request.APIKey, &key); err != nil {
+		t.Fatal(err)
+	}
+	if key.ClientName != "chat" || *key.Throttling != 5 || *key.Daily != 10000 || key.Monthly != nil {
+		t.Errorf("key = %+v", key)
+	}
+
+	// Without RateLimit the key must not carry quotas, which would allow no call
+	request, err = OpenAICompatible("chat", "chat.example.com", "provider_1").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	for _, field := range []string{"throttlingQuota", "dailyQuota", "monthlyQuota"} {
+		if _, ok := (*request.APIKey)[field]; ok {
+			t.Errorf("key without RateLimit sets %s: %v", field, *request.APIKey)
+		}
+	}
+
+	if _, err := OpenAICompatible("chat", "chat.example.com").Build(); err == nil {
+		t.Errorf("expected an error without providers")
+	}
+	if _, err := NewEndpoint("chat").Build(); err == nil {
+		t.Errorf("expected an error without domain")
+	}
+}
@@ -0,0 +1,180 @@
+// Package audit rebuilds change timelines from the audit streams of the API.
+//
+// DNS, load balancer and IPAM audits are normalized into Entry values by a
+// Reader. Entries are grouped per audited subject into a Timeline, which
+// answers who changed a subject and when, and what it looked like at any
+// point in time.
+package audit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	dns "go.clever-cloud.dev/sdk/services/dns"
+	ipam "go.clever-cloud.dev/sdk/services/ipam"
+	loadbalancer "go.clever-cloud.dev/sdk/services/loadbalancer"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Source identifies the product an audit entry comes from
+type Source string
+
+const (
+	SourceDNS          Source = "dns"
+	SourceLoadBalancer Source = "loadbalancer"
+	SourceIPAM         Source = "ipam"
+)
+
+// String returns the underlying string value
+func (s Source) String() string {
+	return string(s)
+}
+
+// Entry is a product independent audit event
+type Entry struct {
+	Source     Source         `json:"source"`
+	OwnerID    ids.OwnerID    `json:"ownerId"`
+	ResourceID ids.ResourceID `json:"resourceId"`
+	// SubjectID is the audited object: the record ID for DNS, the resource ID otherwise
+	SubjectID string    `json:"subjectId"`
+	Kind      string    `json:"kind"`
+	UserID    string    `json:"userId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Attributes holds the audited values, such as a DNS record content and TTL
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Context    any               `json:"context,omitempty"`
+}
+
+// Reader reads an audit stream
+type Reader interface {
+	Read(ctx context.Context) ([]Entry, error)
+}
+
+// ReaderFunc adapts a function to the Reader interface
+type ReaderFunc func(ctx context.Context) ([]Entry, error)
+
+// Read calls f(ctx)
+func (f ReaderFunc) Read(ctx context.Context) ([]Entry, error) {
+	return f(ctx)
+}
+
+// FromDnsAudit converts a DNS audit entry
+func FromDnsAudit(a models.DnsAudit) Entry {
+	return Entry{
+		Source:     SourceDNS,
+		OwnerID:    ids.OwnerID(a.OwnerID),
+		ResourceID: ids.ResourceID(a.ResourceID),
+		SubjectID:  a.RecordID,
+		Kind:       a.Kind.String(),
+		UserID:     a.UserID,
+		CreatedAt:  a.CreatedAt,
+		Attributes: map[string]string{
+			"name":    a.Name,
+			"type":    a.RecordType.String(),
+			"content": a.Content,
+			"ttl":     strconv.Itoa(a.TTL),
+		},
+		Context: a.Context,
+	}
+}
+
+// FromLoadBalancerAudit converts a load balancer audit entry
+func FromLoadBalancerAudit(a models.LoadBalancerAudit) Entry {
+	return Entry{
+		Source:     SourceLoadBalancer,
+		OwnerID:    ids.OwnerID(a.OwnerID),
+		ResourceID: ids.ResourceID(a.ResourceID),
+		SubjectID:  a.ResourceID,
+		Kind:       a.Kind.String(),
+		CreatedAt:  a.CreatedAt,
+		Context:    a.Context,
+	}
+}
+
+// FromIpamAudit converts an IPAM audit entry
+func FromIpamAudit(a models.IpamAudit1) Entry {
+	return Entry{
+		Source:     SourceIPAM,
+		OwnerID:    ids.OwnerID(a.OwnerID),
+		ResourceID: ids.ResourceID(a.ResourceID),
+		SubjectID:  a.ResourceID,
+		Kind:       a.Kind.String(),
+		UserID:     a.UserID,
+		CreatedAt:  a.CreatedAt,
+		Context:    a.Context,
+	}
+}
+
+// DNSReader reads DNS audits of a tenant, narrowed to a resource and a record when set
+func DNSReader(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID, recordID ids.RecordID) Reader {
+	return ReaderFunc(func(ctx context.Context) ([]Entry, error) {
+		var response client.Response[[]models.DnsAudit]
+		switch {
+		case recordID != "":
+			response = dns.Listdnsaudit(ctx, c, tracer, tenantID, resourceID, recordID)
+		case resourceID != "":
+			response = dns.Listdnsauditsforresource(ctx, c, tracer, tenantID, resourceID)
+		default:
+			response = dns.Listdnsauditsforowner(ctx, c, tracer, tenantID)
+		}
+		return convert(response, FromDnsAudit)
+	})
+}
+
+// LoadBalancerReader reads load balancer audits of a tenant, narrowed to a resource when set
+func LoadBalancerReader(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID) Reader {
+	return ReaderFunc(func(ctx context.Context) ([]Entry, error) {
+		var response client.Response[[]models.LoadBalancerAudit]
+		if resourceID != "" {
+			response = loadbalancer.Listauditsforresource(ctx, c, tracer, tenantID, resourceID)
+		} else {
+			response = loadbalancer.Listauditsfortenant(ctx, c, tracer, tenantID)
+		}
+		return convert(response, FromLoadBalancerAudit)
+	})
+}
+
+// IpamReader reads IPAM audits of a tenant, narrowed to a resource when set
+func IpamReader(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID) Reader {
+	return ReaderFunc(func(ctx context.Context) ([]Entry, error) {
+		var response client.Response[[]models.IpamAudit1]
+		if resourceID != "" {
+			response = ipam.Listipamauditsforresource(ctx, c, tracer, tenantID, ids.AuditIPAMResourceID(resourceID))
+		} else {
+			response = ipam.Listauditsforowner(ctx, c, tracer, tenantID)
+		}
+		return convert(response, FromIpamAudit)
+	})
+}
+
+// MultiReader concatenates the entries of several readers
+func MultiReader(readers ...Reader) Reader {
+	return ReaderFunc(func(ctx context.Context) ([]Entry, error) {
+		var entries []Entry
+		for _, r := range readers {
+			read, err := r.Read(ctx)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, read...)
+		}
+		return entries, nil
+	})
+}
+
+func convert[T any](response client.Response[[]T], fn func(T) Entry) ([]Entry, error) {
+	if response.HasError() {
+		return nil, response.Error()
+	}
+
+	audits := *response.Payload()
+	entries := make([]Entry, 0, len(audits))
+	for _, a := range audits {
+		entries = append(entries, fn(a))
+	}
+	return entries, nil
+}
@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+var csvHeader = []string{"created_at", "source", "owner_id", "resource_id", "subject_id", "kind", "user_id", "attributes"}
+
+// WriteCSV writes entries as CSV, one line per entry.
+// Attributes are rendered as sorted key=value pairs separated by semicolons.
+func WriteCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		keys := make([]string, 0, len(e.Attributes))
+		for k := range e.Attributes {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		attributes := make([]string, 0, len(keys))
+		for _, k := range keys {
+			attributes = append(attributes, k+"="+e.Attributes[k])
+		}
+
+		err := cw.Write([]string{
+			e.CreatedAt.UTC().Format(time.RFC3339),
+			e.Source.String(),
+			string(e.OwnerID),
+			string(e.ResourceID),
+			e.SubjectID,
+			e.Kind,
+			e.UserID,
+			strings.Join(attributes, ";"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes timelines as an indented JSON array
+func WriteJSON(w io.Writer, timelines []Timeline) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(timelines)
+}
@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+)
+
+// State is what a subject looked like after a sequence of audit entries
+type State struct {
+	Exists     bool              `json:"exists"`
+	Frozen     bool              `json:"frozen"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Context    any               `json:"context,omitempty"`
+	// LastChange is the entry that produced this state
+	LastChange Entry `json:"lastChange"`
+}
+
+// apply returns the state following an entry
+func (s State) apply(e Entry) State {
+	next := s
+	next.LastChange = e
+
+	switch e.Kind {
+	case "CREATE", "UPDATE", "REGISTER":
+		next.Exists = true
+		if len(e.Attributes) > 0 {
+			next.Attributes = maps.Clone(e.Attributes)
+		}
+		if e.Context != nil {
+			next.Context = e.Context
+		}
+	case "DELETE", "UNREGISTER":
+		next.Exists = false
+	case "FREEZE":
+		next.Frozen = true
+	case "UNFREEZE":
+		next.Frozen = false
+	}
+	return next
+}
+
+// Change is one entry of a timeline with the state before and after it
+type Change struct {
+	Entry  Entry `json:"entry"`
+	Before State `json:"before"`
+	After  State `json:"after"`
+}
+
+// Timeline is the ordered audit history of one subject
+type Timeline struct {
+	Source    Source  `json:"source"`
+	SubjectID string  `json:"subjectId"`
+	Entries   []Entry `json:"entries"`
+}
+
+// Timelines groups entries per subject, each timeline sorted by date.
+// Timelines are ordered by their first entry.
+func Timelines(entries []Entry) []Timeline {
+	type key struct {
+		source  Source
+		subject string
+	}
+
+	bySubject := map[key]*Timeline{}
+	for _, e := range entries {
+		k := key{e.Source, e.SubjectID}
+		t, ok := bySubject[k]
+		if !ok {
+			t = &Timeline{Source: e.Source, SubjectID: e.SubjectID}
+			bySubject[k] = t
+		}
+		t.Entries = append(t.Entries, e)
+	}
+
+	timelines := make([]Timeline, 0, len(bySubject))
+	for _, t := range bySubject {
+		slices.SortStableFunc(t.Entries, func(a, b Entry) int {
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+		timelines = append(timelines, *t)
+	}
+	slices.SortFunc(timelines, func(a, b Timeline) int {
+		return cmp.Or(
+			a.Entries[0].CreatedAt.Compare(b.Entries[0].CreatedAt),
+			cmp.Compare(a.Source, b.Source),
+			cmp.Compare(a.SubjectID, b.SubjectID),
+		)
+	})
+	return timelines
+}
+
+// StateAt rebuilds the subject state at t.
+// ok is false when the timeline has no entry at or before t.
+func (t Timeline) StateAt(at time.Time) (state State, ok bool) {
+	for _, e := range t.Entries {
+		if e.CreatedAt.After(at) {
+			break
+		}
+		state = state.apply(e)
+		ok = true
+	}
+	return state, ok
+}
+
+// Changes returns every entry of the timeline with the surrounding states
+func (t Timeline) Changes() []Change {
+	changes := make([]Change, 0, len(t.Entries))
+	var state State
+	for _, e := range t.Entries {
+		next := state.apply(e)
+		changes = append(changes, Change{Entry: e, Before: state, After: next})
+		state = next
+	}
+	return changes
+}
+
+// Filter returns the entries for which keep returns true
+func Filter(entries []Entry, keep func(Entry) bool) []Entry {
+	var kept []Entry
+	for _, e := range entries {
+		if keep(e) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// Between keeps entries created in [since, until)
+func Between(since, until time.Time) func(Entry) bool {
+	return func(e Entry) bool {
+		return !e.CreatedAt.Before(since) && e.CreatedAt.Before(until)
+	}
+}
+
+// ByUser keeps entries made by userID
+func ByUser(userID string) func(Entry) bool {
+	return func(e Entry) bool {
+		return e.UserID == userID
+	}
+}
@@ -0,0 +1,79 @@
+package audit
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+func TestTimelineStateAt(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	entries := []Entry{
+		FromDnsAudit(models.DnsAudit{RecordID: "rec_1", Kind: models.WriteActionTypeDELETE, UserID: "user_b", CreatedAt: t0.Add(2 * time.Hour), Name: "www.example.com", RecordType: models.DNSRecordTypeA, Content: "192.0.2.1", TTL: 300}),
+		FromDnsAudit(models.DnsAudit{RecordID: "rec_1", Kind: models.WriteActionTypeCREATE, UserID: "user_a", CreatedAt: t0, Name: "www.example.com", RecordType: models.DNSRecordTypeA, Content: "192.0.2.1", TTL: 300}),
+		FromIpamAudit(models.IpamAudit1{ResourceID: "app_1", Kind: models.WriteActionType2FREEZE, UserID: "user_c", CreatedAt: t0.Add(time.Hour)}),
+	}
+
+	timelines := Timelines(entries)
+	if len(timelines) != 2 {
+		t.Fatalf("got %d timelines, want 2", len(timelines))
+	}
+
+	dnsTimeline := timelines[0]
+	if dnsTimeline.Source != SourceDNS || dnsTimeline.SubjectID != "rec_1" {
+		t.Fatalf("first timeline = %s/%s, want dns/rec_1", dnsTimeline.Source, dnsTimeline.SubjectID)
+	}
+
+	if _, ok := dnsTimeline.StateAt(t0.Add(-time.Minute)); ok {
+		t.Errorf("expected no state before the first entry")
+	}
+
+	state, ok := dnsTimeline.StateAt(t0.Add(time.Hour))
+	if !ok || !state.Exists || state.Attributes["content"] != "192.0.2.1" || state.LastChange.UserID != "user_a" {
+		t.Errorf("state after creation = %+v", state)
+	}
+
+	state, _ = dnsTimeline.StateAt(t0.Add(3 * time.Hour))
+	if state.Exists || state.LastChange.UserID != "user_b" {
+		t.Errorf("state after deletion = %+v", state)
+	}
+
+	changes := dnsTimeline.Changes()
+	if len(changes) != 2 || !changes[1].Before.Exists || changes[1].After.Exists {
+		t.Errorf("changes = %+v", changes)
+	}
+
+	ipamState, _ := timelines[1].StateAt(t0.Add(time.Hour))
+	if !ipamState.Frozen {
+		t.Errorf("ipam subject should be frozen")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	entries := []Entry{{
+		Source:     SourceDNS,
+		SubjectID:  "rec_1",
+		Kind:       "CREATE",
+		UserID:     "user_a",
+		CreatedAt:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Attributes: map[string]string{"ttl": "300", "content": "a,b"},
+	}}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, entries); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want header and one entry", len(lines))
+	}
+	want := `2024-05-01T10:00:00Z,dns,,,rec_1,CREATE,user_a,"content=a,b;ttl=300"`
+	if lines[1] != want {
+		t.Errorf("line = %s, want %s", lines[1], want)
+	}
+}
@@ -0,0 +1,237 @@
+// Package billing reports the consumption and cost of an owner across products.
+//
+// A Reporter fans out to the consumption endpoint of every product for a
+// time window, normalizes units and prices the consumption with a price
+// table. Reports aggregate by product, region and resource, export as CSV or
+// JSON and compare month over month.
+//
+// Expand turns the detailed quantities of a consumption into time series,
+// which Resample, Merge and Gaps align, combine and check for missing buckets.
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	cellar "go.clever-cloud.dev/sdk/services/cellar"
+	ipam "go.clever-cloud.dev/sdk/services/ipam"
+	keycloak "go.clever-cloud.dev/sdk/services/keycloak"
+	kubernetes "go.clever-cloud.dev/sdk/services/kubernetes"
+	loadbalancer "go.clever-cloud.dev/sdk/services/loadbalancer"
+	metabase "go.clever-cloud.dev/sdk/services/metabase"
+	otoroshi "go.clever-cloud.dev/sdk/services/otoroshi"
+	pulsar "go.clever-cloud.dev/sdk/services/pulsar"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Window is the time range consumption is reported for, Until excluded
+type Window struct {
+	Since time.Time `json:"since"`
+	Until time.Time `json:"until"`
+}
+
+// Month returns the window of a calendar month
+func Month(year int, month time.Month, loc *time.Location) Window {
+	since := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return Window{Since: since, Until: since.AddDate(0, 1, 0)}
+}
+
+// Previous returns the window of the same length right before w. For a
+// calendar month it is the previous calendar month.
+func (w Window) Previous() Window {
+	if w.Since.Day() == 1 && w.Until.Equal(w.Since.AddDate(0, 1, 0)) {
+		return Window{Since: w.Since.AddDate(0, -1, 0), Until: w.Since}
+	}
+	return Window{Since: w.Since.Add(-w.Until.Sub(w.Since)), Until: w.Since}
+}
+
+func (w Window) String() string {
+	return w.Since.Format(time.RFC3339) + "/" + w.Until.Format(time.RFC3339)
+}
+
+// ListFunc lists the consumption of an owner during a window
+type ListFunc func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption]
+
+// Source is the consumption endpoint of a product
+type Source struct {
+	Product string
+	List    ListFunc
+}
+
+// DefaultSources returns the consumption endpoints of every product.
+// Keycloak, Otoroshi and Metabase list the consumption of every owner; the
+// Reporter keeps the lines of the requested one.
+func DefaultSources() []Source {
+	return []Source{
+		{Product: "kubernetes", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return kubernetes.Listkubernetesconsumptions(ctx, c, tracer, ownerID, kubernetes.WithSince(formatTime(window.Since)), kubernetes.WithUntil(formatTime(window.Until)))
+		}},
+		{Product: "pulsar", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return pulsar.Listpulsarconsumptions(ctx, c, tracer, ownerID, pulsar.WithSince(formatTime(window.Since)), pulsar.WithUntil(formatTime(window.Until)))
+		}},
+		{Product: "ipam", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return ipam.Listipamconsumptions(ctx, c, tracer, ownerID, ipam.WithSince(formatTime(window.Since)), ipam.WithUntil(formatTime(window.Until)))
+		}},
+		{Product: "loadbalancer", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return loadbalancer.Listloadbalancerconsumptions(ctx, c, tracer, ownerID, loadbalancer.WithSince(formatTime(window.Since)), loadbalancer.WithUntil(formatTime(window.Until)))
+		}},
+		{Product: "cellar", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return cellar.Listcellarconsumptions(ctx, c, tracer, ownerID, cellar.WithSince(formatTime(window.Since)), cellar.WithUntil(formatTime(window.Until)))
+		}},
+		{Product: "keycloak", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, _ ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return keycloak.Listallkeycloakconsumption(ctx, c, tracer, &models.KeycloakConsumptionQuery{Since: window.Since, Until: window.Until})
+		}},
+		{Product: "otoroshi", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, _ ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return otoroshi.Listallotoroshiproductconsole(ctx, c, tracer, &models.OtoroshiConsumptionQuery{Since: window.Since, Until: window.Until})
+		}},
+		{Product: "metabase", List: func(ctx context.Context, c *client.Client, tracer trace.Tracer, _ ids.OwnerID, window Window) client.Response[[]models.ResourceConsumption] {
+			return metabase.Listallmetabaseproductconsole(ctx, c, tracer, &models.MetabaseConsumptionQuery{Since: window.Since, Until: window.Until})
+		}},
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Reporter builds consumption reports
+type Reporter struct {
+	client *client.Client
+	tracer trace.Tracer
+
+	sources []Source
+	units   map[string]Conversion
+	prices  *PriceTable
+}
+
+// Option defines configuration options for the Reporter
+type Option func(*Reporter)
+
+// WithSources replaces the products the Reporter fans out to
+func WithSources(sources ...Source) Option {
+	return func(r *Reporter) {
+		r.sources = sources
+	}
+}
+
+// WithUnits adds or overrides unit conversions, keyed by raw unit
+func WithUnits(units map[string]Conversion) Option {
+	return func(r *Reporter) {
+		for unit, conversion := range units {
+			r.units[normalizeUnitKey(unit)] = conversion
+		}
+	}
+}
+
+// WithPrices prices the lines of reports
+func WithPrices(prices PriceTable) Option {
+	return func(r *Reporter) {
+		r.prices = &prices
+	}
+}
+
+// New creates a Reporter
+func New(c *client.Client, tracer trace.Tracer, opts ...Option) *Reporter {
+	r := &Reporter{
+		client:  c,
+		tracer:  tracer,
+		sources: DefaultSources(),
+		units:   DefaultUnits(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Report lists the consumption of an owner during a window on every product.
+// When some products fail the report holds the others and the errors are joined.
+func (r *Reporter) Report(ctx context.Context, ownerID ids.OwnerID, window Window) (Report, error) {
+	ctx, span := r.tracer.Start(ctx, "billing.Report", trace.WithAttributes(
+		attribute.String("ownerId", string(ownerID)),
+		attribute.String("window", window.String()),
+	))
+	defer span.End()
+
+	report := Report{OwnerID: string(ownerID), Window: window}
+	if r.prices != nil {
+		report.Currency = r.prices.Currency
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, source := range r.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			response := source.List(ctx, r.client, r.tracer, ownerID, window)
+			mu.Lock()
+			defer mu.Unlock()
+			if response.HasError() {
+				errs = append(errs, fmt.Errorf("billing: %s: %w", source.Product, response.Error()))
+				return
+			}
+			if response.Payload() == nil {
+				return
+			}
+			for _, consumption := range *response.Payload() {
+				if consumption.OwnerID != string(ownerID) {
+					continue
+				}
+				report.Lines = append(report.Lines, r.lines(source.Product, consumption)...)
+			}
+		}()
+	}
+	wg.Wait()
+
+	report.sort()
+	err := errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return report, err
+}
+
+// lines normalizes and prices the items of a resource consumption
+func (r *Reporter) lines(product string, consumption models.ResourceConsumption) []Line {
+	lines := make([]Line, 0, len(consumption.Consumptions))
+	for _, item := range consumption.Consumptions {
+		line := Line{
+			Product:    product,
+			RegionID:   consumption.RegionID,
+			ResourceID: consumption.ResourceID,
+			Reference:  item.Reference,
+			RawUnit:    item.Unit,
+		}
+		if item.ResourceID != nil && *item.ResourceID != "" {
+			line.ResourceID = *item.ResourceID
+		}
+		line.Unit, line.Quantity = normalize(r.units, item.Unit, item.Quantity)
+		if r.prices != nil {
+			if price, ok := r.prices.Lookup(line); ok {
+				line.Cost, line.Priced = price*line.Quantity, true
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// MonthOverMonth reports the consumption of an owner during a window and the
+// one before it, and compares them by dimensions
+func (r *Reporter) MonthOverMonth(ctx context.Context, ownerID ids.OwnerID, window Window, dims ...Dimension) ([]Delta, error) {
+	previous, previousErr := r.Report(ctx, ownerID, window.Previous())
+	current, currentErr := r.Report(ctx, ownerID, window)
+	return Compare(previous, current, dims...), errors.Join(previousErr, currentErr)
+}
@@ -0,0 +1,147 @@
+package billing
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+func TestPrevious(t *testing.T) {
+	march := Month(2024, time.March, time.UTC)
+	if got, want := march.Previous(), Month(2024, time.February, time.UTC); got != want {
+		t.Errorf("Previous() = %v, want %v", got, want)
+	}
+
+	since := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	week := Window{Since: since, Until: since.AddDate(0, 0, 7)}
+	want := Window{Since: since.AddDate(0, 0, -7), Until: since}
+	if got := week.Previous(); got != want {
+		t.Errorf("Previous() = %v, want %v", got, want)
+	}
+}
+
+func TestLines(t *testing.T) {
+	r := &Reporter{units: DefaultUnits(), prices: &PriceTable{
+		Currency: "EUR",
+		Prices: map[string]float64{
+			"cellar.storage": 0.02,
+			"pulsar/GiB":     0.05,
+		},
+	}}
+	nodeID := "node_1"
+
+	lines := r.lines("cellar", models.ResourceConsumption{
+		RegionID:   "par",
+		ResourceID: "cellar_1",
+		Consumptions: []models.ConsumptionItem{
+			{Reference: "cellar.storage", Quantity: 512 * (1 << 20), Unit: "B"},
+			{Reference: "cellar.nodes", Quantity: 90, Unit: "min", ResourceID: &nodeID},
+		},
+	})
+	lines = append(lines, r.lines("pulsar", models.ResourceConsumption{
+		RegionID:     "par",
+		ResourceID:   "pulsar_1",
+		Consumptions: []models.ConsumptionItem{{Reference: "pulsar.storage", Quantity: 3, Unit: "GiB"}},
+	})...)
+
+	want := []Line{
+		{Product: "cellar", RegionID: "par", ResourceID: "cellar_1", Reference: "cellar.storage", Unit: "GiB", Quantity: 0.5, RawUnit: "B", Cost: 0.01, Priced: true},
+		{Product: "cellar", RegionID: "par", ResourceID: "node_1", Reference: "cellar.nodes", Unit: "h", Quantity: 1.5, RawUnit: "min"},
+		{Product: "pulsar", RegionID: "par", ResourceID: "pulsar_1", Reference: "pulsar.storage", Unit: "GiB", Quantity: 3, RawUnit: "GiB", Cost: 0.15, Priced: true},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+	for i := range want {
+		got := lines[i]
+		if math.Abs(got.Cost-want[i].Cost) < 1e-9 {
+			got.Cost = want[i].Cost
+		}
+		if got != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func testReport(costs map[string]float64) Report {
+	report := Report{Currency: "EUR"}
+	for resource, cost := range costs {
+		product := "cellar"
+		if resource[0] == 'p' {
+			product = "pulsar"
+		}
+		report.Lines = append(report.Lines, Line{Product: product, RegionID: "par", ResourceID: resource, Unit: "GiB", Quantity: cost * 10, Cost: cost, Priced: true})
+	}
+	report.sort()
+	return report
+}
+
+func TestTotals(t *testing.T) {
+	report := testReport(map[string]float64{"c1": 1, "c2": 2, "p1": 4})
+	report.Lines = append(report.Lines, Line{Product: "pulsar", RegionID: "par", ResourceID: "p1", Unit: "h", Quantity: 5})
+
+	totals := report.Totals(ByProduct)
+	if len(totals) != 2 {
+		t.Fatalf("got %d totals, want 2", len(totals))
+	}
+	cellar, pulsar := totals[0], totals[1]
+	if cellar.Product != "cellar" || cellar.Cost != 3 || cellar.Quantities["GiB"] != 30 || cellar.Unpriced != 0 {
+		t.Errorf("cellar total = %+v", cellar)
+	}
+	if pulsar.Product != "pulsar" || pulsar.Cost != 4 || pulsar.Quantities["h"] != 5 || pulsar.Unpriced != 1 {
+		t.Errorf("pulsar total = %+v", pulsar)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTotalsCSV(&buf, totals); err != nil {
+		t.Fatalf("WriteTotalsCSV failed: %v", err)
+	}
+	want := "product,region,resource,reference,quantities,cost,unpriced\n" +
+		"cellar,,,,30 GiB,3,0\n" +
+		"pulsar,,,,40 GiB; 5 h,4,1\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	previous := testReport(map[string]float64{"c1": 2, "c2": 1})
+	current := testReport(map[string]float64{"c1": 3, "p1": 4})
+
+	deltas := Compare(previous, current, ByResource)
+	if len(deltas) != 3 {
+		t.Fatalf("got %d deltas, want 3", len(deltas))
+	}
+
+	if d := deltas[0]; d.ResourceID != "c1" || d.Change() != 1 {
+		t.Errorf("c1 delta = %+v", d)
+	} else if ratio, ok := d.Ratio(); !ok || ratio != 0.5 {
+		t.Errorf("c1 ratio = %v, %v", ratio, ok)
+	}
+	if d := deltas[1]; d.ResourceID != "c2" || d.Change() != -1 {
+		t.Errorf("c2 delta = %+v", d)
+	}
+	if d := deltas[2]; d.ResourceID != "p1" || d.Change() != 4 {
+		t.Errorf("p1 delta = %+v", d)
+	} else if _, ok := d.Ratio(); ok {
+		t.Error("p1 has a ratio without a previous cost")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	report := testReport(map[string]float64{"c1": 1.5})
+	report.Lines[0].RawUnit = "B"
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	want := "product,region,resource,reference,quantity,unit,raw_unit,cost,currency,priced\n" +
+		"cellar,par,c1,,15,GiB,B,1.5,EUR,true\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
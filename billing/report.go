@@ -0,0 +1,304 @@
+package billing
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Line is the consumption of a resource for one reference
+type Line struct {
+	Product    string  `json:"product"`
+	RegionID   string  `json:"regionId"`
+	ResourceID string  `json:"resourceId"`
+	Reference  string  `json:"reference"`
+	Unit       string  `json:"unit"`
+	Quantity   float64 `json:"quantity"`
+	// RawUnit is the unit the product reported the quantity in
+	RawUnit string  `json:"rawUnit"`
+	Cost    float64 `json:"cost"`
+	// Priced is false when the price table has no price for the line
+	Priced bool `json:"priced"`
+}
+
+// Report is the consumption of an owner during a window
+type Report struct {
+	OwnerID  string `json:"ownerId"`
+	Window   Window `json:"window"`
+	Currency string `json:"currency,omitempty"`
+	Lines    []Line `json:"lines"`
+}
+
+func (r *Report) sort() {
+	slices.SortFunc(r.Lines, func(a, b Line) int {
+		return cmp.Or(
+			cmp.Compare(a.Product, b.Product),
+			cmp.Compare(a.RegionID, b.RegionID),
+			cmp.Compare(a.ResourceID, b.ResourceID),
+			cmp.Compare(a.Reference, b.Reference),
+			cmp.Compare(a.Unit, b.Unit),
+		)
+	})
+}
+
+// Cost returns the total cost of the report
+func (r Report) Cost() float64 {
+	var cost float64
+	for _, line := range r.Lines {
+		cost += line.Cost
+	}
+	return cost
+}
+
+// Unpriced returns the lines the price table has no price for
+func (r Report) Unpriced() []Line {
+	var unpriced []Line
+	for _, line := range r.Lines {
+		if !line.Priced {
+			unpriced = append(unpriced, line)
+		}
+	}
+	return unpriced
+}
+
+// PriceTable prices consumption per canonical unit. A line is priced by its
+// reference, or else by its product and unit as "product/unit".
+type PriceTable struct {
+	Currency string             `json:"currency"`
+	Prices   map[string]float64 `json:"prices"`
+}
+
+// ReadPriceTable reads a price table from JSON
+func ReadPriceTable(r io.Reader) (PriceTable, error) {
+	var table PriceTable
+	err := json.NewDecoder(r).Decode(&table)
+	return table, err
+}
+
+// Lookup returns the price of one unit of a line
+func (t PriceTable) Lookup(line Line) (float64, bool) {
+	if price, ok := t.Prices[line.Reference]; ok {
+		return price, true
+	}
+	price, ok := t.Prices[line.Product+"/"+line.Unit]
+	return price, ok
+}
+
+// Dimension is what totals are grouped by
+type Dimension int
+
+const (
+	ByProduct Dimension = iota
+	ByRegion
+	ByResource
+	ByReference
+)
+
+// Group identifies a total. Only the fields of the dimensions the totals
+// are grouped by are set.
+type Group struct {
+	Product    string `json:"product,omitempty"`
+	RegionID   string `json:"regionId,omitempty"`
+	ResourceID string `json:"resourceId,omitempty"`
+	Reference  string `json:"reference,omitempty"`
+}
+
+func groupOf(line Line, dims []Dimension) Group {
+	var g Group
+	for _, dim := range dims {
+		switch dim {
+		case ByProduct:
+			g.Product = line.Product
+		case ByRegion:
+			g.RegionID = line.RegionID
+		case ByResource:
+			g.ResourceID = line.ResourceID
+		case ByReference:
+			g.Reference = line.Reference
+		}
+	}
+	return g
+}
+
+func compareGroups(a, b Group) int {
+	return cmp.Or(
+		cmp.Compare(a.Product, b.Product),
+		cmp.Compare(a.RegionID, b.RegionID),
+		cmp.Compare(a.ResourceID, b.ResourceID),
+		cmp.Compare(a.Reference, b.Reference),
+	)
+}
+
+// Total is the consumption and cost of a group of lines
+type Total struct {
+	Group
+	// Quantities are summed by unit
+	Quantities map[string]float64 `json:"quantities"`
+	Cost       float64            `json:"cost"`
+	Unpriced   int                `json:"unpriced,omitempty"`
+}
+
+// Totals aggregates the lines of the report by dimensions, ordered by group
+func (r Report) Totals(dims ...Dimension) []Total {
+	byGroup := map[Group]*Total{}
+	for _, line := range r.Lines {
+		g := groupOf(line, dims)
+		total, ok := byGroup[g]
+		if !ok {
+			total = &Total{Group: g, Quantities: map[string]float64{}}
+			byGroup[g] = total
+		}
+		total.Quantities[line.Unit] += line.Quantity
+		total.Cost += line.Cost
+		if !line.Priced {
+			total.Unpriced++
+		}
+	}
+
+	totals := make([]Total, 0, len(byGroup))
+	for _, total := range byGroup {
+		totals = append(totals, *total)
+	}
+	slices.SortFunc(totals, func(a, b Total) int { return compareGroups(a.Group, b.Group) })
+	return totals
+}
+
+// Delta is the change of a group between two reports
+type Delta struct {
+	Group
+	Previous Total `json:"previous"`
+	Current  Total `json:"current"`
+}
+
+// Change returns how much the cost changed
+func (d Delta) Change() float64 {
+	return d.Current.Cost - d.Previous.Cost
+}
+
+// Ratio returns the change of the cost relative to the previous one, false
+// when there was no previous cost
+func (d Delta) Ratio() (float64, bool) {
+	if d.Previous.Cost == 0 {
+		return 0, false
+	}
+	return d.Change() / d.Previous.Cost, true
+}
+
+// Compare returns the change of every group from the previous report to the
+// current one, ordered by group. Groups missing from a report have a zero total.
+func Compare(previous Report, current Report, dims ...Dimension) []Delta {
+	deltas := map[Group]*Delta{}
+	delta := func(g Group) *Delta {
+		d, ok := deltas[g]
+		if !ok {
+			d = &Delta{Group: g}
+			deltas[g] = d
+		}
+		return d
+	}
+	for _, total := range previous.Totals(dims...) {
+		delta(total.Group).Previous = total
+	}
+	for _, total := range current.Totals(dims...) {
+		delta(total.Group).Current = total
+	}
+
+	result := make([]Delta, 0, len(deltas))
+	for _, d := range deltas {
+		result = append(result, *d)
+	}
+	slices.SortFunc(result, func(a, b Delta) int { return compareGroups(a.Group, b.Group) })
+	return result
+}
+
+// WriteJSON writes the report as an indented JSON document
+func (r Report) WriteJSON(w io.Writer) error {
+	return writeJSON(w, r)
+}
+
+// WriteCSV writes the lines of the report as CSV with a header row
+func (r Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"product", "region", "resource", "reference", "quantity", "unit", "raw_unit", "cost", "currency", "priced"})
+	for _, line := range r.Lines {
+		cw.Write([]string{
+			line.Product,
+			line.RegionID,
+			line.ResourceID,
+			line.Reference,
+			formatFloat(line.Quantity),
+			line.Unit,
+			line.RawUnit,
+			formatFloat(line.Cost),
+			r.Currency,
+			strconv.FormatBool(line.Priced),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteTotalsCSV writes totals as CSV with a header row. Quantities of
+// several units share a cell, separated by semicolons.
+func WriteTotalsCSV(w io.Writer, totals []Total) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"product", "region", "resource", "reference", "quantities", "cost", "unpriced"})
+	for _, total := range totals {
+		cw.Write(append(groupRecord(total.Group), formatQuantities(total.Quantities), formatFloat(total.Cost), strconv.Itoa(total.Unpriced)))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteDeltasCSV writes a comparison as CSV with a header row. The ratio is
+// empty for groups without a previous cost.
+func WriteDeltasCSV(w io.Writer, deltas []Delta) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"product", "region", "resource", "reference", "previous_cost", "current_cost", "change", "ratio"})
+	for _, d := range deltas {
+		ratio := ""
+		if r, ok := d.Ratio(); ok {
+			ratio = formatFloat(r)
+		}
+		cw.Write(append(groupRecord(d.Group), formatFloat(d.Previous.Cost), formatFloat(d.Current.Cost), formatFloat(d.Change()), ratio))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteTotalsJSON writes totals as an indented JSON document
+func WriteTotalsJSON(w io.Writer, totals []Total) error {
+	return writeJSON(w, totals)
+}
+
+// WriteDeltasJSON writes a comparison as an indented JSON document
+func WriteDeltasJSON(w io.Writer, deltas []Delta) error {
+	return writeJSON(w, deltas)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func groupRecord(g Group) []string {
+	return []string{g.Product, g.RegionID, g.ResourceID, g.Reference}
+}
+
+func formatQuantities(quantities map[string]float64) string {
+	parts := make([]string, 0, len(quantities))
+	for _, unit := range slices.Sorted(maps.Keys(quantities)) {
+		parts = append(parts, formatFloat(quantities[unit])+" "+unit)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,362 @@
+package billing
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// ErrInterval is returned for consumption intervals that cannot be parsed
+var ErrInterval = errors.New("billing: invalid interval")
+
+// Interval is the step between the quantities of a consumption detail.
+// Months and days are calendar steps, so that monthly buckets starting on
+// the first of a month start on the first of every month.
+type Interval struct {
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+var (
+	namedIntervals = map[string]Interval{
+		"minute":  {Duration: time.Minute},
+		"minutes": {Duration: time.Minute},
+		"hour":    {Duration: time.Hour},
+		"hourly":  {Duration: time.Hour},
+		"hours":   {Duration: time.Hour},
+		"day":     {Days: 1},
+		"daily":   {Days: 1},
+		"days":    {Days: 1},
+		"week":    {Days: 7},
+		"weekly":  {Days: 7},
+		"weeks":   {Days: 7},
+		"month":   {Months: 1},
+		"monthly": {Months: 1},
+		"months":  {Months: 1},
+	}
+	isoInterval = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+// ParseInterval parses an interval written as an ISO 8601 duration such as
+// PT1H or P1D, a Go duration such as 15m, or a name such as hour or DAILY
+func ParseInterval(s string) (Interval, error) {
+	s = strings.TrimSpace(s)
+	if i, ok := namedIntervals[strings.ToLower(s)]; ok {
+		return i, nil
+	}
+	if m := isoInterval.FindStringSubmatch(strings.ToUpper(s)); m != nil {
+		n := make([]int, len(m))
+		for k := 1; k < len(m); k++ {
+			n[k], _ = strconv.Atoi(m[k])
+		}
+		i := Interval{
+			Months:   n[1]*12 + n[2],
+			Days:     n[3]*7 + n[4],
+			Duration: time.Duration(n[5])*time.Hour + time.Duration(n[6])*time.Minute + time.Duration(n[7])*time.Second,
+		}
+		if !i.IsZero() {
+			return i, nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return Interval{Duration: d}, nil
+	}
+	return Interval{}, fmt.Errorf("%w: %q", ErrInterval, s)
+}
+
+// IsZero reports whether the interval is empty
+func (i Interval) IsZero() bool {
+	return i == Interval{}
+}
+
+// Bucket returns the start of the k-th bucket from start. Buckets are
+// counted from start rather than from one another so that days missing
+// from a month do not shift the following buckets.
+func (i Interval) Bucket(start time.Time, k int) time.Time {
+	return start.AddDate(0, k*i.Months, k*i.Days).Add(time.Duration(k) * i.Duration)
+}
+
+// approx returns the length of the interval with 30-day months, to compare intervals
+func (i Interval) approx() time.Duration {
+	return time.Duration(i.Months*30+i.Days)*24*time.Hour + i.Duration
+}
+
+// String returns the interval as an ISO 8601 duration
+func (i Interval) String() string {
+	if i.IsZero() {
+		return "PT0S"
+	}
+	var b strings.Builder
+	b.WriteString("P")
+	if i.Months != 0 {
+		fmt.Fprintf(&b, "%dM", i.Months)
+	}
+	if i.Days != 0 {
+		fmt.Fprintf(&b, "%dD", i.Days)
+	}
+	if d := i.Duration; d != 0 {
+		b.WriteString("T")
+		if h := d / time.Hour; h != 0 {
+			fmt.Fprintf(&b, "%dH", h)
+		}
+		if m := d % time.Hour / time.Minute; m != 0 {
+			fmt.Fprintf(&b, "%dM", m)
+		}
+		if s := d % time.Minute; s != 0 {
+			b.WriteString(strconv.FormatFloat(s.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+	return b.String()
+}
+
+// MarshalText writes the interval as an ISO 8601 duration
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText parses an interval with ParseInterval
+func (i *Interval) UnmarshalText(text []byte) error {
+	parsed, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// Point is the quantity consumed during the bucket starting at Time
+type Point struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// ExpandDetail returns the points of a consumption detail whose first
+// bucket starts at since
+func ExpandDetail(since time.Time, detail models.ConsumptionDetail) ([]Point, error) {
+	if len(detail.Quantities) == 0 {
+		return nil, nil
+	}
+	interval, err := ParseInterval(detail.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(detail.Quantities))
+	for k, quantity := range detail.Quantities {
+		points[k] = Point{Time: interval.Bucket(since, k), Value: quantity}
+	}
+	return points, nil
+}
+
+// Series is the consumption of a resource for one reference over time
+type Series struct {
+	Product    string   `json:"product,omitempty"`
+	RegionID   string   `json:"regionId,omitempty"`
+	ResourceID string   `json:"resourceId"`
+	Reference  string   `json:"reference"`
+	Unit       string   `json:"unit"`
+	Interval   Interval `json:"interval"`
+	Points     []Point  `json:"points"`
+}
+
+// Total returns the sum of the points
+func (s Series) Total() float64 {
+	var total float64
+	for _, p := range s.Points {
+		total += p.Value
+	}
+	return total
+}
+
+type seriesKey struct {
+	product, regionID, resourceID, reference, unit string
+}
+
+func (s Series) key() seriesKey {
+	return seriesKey{s.Product, s.RegionID, s.ResourceID, s.Reference, s.Unit}
+}
+
+// Expand returns a series for each item of a resource consumption. Items
+// without detailed quantities give a single point at the start of the window.
+func Expand(product string, consumption models.ResourceConsumption) ([]Series, error) {
+	var (
+		series []Series
+		errs   []error
+	)
+	for _, item := range consumption.Consumptions {
+		s := Series{
+			Product:    product,
+			RegionID:   consumption.RegionID,
+			ResourceID: consumption.ResourceID,
+			Reference:  item.Reference,
+			Unit:       item.Unit,
+		}
+		if item.ResourceID != nil && *item.ResourceID != "" {
+			s.ResourceID = *item.ResourceID
+		}
+
+		if len(item.Details.Quantities) == 0 {
+			s.Points = []Point{{Time: consumption.Since, Value: item.Quantity}}
+			if !consumption.Until.IsZero() {
+				s.Interval = Interval{Duration: consumption.Until.Sub(consumption.Since)}
+			}
+			series = append(series, s)
+			continue
+		}
+
+		points, err := ExpandDetail(consumption.Since, item.Details)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", s.ResourceID, s.Reference, err))
+			continue
+		}
+		s.Interval, _ = ParseInterval(item.Details.Interval)
+		s.Points = points
+		series = append(series, s)
+	}
+	return series, errors.Join(errs...)
+}
+
+// Resample sums the points of a series into the buckets of an interval,
+// the first one starting at start. Points before start are dropped.
+// Resampling to a finer interval does not spread quantities: each lands in
+// the bucket holding the start of its original bucket.
+func Resample(s Series, start time.Time, interval Interval) Series {
+	if interval.IsZero() {
+		return s
+	}
+	resampled := s
+	resampled.Interval = interval
+	resampled.Points = nil
+
+	points := slices.Clone(s.Points)
+	slices.SortFunc(points, func(a, b Point) int { return a.Time.Compare(b.Time) })
+
+	k := 0
+	for _, p := range points {
+		if p.Time.Before(start) {
+			continue
+		}
+		for !p.Time.Before(interval.Bucket(start, k+1)) {
+			k++
+		}
+		bucket := interval.Bucket(start, k)
+		if n := len(resampled.Points); n > 0 && resampled.Points[n-1].Time.Equal(bucket) {
+			resampled.Points[n-1].Value += p.Value
+			continue
+		}
+		resampled.Points = append(resampled.Points, Point{Time: bucket, Value: p.Value})
+	}
+	return resampled
+}
+
+// Merge combines the series of the same product, region, resource, reference
+// and unit, such as those of several pages or reports, summing the points of
+// a same bucket. Series of a group with differing intervals are resampled to
+// the coarsest one, from the earliest point. The result is ordered.
+func Merge(series ...Series) []Series {
+	groups := map[seriesKey][]Series{}
+	var keys []seriesKey
+	for _, s := range series {
+		key := s.key()
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], s)
+	}
+
+	merged := make([]Series, 0, len(keys))
+	for _, key := range keys {
+		group := groups[key]
+		coarsest := group[0].Interval
+		var start time.Time
+		for _, s := range group {
+			if s.Interval.approx() > coarsest.approx() {
+				coarsest = s.Interval
+			}
+			for _, p := range s.Points {
+				if start.IsZero() || p.Time.Before(start) {
+					start = p.Time
+				}
+			}
+		}
+
+		result := group[0]
+		result.Interval = coarsest
+		result.Points = nil
+		for _, s := range group {
+			if s.Interval != coarsest {
+				s = Resample(s, start, coarsest)
+			}
+			result.Points = append(result.Points, s.Points...)
+		}
+		result.Points = sumByTime(result.Points)
+		merged = append(merged, result)
+	}
+
+	slices.SortFunc(merged, func(a, b Series) int {
+		return cmp.Or(
+			cmp.Compare(a.Product, b.Product),
+			cmp.Compare(a.RegionID, b.RegionID),
+			cmp.Compare(a.ResourceID, b.ResourceID),
+			cmp.Compare(a.Reference, b.Reference),
+			cmp.Compare(a.Unit, b.Unit),
+		)
+	})
+	return merged
+}
+
+// sumByTime sorts points and sums those at the same time
+func sumByTime(points []Point) []Point {
+	slices.SortStableFunc(points, func(a, b Point) int { return a.Time.Compare(b.Time) })
+	var result []Point
+	for _, p := range points {
+		if n := len(result); n > 0 && result[n-1].Time.Equal(p.Time) {
+			result[n-1].Value += p.Value
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// Gaps returns the ranges of buckets of the window the series has no point
+// for. Buckets start at window.Since and follow the interval of the series.
+func Gaps(s Series, window Window) []Window {
+	if s.Interval.IsZero() {
+		return nil
+	}
+
+	points := slices.Clone(s.Points)
+	slices.SortFunc(points, func(a, b Point) int { return a.Time.Compare(b.Time) })
+
+	var gaps []Window
+	p := 0
+	for k := 0; s.Interval.Bucket(window.Since, k).Before(window.Until); k++ {
+		bucket, next := s.Interval.Bucket(window.Since, k), s.Interval.Bucket(window.Since, k+1)
+		for p < len(points) && points[p].Time.Before(bucket) {
+			p++
+		}
+		if p < len(points) && points[p].Time.Before(next) {
+			continue
+		}
+
+		end := next
+		if end.After(window.Until) {
+			end = window.Until
+		}
+		if n := len(gaps); n > 0 && gaps[n-1].Until.Equal(bucket) {
+			gaps[n-1].Until = end
+			continue
+		}
+		gaps = append(gaps, Window{Since: bucket, Until: end})
+	}
+	return gaps
+}
@@ -0,0 +1,151 @@
+package billing
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Interval
+	}{
+		{in: "PT1H", want: Interval{Duration: time.Hour}},
+		{in: "PT15M", want: Interval{Duration: 15 * time.Minute}},
+		{in: "P1D", want: Interval{Days: 1}},
+		{in: "P1W", want: Interval{Days: 7}},
+		{in: "P1M", want: Interval{Months: 1}},
+		{in: "P1DT12H", want: Interval{Days: 1, Duration: 12 * time.Hour}},
+		{in: "5m", want: Interval{Duration: 5 * time.Minute}},
+		{in: "HOUR", want: Interval{Duration: time.Hour}},
+		{in: "daily", want: Interval{Days: 1}},
+	}
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.in)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) failed: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseInterval(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if again, err := ParseInterval(got.String()); err != nil || again != got {
+			t.Errorf("%q does not round trip through %q", tt.in, got.String())
+		}
+	}
+
+	for _, in := range []string{"", "P", "PT", "-1h", "fortnightly"} {
+		if _, err := ParseInterval(in); !errors.Is(err, ErrInterval) {
+			t.Errorf("ParseInterval(%q) error = %v, want ErrInterval", in, err)
+		}
+	}
+}
+
+func TestIntervalJSON(t *testing.T) {
+	data, err := json.Marshal(Series{Interval: Interval{Days: 1}})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var s Series
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if s.Interval != (Interval{Days: 1}) {
+		t.Errorf("interval = %+v after %s", s.Interval, data)
+	}
+}
+
+var since = time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+func at(hours int) time.Time {
+	return since.Add(time.Duration(hours) * time.Hour)
+}
+
+func TestExpand(t *testing.T) {
+	series, err := Expand("pulsar", models.ResourceConsumption{
+		ResourceID: "pulsar_1",
+		Since:      since,
+		Until:      since.AddDate(0, 2, 0),
+		Consumptions: []models.ConsumptionItem{
+			{Reference: "storage", Unit: "GiB", Quantity: 6, Details: models.ConsumptionDetail{Interval: "P1M", Quantities: []float64{1, 2, 3}}},
+			{Reference: "flat", Unit: "h", Quantity: 4},
+			{Reference: "broken", Unit: "h", Details: models.ConsumptionDetail{Interval: "often", Quantities: []float64{1}}},
+		},
+	})
+	if !errors.Is(err, ErrInterval) {
+		t.Errorf("error = %v, want ErrInterval", err)
+	}
+	if len(series) != 2 {
+		t.Fatalf("got %d series, want 2", len(series))
+	}
+
+	storage := series[0]
+	want := []Point{
+		{Time: since, Value: 1},
+		{Time: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Value: 2},
+		{Time: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), Value: 3},
+	}
+	if !slices.Equal(storage.Points, want) {
+		t.Errorf("storage points = %v, want %v", storage.Points, want)
+	}
+
+	flat := series[1]
+	if len(flat.Points) != 1 || flat.Points[0] != (Point{Time: since, Value: 4}) || flat.Total() != 4 {
+		t.Errorf("flat points = %v", flat.Points)
+	}
+}
+
+func TestResample(t *testing.T) {
+	s := Series{Interval: Interval{Duration: 15 * time.Minute}}
+	for quarter := range 8 {
+		s.Points = append(s.Points, Point{Time: since.Add(time.Duration(quarter) * 15 * time.Minute), Value: 1})
+	}
+
+	hourly := Resample(s, since, Interval{Duration: time.Hour})
+	want := []Point{{Time: at(0), Value: 4}, {Time: at(1), Value: 4}}
+	if !slices.Equal(hourly.Points, want) {
+		t.Errorf("points = %v, want %v", hourly.Points, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	hourly := Interval{Duration: time.Hour}
+	page1 := Series{ResourceID: "r", Reference: "cpu", Interval: hourly, Points: []Point{{Time: at(0), Value: 1}, {Time: at(1), Value: 2}}}
+	page2 := Series{ResourceID: "r", Reference: "cpu", Interval: hourly, Points: []Point{{Time: at(1), Value: 3}, {Time: at(2), Value: 4}}}
+	coarse := Series{ResourceID: "r", Reference: "cpu", Interval: Interval{Duration: 2 * time.Hour}, Points: []Point{{Time: at(2), Value: 10}}}
+	other := Series{ResourceID: "a", Reference: "cpu", Interval: hourly, Points: []Point{{Time: at(0), Value: 1}}}
+
+	merged := Merge(page1, other, page2)
+	if len(merged) != 2 || merged[0].ResourceID != "a" {
+		t.Fatalf("merged = %+v", merged)
+	}
+	want := []Point{{Time: at(0), Value: 1}, {Time: at(1), Value: 5}, {Time: at(2), Value: 4}}
+	if !slices.Equal(merged[1].Points, want) {
+		t.Errorf("points = %v, want %v", merged[1].Points, want)
+	}
+
+	merged = Merge(page1, page2, coarse)
+	want = []Point{{Time: at(0), Value: 6}, {Time: at(2), Value: 14}}
+	if len(merged) != 1 || merged[0].Interval != coarse.Interval || !slices.Equal(merged[0].Points, want) {
+		t.Errorf("merged = %+v, want points %v", merged, want)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	s := Series{Interval: Interval{Duration: time.Hour}, Points: []Point{
+		{Time: at(0), Value: 1},
+		{Time: at(3), Value: 1},
+		{Time: at(4), Value: 1},
+	}}
+
+	gaps := Gaps(s, Window{Since: at(0), Until: at(7)})
+	want := []Window{{Since: at(1), Until: at(3)}, {Since: at(5), Until: at(7)}}
+	if !slices.Equal(gaps, want) {
+		t.Errorf("gaps = %v, want %v", gaps, want)
+	}
+}
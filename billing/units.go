@@ -0,0 +1,56 @@
+package billing
+
+import "strings"
+
+// Conversion converts quantities of a raw unit to a canonical one
+type Conversion struct {
+	Unit   string  `json:"unit"`
+	Factor float64 `json:"factor"`
+}
+
+const (
+	gib  = 1 << 30
+	hour = 3600
+)
+
+// DefaultUnits returns the conversions of the data sizes products report to
+// GiB and of their durations to hours. Keys are lower case.
+func DefaultUnits() map[string]Conversion {
+	return map[string]Conversion{
+		"b":       {Unit: "GiB", Factor: 1.0 / gib},
+		"byte":    {Unit: "GiB", Factor: 1.0 / gib},
+		"bytes":   {Unit: "GiB", Factor: 1.0 / gib},
+		"kb":      {Unit: "GiB", Factor: 1e3 / gib},
+		"kib":     {Unit: "GiB", Factor: 1.0 / (1 << 20)},
+		"mb":      {Unit: "GiB", Factor: 1e6 / gib},
+		"mib":     {Unit: "GiB", Factor: 1.0 / (1 << 10)},
+		"gb":      {Unit: "GiB", Factor: 1e9 / gib},
+		"gib":     {Unit: "GiB", Factor: 1},
+		"tb":      {Unit: "GiB", Factor: 1e12 / gib},
+		"tib":     {Unit: "GiB", Factor: 1 << 10},
+		"ms":      {Unit: "h", Factor: 1.0 / (hour * 1000)},
+		"s":       {Unit: "h", Factor: 1.0 / hour},
+		"second":  {Unit: "h", Factor: 1.0 / hour},
+		"seconds": {Unit: "h", Factor: 1.0 / hour},
+		"min":     {Unit: "h", Factor: 1.0 / 60},
+		"minute":  {Unit: "h", Factor: 1.0 / 60},
+		"minutes": {Unit: "h", Factor: 1.0 / 60},
+		"h":       {Unit: "h", Factor: 1},
+		"hour":    {Unit: "h", Factor: 1},
+		"hours":   {Unit: "h", Factor: 1},
+	}
+}
+
+func normalizeUnitKey(unit string) string {
+	return strings.ToLower(strings.TrimSpace(unit))
+}
+
+// normalize converts a quantity to its canonical unit. Units without a
+// conversion are kept as they are.
+func normalize(units map[string]Conversion, unit string, quantity float64) (string, float64) {
+	conversion, ok := units[normalizeUnitKey(unit)]
+	if !ok {
+		return unit, quantity
+	}
+	return conversion.Unit, quantity * conversion.Factor
+}
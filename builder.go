@@ -2,7 +2,9 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	models "go.clever-cloud.dev/sdk/models"
 	ai "go.clever-cloud.dev/sdk/services/ai"
 	base "go.clever-cloud.dev/sdk/services/base"
@@ -158,7 +160,7 @@ func (b *v2ProvidersAddonAiBuilderImpl) Resources() V2ProvidersAddonAiResourcesB
 
 // V2ProvidersAddonAiResourcesBuilder provides access to operations
 type V2ProvidersAddonAiResourcesBuilder interface {
-	Aiid(aiid string) V2ProvidersAddonAiResourcesAiidBuilder
+	Aiid(aiid ids.AIID) V2ProvidersAddonAiResourcesAiidBuilder
 	Createai(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -173,7 +175,7 @@ func newV2ProvidersAddonAiResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonAiResou
 }
 
 // Aiid returns builder for aiid
-func (b *v2ProvidersAddonAiResourcesBuilderImpl) Aiid(aiid string) V2ProvidersAddonAiResourcesAiidBuilder {
+func (b *v2ProvidersAddonAiResourcesBuilderImpl) Aiid(aiid ids.AIID) V2ProvidersAddonAiResourcesAiidBuilder {
 	return newV2ProvidersAddonAiResourcesAiidBuilder(b.sdk, aiid)
 }
 
@@ -190,11 +192,11 @@ type V2ProvidersAddonAiResourcesAiidBuilder interface {
 // v2ProvidersAddonAiResourcesAiidBuilderImpl implements V2ProvidersAddonAiResourcesAiidBuilder
 type v2ProvidersAddonAiResourcesAiidBuilderImpl struct {
 	sdk  *sdkImpl
-	aiid string
+	aiid ids.AIID
 }
 
 // newV2ProvidersAddonAiResourcesAiidBuilder creates a new V2ProvidersAddonAiResourcesAiidBuilder
-func newV2ProvidersAddonAiResourcesAiidBuilder(sdk *sdkImpl, aiid string) V2ProvidersAddonAiResourcesAiidBuilder {
+func newV2ProvidersAddonAiResourcesAiidBuilder(sdk *sdkImpl, aiid ids.AIID) V2ProvidersAddonAiResourcesAiidBuilder {
 	return &v2ProvidersAddonAiResourcesAiidBuilderImpl{
 		aiid: aiid,
 		sdk:  sdk,
@@ -208,7 +210,7 @@ func (b *v2ProvidersAddonAiResourcesAiidBuilderImpl) Deleteai(ctx context.Contex
 
 // V2ProvidersAddonCellarBuilder provides access to operations
 type V2ProvidersAddonCellarBuilder interface {
-	Cellarid(cellarid string) V2ProvidersAddonCellarCellaridBuilder
+	Cellarid(cellarid ids.CellarID) V2ProvidersAddonCellarCellaridBuilder
 	Resources() V2ProvidersAddonCellarResourcesBuilder
 }
 
@@ -223,7 +225,7 @@ func newV2ProvidersAddonCellarBuilder(sdk *sdkImpl) V2ProvidersAddonCellarBuilde
 }
 
 // Cellarid returns builder for cellarid
-func (b *v2ProvidersAddonCellarBuilderImpl) Cellarid(cellarid string) V2ProvidersAddonCellarCellaridBuilder {
+func (b *v2ProvidersAddonCellarBuilderImpl) Cellarid(cellarid ids.CellarID) V2ProvidersAddonCellarCellaridBuilder {
 	return newV2ProvidersAddonCellarCellaridBuilder(b.sdk, cellarid)
 }
 
@@ -240,11 +242,11 @@ type V2ProvidersAddonCellarCellaridBuilder interface {
 // v2ProvidersAddonCellarCellaridBuilderImpl implements V2ProvidersAddonCellarCellaridBuilder
 type v2ProvidersAddonCellarCellaridBuilderImpl struct {
 	sdk      *sdkImpl
-	cellarid string
+	cellarid ids.CellarID
 }
 
 // newV2ProvidersAddonCellarCellaridBuilder creates a new V2ProvidersAddonCellarCellaridBuilder
-func newV2ProvidersAddonCellarCellaridBuilder(sdk *sdkImpl, cellarid string) V2ProvidersAddonCellarCellaridBuilder {
+func newV2ProvidersAddonCellarCellaridBuilder(sdk *sdkImpl, cellarid ids.CellarID) V2ProvidersAddonCellarCellaridBuilder {
 	return &v2ProvidersAddonCellarCellaridBuilderImpl{
 		cellarid: cellarid,
 		sdk:      sdk,
@@ -298,7 +300,7 @@ func (b *v2ProvidersAddonCumulocityBuilderImpl) Resources() V2ProvidersAddonCumu
 
 // V2ProvidersAddonCumulocityResourcesBuilder provides access to operations
 type V2ProvidersAddonCumulocityResourcesBuilder interface {
-	Addoncumulocityid(addoncumulocityid string) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder
+	Addoncumulocityid(addoncumulocityid ids.AddonCumulocityID) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder
 	Createcumulocity(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -313,7 +315,7 @@ func newV2ProvidersAddonCumulocityResourcesBuilder(sdk *sdkImpl) V2ProvidersAddo
 }
 
 // Addoncumulocityid returns builder for addoncumulocityid
-func (b *v2ProvidersAddonCumulocityResourcesBuilderImpl) Addoncumulocityid(addoncumulocityid string) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder {
+func (b *v2ProvidersAddonCumulocityResourcesBuilderImpl) Addoncumulocityid(addoncumulocityid ids.AddonCumulocityID) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder {
 	return newV2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder(b.sdk, addoncumulocityid)
 }
 
@@ -330,11 +332,11 @@ type V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder interface {
 // v2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilderImpl implements V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder
 type v2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilderImpl struct {
 	sdk               *sdkImpl
-	addoncumulocityid string
+	addoncumulocityid ids.AddonCumulocityID
 }
 
 // newV2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder creates a new V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder
-func newV2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder(sdk *sdkImpl, addoncumulocityid string) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder {
+func newV2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder(sdk *sdkImpl, addoncumulocityid ids.AddonCumulocityID) V2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilder {
 	return &v2ProvidersAddonCumulocityResourcesAddoncumulocityidBuilderImpl{
 		addoncumulocityid: addoncumulocityid,
 		sdk:               sdk,
@@ -368,7 +370,7 @@ func (b *v2ProvidersAddonKeycloakBuilderImpl) Resources() V2ProvidersAddonKeyclo
 
 // V2ProvidersAddonKeycloakResourcesBuilder provides access to operations
 type V2ProvidersAddonKeycloakResourcesBuilder interface {
-	Addonkeycloakid(addonkeycloakid string) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder
+	Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder
 	Createkeycloak(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -383,7 +385,7 @@ func newV2ProvidersAddonKeycloakResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonK
 }
 
 // Addonkeycloakid returns builder for addonkeycloakid
-func (b *v2ProvidersAddonKeycloakResourcesBuilderImpl) Addonkeycloakid(addonkeycloakid string) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder {
+func (b *v2ProvidersAddonKeycloakResourcesBuilderImpl) Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder {
 	return newV2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder(b.sdk, addonkeycloakid)
 }
 
@@ -400,11 +402,11 @@ type V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder interface {
 // v2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilderImpl implements V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder
 type v2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder creates a new V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder
-func newV2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder(sdk *sdkImpl, addonkeycloakid string) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder {
+func newV2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilder {
 	return &v2ProvidersAddonKeycloakResourcesAddonkeycloakidBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -438,7 +440,7 @@ func (b *v2ProvidersAddonMatomoBuilderImpl) Resources() V2ProvidersAddonMatomoRe
 
 // V2ProvidersAddonMatomoResourcesBuilder provides access to operations
 type V2ProvidersAddonMatomoResourcesBuilder interface {
-	Addonmatomoid(addonmatomoid string) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder
+	Addonmatomoid(addonmatomoid ids.AddonMatomoID) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder
 	Creatematomo(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -453,7 +455,7 @@ func newV2ProvidersAddonMatomoResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonMat
 }
 
 // Addonmatomoid returns builder for addonmatomoid
-func (b *v2ProvidersAddonMatomoResourcesBuilderImpl) Addonmatomoid(addonmatomoid string) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder {
+func (b *v2ProvidersAddonMatomoResourcesBuilderImpl) Addonmatomoid(addonmatomoid ids.AddonMatomoID) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder {
 	return newV2ProvidersAddonMatomoResourcesAddonmatomoidBuilder(b.sdk, addonmatomoid)
 }
 
@@ -470,11 +472,11 @@ type V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder interface {
 // v2ProvidersAddonMatomoResourcesAddonmatomoidBuilderImpl implements V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder
 type v2ProvidersAddonMatomoResourcesAddonmatomoidBuilderImpl struct {
 	sdk           *sdkImpl
-	addonmatomoid string
+	addonmatomoid ids.AddonMatomoID
 }
 
 // newV2ProvidersAddonMatomoResourcesAddonmatomoidBuilder creates a new V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder
-func newV2ProvidersAddonMatomoResourcesAddonmatomoidBuilder(sdk *sdkImpl, addonmatomoid string) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder {
+func newV2ProvidersAddonMatomoResourcesAddonmatomoidBuilder(sdk *sdkImpl, addonmatomoid ids.AddonMatomoID) V2ProvidersAddonMatomoResourcesAddonmatomoidBuilder {
 	return &v2ProvidersAddonMatomoResourcesAddonmatomoidBuilderImpl{
 		addonmatomoid: addonmatomoid,
 		sdk:           sdk,
@@ -508,7 +510,7 @@ func (b *v2ProvidersAddonMetabaseBuilderImpl) Resources() V2ProvidersAddonMetaba
 
 // V2ProvidersAddonMetabaseResourcesBuilder provides access to operations
 type V2ProvidersAddonMetabaseResourcesBuilder interface {
-	Addonmetabaseid(addonmetabaseid string) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder
+	Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder
 	Createmetabase(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -523,7 +525,7 @@ func newV2ProvidersAddonMetabaseResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonM
 }
 
 // Addonmetabaseid returns builder for addonmetabaseid
-func (b *v2ProvidersAddonMetabaseResourcesBuilderImpl) Addonmetabaseid(addonmetabaseid string) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder {
+func (b *v2ProvidersAddonMetabaseResourcesBuilderImpl) Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder {
 	return newV2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder(b.sdk, addonmetabaseid)
 }
 
@@ -540,11 +542,11 @@ type V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder interface {
 // v2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilderImpl implements V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder
 type v2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder creates a new V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder
-func newV2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder(sdk *sdkImpl, addonmetabaseid string) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder {
+func newV2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilder {
 	return &v2ProvidersAddonMetabaseResourcesAddonmetabaseidBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -578,7 +580,7 @@ func (b *v2ProvidersAddonOtoroshiBuilderImpl) Resources() V2ProvidersAddonOtoros
 
 // V2ProvidersAddonOtoroshiResourcesBuilder provides access to operations
 type V2ProvidersAddonOtoroshiResourcesBuilder interface {
-	Otoroshiid(otoroshiid string) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder
+	Otoroshiid(otoroshiid ids.OtoroshiID) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder
 	Createotoroshi(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -593,7 +595,7 @@ func newV2ProvidersAddonOtoroshiResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonO
 }
 
 // Otoroshiid returns builder for otoroshiid
-func (b *v2ProvidersAddonOtoroshiResourcesBuilderImpl) Otoroshiid(otoroshiid string) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder {
+func (b *v2ProvidersAddonOtoroshiResourcesBuilderImpl) Otoroshiid(otoroshiid ids.OtoroshiID) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder {
 	return newV2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder(b.sdk, otoroshiid)
 }
 
@@ -610,11 +612,11 @@ type V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder interface {
 // v2ProvidersAddonOtoroshiResourcesOtoroshiidBuilderImpl implements V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder
 type v2ProvidersAddonOtoroshiResourcesOtoroshiidBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder creates a new V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder
-func newV2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder(sdk *sdkImpl, otoroshiid string) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder {
+func newV2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V2ProvidersAddonOtoroshiResourcesOtoroshiidBuilder {
 	return &v2ProvidersAddonOtoroshiResourcesOtoroshiidBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -648,7 +650,7 @@ func (b *v2ProvidersAddonPulsarBuilderImpl) Resources() V2ProvidersAddonPulsarRe
 
 // V2ProvidersAddonPulsarResourcesBuilder provides access to operations
 type V2ProvidersAddonPulsarResourcesBuilder interface {
-	Pulsarid(pulsarid string) V2ProvidersAddonPulsarResourcesPulsaridBuilder
+	Pulsarid(pulsarid ids.PulsarID) V2ProvidersAddonPulsarResourcesPulsaridBuilder
 	Createpulsarv2(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -663,7 +665,7 @@ func newV2ProvidersAddonPulsarResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonPul
 }
 
 // Pulsarid returns builder for pulsarid
-func (b *v2ProvidersAddonPulsarResourcesBuilderImpl) Pulsarid(pulsarid string) V2ProvidersAddonPulsarResourcesPulsaridBuilder {
+func (b *v2ProvidersAddonPulsarResourcesBuilderImpl) Pulsarid(pulsarid ids.PulsarID) V2ProvidersAddonPulsarResourcesPulsaridBuilder {
 	return newV2ProvidersAddonPulsarResourcesPulsaridBuilder(b.sdk, pulsarid)
 }
 
@@ -682,11 +684,11 @@ type V2ProvidersAddonPulsarResourcesPulsaridBuilder interface {
 // v2ProvidersAddonPulsarResourcesPulsaridBuilderImpl implements V2ProvidersAddonPulsarResourcesPulsaridBuilder
 type v2ProvidersAddonPulsarResourcesPulsaridBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV2ProvidersAddonPulsarResourcesPulsaridBuilder creates a new V2ProvidersAddonPulsarResourcesPulsaridBuilder
-func newV2ProvidersAddonPulsarResourcesPulsaridBuilder(sdk *sdkImpl, pulsarid string) V2ProvidersAddonPulsarResourcesPulsaridBuilder {
+func newV2ProvidersAddonPulsarResourcesPulsaridBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V2ProvidersAddonPulsarResourcesPulsaridBuilder {
 	return &v2ProvidersAddonPulsarResourcesPulsaridBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -716,11 +718,11 @@ type V2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder interface {
 // v2ProvidersAddonPulsarResourcesPulsaridTopicsBuilderImpl implements V2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder
 type v2ProvidersAddonPulsarResourcesPulsaridTopicsBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder creates a new V2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder
-func newV2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder(sdk *sdkImpl, pulsarid string) V2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder {
+func newV2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V2ProvidersAddonPulsarResourcesPulsaridTopicsBuilder {
 	return &v2ProvidersAddonPulsarResourcesPulsaridTopicsBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -740,12 +742,12 @@ type V2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder interface {
 // v2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilderImpl implements V2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder
 type v2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder creates a new V2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder
-func newV2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder(sdk *sdkImpl, pulsarid string, topic string) V2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder {
+func newV2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilder {
 	return &v2ProvidersAddonPulsarResourcesPulsaridTopicsTopicBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -780,7 +782,7 @@ func (b *v2ProvidersAddonTsBuilderImpl) Resources() V2ProvidersAddonTsResourcesB
 
 // V2ProvidersAddonTsResourcesBuilder provides access to operations
 type V2ProvidersAddonTsResourcesBuilder interface {
-	Addontsid(addontsid string) V2ProvidersAddonTsResourcesAddontsidBuilder
+	Addontsid(addontsid ids.AddonTsID) V2ProvidersAddonTsResourcesAddontsidBuilder
 	Createmateriats(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -795,7 +797,7 @@ func newV2ProvidersAddonTsResourcesBuilder(sdk *sdkImpl) V2ProvidersAddonTsResou
 }
 
 // Addontsid returns builder for addontsid
-func (b *v2ProvidersAddonTsResourcesBuilderImpl) Addontsid(addontsid string) V2ProvidersAddonTsResourcesAddontsidBuilder {
+func (b *v2ProvidersAddonTsResourcesBuilderImpl) Addontsid(addontsid ids.AddonTsID) V2ProvidersAddonTsResourcesAddontsidBuilder {
 	return newV2ProvidersAddonTsResourcesAddontsidBuilder(b.sdk, addontsid)
 }
 
@@ -812,11 +814,11 @@ type V2ProvidersAddonTsResourcesAddontsidBuilder interface {
 // v2ProvidersAddonTsResourcesAddontsidBuilderImpl implements V2ProvidersAddonTsResourcesAddontsidBuilder
 type v2ProvidersAddonTsResourcesAddontsidBuilderImpl struct {
 	sdk       *sdkImpl
-	addontsid string
+	addontsid ids.AddonTsID
 }
 
 // newV2ProvidersAddonTsResourcesAddontsidBuilder creates a new V2ProvidersAddonTsResourcesAddontsidBuilder
-func newV2ProvidersAddonTsResourcesAddontsidBuilder(sdk *sdkImpl, addontsid string) V2ProvidersAddonTsResourcesAddontsidBuilder {
+func newV2ProvidersAddonTsResourcesAddontsidBuilder(sdk *sdkImpl, addontsid ids.AddonTsID) V2ProvidersAddonTsResourcesAddontsidBuilder {
 	return &v2ProvidersAddonTsResourcesAddontsidBuilderImpl{
 		addontsid: addontsid,
 		sdk:       sdk,
@@ -850,7 +852,7 @@ func (b *v2ProvidersConfigProviderBuilderImpl) Resources() V2ProvidersConfigProv
 
 // V2ProvidersConfigProviderResourcesBuilder provides access to operations
 type V2ProvidersConfigProviderResourcesBuilder interface {
-	Addonid(addonid string) V2ProvidersConfigProviderResourcesAddonidBuilder
+	Addonid(addonid ids.AddonID) V2ProvidersConfigProviderResourcesAddonidBuilder
 	Createconfigurationprovider(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -865,7 +867,7 @@ func newV2ProvidersConfigProviderResourcesBuilder(sdk *sdkImpl) V2ProvidersConfi
 }
 
 // Addonid returns builder for addonid
-func (b *v2ProvidersConfigProviderResourcesBuilderImpl) Addonid(addonid string) V2ProvidersConfigProviderResourcesAddonidBuilder {
+func (b *v2ProvidersConfigProviderResourcesBuilderImpl) Addonid(addonid ids.AddonID) V2ProvidersConfigProviderResourcesAddonidBuilder {
 	return newV2ProvidersConfigProviderResourcesAddonidBuilder(b.sdk, addonid)
 }
 
@@ -883,11 +885,11 @@ type V2ProvidersConfigProviderResourcesAddonidBuilder interface {
 // v2ProvidersConfigProviderResourcesAddonidBuilderImpl implements V2ProvidersConfigProviderResourcesAddonidBuilder
 type v2ProvidersConfigProviderResourcesAddonidBuilderImpl struct {
 	sdk     *sdkImpl
-	addonid string
+	addonid ids.AddonID
 }
 
 // newV2ProvidersConfigProviderResourcesAddonidBuilder creates a new V2ProvidersConfigProviderResourcesAddonidBuilder
-func newV2ProvidersConfigProviderResourcesAddonidBuilder(sdk *sdkImpl, addonid string) V2ProvidersConfigProviderResourcesAddonidBuilder {
+func newV2ProvidersConfigProviderResourcesAddonidBuilder(sdk *sdkImpl, addonid ids.AddonID) V2ProvidersConfigProviderResourcesAddonidBuilder {
 	return &v2ProvidersConfigProviderResourcesAddonidBuilderImpl{
 		addonid: addonid,
 		sdk:     sdk,
@@ -926,7 +928,7 @@ func (b *v2ProvidersKvBuilderImpl) Resources() V2ProvidersKvResourcesBuilder {
 
 // V2ProvidersKvResourcesBuilder provides access to operations
 type V2ProvidersKvResourcesBuilder interface {
-	Kvid(kvid string) V2ProvidersKvResourcesKvidBuilder
+	Kvid(kvid ids.KVID) V2ProvidersKvResourcesKvidBuilder
 	Createmateriakv(ctx context.Context, request *models.ProvisionRequest) client.Response[models.ProvisionResponse]
 }
 
@@ -941,7 +943,7 @@ func newV2ProvidersKvResourcesBuilder(sdk *sdkImpl) V2ProvidersKvResourcesBuilde
 }
 
 // Kvid returns builder for kvid
-func (b *v2ProvidersKvResourcesBuilderImpl) Kvid(kvid string) V2ProvidersKvResourcesKvidBuilder {
+func (b *v2ProvidersKvResourcesBuilderImpl) Kvid(kvid ids.KVID) V2ProvidersKvResourcesKvidBuilder {
 	return newV2ProvidersKvResourcesKvidBuilder(b.sdk, kvid)
 }
 
@@ -959,11 +961,11 @@ type V2ProvidersKvResourcesKvidBuilder interface {
 // v2ProvidersKvResourcesKvidBuilderImpl implements V2ProvidersKvResourcesKvidBuilder
 type v2ProvidersKvResourcesKvidBuilderImpl struct {
 	sdk  *sdkImpl
-	kvid string
+	kvid ids.KVID
 }
 
 // newV2ProvidersKvResourcesKvidBuilder creates a new V2ProvidersKvResourcesKvidBuilder
-func newV2ProvidersKvResourcesKvidBuilder(sdk *sdkImpl, kvid string) V2ProvidersKvResourcesKvidBuilder {
+func newV2ProvidersKvResourcesKvidBuilder(sdk *sdkImpl, kvid ids.KVID) V2ProvidersKvResourcesKvidBuilder {
 	return &v2ProvidersKvResourcesKvidBuilderImpl{
 		kvid: kvid,
 		sdk:  sdk,
@@ -1318,7 +1320,7 @@ func (b *v4AddonProvidersAddonAiBuilderImpl) Addons() V4AddonProvidersAddonAiAdd
 
 // V4AddonProvidersAddonAiAddonsBuilder provides access to operations
 type V4AddonProvidersAddonAiAddonsBuilder interface {
-	Aiid(aiid string) V4AddonProvidersAddonAiAddonsAiidBuilder
+	Aiid(aiid ids.AIID) V4AddonProvidersAddonAiAddonsAiidBuilder
 }
 
 // v4AddonProvidersAddonAiAddonsBuilderImpl implements V4AddonProvidersAddonAiAddonsBuilder
@@ -1332,7 +1334,7 @@ func newV4AddonProvidersAddonAiAddonsBuilder(sdk *sdkImpl) V4AddonProvidersAddon
 }
 
 // Aiid returns builder for aiid
-func (b *v4AddonProvidersAddonAiAddonsBuilderImpl) Aiid(aiid string) V4AddonProvidersAddonAiAddonsAiidBuilder {
+func (b *v4AddonProvidersAddonAiAddonsBuilderImpl) Aiid(aiid ids.AIID) V4AddonProvidersAddonAiAddonsAiidBuilder {
 	return newV4AddonProvidersAddonAiAddonsAiidBuilder(b.sdk, aiid)
 }
 
@@ -1344,11 +1346,11 @@ type V4AddonProvidersAddonAiAddonsAiidBuilder interface {
 // v4AddonProvidersAddonAiAddonsAiidBuilderImpl implements V4AddonProvidersAddonAiAddonsAiidBuilder
 type v4AddonProvidersAddonAiAddonsAiidBuilderImpl struct {
 	sdk  *sdkImpl
-	aiid string
+	aiid ids.AIID
 }
 
 // newV4AddonProvidersAddonAiAddonsAiidBuilder creates a new V4AddonProvidersAddonAiAddonsAiidBuilder
-func newV4AddonProvidersAddonAiAddonsAiidBuilder(sdk *sdkImpl, aiid string) V4AddonProvidersAddonAiAddonsAiidBuilder {
+func newV4AddonProvidersAddonAiAddonsAiidBuilder(sdk *sdkImpl, aiid ids.AIID) V4AddonProvidersAddonAiAddonsAiidBuilder {
 	return &v4AddonProvidersAddonAiAddonsAiidBuilderImpl{
 		aiid: aiid,
 		sdk:  sdk,
@@ -1362,7 +1364,7 @@ func (b *v4AddonProvidersAddonAiAddonsAiidBuilderImpl) Getai(ctx context.Context
 
 // V4AddonProvidersAddonCellarBuilder provides access to operations
 type V4AddonProvidersAddonCellarBuilder interface {
-	Addonid(addonid string) V4AddonProvidersAddonCellarAddonidBuilder
+	Addonid(addonid ids.AddonID) V4AddonProvidersAddonCellarAddonidBuilder
 }
 
 // v4AddonProvidersAddonCellarBuilderImpl implements V4AddonProvidersAddonCellarBuilder
@@ -1376,7 +1378,7 @@ func newV4AddonProvidersAddonCellarBuilder(sdk *sdkImpl) V4AddonProvidersAddonCe
 }
 
 // Addonid returns builder for addonid
-func (b *v4AddonProvidersAddonCellarBuilderImpl) Addonid(addonid string) V4AddonProvidersAddonCellarAddonidBuilder {
+func (b *v4AddonProvidersAddonCellarBuilderImpl) Addonid(addonid ids.AddonID) V4AddonProvidersAddonCellarAddonidBuilder {
 	return newV4AddonProvidersAddonCellarAddonidBuilder(b.sdk, addonid)
 }
 
@@ -1388,11 +1390,11 @@ type V4AddonProvidersAddonCellarAddonidBuilder interface {
 // v4AddonProvidersAddonCellarAddonidBuilderImpl implements V4AddonProvidersAddonCellarAddonidBuilder
 type v4AddonProvidersAddonCellarAddonidBuilderImpl struct {
 	sdk     *sdkImpl
-	addonid string
+	addonid ids.AddonID
 }
 
 // newV4AddonProvidersAddonCellarAddonidBuilder creates a new V4AddonProvidersAddonCellarAddonidBuilder
-func newV4AddonProvidersAddonCellarAddonidBuilder(sdk *sdkImpl, addonid string) V4AddonProvidersAddonCellarAddonidBuilder {
+func newV4AddonProvidersAddonCellarAddonidBuilder(sdk *sdkImpl, addonid ids.AddonID) V4AddonProvidersAddonCellarAddonidBuilder {
 	return &v4AddonProvidersAddonCellarAddonidBuilderImpl{
 		addonid: addonid,
 		sdk:     sdk,
@@ -1426,7 +1428,7 @@ func (b *v4AddonProvidersAddonCumulocityBuilderImpl) Addons() V4AddonProvidersAd
 
 // V4AddonProvidersAddonCumulocityAddonsBuilder provides access to operations
 type V4AddonProvidersAddonCumulocityAddonsBuilder interface {
-	Addoncumulocityid(addoncumulocityid string) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder
+	Addoncumulocityid(addoncumulocityid ids.AddonCumulocityID) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder
 }
 
 // v4AddonProvidersAddonCumulocityAddonsBuilderImpl implements V4AddonProvidersAddonCumulocityAddonsBuilder
@@ -1440,7 +1442,7 @@ func newV4AddonProvidersAddonCumulocityAddonsBuilder(sdk *sdkImpl) V4AddonProvid
 }
 
 // Addoncumulocityid returns builder for addoncumulocityid
-func (b *v4AddonProvidersAddonCumulocityAddonsBuilderImpl) Addoncumulocityid(addoncumulocityid string) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder {
+func (b *v4AddonProvidersAddonCumulocityAddonsBuilderImpl) Addoncumulocityid(addoncumulocityid ids.AddonCumulocityID) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder {
 	return newV4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder(b.sdk, addoncumulocityid)
 }
 
@@ -1452,11 +1454,11 @@ type V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder interface {
 // v4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilderImpl implements V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder
 type v4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilderImpl struct {
 	sdk               *sdkImpl
-	addoncumulocityid string
+	addoncumulocityid ids.AddonCumulocityID
 }
 
 // newV4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder creates a new V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder
-func newV4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder(sdk *sdkImpl, addoncumulocityid string) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder {
+func newV4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder(sdk *sdkImpl, addoncumulocityid ids.AddonCumulocityID) V4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilder {
 	return &v4AddonProvidersAddonCumulocityAddonsAddoncumulocityidBuilderImpl{
 		addoncumulocityid: addoncumulocityid,
 		sdk:               sdk,
@@ -1490,7 +1492,7 @@ func (b *v4AddonProvidersAddonKeycloakBuilderImpl) Addons() V4AddonProvidersAddo
 
 // V4AddonProvidersAddonKeycloakAddonsBuilder provides access to operations
 type V4AddonProvidersAddonKeycloakAddonsBuilder interface {
-	Addonkeycloakid(addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder
+	Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder
 }
 
 // v4AddonProvidersAddonKeycloakAddonsBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsBuilder
@@ -1504,7 +1506,7 @@ func newV4AddonProvidersAddonKeycloakAddonsBuilder(sdk *sdkImpl) V4AddonProvider
 }
 
 // Addonkeycloakid returns builder for addonkeycloakid
-func (b *v4AddonProvidersAddonKeycloakAddonsBuilderImpl) Addonkeycloakid(addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder {
+func (b *v4AddonProvidersAddonKeycloakAddonsBuilderImpl) Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder {
 	return newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder(b.sdk, addonkeycloakid)
 }
 
@@ -1521,11 +1523,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder interface {
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1570,11 +1572,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder interf
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidApplicationBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1595,11 +1597,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder inter
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidNetworkgroupBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1624,11 +1626,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder interface {
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebootBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1648,11 +1650,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder interface
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidRebuildBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1673,11 +1675,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder interface
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1702,11 +1704,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder inter
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionCheckBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1726,11 +1728,11 @@ type V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder inte
 // v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilderImpl implements V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder
 type v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilderImpl struct {
 	sdk             *sdkImpl
-	addonkeycloakid string
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder creates a new V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder
-func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder(sdk *sdkImpl, addonkeycloakid string) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder {
+func newV4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder(sdk *sdkImpl, addonkeycloakid ids.AddonKeycloakID) V4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilder {
 	return &v4AddonProvidersAddonKeycloakAddonsAddonkeycloakidVersionUpdateBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		sdk:             sdk,
@@ -1776,7 +1778,7 @@ func (b *v4AddonProvidersAddonMatomoBuilderImpl) Getmatomoproviderinformation(ct
 
 // V4AddonProvidersAddonMatomoAddonsBuilder provides access to operations
 type V4AddonProvidersAddonMatomoAddonsBuilder interface {
-	Addonmatomoid(addonmatomoid string) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder
+	Addonmatomoid(addonmatomoid ids.AddonMatomoID) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder
 }
 
 // v4AddonProvidersAddonMatomoAddonsBuilderImpl implements V4AddonProvidersAddonMatomoAddonsBuilder
@@ -1790,7 +1792,7 @@ func newV4AddonProvidersAddonMatomoAddonsBuilder(sdk *sdkImpl) V4AddonProvidersA
 }
 
 // Addonmatomoid returns builder for addonmatomoid
-func (b *v4AddonProvidersAddonMatomoAddonsBuilderImpl) Addonmatomoid(addonmatomoid string) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder {
+func (b *v4AddonProvidersAddonMatomoAddonsBuilderImpl) Addonmatomoid(addonmatomoid ids.AddonMatomoID) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder {
 	return newV4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder(b.sdk, addonmatomoid)
 }
 
@@ -1804,11 +1806,11 @@ type V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder interface {
 // v4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilderImpl implements V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder
 type v4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilderImpl struct {
 	sdk           *sdkImpl
-	addonmatomoid string
+	addonmatomoid ids.AddonMatomoID
 }
 
 // newV4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder creates a new V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder
-func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder(sdk *sdkImpl, addonmatomoid string) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder {
+func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder(sdk *sdkImpl, addonmatomoid ids.AddonMatomoID) V4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilder {
 	return &v4AddonProvidersAddonMatomoAddonsAddonmatomoidBuilderImpl{
 		addonmatomoid: addonmatomoid,
 		sdk:           sdk,
@@ -1838,11 +1840,11 @@ type V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder interface {
 // v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilderImpl implements V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder
 type v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilderImpl struct {
 	sdk           *sdkImpl
-	addonmatomoid string
+	addonmatomoid ids.AddonMatomoID
 }
 
 // newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder creates a new V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder
-func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder(sdk *sdkImpl, addonmatomoid string) V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder {
+func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder(sdk *sdkImpl, addonmatomoid ids.AddonMatomoID) V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilder {
 	return &v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebootBuilderImpl{
 		addonmatomoid: addonmatomoid,
 		sdk:           sdk,
@@ -1862,11 +1864,11 @@ type V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder interface {
 // v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilderImpl implements V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder
 type v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilderImpl struct {
 	sdk           *sdkImpl
-	addonmatomoid string
+	addonmatomoid ids.AddonMatomoID
 }
 
 // newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder creates a new V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder
-func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder(sdk *sdkImpl, addonmatomoid string) V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder {
+func newV4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder(sdk *sdkImpl, addonmatomoid ids.AddonMatomoID) V4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilder {
 	return &v4AddonProvidersAddonMatomoAddonsAddonmatomoidRebuildBuilderImpl{
 		addonmatomoid: addonmatomoid,
 		sdk:           sdk,
@@ -1940,7 +1942,7 @@ func (b *v4AddonProvidersAddonMetabaseBuilderImpl) Addons() V4AddonProvidersAddo
 
 // V4AddonProvidersAddonMetabaseAddonsBuilder provides access to operations
 type V4AddonProvidersAddonMetabaseAddonsBuilder interface {
-	Addonmetabaseid(addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder
+	Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder
 }
 
 // v4AddonProvidersAddonMetabaseAddonsBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsBuilder
@@ -1954,7 +1956,7 @@ func newV4AddonProvidersAddonMetabaseAddonsBuilder(sdk *sdkImpl) V4AddonProvider
 }
 
 // Addonmetabaseid returns builder for addonmetabaseid
-func (b *v4AddonProvidersAddonMetabaseAddonsBuilderImpl) Addonmetabaseid(addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder {
+func (b *v4AddonProvidersAddonMetabaseAddonsBuilderImpl) Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder {
 	return newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder(b.sdk, addonmetabaseid)
 }
 
@@ -1969,11 +1971,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder interface {
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2008,11 +2010,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder interface {
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebootBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2032,11 +2034,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder interface
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidRebuildBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2057,11 +2059,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder interface
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2086,11 +2088,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder inter
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionCheckBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2110,11 +2112,11 @@ type V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder inte
 // v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilderImpl implements V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder
 type v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilderImpl struct {
 	sdk             *sdkImpl
-	addonmetabaseid string
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder creates a new V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder
-func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder(sdk *sdkImpl, addonmetabaseid string) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder {
+func newV4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder(sdk *sdkImpl, addonmetabaseid ids.AddonMetabaseID) V4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilder {
 	return &v4AddonProvidersAddonMetabaseAddonsAddonmetabaseidVersionUpdateBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		sdk:             sdk,
@@ -2148,7 +2150,7 @@ func (b *v4AddonProvidersAddonOtoroshiBuilderImpl) Addons() V4AddonProvidersAddo
 
 // V4AddonProvidersAddonOtoroshiAddonsBuilder provides access to operations
 type V4AddonProvidersAddonOtoroshiAddonsBuilder interface {
-	Otoroshiid(otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder
+	Otoroshiid(otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder
 }
 
 // v4AddonProvidersAddonOtoroshiAddonsBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsBuilder
@@ -2162,7 +2164,7 @@ func newV4AddonProvidersAddonOtoroshiAddonsBuilder(sdk *sdkImpl) V4AddonProvider
 }
 
 // Otoroshiid returns builder for otoroshiid
-func (b *v4AddonProvidersAddonOtoroshiAddonsBuilderImpl) Otoroshiid(otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder {
+func (b *v4AddonProvidersAddonOtoroshiAddonsBuilderImpl) Otoroshiid(otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder {
 	return newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder(b.sdk, otoroshiid)
 }
 
@@ -2179,11 +2181,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder interface {
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2228,11 +2230,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder interface {
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidConfigYAMLBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2253,11 +2255,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder interface
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidNetworkgroupBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2282,11 +2284,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder interface {
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebootBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2306,11 +2308,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder interface {
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidRebuildBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2331,11 +2333,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder interface {
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2360,11 +2362,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder interface
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionCheckBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2384,11 +2386,11 @@ type V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder interface
 // v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilderImpl implements V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder
 type v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder creates a new V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder
-func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder(sdk *sdkImpl, otoroshiid string) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder {
+func newV4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilder {
 	return &v4AddonProvidersAddonOtoroshiAddonsOtoroshiidVersionUpdateBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -2434,7 +2436,7 @@ func (b *v4AddonProvidersAddonPulsarBuilderImpl) Getpulsarproviderinfo(ctx conte
 
 // V4AddonProvidersAddonPulsarAddonsBuilder provides access to operations
 type V4AddonProvidersAddonPulsarAddonsBuilder interface {
-	Pulsarid(pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder
+	Pulsarid(pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder
 	Createpulsarv4(ctx context.Context, request *models.WannabePulsar) client.Response[models.Pulsar]
 }
 
@@ -2449,7 +2451,7 @@ func newV4AddonProvidersAddonPulsarAddonsBuilder(sdk *sdkImpl) V4AddonProvidersA
 }
 
 // Pulsarid returns builder for pulsarid
-func (b *v4AddonProvidersAddonPulsarAddonsBuilderImpl) Pulsarid(pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder {
+func (b *v4AddonProvidersAddonPulsarAddonsBuilderImpl) Pulsarid(pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder {
 	return newV4AddonProvidersAddonPulsarAddonsPulsaridBuilder(b.sdk, pulsarid)
 }
 
@@ -2473,11 +2475,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridBuilder interface {
 // v4AddonProvidersAddonPulsarAddonsPulsaridBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2532,11 +2534,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder in
 // v4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridCreateTenantAndNamespaceBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2556,11 +2558,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder in
 // v4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridDeleteTenantAndNamespaceBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2581,11 +2583,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder interfa
 // v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2613,12 +2615,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder in
 // v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2654,12 +2656,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuild
 // v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicTokenBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2680,12 +2682,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuil
 // v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridNonPersistentTopicsTopicUnloadBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2706,11 +2708,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder interface {
 // v4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridRenewBiscuitBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2731,11 +2733,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder interface {
 // v4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridStoragePoliciesBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2761,11 +2763,11 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder interface {
 // v4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder(sdk *sdkImpl, pulsarid string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder(sdk *sdkImpl, pulsarid ids.PulsarID) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridTopicsBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2793,12 +2795,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder interface {
 // v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2834,12 +2836,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder interface
 // v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicTokenBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2860,12 +2862,12 @@ type V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder interface
 // v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilderImpl implements V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder
 type v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilderImpl struct {
 	sdk      *sdkImpl
-	pulsarid string
+	pulsarid ids.PulsarID
 	topic    string
 }
 
 // newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder creates a new V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder
-func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder(sdk *sdkImpl, pulsarid string, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder {
+func newV4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder(sdk *sdkImpl, pulsarid ids.PulsarID, topic string) V4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilder {
 	return &v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilderImpl{
 		pulsarid: pulsarid,
 		sdk:      sdk,
@@ -2880,7 +2882,7 @@ func (b *v4AddonProvidersAddonPulsarAddonsPulsaridTopicsTopicUnloadBuilderImpl)
 
 // V4AddonProvidersAddonPulsarClustersBuilder provides access to operations
 type V4AddonProvidersAddonPulsarClustersBuilder interface {
-	Clusterid(clusterid string) V4AddonProvidersAddonPulsarClustersClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4AddonProvidersAddonPulsarClustersClusteridBuilder
 	Listpulsarclusters(ctx context.Context) client.Response[[]models.PulsarCluster]
 	Createpulsarcluster(ctx context.Context, request *models.WannabePulsarCluster) client.Response[models.PulsarCluster]
 }
@@ -2896,7 +2898,7 @@ func newV4AddonProvidersAddonPulsarClustersBuilder(sdk *sdkImpl) V4AddonProvider
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4AddonProvidersAddonPulsarClustersBuilderImpl) Clusterid(clusterid string) V4AddonProvidersAddonPulsarClustersClusteridBuilder {
+func (b *v4AddonProvidersAddonPulsarClustersBuilderImpl) Clusterid(clusterid ids.ClusterID) V4AddonProvidersAddonPulsarClustersClusteridBuilder {
 	return newV4AddonProvidersAddonPulsarClustersClusteridBuilder(b.sdk, clusterid)
 }
 
@@ -2920,11 +2922,11 @@ type V4AddonProvidersAddonPulsarClustersClusteridBuilder interface {
 // v4AddonProvidersAddonPulsarClustersClusteridBuilderImpl implements V4AddonProvidersAddonPulsarClustersClusteridBuilder
 type v4AddonProvidersAddonPulsarClustersClusteridBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4AddonProvidersAddonPulsarClustersClusteridBuilder creates a new V4AddonProvidersAddonPulsarClustersClusteridBuilder
-func newV4AddonProvidersAddonPulsarClustersClusteridBuilder(sdk *sdkImpl, clusterid string) V4AddonProvidersAddonPulsarClustersClusteridBuilder {
+func newV4AddonProvidersAddonPulsarClustersClusteridBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4AddonProvidersAddonPulsarClustersClusteridBuilder {
 	return &v4AddonProvidersAddonPulsarClustersClusteridBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -2980,7 +2982,7 @@ func (b *v4AddonProvidersAddonTsBuilderImpl) Token() V4AddonProvidersAddonTsToke
 
 // V4AddonProvidersAddonTsAddonsBuilder provides access to operations
 type V4AddonProvidersAddonTsAddonsBuilder interface {
-	Addontsid(addontsid string) V4AddonProvidersAddonTsAddonsAddontsidBuilder
+	Addontsid(addontsid ids.AddonTsID) V4AddonProvidersAddonTsAddonsAddontsidBuilder
 }
 
 // v4AddonProvidersAddonTsAddonsBuilderImpl implements V4AddonProvidersAddonTsAddonsBuilder
@@ -2994,7 +2996,7 @@ func newV4AddonProvidersAddonTsAddonsBuilder(sdk *sdkImpl) V4AddonProvidersAddon
 }
 
 // Addontsid returns builder for addontsid
-func (b *v4AddonProvidersAddonTsAddonsBuilderImpl) Addontsid(addontsid string) V4AddonProvidersAddonTsAddonsAddontsidBuilder {
+func (b *v4AddonProvidersAddonTsAddonsBuilderImpl) Addontsid(addontsid ids.AddonTsID) V4AddonProvidersAddonTsAddonsAddontsidBuilder {
 	return newV4AddonProvidersAddonTsAddonsAddontsidBuilder(b.sdk, addontsid)
 }
 
@@ -3007,11 +3009,11 @@ type V4AddonProvidersAddonTsAddonsAddontsidBuilder interface {
 // v4AddonProvidersAddonTsAddonsAddontsidBuilderImpl implements V4AddonProvidersAddonTsAddonsAddontsidBuilder
 type v4AddonProvidersAddonTsAddonsAddontsidBuilderImpl struct {
 	sdk       *sdkImpl
-	addontsid string
+	addontsid ids.AddonTsID
 }
 
 // newV4AddonProvidersAddonTsAddonsAddontsidBuilder creates a new V4AddonProvidersAddonTsAddonsAddontsidBuilder
-func newV4AddonProvidersAddonTsAddonsAddontsidBuilder(sdk *sdkImpl, addontsid string) V4AddonProvidersAddonTsAddonsAddontsidBuilder {
+func newV4AddonProvidersAddonTsAddonsAddontsidBuilder(sdk *sdkImpl, addontsid ids.AddonTsID) V4AddonProvidersAddonTsAddonsAddontsidBuilder {
 	return &v4AddonProvidersAddonTsAddonsAddontsidBuilderImpl{
 		addontsid: addontsid,
 		sdk:       sdk,
@@ -3036,11 +3038,11 @@ type V4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder interface {
 // v4AddonProvidersAddonTsAddonsAddontsidQuotaBuilderImpl implements V4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder
 type v4AddonProvidersAddonTsAddonsAddontsidQuotaBuilderImpl struct {
 	sdk       *sdkImpl
-	addontsid string
+	addontsid ids.AddonTsID
 }
 
 // newV4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder creates a new V4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder
-func newV4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder(sdk *sdkImpl, addontsid string) V4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder {
+func newV4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder(sdk *sdkImpl, addontsid ids.AddonTsID) V4AddonProvidersAddonTsAddonsAddontsidQuotaBuilder {
 	return &v4AddonProvidersAddonTsAddonsAddontsidQuotaBuilderImpl{
 		addontsid: addontsid,
 		sdk:       sdk,
@@ -3134,7 +3136,7 @@ func (b *v4AddonProvidersConfigProviderBuilderImpl) Addons() V4AddonProvidersCon
 
 // V4AddonProvidersConfigProviderAddonsBuilder provides access to operations
 type V4AddonProvidersConfigProviderAddonsBuilder interface {
-	Addonid(addonid string) V4AddonProvidersConfigProviderAddonsAddonidBuilder
+	Addonid(addonid ids.AddonID) V4AddonProvidersConfigProviderAddonsAddonidBuilder
 }
 
 // v4AddonProvidersConfigProviderAddonsBuilderImpl implements V4AddonProvidersConfigProviderAddonsBuilder
@@ -3148,7 +3150,7 @@ func newV4AddonProvidersConfigProviderAddonsBuilder(sdk *sdkImpl) V4AddonProvide
 }
 
 // Addonid returns builder for addonid
-func (b *v4AddonProvidersConfigProviderAddonsBuilderImpl) Addonid(addonid string) V4AddonProvidersConfigProviderAddonsAddonidBuilder {
+func (b *v4AddonProvidersConfigProviderAddonsBuilderImpl) Addonid(addonid ids.AddonID) V4AddonProvidersConfigProviderAddonsAddonidBuilder {
 	return newV4AddonProvidersConfigProviderAddonsAddonidBuilder(b.sdk, addonid)
 }
 
@@ -3161,11 +3163,11 @@ type V4AddonProvidersConfigProviderAddonsAddonidBuilder interface {
 // v4AddonProvidersConfigProviderAddonsAddonidBuilderImpl implements V4AddonProvidersConfigProviderAddonsAddonidBuilder
 type v4AddonProvidersConfigProviderAddonsAddonidBuilderImpl struct {
 	sdk     *sdkImpl
-	addonid string
+	addonid ids.AddonID
 }
 
 // newV4AddonProvidersConfigProviderAddonsAddonidBuilder creates a new V4AddonProvidersConfigProviderAddonsAddonidBuilder
-func newV4AddonProvidersConfigProviderAddonsAddonidBuilder(sdk *sdkImpl, addonid string) V4AddonProvidersConfigProviderAddonsAddonidBuilder {
+func newV4AddonProvidersConfigProviderAddonsAddonidBuilder(sdk *sdkImpl, addonid ids.AddonID) V4AddonProvidersConfigProviderAddonsAddonidBuilder {
 	return &v4AddonProvidersConfigProviderAddonsAddonidBuilderImpl{
 		addonid: addonid,
 		sdk:     sdk,
@@ -3191,11 +3193,11 @@ type V4AddonProvidersConfigProviderAddonsAddonidEnvBuilder interface {
 // v4AddonProvidersConfigProviderAddonsAddonidEnvBuilderImpl implements V4AddonProvidersConfigProviderAddonsAddonidEnvBuilder
 type v4AddonProvidersConfigProviderAddonsAddonidEnvBuilderImpl struct {
 	sdk     *sdkImpl
-	addonid string
+	addonid ids.AddonID
 }
 
 // newV4AddonProvidersConfigProviderAddonsAddonidEnvBuilder creates a new V4AddonProvidersConfigProviderAddonsAddonidEnvBuilder
-func newV4AddonProvidersConfigProviderAddonsAddonidEnvBuilder(sdk *sdkImpl, addonid string) V4AddonProvidersConfigProviderAddonsAddonidEnvBuilder {
+func newV4AddonProvidersConfigProviderAddonsAddonidEnvBuilder(sdk *sdkImpl, addonid ids.AddonID) V4AddonProvidersConfigProviderAddonsAddonidEnvBuilder {
 	return &v4AddonProvidersConfigProviderAddonsAddonidEnvBuilderImpl{
 		addonid: addonid,
 		sdk:     sdk,
@@ -3314,7 +3316,7 @@ func (b *v4AiBuilderImpl) Organisations() V4AiOrganisationsBuilder {
 
 // V4AiOrganisationsBuilder provides access to operations
 type V4AiOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4AiOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4AiOrganisationsOwneridBuilder
 }
 
 // v4AiOrganisationsBuilderImpl implements V4AiOrganisationsBuilder
@@ -3328,7 +3330,7 @@ func newV4AiOrganisationsBuilder(sdk *sdkImpl) V4AiOrganisationsBuilder {
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4AiOrganisationsBuilderImpl) Ownerid(ownerid string) V4AiOrganisationsOwneridBuilder {
+func (b *v4AiOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4AiOrganisationsOwneridBuilder {
 	return newV4AiOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -3340,11 +3342,11 @@ type V4AiOrganisationsOwneridBuilder interface {
 // v4AiOrganisationsOwneridBuilderImpl implements V4AiOrganisationsOwneridBuilder
 type v4AiOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4AiOrganisationsOwneridBuilder creates a new V4AiOrganisationsOwneridBuilder
-func newV4AiOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4AiOrganisationsOwneridBuilder {
+func newV4AiOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4AiOrganisationsOwneridBuilder {
 	return &v4AiOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -3358,17 +3360,17 @@ func (b *v4AiOrganisationsOwneridBuilderImpl) Ai() V4AiOrganisationsOwneridAiBui
 
 // V4AiOrganisationsOwneridAiBuilder provides access to operations
 type V4AiOrganisationsOwneridAiBuilder interface {
-	Aiid(aiid string) V4AiOrganisationsOwneridAiAiidBuilder
+	Aiid(aiid ids.AIID) V4AiOrganisationsOwneridAiAiidBuilder
 }
 
 // v4AiOrganisationsOwneridAiBuilderImpl implements V4AiOrganisationsOwneridAiBuilder
 type v4AiOrganisationsOwneridAiBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4AiOrganisationsOwneridAiBuilder creates a new V4AiOrganisationsOwneridAiBuilder
-func newV4AiOrganisationsOwneridAiBuilder(sdk *sdkImpl, ownerid string) V4AiOrganisationsOwneridAiBuilder {
+func newV4AiOrganisationsOwneridAiBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4AiOrganisationsOwneridAiBuilder {
 	return &v4AiOrganisationsOwneridAiBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -3376,7 +3378,7 @@ func newV4AiOrganisationsOwneridAiBuilder(sdk *sdkImpl, ownerid string) V4AiOrga
 }
 
 // Aiid returns builder for aiid
-func (b *v4AiOrganisationsOwneridAiBuilderImpl) Aiid(aiid string) V4AiOrganisationsOwneridAiAiidBuilder {
+func (b *v4AiOrganisationsOwneridAiBuilderImpl) Aiid(aiid ids.AIID) V4AiOrganisationsOwneridAiAiidBuilder {
 	return newV4AiOrganisationsOwneridAiAiidBuilder(b.sdk, b.ownerid, aiid)
 }
 
@@ -3389,12 +3391,12 @@ type V4AiOrganisationsOwneridAiAiidBuilder interface {
 // v4AiOrganisationsOwneridAiAiidBuilderImpl implements V4AiOrganisationsOwneridAiAiidBuilder
 type v4AiOrganisationsOwneridAiAiidBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	aiid    string
+	ownerid ids.OwnerID
+	aiid    ids.AIID
 }
 
 // newV4AiOrganisationsOwneridAiAiidBuilder creates a new V4AiOrganisationsOwneridAiAiidBuilder
-func newV4AiOrganisationsOwneridAiAiidBuilder(sdk *sdkImpl, ownerid string, aiid string) V4AiOrganisationsOwneridAiAiidBuilder {
+func newV4AiOrganisationsOwneridAiAiidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID) V4AiOrganisationsOwneridAiAiidBuilder {
 	return &v4AiOrganisationsOwneridAiAiidBuilderImpl{
 		aiid:    aiid,
 		ownerid: ownerid,
@@ -3414,20 +3416,20 @@ func (b *v4AiOrganisationsOwneridAiAiidBuilderImpl) Providers() V4AiOrganisation
 
 // V4AiOrganisationsOwneridAiAiidEndpointsBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidEndpointsBuilder interface {
-	Endpointid(endpointid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder
-	Listaiendpoints(ctx context.Context) client.Response[client.Nothing]
+	Endpointid(endpointid ids.EndpointID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder
+	Listaiendpoints(ctx context.Context) client.Response[json.RawMessage]
 	Createendpoint(ctx context.Context, request *models.CreateEndpointRequest) client.Response[models.AICreationResponse]
 }
 
 // v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	aiid    string
+	ownerid ids.OwnerID
+	aiid    ids.AIID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsBuilder(sdk *sdkImpl, ownerid string, aiid string) V4AiOrganisationsOwneridAiAiidEndpointsBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID) V4AiOrganisationsOwneridAiAiidEndpointsBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl{
 		aiid:    aiid,
 		ownerid: ownerid,
@@ -3436,12 +3438,12 @@ func newV4AiOrganisationsOwneridAiAiidEndpointsBuilder(sdk *sdkImpl, ownerid str
 }
 
 // Endpointid returns builder for endpointid
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl) Endpointid(endpointid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl) Endpointid(endpointid ids.EndpointID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder {
 	return newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder(b.sdk, b.ownerid, b.aiid, endpointid)
 }
 
 // Listaiendpoints calls ai.Listaiendpoints
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl) Listaiendpoints(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsBuilderImpl) Listaiendpoints(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Listaiendpoints(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid)
 }
 
@@ -3462,13 +3464,13 @@ type V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder interface {
 // v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	aiid       string
-	endpointid string
+	ownerid    ids.OwnerID
+	aiid       ids.AIID
+	endpointid ids.EndpointID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder(sdk *sdkImpl, ownerid string, aiid string, endpointid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID, endpointid ids.EndpointID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilderImpl{
 		aiid:       aiid,
 		endpointid: endpointid,
@@ -3504,21 +3506,21 @@ func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBuilderImpl) Updateend
 
 // V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder interface {
-	APIkeyid(apikeyid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
-	Getaiapikeys(ctx context.Context) client.Response[client.Nothing]
+	APIkeyid(apikeyid ids.ApikeyID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
+	Getaiapikeys(ctx context.Context) client.Response[json.RawMessage]
 	Createotoroshiapikey(ctx context.Context, request *models.CreateApiKeyRequest) client.Response[client.Nothing]
 }
 
 // v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	aiid       string
-	endpointid string
+	ownerid    ids.OwnerID
+	aiid       ids.AIID
+	endpointid ids.EndpointID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder(sdk *sdkImpl, ownerid string, aiid string, endpointid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID, endpointid ids.EndpointID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl{
 		aiid:       aiid,
 		endpointid: endpointid,
@@ -3528,12 +3530,12 @@ func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilder(sdk *sdk
 }
 
 // APIkeyid returns builder for apikeyid
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl) APIkeyid(apikeyid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl) APIkeyid(apikeyid ids.ApikeyID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder {
 	return newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder(b.sdk, b.ownerid, b.aiid, b.endpointid, apikeyid)
 }
 
 // Getaiapikeys calls ai.Getaiapikeys
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl) Getaiapikeys(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl) Getaiapikeys(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Getaiapikeys(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid, b.endpointid)
 }
 
@@ -3545,21 +3547,21 @@ func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysBuilderImpl) Cr
 // V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder interface {
 	Deleteotoroshiapikey(ctx context.Context) client.Response[models.ApiKeyDeletionResult]
-	Getaiapikey(ctx context.Context) client.Response[client.Nothing]
+	Getaiapikey(ctx context.Context) client.Response[json.RawMessage]
 	Updateotoroshiapikey(ctx context.Context) client.Response[client.Nothing]
 }
 
 // v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	aiid       string
-	endpointid string
-	apikeyid   string
+	ownerid    ids.OwnerID
+	aiid       ids.AIID
+	endpointid ids.EndpointID
+	apikeyid   ids.ApikeyID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder(sdk *sdkImpl, ownerid string, aiid string, endpointid string, apikeyid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID, endpointid ids.EndpointID, apikeyid ids.ApikeyID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilderImpl{
 		aiid:       aiid,
 		apikeyid:   apikeyid,
@@ -3575,7 +3577,7 @@ func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
 }
 
 // Getaiapikey calls ai.Getaiapikey
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilderImpl) Getaiapikey(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilderImpl) Getaiapikey(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Getaiapikey(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid, b.endpointid, b.apikeyid)
 }
 
@@ -3586,20 +3588,20 @@ func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidAPIkeysAPIkeyidBuilder
 
 // V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder interface {
-	Budgetid(budgetid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder
-	Getendpointbudgets(ctx context.Context) client.Response[client.Nothing]
+	Budgetid(budgetid ids.BudgetID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder
+	Getendpointbudgets(ctx context.Context) client.Response[json.RawMessage]
 }
 
 // v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	aiid       string
-	endpointid string
+	ownerid    ids.OwnerID
+	aiid       ids.AIID
+	endpointid ids.EndpointID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder(sdk *sdkImpl, ownerid string, aiid string, endpointid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID, endpointid ids.EndpointID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl{
 		aiid:       aiid,
 		endpointid: endpointid,
@@ -3609,31 +3611,31 @@ func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilder(sdk *sdk
 }
 
 // Budgetid returns builder for budgetid
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl) Budgetid(budgetid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl) Budgetid(budgetid ids.BudgetID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder {
 	return newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder(b.sdk, b.ownerid, b.aiid, b.endpointid, budgetid)
 }
 
 // Getendpointbudgets calls ai.Getendpointbudgets
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl) Getendpointbudgets(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBuilderImpl) Getendpointbudgets(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Getendpointbudgets(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid, b.endpointid)
 }
 
 // V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder interface {
-	Getbudget(ctx context.Context) client.Response[client.Nothing]
+	Getbudget(ctx context.Context) client.Response[json.RawMessage]
 }
 
 // v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilderImpl implements V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder
 type v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	aiid       string
-	endpointid string
-	budgetid   string
+	ownerid    ids.OwnerID
+	aiid       ids.AIID
+	endpointid ids.EndpointID
+	budgetid   ids.BudgetID
 }
 
 // newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder creates a new V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder
-func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder(sdk *sdkImpl, ownerid string, aiid string, endpointid string, budgetid string) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder {
+func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID, endpointid ids.EndpointID, budgetid ids.BudgetID) V4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder {
 	return &v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilderImpl{
 		aiid:       aiid,
 		budgetid:   budgetid,
@@ -3644,24 +3646,24 @@ func newV4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilder(
 }
 
 // Getbudget calls ai.Getbudget
-func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilderImpl) Getbudget(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidEndpointsEndpointidBudgetsBudgetidBuilderImpl) Getbudget(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Getbudget(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid, b.endpointid, b.budgetid)
 }
 
 // V4AiOrganisationsOwneridAiAiidProvidersBuilder provides access to operations
 type V4AiOrganisationsOwneridAiAiidProvidersBuilder interface {
-	Getproviderinfos(ctx context.Context) client.Response[client.Nothing]
+	Getproviderinfos(ctx context.Context) client.Response[json.RawMessage]
 }
 
 // v4AiOrganisationsOwneridAiAiidProvidersBuilderImpl implements V4AiOrganisationsOwneridAiAiidProvidersBuilder
 type v4AiOrganisationsOwneridAiAiidProvidersBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	aiid    string
+	ownerid ids.OwnerID
+	aiid    ids.AIID
 }
 
 // newV4AiOrganisationsOwneridAiAiidProvidersBuilder creates a new V4AiOrganisationsOwneridAiAiidProvidersBuilder
-func newV4AiOrganisationsOwneridAiAiidProvidersBuilder(sdk *sdkImpl, ownerid string, aiid string) V4AiOrganisationsOwneridAiAiidProvidersBuilder {
+func newV4AiOrganisationsOwneridAiAiidProvidersBuilder(sdk *sdkImpl, ownerid ids.OwnerID, aiid ids.AIID) V4AiOrganisationsOwneridAiAiidProvidersBuilder {
 	return &v4AiOrganisationsOwneridAiAiidProvidersBuilderImpl{
 		aiid:    aiid,
 		ownerid: ownerid,
@@ -3670,7 +3672,7 @@ func newV4AiOrganisationsOwneridAiAiidProvidersBuilder(sdk *sdkImpl, ownerid str
 }
 
 // Getproviderinfos calls ai.Getproviderinfos
-func (b *v4AiOrganisationsOwneridAiAiidProvidersBuilderImpl) Getproviderinfos(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4AiOrganisationsOwneridAiAiidProvidersBuilderImpl) Getproviderinfos(ctx context.Context) client.Response[json.RawMessage] {
 	return ai.Getproviderinfos(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.aiid)
 }
 
@@ -3696,7 +3698,7 @@ func (b *v4CellarBuilderImpl) Organisations() V4CellarOrganisationsBuilder {
 
 // V4CellarOrganisationsBuilder provides access to operations
 type V4CellarOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4CellarOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4CellarOrganisationsOwneridBuilder
 }
 
 // v4CellarOrganisationsBuilderImpl implements V4CellarOrganisationsBuilder
@@ -3710,7 +3712,7 @@ func newV4CellarOrganisationsBuilder(sdk *sdkImpl) V4CellarOrganisationsBuilder
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4CellarOrganisationsBuilderImpl) Ownerid(ownerid string) V4CellarOrganisationsOwneridBuilder {
+func (b *v4CellarOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4CellarOrganisationsOwneridBuilder {
 	return newV4CellarOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -3723,11 +3725,11 @@ type V4CellarOrganisationsOwneridBuilder interface {
 // v4CellarOrganisationsOwneridBuilderImpl implements V4CellarOrganisationsOwneridBuilder
 type v4CellarOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4CellarOrganisationsOwneridBuilder creates a new V4CellarOrganisationsOwneridBuilder
-func newV4CellarOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4CellarOrganisationsOwneridBuilder {
+func newV4CellarOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4CellarOrganisationsOwneridBuilder {
 	return &v4CellarOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -3746,18 +3748,18 @@ func (b *v4CellarOrganisationsOwneridBuilderImpl) Clusters() V4CellarOrganisatio
 
 // V4CellarOrganisationsOwneridCellarBuilder provides access to operations
 type V4CellarOrganisationsOwneridCellarBuilder interface {
-	Cellarid(cellarid string) V4CellarOrganisationsOwneridCellarCellaridBuilder
+	Cellarid(cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridBuilder
 	Consumptions() V4CellarOrganisationsOwneridCellarConsumptionsBuilder
 }
 
 // v4CellarOrganisationsOwneridCellarBuilderImpl implements V4CellarOrganisationsOwneridCellarBuilder
 type v4CellarOrganisationsOwneridCellarBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4CellarOrganisationsOwneridCellarBuilder creates a new V4CellarOrganisationsOwneridCellarBuilder
-func newV4CellarOrganisationsOwneridCellarBuilder(sdk *sdkImpl, ownerid string) V4CellarOrganisationsOwneridCellarBuilder {
+func newV4CellarOrganisationsOwneridCellarBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4CellarOrganisationsOwneridCellarBuilder {
 	return &v4CellarOrganisationsOwneridCellarBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -3765,7 +3767,7 @@ func newV4CellarOrganisationsOwneridCellarBuilder(sdk *sdkImpl, ownerid string)
 }
 
 // Cellarid returns builder for cellarid
-func (b *v4CellarOrganisationsOwneridCellarBuilderImpl) Cellarid(cellarid string) V4CellarOrganisationsOwneridCellarCellaridBuilder {
+func (b *v4CellarOrganisationsOwneridCellarBuilderImpl) Cellarid(cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridBuilder {
 	return newV4CellarOrganisationsOwneridCellarCellaridBuilder(b.sdk, b.ownerid, cellarid)
 }
 
@@ -3785,12 +3787,12 @@ type V4CellarOrganisationsOwneridCellarCellaridBuilder interface {
 // v4CellarOrganisationsOwneridCellarCellaridBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -3828,12 +3830,12 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBuilder interface {
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridBucketsBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridBucketsBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -3867,13 +3869,13 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder interfac
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -3914,13 +3916,13 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder i
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -3964,14 +3966,14 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkey
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 	objectkey  string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4004,14 +4006,14 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkey
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 	objectkey  string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsObjectkeyPresignedURLBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4034,13 +4036,13 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadU
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsDownloadURLBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4062,13 +4064,13 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBui
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4090,14 +4092,14 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObj
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 	objectkey  string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string, objectkey string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadObjectkeyBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4120,13 +4122,13 @@ type V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURL
 // v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder
 type v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	cellarid   string
+	ownerid    ids.OwnerID
+	cellarid   ids.CellarID
 	bucketname string
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder(sdk *sdkImpl, ownerid string, cellarid string, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID, bucketname string) V4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridBucketsBucketnameObjectsUploadURLBuilderImpl{
 		bucketname: bucketname,
 		cellarid:   cellarid,
@@ -4150,12 +4152,12 @@ type V4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder interface {
 // v4CellarOrganisationsOwneridCellarCellaridCredentialsBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder
 type v4CellarOrganisationsOwneridCellarCellaridCredentialsBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridCredentialsBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridCredentialsBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -4186,12 +4188,12 @@ type V4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder in
 // v4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder
 type v4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridCredentialsPresignedURLBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -4212,12 +4214,12 @@ type V4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder interface
 // v4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder
 type v4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridCredentialsRenewBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -4238,12 +4240,12 @@ type V4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder interface {
 // v4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilderImpl implements V4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder
 type v4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilderImpl struct {
 	sdk      *sdkImpl
-	ownerid  string
-	cellarid string
+	ownerid  ids.OwnerID
+	cellarid ids.CellarID
 }
 
 // newV4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder creates a new V4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder
-func newV4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder(sdk *sdkImpl, ownerid string, cellarid string) V4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder {
+func newV4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder(sdk *sdkImpl, ownerid ids.OwnerID, cellarid ids.CellarID) V4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilder {
 	return &v4CellarOrganisationsOwneridCellarCellaridCredentialsCfgBuilderImpl{
 		cellarid: cellarid,
 		ownerid:  ownerid,
@@ -4264,11 +4266,11 @@ type V4CellarOrganisationsOwneridCellarConsumptionsBuilder interface {
 // v4CellarOrganisationsOwneridCellarConsumptionsBuilderImpl implements V4CellarOrganisationsOwneridCellarConsumptionsBuilder
 type v4CellarOrganisationsOwneridCellarConsumptionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4CellarOrganisationsOwneridCellarConsumptionsBuilder creates a new V4CellarOrganisationsOwneridCellarConsumptionsBuilder
-func newV4CellarOrganisationsOwneridCellarConsumptionsBuilder(sdk *sdkImpl, ownerid string) V4CellarOrganisationsOwneridCellarConsumptionsBuilder {
+func newV4CellarOrganisationsOwneridCellarConsumptionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4CellarOrganisationsOwneridCellarConsumptionsBuilder {
 	return &v4CellarOrganisationsOwneridCellarConsumptionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -4290,11 +4292,11 @@ type V4CellarOrganisationsOwneridClustersBuilder interface {
 // v4CellarOrganisationsOwneridClustersBuilderImpl implements V4CellarOrganisationsOwneridClustersBuilder
 type v4CellarOrganisationsOwneridClustersBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4CellarOrganisationsOwneridClustersBuilder creates a new V4CellarOrganisationsOwneridClustersBuilder
-func newV4CellarOrganisationsOwneridClustersBuilder(sdk *sdkImpl, ownerid string) V4CellarOrganisationsOwneridClustersBuilder {
+func newV4CellarOrganisationsOwneridClustersBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4CellarOrganisationsOwneridClustersBuilder {
 	return &v4CellarOrganisationsOwneridClustersBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -4326,12 +4328,12 @@ type V4CellarOrganisationsOwneridClustersClusterindexBuilder interface {
 // v4CellarOrganisationsOwneridClustersClusterindexBuilderImpl implements V4CellarOrganisationsOwneridClustersClusterindexBuilder
 type v4CellarOrganisationsOwneridClustersClusterindexBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
+	ownerid      ids.OwnerID
 	clusterindex int64
 }
 
 // newV4CellarOrganisationsOwneridClustersClusterindexBuilder creates a new V4CellarOrganisationsOwneridClustersClusterindexBuilder
-func newV4CellarOrganisationsOwneridClustersClusterindexBuilder(sdk *sdkImpl, ownerid string, clusterindex int64) V4CellarOrganisationsOwneridClustersClusterindexBuilder {
+func newV4CellarOrganisationsOwneridClustersClusterindexBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterindex int64) V4CellarOrganisationsOwneridClustersClusterindexBuilder {
 	return &v4CellarOrganisationsOwneridClustersClusterindexBuilderImpl{
 		clusterindex: clusterindex,
 		ownerid:      ownerid,
@@ -4636,7 +4638,7 @@ func (b *v4ComputePlacementDryRunDebugBuilderImpl) Dryrunplacementdebug(ctx cont
 
 // V4ComputeVirtualMachinesBuilder provides access to operations
 type V4ComputeVirtualMachinesBuilder interface {
-	Virtualmachineid(virtualmachineid string) V4ComputeVirtualMachinesVirtualmachineidBuilder
+	Virtualmachineid(virtualmachineid ids.VirtualMachineID) V4ComputeVirtualMachinesVirtualmachineidBuilder
 	Listvirtualmachines(ctx context.Context, opts ...infrastructure.Option) client.Response[client.Nothing]
 }
 
@@ -4651,7 +4653,7 @@ func newV4ComputeVirtualMachinesBuilder(sdk *sdkImpl) V4ComputeVirtualMachinesBu
 }
 
 // Virtualmachineid returns builder for virtualmachineid
-func (b *v4ComputeVirtualMachinesBuilderImpl) Virtualmachineid(virtualmachineid string) V4ComputeVirtualMachinesVirtualmachineidBuilder {
+func (b *v4ComputeVirtualMachinesBuilderImpl) Virtualmachineid(virtualmachineid ids.VirtualMachineID) V4ComputeVirtualMachinesVirtualmachineidBuilder {
 	return newV4ComputeVirtualMachinesVirtualmachineidBuilder(b.sdk, virtualmachineid)
 }
 
@@ -4668,11 +4670,11 @@ type V4ComputeVirtualMachinesVirtualmachineidBuilder interface {
 // v4ComputeVirtualMachinesVirtualmachineidBuilderImpl implements V4ComputeVirtualMachinesVirtualmachineidBuilder
 type v4ComputeVirtualMachinesVirtualmachineidBuilderImpl struct {
 	sdk              *sdkImpl
-	virtualmachineid string
+	virtualmachineid ids.VirtualMachineID
 }
 
 // newV4ComputeVirtualMachinesVirtualmachineidBuilder creates a new V4ComputeVirtualMachinesVirtualmachineidBuilder
-func newV4ComputeVirtualMachinesVirtualmachineidBuilder(sdk *sdkImpl, virtualmachineid string) V4ComputeVirtualMachinesVirtualmachineidBuilder {
+func newV4ComputeVirtualMachinesVirtualmachineidBuilder(sdk *sdkImpl, virtualmachineid ids.VirtualMachineID) V4ComputeVirtualMachinesVirtualmachineidBuilder {
 	return &v4ComputeVirtualMachinesVirtualmachineidBuilderImpl{
 		sdk:              sdk,
 		virtualmachineid: virtualmachineid,
@@ -4706,7 +4708,7 @@ func (b *v4DnsBuilderImpl) Organisations() V4DnsOrganisationsBuilder {
 
 // V4DnsOrganisationsBuilder provides access to operations
 type V4DnsOrganisationsBuilder interface {
-	Tenantid(tenantid string) V4DnsOrganisationsTenantidBuilder
+	Tenantid(tenantid ids.TenantID) V4DnsOrganisationsTenantidBuilder
 }
 
 // v4DnsOrganisationsBuilderImpl implements V4DnsOrganisationsBuilder
@@ -4720,7 +4722,7 @@ func newV4DnsOrganisationsBuilder(sdk *sdkImpl) V4DnsOrganisationsBuilder {
 }
 
 // Tenantid returns builder for tenantid
-func (b *v4DnsOrganisationsBuilderImpl) Tenantid(tenantid string) V4DnsOrganisationsTenantidBuilder {
+func (b *v4DnsOrganisationsBuilderImpl) Tenantid(tenantid ids.TenantID) V4DnsOrganisationsTenantidBuilder {
 	return newV4DnsOrganisationsTenantidBuilder(b.sdk, tenantid)
 }
 
@@ -4734,11 +4736,11 @@ type V4DnsOrganisationsTenantidBuilder interface {
 // v4DnsOrganisationsTenantidBuilderImpl implements V4DnsOrganisationsTenantidBuilder
 type v4DnsOrganisationsTenantidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4DnsOrganisationsTenantidBuilder creates a new V4DnsOrganisationsTenantidBuilder
-func newV4DnsOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid string) V4DnsOrganisationsTenantidBuilder {
+func newV4DnsOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4DnsOrganisationsTenantidBuilder {
 	return &v4DnsOrganisationsTenantidBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -4768,11 +4770,11 @@ type V4DnsOrganisationsTenantidAuditBuilder interface {
 // v4DnsOrganisationsTenantidAuditBuilderImpl implements V4DnsOrganisationsTenantidAuditBuilder
 type v4DnsOrganisationsTenantidAuditBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4DnsOrganisationsTenantidAuditBuilder creates a new V4DnsOrganisationsTenantidAuditBuilder
-func newV4DnsOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid string) V4DnsOrganisationsTenantidAuditBuilder {
+func newV4DnsOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4DnsOrganisationsTenantidAuditBuilder {
 	return &v4DnsOrganisationsTenantidAuditBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -4793,11 +4795,11 @@ type V4DnsOrganisationsTenantidRecordsBuilder interface {
 // v4DnsOrganisationsTenantidRecordsBuilderImpl implements V4DnsOrganisationsTenantidRecordsBuilder
 type v4DnsOrganisationsTenantidRecordsBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4DnsOrganisationsTenantidRecordsBuilder creates a new V4DnsOrganisationsTenantidRecordsBuilder
-func newV4DnsOrganisationsTenantidRecordsBuilder(sdk *sdkImpl, tenantid string) V4DnsOrganisationsTenantidRecordsBuilder {
+func newV4DnsOrganisationsTenantidRecordsBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4DnsOrganisationsTenantidRecordsBuilder {
 	return &v4DnsOrganisationsTenantidRecordsBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -4816,17 +4818,17 @@ func (b *v4DnsOrganisationsTenantidRecordsBuilderImpl) Listdnsrecordsforowner(ct
 
 // V4DnsOrganisationsTenantidResourcesBuilder provides access to operations
 type V4DnsOrganisationsTenantidResourcesBuilder interface {
-	Resourceid(resourceid string) V4DnsOrganisationsTenantidResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidBuilder
 }
 
 // v4DnsOrganisationsTenantidResourcesBuilderImpl implements V4DnsOrganisationsTenantidResourcesBuilder
 type v4DnsOrganisationsTenantidResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4DnsOrganisationsTenantidResourcesBuilder creates a new V4DnsOrganisationsTenantidResourcesBuilder
-func newV4DnsOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid string) V4DnsOrganisationsTenantidResourcesBuilder {
+func newV4DnsOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4DnsOrganisationsTenantidResourcesBuilder {
 	return &v4DnsOrganisationsTenantidResourcesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -4834,7 +4836,7 @@ func newV4DnsOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid string
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4DnsOrganisationsTenantidResourcesBuilderImpl) Resourceid(resourceid string) V4DnsOrganisationsTenantidResourcesResourceidBuilder {
+func (b *v4DnsOrganisationsTenantidResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidBuilder {
 	return newV4DnsOrganisationsTenantidResourcesResourceidBuilder(b.sdk, b.tenantid, resourceid)
 }
 
@@ -4847,12 +4849,12 @@ type V4DnsOrganisationsTenantidResourcesResourceidBuilder interface {
 // v4DnsOrganisationsTenantidResourcesResourceidBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4DnsOrganisationsTenantidResourcesResourceidBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -4872,19 +4874,19 @@ func (b *v4DnsOrganisationsTenantidResourcesResourceidBuilderImpl) Records() V4D
 
 // V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder provides access to operations
 type V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder interface {
-	Recordid(recordid string) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder
+	Recordid(recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder
 	Listdnsauditsforresource(ctx context.Context) client.Response[[]models.DnsAudit]
 }
 
 // v4DnsOrganisationsTenantidResourcesResourceidAuditBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidAuditBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidAuditBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidAuditBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidAuditBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidAuditBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidAuditBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -4893,7 +4895,7 @@ func newV4DnsOrganisationsTenantidResourcesResourceidAuditBuilder(sdk *sdkImpl,
 }
 
 // Recordid returns builder for recordid
-func (b *v4DnsOrganisationsTenantidResourcesResourceidAuditBuilderImpl) Recordid(recordid string) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder {
+func (b *v4DnsOrganisationsTenantidResourcesResourceidAuditBuilderImpl) Recordid(recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder {
 	return newV4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder(b.sdk, b.tenantid, b.resourceid, recordid)
 }
 
@@ -4910,13 +4912,13 @@ type V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder interface
 // v4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
-	recordid   string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
+	recordid   ids.RecordID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder(sdk *sdkImpl, tenantid string, resourceid string, recordid string) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilderImpl{
 		recordid:   recordid,
 		resourceid: resourceid,
@@ -4932,22 +4934,22 @@ func (b *v4DnsOrganisationsTenantidResourcesResourceidAuditRecordidBuilderImpl)
 
 // V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder provides access to operations
 type V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder interface {
-	Recordid(recordid string) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder
+	Recordid(recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder
 	Type() V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder
 	Deletednsrecordsforresource(ctx context.Context) client.Response[client.Nothing]
 	Listdnsrecordsforresource(ctx context.Context) client.Response[[]models.DnsRecord1]
-	Creatednsrecords(ctx context.Context) client.Response[[]models.DnsRecordIdResponse]
+	Creatednsrecords(ctx context.Context, request any) client.Response[[]models.DnsRecordIdResponse]
 }
 
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -4956,7 +4958,7 @@ func newV4DnsOrganisationsTenantidResourcesResourceidRecordsBuilder(sdk *sdkImpl
 }
 
 // Recordid returns builder for recordid
-func (b *v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl) Recordid(recordid string) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder {
+func (b *v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl) Recordid(recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder {
 	return newV4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder(b.sdk, b.tenantid, b.resourceid, recordid)
 }
 
@@ -4976,8 +4978,8 @@ func (b *v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl) Listdn
 }
 
 // Creatednsrecords calls dns.Creatednsrecords
-func (b *v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl) Creatednsrecords(ctx context.Context) client.Response[[]models.DnsRecordIdResponse] {
-	return dns.Creatednsrecords(ctx, b.sdk.Client(), b.sdk.Tracer(), b.tenantid, b.resourceid)
+func (b *v4DnsOrganisationsTenantidResourcesResourceidRecordsBuilderImpl) Creatednsrecords(ctx context.Context, request any) client.Response[[]models.DnsRecordIdResponse] {
+	return dns.Creatednsrecords(ctx, b.sdk.Client(), b.sdk.Tracer(), b.tenantid, b.resourceid, request)
 }
 
 // V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder provides access to operations
@@ -4989,13 +4991,13 @@ type V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder interfa
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
-	recordid   string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
+	recordid   ids.RecordID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder(sdk *sdkImpl, tenantid string, resourceid string, recordid string) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, recordid ids.RecordID) V4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsRecordidBuilderImpl{
 		recordid:   recordid,
 		resourceid: resourceid,
@@ -5022,12 +5024,12 @@ type V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder interface {
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -5048,13 +5050,13 @@ type V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder interfa
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	type_      string
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder(sdk *sdkImpl, tenantid string, resourceid string, type_ string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, type_ string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -5076,13 +5078,13 @@ type V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder int
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	type_      string
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder(sdk *sdkImpl, tenantid string, resourceid string, type_ string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, type_ string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -5105,14 +5107,14 @@ type V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameB
 // v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilderImpl implements V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder
 type v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	type_      string
 	recordname string
 }
 
 // newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder creates a new V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder
-func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder(sdk *sdkImpl, tenantid string, resourceid string, type_ string, recordname string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder {
+func newV4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, type_ string, recordname string) V4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilder {
 	return &v4DnsOrganisationsTenantidResourcesResourceidRecordsTypeTypeNameRecordnameBuilderImpl{
 		recordname: recordname,
 		resourceid: resourceid,
@@ -5154,7 +5156,7 @@ func (b *v4DrainsBuilderImpl) Organisations() V4DrainsOrganisationsBuilder {
 
 // V4DrainsOrganisationsBuilder provides access to operations
 type V4DrainsOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4DrainsOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4DrainsOrganisationsOwneridBuilder
 }
 
 // v4DrainsOrganisationsBuilderImpl implements V4DrainsOrganisationsBuilder
@@ -5168,7 +5170,7 @@ func newV4DrainsOrganisationsBuilder(sdk *sdkImpl) V4DrainsOrganisationsBuilder
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4DrainsOrganisationsBuilderImpl) Ownerid(ownerid string) V4DrainsOrganisationsOwneridBuilder {
+func (b *v4DrainsOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4DrainsOrganisationsOwneridBuilder {
 	return newV4DrainsOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -5182,11 +5184,11 @@ type V4DrainsOrganisationsOwneridBuilder interface {
 // v4DrainsOrganisationsOwneridBuilderImpl implements V4DrainsOrganisationsOwneridBuilder
 type v4DrainsOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4DrainsOrganisationsOwneridBuilder creates a new V4DrainsOrganisationsOwneridBuilder
-func newV4DrainsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4DrainsOrganisationsOwneridBuilder {
+func newV4DrainsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4DrainsOrganisationsOwneridBuilder {
 	return &v4DrainsOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5210,17 +5212,17 @@ func (b *v4DrainsOrganisationsOwneridBuilderImpl) Resources() V4DrainsOrganisati
 
 // V4DrainsOrganisationsOwneridApplicationsBuilder provides access to operations
 type V4DrainsOrganisationsOwneridApplicationsBuilder interface {
-	Applicationid(applicationid string) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder
+	Applicationid(applicationid ids.ApplicationID) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder
 }
 
 // v4DrainsOrganisationsOwneridApplicationsBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsBuilder
 type v4DrainsOrganisationsOwneridApplicationsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsBuilder creates a new V4DrainsOrganisationsOwneridApplicationsBuilder
-func newV4DrainsOrganisationsOwneridApplicationsBuilder(sdk *sdkImpl, ownerid string) V4DrainsOrganisationsOwneridApplicationsBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4DrainsOrganisationsOwneridApplicationsBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5228,7 +5230,7 @@ func newV4DrainsOrganisationsOwneridApplicationsBuilder(sdk *sdkImpl, ownerid st
 }
 
 // Applicationid returns builder for applicationid
-func (b *v4DrainsOrganisationsOwneridApplicationsBuilderImpl) Applicationid(applicationid string) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder {
+func (b *v4DrainsOrganisationsOwneridApplicationsBuilderImpl) Applicationid(applicationid ids.ApplicationID) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder {
 	return newV4DrainsOrganisationsOwneridApplicationsApplicationidBuilder(b.sdk, b.ownerid, applicationid)
 }
 
@@ -5240,12 +5242,12 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder interface {
 // v4DrainsOrganisationsOwneridApplicationsApplicationidBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidBuilder(sdk *sdkImpl, ownerid string, applicationid string) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID) V4DrainsOrganisationsOwneridApplicationsApplicationidBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidBuilderImpl{
 		applicationid: applicationid,
 		ownerid:       ownerid,
@@ -5260,7 +5262,7 @@ func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidBuilderImpl) Drain
 
 // V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder provides access to operations
 type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder interface {
-	Drainid(drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder
+	Drainid(drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder
 	Deleteresourcedrains(ctx context.Context) client.Response[[]models.Drain]
 	Listdrains(ctx context.Context, opts ...log.Option) client.Response[[]models.Drain]
 	Createdrain(ctx context.Context, request *models.WannabeDrain) client.Response[models.Drain]
@@ -5269,12 +5271,12 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder interfac
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder(sdk *sdkImpl, ownerid string, applicationid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilderImpl{
 		applicationid: applicationid,
 		ownerid:       ownerid,
@@ -5283,7 +5285,7 @@ func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilder(sdk *
 }
 
 // Drainid returns builder for drainid
-func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilderImpl) Drainid(drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder {
+func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsBuilderImpl) Drainid(drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder {
 	return newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder(b.sdk, b.ownerid, b.applicationid, drainid)
 }
 
@@ -5315,13 +5317,13 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder i
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
-	drainid       string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
+	drainid       ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder(sdk *sdkImpl, ownerid string, applicationid string, drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID, drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidBuilderImpl{
 		applicationid: applicationid,
 		drainid:       drainid,
@@ -5368,13 +5370,13 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBu
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
-	drainid       string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
+	drainid       ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder(sdk *sdkImpl, ownerid string, applicationid string, drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID, drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidDisableBuilderImpl{
 		applicationid: applicationid,
 		drainid:       drainid,
@@ -5396,13 +5398,13 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBui
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
-	drainid       string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
+	drainid       ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder(sdk *sdkImpl, ownerid string, applicationid string, drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID, drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidEnableBuilderImpl{
 		applicationid: applicationid,
 		drainid:       drainid,
@@ -5424,13 +5426,13 @@ type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCurs
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
-	drainid       string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
+	drainid       ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder(sdk *sdkImpl, ownerid string, applicationid string, drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID, drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidResetCursorBuilderImpl{
 		applicationid: applicationid,
 		drainid:       drainid,
@@ -5446,19 +5448,19 @@ func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidReset
 
 // V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder provides access to operations
 type V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder interface {
-	Getdraintestcommand(ctx context.Context) client.Response[client.Nothing]
+	Getdraintestcommand(ctx context.Context) client.Response[json.RawMessage]
 }
 
 // v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilderImpl implements V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder
 type v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilderImpl struct {
 	sdk           *sdkImpl
-	ownerid       string
-	applicationid string
-	drainid       string
+	ownerid       ids.OwnerID
+	applicationid ids.ApplicationID
+	drainid       ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder creates a new V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder
-func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder(sdk *sdkImpl, ownerid string, applicationid string, drainid string) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder {
+func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder(sdk *sdkImpl, ownerid ids.OwnerID, applicationid ids.ApplicationID, drainid ids.DrainID) V4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilder {
 	return &v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilderImpl{
 		applicationid: applicationid,
 		drainid:       drainid,
@@ -5468,7 +5470,7 @@ func newV4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCo
 }
 
 // Getdraintestcommand calls log.Getdraintestcommand
-func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilderImpl) Getdraintestcommand(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4DrainsOrganisationsOwneridApplicationsApplicationidDrainsDrainidTestCommandBuilderImpl) Getdraintestcommand(ctx context.Context) client.Response[json.RawMessage] {
 	return log.Getdraintestcommand(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.applicationid, b.drainid)
 }
 
@@ -5480,11 +5482,11 @@ type V4DrainsOrganisationsOwneridDrainsBuilder interface {
 // v4DrainsOrganisationsOwneridDrainsBuilderImpl implements V4DrainsOrganisationsOwneridDrainsBuilder
 type v4DrainsOrganisationsOwneridDrainsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4DrainsOrganisationsOwneridDrainsBuilder creates a new V4DrainsOrganisationsOwneridDrainsBuilder
-func newV4DrainsOrganisationsOwneridDrainsBuilder(sdk *sdkImpl, ownerid string) V4DrainsOrganisationsOwneridDrainsBuilder {
+func newV4DrainsOrganisationsOwneridDrainsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4DrainsOrganisationsOwneridDrainsBuilder {
 	return &v4DrainsOrganisationsOwneridDrainsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5498,17 +5500,17 @@ func (b *v4DrainsOrganisationsOwneridDrainsBuilderImpl) Deleteownerdrains(ctx co
 
 // V4DrainsOrganisationsOwneridResourcesBuilder provides access to operations
 type V4DrainsOrganisationsOwneridResourcesBuilder interface {
-	Resourceid(resourceid string) V4DrainsOrganisationsOwneridResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4DrainsOrganisationsOwneridResourcesResourceidBuilder
 }
 
 // v4DrainsOrganisationsOwneridResourcesBuilderImpl implements V4DrainsOrganisationsOwneridResourcesBuilder
 type v4DrainsOrganisationsOwneridResourcesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesBuilder creates a new V4DrainsOrganisationsOwneridResourcesBuilder
-func newV4DrainsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid string) V4DrainsOrganisationsOwneridResourcesBuilder {
+func newV4DrainsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4DrainsOrganisationsOwneridResourcesBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5516,7 +5518,7 @@ func newV4DrainsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid strin
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4DrainsOrganisationsOwneridResourcesBuilderImpl) Resourceid(resourceid string) V4DrainsOrganisationsOwneridResourcesResourceidBuilder {
+func (b *v4DrainsOrganisationsOwneridResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4DrainsOrganisationsOwneridResourcesResourceidBuilder {
 	return newV4DrainsOrganisationsOwneridResourcesResourceidBuilder(b.sdk, b.ownerid, resourceid)
 }
 
@@ -5528,12 +5530,12 @@ type V4DrainsOrganisationsOwneridResourcesResourceidBuilder interface {
 // v4DrainsOrganisationsOwneridResourcesResourceidBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4DrainsOrganisationsOwneridResourcesResourceidBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4DrainsOrganisationsOwneridResourcesResourceidBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -5548,7 +5550,7 @@ func (b *v4DrainsOrganisationsOwneridResourcesResourceidBuilderImpl) Drains() V4
 
 // V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder provides access to operations
 type V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder interface {
-	Drainid(drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder
+	Drainid(drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder
 	Deleteresourcedrainsbyresource(ctx context.Context) client.Response[[]models.Drain]
 	Listdrainsbyresource(ctx context.Context, opts ...log.Option) client.Response[[]models.Drain]
 	Createdrainbyresource(ctx context.Context, request *models.WannabeDrain) client.Response[models.Drain]
@@ -5557,12 +5559,12 @@ type V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder interface {
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -5571,7 +5573,7 @@ func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilder(sdk *sdkImp
 }
 
 // Drainid returns builder for drainid
-func (b *v4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilderImpl) Drainid(drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder {
+func (b *v4DrainsOrganisationsOwneridResourcesResourceidDrainsBuilderImpl) Drainid(drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder {
 	return newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder(b.sdk, b.ownerid, b.resourceid, drainid)
 }
 
@@ -5603,13 +5605,13 @@ type V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder interfa
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
-	drainid    string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
+	drainid    ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder(sdk *sdkImpl, ownerid string, resourceid string, drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID, drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidBuilderImpl{
 		drainid:    drainid,
 		ownerid:    ownerid,
@@ -5656,13 +5658,13 @@ type V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
-	drainid    string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
+	drainid    ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder(sdk *sdkImpl, ownerid string, resourceid string, drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID, drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidDisableBuilderImpl{
 		drainid:    drainid,
 		ownerid:    ownerid,
@@ -5684,13 +5686,13 @@ type V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder i
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
-	drainid    string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
+	drainid    ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder(sdk *sdkImpl, ownerid string, resourceid string, drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID, drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidEnableBuilderImpl{
 		drainid:    drainid,
 		ownerid:    ownerid,
@@ -5712,13 +5714,13 @@ type V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuil
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
-	drainid    string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
+	drainid    ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder(sdk *sdkImpl, ownerid string, resourceid string, drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID, drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursorBuilderImpl{
 		drainid:    drainid,
 		ownerid:    ownerid,
@@ -5734,19 +5736,19 @@ func (b *v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidResetCursor
 
 // V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder provides access to operations
 type V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder interface {
-	Getdraintestcommandbyresource(ctx context.Context) client.Response[client.Nothing]
+	Getdraintestcommandbyresource(ctx context.Context) client.Response[json.RawMessage]
 }
 
 // v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilderImpl implements V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder
 type v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
-	drainid    string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
+	drainid    ids.DrainID
 }
 
 // newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder creates a new V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder
-func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder(sdk *sdkImpl, ownerid string, resourceid string, drainid string) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder {
+func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID, drainid ids.DrainID) V4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilder {
 	return &v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilderImpl{
 		drainid:    drainid,
 		ownerid:    ownerid,
@@ -5756,13 +5758,13 @@ func newV4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandB
 }
 
 // Getdraintestcommandbyresource calls log.Getdraintestcommandbyresource
-func (b *v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilderImpl) Getdraintestcommandbyresource(ctx context.Context) client.Response[client.Nothing] {
+func (b *v4DrainsOrganisationsOwneridResourcesResourceidDrainsDrainidTestCommandBuilderImpl) Getdraintestcommandbyresource(ctx context.Context) client.Response[json.RawMessage] {
 	return log.Getdraintestcommandbyresource(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.resourceid, b.drainid)
 }
 
 // V4EmailsBuilder provides access to operations
 type V4EmailsBuilder interface {
-	Emailaddressid(emailaddressid string) V4EmailsEmailaddressidBuilder
+	Emailaddressid(emailaddressid ids.EmailAddressID) V4EmailsEmailaddressidBuilder
 	Createemailaddress(ctx context.Context, request *models.WannabeEmailAddress) client.Response[models.EmailAddress]
 }
 
@@ -5777,7 +5779,7 @@ func newV4EmailsBuilder(sdk *sdkImpl) V4EmailsBuilder {
 }
 
 // Emailaddressid returns builder for emailaddressid
-func (b *v4EmailsBuilderImpl) Emailaddressid(emailaddressid string) V4EmailsEmailaddressidBuilder {
+func (b *v4EmailsBuilderImpl) Emailaddressid(emailaddressid ids.EmailAddressID) V4EmailsEmailaddressidBuilder {
 	return newV4EmailsEmailaddressidBuilder(b.sdk, emailaddressid)
 }
 
@@ -5796,11 +5798,11 @@ type V4EmailsEmailaddressidBuilder interface {
 // v4EmailsEmailaddressidBuilderImpl implements V4EmailsEmailaddressidBuilder
 type v4EmailsEmailaddressidBuilderImpl struct {
 	sdk            *sdkImpl
-	emailaddressid string
+	emailaddressid ids.EmailAddressID
 }
 
 // newV4EmailsEmailaddressidBuilder creates a new V4EmailsEmailaddressidBuilder
-func newV4EmailsEmailaddressidBuilder(sdk *sdkImpl, emailaddressid string) V4EmailsEmailaddressidBuilder {
+func newV4EmailsEmailaddressidBuilder(sdk *sdkImpl, emailaddressid ids.EmailAddressID) V4EmailsEmailaddressidBuilder {
 	return &v4EmailsEmailaddressidBuilderImpl{
 		emailaddressid: emailaddressid,
 		sdk:            sdk,
@@ -5894,7 +5896,7 @@ func (b *v4FunctionsDeploymentsStatusBuilderImpl) Listdeploymentsbystatus(ctx co
 
 // V4FunctionsOrganisationsBuilder provides access to operations
 type V4FunctionsOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4FunctionsOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4FunctionsOrganisationsOwneridBuilder
 }
 
 // v4FunctionsOrganisationsBuilderImpl implements V4FunctionsOrganisationsBuilder
@@ -5908,7 +5910,7 @@ func newV4FunctionsOrganisationsBuilder(sdk *sdkImpl) V4FunctionsOrganisationsBu
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4FunctionsOrganisationsBuilderImpl) Ownerid(ownerid string) V4FunctionsOrganisationsOwneridBuilder {
+func (b *v4FunctionsOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4FunctionsOrganisationsOwneridBuilder {
 	return newV4FunctionsOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -5920,11 +5922,11 @@ type V4FunctionsOrganisationsOwneridBuilder interface {
 // v4FunctionsOrganisationsOwneridBuilderImpl implements V4FunctionsOrganisationsOwneridBuilder
 type v4FunctionsOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4FunctionsOrganisationsOwneridBuilder creates a new V4FunctionsOrganisationsOwneridBuilder
-func newV4FunctionsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4FunctionsOrganisationsOwneridBuilder {
+func newV4FunctionsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4FunctionsOrganisationsOwneridBuilder {
 	return &v4FunctionsOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5938,7 +5940,7 @@ func (b *v4FunctionsOrganisationsOwneridBuilderImpl) Functions() V4FunctionsOrga
 
 // V4FunctionsOrganisationsOwneridFunctionsBuilder provides access to operations
 type V4FunctionsOrganisationsOwneridFunctionsBuilder interface {
-	Functionid(functionid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder
+	Functionid(functionid ids.FunctionID) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder
 	Listfunctions(ctx context.Context) client.Response[[]models.FunctionResponse]
 	Createfunction(ctx context.Context, request *models.FunctionCreateOpts) client.Response[models.FunctionResponse]
 }
@@ -5946,11 +5948,11 @@ type V4FunctionsOrganisationsOwneridFunctionsBuilder interface {
 // v4FunctionsOrganisationsOwneridFunctionsBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsBuilder
 type v4FunctionsOrganisationsOwneridFunctionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsBuilder(sdk *sdkImpl, ownerid string) V4FunctionsOrganisationsOwneridFunctionsBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4FunctionsOrganisationsOwneridFunctionsBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -5958,7 +5960,7 @@ func newV4FunctionsOrganisationsOwneridFunctionsBuilder(sdk *sdkImpl, ownerid st
 }
 
 // Functionid returns builder for functionid
-func (b *v4FunctionsOrganisationsOwneridFunctionsBuilderImpl) Functionid(functionid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder {
+func (b *v4FunctionsOrganisationsOwneridFunctionsBuilderImpl) Functionid(functionid ids.FunctionID) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder {
 	return newV4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder(b.sdk, b.ownerid, functionid)
 }
 
@@ -5984,12 +5986,12 @@ type V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder interface {
 // v4FunctionsOrganisationsOwneridFunctionsFunctionidBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder
 type v4FunctionsOrganisationsOwneridFunctionsFunctionidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	functionid string
+	ownerid    ids.OwnerID
+	functionid ids.FunctionID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder(sdk *sdkImpl, ownerid string, functionid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, functionid ids.FunctionID) V4FunctionsOrganisationsOwneridFunctionsFunctionidBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsFunctionidBuilderImpl{
 		functionid: functionid,
 		ownerid:    ownerid,
@@ -6024,7 +6026,7 @@ func (b *v4FunctionsOrganisationsOwneridFunctionsFunctionidBuilderImpl) Replacef
 
 // V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder provides access to operations
 type V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder interface {
-	Deploymentid(deploymentid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder
+	Deploymentid(deploymentid ids.DeploymentID) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder
 	Listdeployments(ctx context.Context) client.Response[[]models.Deployment1]
 	Createfunctiondeployment(ctx context.Context, request *models.DeploymentCreateOpts) client.Response[models.DeploymentCreationResponse]
 }
@@ -6032,12 +6034,12 @@ type V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder interf
 // v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder
 type v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	functionid string
+	ownerid    ids.OwnerID
+	functionid ids.FunctionID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder(sdk *sdkImpl, ownerid string, functionid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, functionid ids.FunctionID) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilderImpl{
 		functionid: functionid,
 		ownerid:    ownerid,
@@ -6046,7 +6048,7 @@ func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilder(sdk
 }
 
 // Deploymentid returns builder for deploymentid
-func (b *v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilderImpl) Deploymentid(deploymentid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder {
+func (b *v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsBuilderImpl) Deploymentid(deploymentid ids.DeploymentID) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder {
 	return newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder(b.sdk, b.ownerid, b.functionid, deploymentid)
 }
 
@@ -6071,13 +6073,13 @@ type V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBu
 // v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder
 type v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	functionid   string
-	deploymentid string
+	ownerid      ids.OwnerID
+	functionid   ids.FunctionID
+	deploymentid ids.DeploymentID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder(sdk *sdkImpl, ownerid string, functionid string, deploymentid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, functionid ids.FunctionID, deploymentid ids.DeploymentID) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidBuilderImpl{
 		deploymentid: deploymentid,
 		functionid:   functionid,
@@ -6114,13 +6116,13 @@ type V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTr
 // v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder
 type v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	functionid   string
-	deploymentid string
+	ownerid      ids.OwnerID
+	functionid   ids.FunctionID
+	deploymentid ids.DeploymentID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder(sdk *sdkImpl, ownerid string, functionid string, deploymentid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder(sdk *sdkImpl, ownerid ids.OwnerID, functionid ids.FunctionID, deploymentid ids.DeploymentID) V4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsFunctionidDeploymentsDeploymentidTriggerBuilderImpl{
 		deploymentid: deploymentid,
 		functionid:   functionid,
@@ -6144,12 +6146,12 @@ type V4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder inte
 // v4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilderImpl implements V4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder
 type v4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	functionid string
+	ownerid    ids.OwnerID
+	functionid ids.FunctionID
 }
 
 // newV4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder creates a new V4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder
-func newV4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder(sdk *sdkImpl, ownerid string, functionid string) V4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder {
+func newV4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder(sdk *sdkImpl, ownerid ids.OwnerID, functionid ids.FunctionID) V4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilder {
 	return &v4FunctionsOrganisationsOwneridFunctionsFunctionidTriggerPulsarBuilderImpl{
 		functionid: functionid,
 		ownerid:    ownerid,
@@ -6200,7 +6202,7 @@ func (b *v4IamBuilderImpl) Tokens() V4IamTokensBuilder {
 
 // V4IamOrganisationsBuilder provides access to operations
 type V4IamOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4IamOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4IamOrganisationsOwneridBuilder
 }
 
 // v4IamOrganisationsBuilderImpl implements V4IamOrganisationsBuilder
@@ -6214,7 +6216,7 @@ func newV4IamOrganisationsBuilder(sdk *sdkImpl) V4IamOrganisationsBuilder {
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4IamOrganisationsBuilderImpl) Ownerid(ownerid string) V4IamOrganisationsOwneridBuilder {
+func (b *v4IamOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4IamOrganisationsOwneridBuilder {
 	return newV4IamOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -6226,11 +6228,11 @@ type V4IamOrganisationsOwneridBuilder interface {
 // v4IamOrganisationsOwneridBuilderImpl implements V4IamOrganisationsOwneridBuilder
 type v4IamOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IamOrganisationsOwneridBuilder creates a new V4IamOrganisationsOwneridBuilder
-func newV4IamOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4IamOrganisationsOwneridBuilder {
+func newV4IamOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IamOrganisationsOwneridBuilder {
 	return &v4IamOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -6251,11 +6253,11 @@ type V4IamOrganisationsOwneridIamBuilder interface {
 // v4IamOrganisationsOwneridIamBuilderImpl implements V4IamOrganisationsOwneridIamBuilder
 type v4IamOrganisationsOwneridIamBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IamOrganisationsOwneridIamBuilder creates a new V4IamOrganisationsOwneridIamBuilder
-func newV4IamOrganisationsOwneridIamBuilder(sdk *sdkImpl, ownerid string) V4IamOrganisationsOwneridIamBuilder {
+func newV4IamOrganisationsOwneridIamBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IamOrganisationsOwneridIamBuilder {
 	return &v4IamOrganisationsOwneridIamBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -6274,17 +6276,17 @@ func (b *v4IamOrganisationsOwneridIamBuilderImpl) Tokens() V4IamOrganisationsOwn
 
 // V4IamOrganisationsOwneridIamMateriaDbKvBuilder provides access to operations
 type V4IamOrganisationsOwneridIamMateriaDbKvBuilder interface {
-	Kvid(kvid string) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder
+	Kvid(kvid ids.KVID) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder
 }
 
 // v4IamOrganisationsOwneridIamMateriaDbKvBuilderImpl implements V4IamOrganisationsOwneridIamMateriaDbKvBuilder
 type v4IamOrganisationsOwneridIamMateriaDbKvBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IamOrganisationsOwneridIamMateriaDbKvBuilder creates a new V4IamOrganisationsOwneridIamMateriaDbKvBuilder
-func newV4IamOrganisationsOwneridIamMateriaDbKvBuilder(sdk *sdkImpl, ownerid string) V4IamOrganisationsOwneridIamMateriaDbKvBuilder {
+func newV4IamOrganisationsOwneridIamMateriaDbKvBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IamOrganisationsOwneridIamMateriaDbKvBuilder {
 	return &v4IamOrganisationsOwneridIamMateriaDbKvBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -6292,7 +6294,7 @@ func newV4IamOrganisationsOwneridIamMateriaDbKvBuilder(sdk *sdkImpl, ownerid str
 }
 
 // Kvid returns builder for kvid
-func (b *v4IamOrganisationsOwneridIamMateriaDbKvBuilderImpl) Kvid(kvid string) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder {
+func (b *v4IamOrganisationsOwneridIamMateriaDbKvBuilderImpl) Kvid(kvid ids.KVID) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder {
 	return newV4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder(b.sdk, b.ownerid, kvid)
 }
 
@@ -6304,12 +6306,12 @@ type V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder interface {
 // v4IamOrganisationsOwneridIamMateriaDbKvKvidBuilderImpl implements V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder
 type v4IamOrganisationsOwneridIamMateriaDbKvKvidBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	kvid    string
+	ownerid ids.OwnerID
+	kvid    ids.KVID
 }
 
 // newV4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder creates a new V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder
-func newV4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder(sdk *sdkImpl, ownerid string, kvid string) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder {
+func newV4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, kvid ids.KVID) V4IamOrganisationsOwneridIamMateriaDbKvKvidBuilder {
 	return &v4IamOrganisationsOwneridIamMateriaDbKvKvidBuilderImpl{
 		kvid:    kvid,
 		ownerid: ownerid,
@@ -6330,12 +6332,12 @@ type V4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder interface {
 // v4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilderImpl implements V4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder
 type v4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	kvid    string
+	ownerid ids.OwnerID
+	kvid    ids.KVID
 }
 
 // newV4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder creates a new V4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder
-func newV4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder(sdk *sdkImpl, ownerid string, kvid string) V4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder {
+func newV4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder(sdk *sdkImpl, ownerid ids.OwnerID, kvid ids.KVID) V4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilder {
 	return &v4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilderImpl{
 		kvid:    kvid,
 		ownerid: ownerid,
@@ -6350,27 +6352,27 @@ func (b *v4IamOrganisationsOwneridIamMateriaDbKvKvidTokensBuilderImpl) Createbis
 
 // V4IamOrganisationsOwneridIamTokensBuilder provides access to operations
 type V4IamOrganisationsOwneridIamTokensBuilder interface {
-	P1(p1 string) V4IamOrganisationsOwneridIamTokensP1Builder
+	Tokenid(tokenid ids.TokenID) V4IamOrganisationsOwneridIamTokensTokenidBuilder
 	Listbiscuits(ctx context.Context, opts ...base.Option) client.Response[[]models.IAMBiscuit]
 }
 
 // v4IamOrganisationsOwneridIamTokensBuilderImpl implements V4IamOrganisationsOwneridIamTokensBuilder
 type v4IamOrganisationsOwneridIamTokensBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IamOrganisationsOwneridIamTokensBuilder creates a new V4IamOrganisationsOwneridIamTokensBuilder
-func newV4IamOrganisationsOwneridIamTokensBuilder(sdk *sdkImpl, ownerid string) V4IamOrganisationsOwneridIamTokensBuilder {
+func newV4IamOrganisationsOwneridIamTokensBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IamOrganisationsOwneridIamTokensBuilder {
 	return &v4IamOrganisationsOwneridIamTokensBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
 	}
 }
 
-// P1 returns builder for p1
-func (b *v4IamOrganisationsOwneridIamTokensBuilderImpl) P1(p1 string) V4IamOrganisationsOwneridIamTokensP1Builder {
-	return newV4IamOrganisationsOwneridIamTokensP1Builder(b.sdk, b.ownerid, p1)
+// Tokenid returns builder for tokenid
+func (b *v4IamOrganisationsOwneridIamTokensBuilderImpl) Tokenid(tokenid ids.TokenID) V4IamOrganisationsOwneridIamTokensTokenidBuilder {
+	return newV4IamOrganisationsOwneridIamTokensTokenidBuilder(b.sdk, b.ownerid, tokenid)
 }
 
 // Listbiscuits calls base.Listbiscuits
@@ -6378,36 +6380,36 @@ func (b *v4IamOrganisationsOwneridIamTokensBuilderImpl) Listbiscuits(ctx context
 	return base.Listbiscuits(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, opts...)
 }
 
-// V4IamOrganisationsOwneridIamTokensP1Builder provides access to operations
-type V4IamOrganisationsOwneridIamTokensP1Builder interface {
+// V4IamOrganisationsOwneridIamTokensTokenidBuilder provides access to operations
+type V4IamOrganisationsOwneridIamTokensTokenidBuilder interface {
 	Deletebiscuit(ctx context.Context) client.Response[client.Nothing]
 	Getbiscuit(ctx context.Context) client.Response[models.IAMBiscuit]
 }
 
-// v4IamOrganisationsOwneridIamTokensP1BuilderImpl implements V4IamOrganisationsOwneridIamTokensP1Builder
-type v4IamOrganisationsOwneridIamTokensP1BuilderImpl struct {
+// v4IamOrganisationsOwneridIamTokensTokenidBuilderImpl implements V4IamOrganisationsOwneridIamTokensTokenidBuilder
+type v4IamOrganisationsOwneridIamTokensTokenidBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	p1      string
+	ownerid ids.OwnerID
+	tokenid ids.TokenID
 }
 
-// newV4IamOrganisationsOwneridIamTokensP1Builder creates a new V4IamOrganisationsOwneridIamTokensP1Builder
-func newV4IamOrganisationsOwneridIamTokensP1Builder(sdk *sdkImpl, ownerid string, p1 string) V4IamOrganisationsOwneridIamTokensP1Builder {
-	return &v4IamOrganisationsOwneridIamTokensP1BuilderImpl{
+// newV4IamOrganisationsOwneridIamTokensTokenidBuilder creates a new V4IamOrganisationsOwneridIamTokensTokenidBuilder
+func newV4IamOrganisationsOwneridIamTokensTokenidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, tokenid ids.TokenID) V4IamOrganisationsOwneridIamTokensTokenidBuilder {
+	return &v4IamOrganisationsOwneridIamTokensTokenidBuilderImpl{
 		ownerid: ownerid,
-		p1:      p1,
 		sdk:     sdk,
+		tokenid: tokenid,
 	}
 }
 
 // Deletebiscuit calls base.Deletebiscuit
-func (b *v4IamOrganisationsOwneridIamTokensP1BuilderImpl) Deletebiscuit(ctx context.Context) client.Response[client.Nothing] {
-	return base.Deletebiscuit(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.p1)
+func (b *v4IamOrganisationsOwneridIamTokensTokenidBuilderImpl) Deletebiscuit(ctx context.Context) client.Response[client.Nothing] {
+	return base.Deletebiscuit(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.tokenid)
 }
 
 // Getbiscuit calls base.Getbiscuit
-func (b *v4IamOrganisationsOwneridIamTokensP1BuilderImpl) Getbiscuit(ctx context.Context) client.Response[models.IAMBiscuit] {
-	return base.Getbiscuit(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.p1)
+func (b *v4IamOrganisationsOwneridIamTokensTokenidBuilderImpl) Getbiscuit(ctx context.Context) client.Response[models.IAMBiscuit] {
+	return base.Getbiscuit(ctx, b.sdk.Client(), b.sdk.Tracer(), b.ownerid, b.tokenid)
 }
 
 // V4IamTokensBuilder provides access to operations
@@ -6452,7 +6454,7 @@ func (b *v4IamTokensRevocationsBuilderImpl) Listiamrevocations(ctx context.Conte
 
 // V4IDentitiesBuilder provides access to operations
 type V4IDentitiesBuilder interface {
-	IDentityid(identityid string) V4IDentitiesIDentityidBuilder
+	IDentityid(identityid ids.IdentityID) V4IDentitiesIDentityidBuilder
 	Createpartialidentity(ctx context.Context, request *models.WannabeEmailAddress) client.Response[models.PartialIdentity]
 }
 
@@ -6467,7 +6469,7 @@ func newV4IDentitiesBuilder(sdk *sdkImpl) V4IDentitiesBuilder {
 }
 
 // IDentityid returns builder for identityid
-func (b *v4IDentitiesBuilderImpl) IDentityid(identityid string) V4IDentitiesIDentityidBuilder {
+func (b *v4IDentitiesBuilderImpl) IDentityid(identityid ids.IdentityID) V4IDentitiesIDentityidBuilder {
 	return newV4IDentitiesIDentityidBuilder(b.sdk, identityid)
 }
 
@@ -6486,11 +6488,11 @@ type V4IDentitiesIDentityidBuilder interface {
 // v4IDentitiesIDentityidBuilderImpl implements V4IDentitiesIDentityidBuilder
 type v4IDentitiesIDentityidBuilderImpl struct {
 	sdk        *sdkImpl
-	identityid string
+	identityid ids.IdentityID
 }
 
 // newV4IDentitiesIDentityidBuilder creates a new V4IDentitiesIDentityidBuilder
-func newV4IDentitiesIDentityidBuilder(sdk *sdkImpl, identityid string) V4IDentitiesIDentityidBuilder {
+func newV4IDentitiesIDentityidBuilder(sdk *sdkImpl, identityid ids.IdentityID) V4IDentitiesIDentityidBuilder {
 	return &v4IDentitiesIDentityidBuilderImpl{
 		identityid: identityid,
 		sdk:        sdk,
@@ -6520,11 +6522,11 @@ type V4IDentitiesIDentityidCompleteBuilder interface {
 // v4IDentitiesIDentityidCompleteBuilderImpl implements V4IDentitiesIDentityidCompleteBuilder
 type v4IDentitiesIDentityidCompleteBuilderImpl struct {
 	sdk        *sdkImpl
-	identityid string
+	identityid ids.IdentityID
 }
 
 // newV4IDentitiesIDentityidCompleteBuilder creates a new V4IDentitiesIDentityidCompleteBuilder
-func newV4IDentitiesIDentityidCompleteBuilder(sdk *sdkImpl, identityid string) V4IDentitiesIDentityidCompleteBuilder {
+func newV4IDentitiesIDentityidCompleteBuilder(sdk *sdkImpl, identityid ids.IdentityID) V4IDentitiesIDentityidCompleteBuilder {
 	return &v4IDentitiesIDentityidCompleteBuilderImpl{
 		identityid: identityid,
 		sdk:        sdk,
@@ -6539,7 +6541,7 @@ func (b *v4IDentitiesIDentityidCompleteBuilderImpl) Updatepartialidentity(ctx co
 // V4ImagesBuilder provides access to operations
 type V4ImagesBuilder interface {
 	Image(image string) V4ImagesImageBuilder
-	Imageid(imageid string) V4ImagesImageidBuilder
+	Imageid(imageid ids.ImageID) V4ImagesImageidBuilder
 	Createimage(ctx context.Context, opts ...image.Option) client.Response[models.ImageOutput]
 }
 
@@ -6559,7 +6561,7 @@ func (b *v4ImagesBuilderImpl) Image(image string) V4ImagesImageBuilder {
 }
 
 // Imageid returns builder for imageid
-func (b *v4ImagesBuilderImpl) Imageid(imageid string) V4ImagesImageidBuilder {
+func (b *v4ImagesBuilderImpl) Imageid(imageid ids.ImageID) V4ImagesImageidBuilder {
 	return newV4ImagesImageidBuilder(b.sdk, imageid)
 }
 
@@ -6771,11 +6773,11 @@ type V4ImagesImageidBuilder interface {
 // v4ImagesImageidBuilderImpl implements V4ImagesImageidBuilder
 type v4ImagesImageidBuilderImpl struct {
 	sdk     *sdkImpl
-	imageid string
+	imageid ids.ImageID
 }
 
 // newV4ImagesImageidBuilder creates a new V4ImagesImageidBuilder
-func newV4ImagesImageidBuilder(sdk *sdkImpl, imageid string) V4ImagesImageidBuilder {
+func newV4ImagesImageidBuilder(sdk *sdkImpl, imageid ids.ImageID) V4ImagesImageidBuilder {
 	return &v4ImagesImageidBuilderImpl{
 		imageid: imageid,
 		sdk:     sdk,
@@ -6820,7 +6822,7 @@ func (b *v4InfrastructureBuilderImpl) VirtualMachines() V4InfrastructureVirtualM
 
 // V4InfrastructureDeploymentsBuilder provides access to operations
 type V4InfrastructureDeploymentsBuilder interface {
-	Deploymentid(deploymentid string) V4InfrastructureDeploymentsDeploymentidBuilder
+	Deploymentid(deploymentid ids.DeploymentID) V4InfrastructureDeploymentsDeploymentidBuilder
 	Createinfrastructuredeployment(ctx context.Context, request *models.DeploymentInput) client.Response[client.Nothing]
 }
 
@@ -6835,7 +6837,7 @@ func newV4InfrastructureDeploymentsBuilder(sdk *sdkImpl) V4InfrastructureDeploym
 }
 
 // Deploymentid returns builder for deploymentid
-func (b *v4InfrastructureDeploymentsBuilderImpl) Deploymentid(deploymentid string) V4InfrastructureDeploymentsDeploymentidBuilder {
+func (b *v4InfrastructureDeploymentsBuilderImpl) Deploymentid(deploymentid ids.DeploymentID) V4InfrastructureDeploymentsDeploymentidBuilder {
 	return newV4InfrastructureDeploymentsDeploymentidBuilder(b.sdk, deploymentid)
 }
 
@@ -6852,11 +6854,11 @@ type V4InfrastructureDeploymentsDeploymentidBuilder interface {
 // v4InfrastructureDeploymentsDeploymentidBuilderImpl implements V4InfrastructureDeploymentsDeploymentidBuilder
 type v4InfrastructureDeploymentsDeploymentidBuilderImpl struct {
 	sdk          *sdkImpl
-	deploymentid string
+	deploymentid ids.DeploymentID
 }
 
 // newV4InfrastructureDeploymentsDeploymentidBuilder creates a new V4InfrastructureDeploymentsDeploymentidBuilder
-func newV4InfrastructureDeploymentsDeploymentidBuilder(sdk *sdkImpl, deploymentid string) V4InfrastructureDeploymentsDeploymentidBuilder {
+func newV4InfrastructureDeploymentsDeploymentidBuilder(sdk *sdkImpl, deploymentid ids.DeploymentID) V4InfrastructureDeploymentsDeploymentidBuilder {
 	return &v4InfrastructureDeploymentsDeploymentidBuilderImpl{
 		deploymentid: deploymentid,
 		sdk:          sdk,
@@ -6870,7 +6872,7 @@ func (b *v4InfrastructureDeploymentsDeploymentidBuilderImpl) Getdeployment(ctx c
 
 // V4InfrastructureVirtualMachinesBuilder provides access to operations
 type V4InfrastructureVirtualMachinesBuilder interface {
-	Virtualmachineid(virtualmachineid string) V4InfrastructureVirtualMachinesVirtualmachineidBuilder
+	Virtualmachineid(virtualmachineid ids.VirtualMachineID) V4InfrastructureVirtualMachinesVirtualmachineidBuilder
 }
 
 // v4InfrastructureVirtualMachinesBuilderImpl implements V4InfrastructureVirtualMachinesBuilder
@@ -6884,7 +6886,7 @@ func newV4InfrastructureVirtualMachinesBuilder(sdk *sdkImpl) V4InfrastructureVir
 }
 
 // Virtualmachineid returns builder for virtualmachineid
-func (b *v4InfrastructureVirtualMachinesBuilderImpl) Virtualmachineid(virtualmachineid string) V4InfrastructureVirtualMachinesVirtualmachineidBuilder {
+func (b *v4InfrastructureVirtualMachinesBuilderImpl) Virtualmachineid(virtualmachineid ids.VirtualMachineID) V4InfrastructureVirtualMachinesVirtualmachineidBuilder {
 	return newV4InfrastructureVirtualMachinesVirtualmachineidBuilder(b.sdk, virtualmachineid)
 }
 
@@ -6896,11 +6898,11 @@ type V4InfrastructureVirtualMachinesVirtualmachineidBuilder interface {
 // v4InfrastructureVirtualMachinesVirtualmachineidBuilderImpl implements V4InfrastructureVirtualMachinesVirtualmachineidBuilder
 type v4InfrastructureVirtualMachinesVirtualmachineidBuilderImpl struct {
 	sdk              *sdkImpl
-	virtualmachineid string
+	virtualmachineid ids.VirtualMachineID
 }
 
 // newV4InfrastructureVirtualMachinesVirtualmachineidBuilder creates a new V4InfrastructureVirtualMachinesVirtualmachineidBuilder
-func newV4InfrastructureVirtualMachinesVirtualmachineidBuilder(sdk *sdkImpl, virtualmachineid string) V4InfrastructureVirtualMachinesVirtualmachineidBuilder {
+func newV4InfrastructureVirtualMachinesVirtualmachineidBuilder(sdk *sdkImpl, virtualmachineid ids.VirtualMachineID) V4InfrastructureVirtualMachinesVirtualmachineidBuilder {
 	return &v4InfrastructureVirtualMachinesVirtualmachineidBuilderImpl{
 		sdk:              sdk,
 		virtualmachineid: virtualmachineid,
@@ -6934,8 +6936,8 @@ func (b *v4IPamBuilderImpl) Organisations() V4IPamOrganisationsBuilder {
 
 // V4IPamOrganisationsBuilder provides access to operations
 type V4IPamOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4IPamOrganisationsOwneridBuilder
-	Tenantid(tenantid string) V4IPamOrganisationsTenantidBuilder
+	Ownerid(ownerid ids.OwnerID) V4IPamOrganisationsOwneridBuilder
+	Tenantid(tenantid ids.TenantID) V4IPamOrganisationsTenantidBuilder
 }
 
 // v4IPamOrganisationsBuilderImpl implements V4IPamOrganisationsBuilder
@@ -6949,12 +6951,12 @@ func newV4IPamOrganisationsBuilder(sdk *sdkImpl) V4IPamOrganisationsBuilder {
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4IPamOrganisationsBuilderImpl) Ownerid(ownerid string) V4IPamOrganisationsOwneridBuilder {
+func (b *v4IPamOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4IPamOrganisationsOwneridBuilder {
 	return newV4IPamOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
 // Tenantid returns builder for tenantid
-func (b *v4IPamOrganisationsBuilderImpl) Tenantid(tenantid string) V4IPamOrganisationsTenantidBuilder {
+func (b *v4IPamOrganisationsBuilderImpl) Tenantid(tenantid ids.TenantID) V4IPamOrganisationsTenantidBuilder {
 	return newV4IPamOrganisationsTenantidBuilder(b.sdk, tenantid)
 }
 
@@ -6966,11 +6968,11 @@ type V4IPamOrganisationsOwneridBuilder interface {
 // v4IPamOrganisationsOwneridBuilderImpl implements V4IPamOrganisationsOwneridBuilder
 type v4IPamOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IPamOrganisationsOwneridBuilder creates a new V4IPamOrganisationsOwneridBuilder
-func newV4IPamOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4IPamOrganisationsOwneridBuilder {
+func newV4IPamOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IPamOrganisationsOwneridBuilder {
 	return &v4IPamOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -6990,11 +6992,11 @@ type V4IPamOrganisationsOwneridIPamBuilder interface {
 // v4IPamOrganisationsOwneridIPamBuilderImpl implements V4IPamOrganisationsOwneridIPamBuilder
 type v4IPamOrganisationsOwneridIPamBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IPamOrganisationsOwneridIPamBuilder creates a new V4IPamOrganisationsOwneridIPamBuilder
-func newV4IPamOrganisationsOwneridIPamBuilder(sdk *sdkImpl, ownerid string) V4IPamOrganisationsOwneridIPamBuilder {
+func newV4IPamOrganisationsOwneridIPamBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IPamOrganisationsOwneridIPamBuilder {
 	return &v4IPamOrganisationsOwneridIPamBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -7014,11 +7016,11 @@ type V4IPamOrganisationsOwneridIPamConsumptionsBuilder interface {
 // v4IPamOrganisationsOwneridIPamConsumptionsBuilderImpl implements V4IPamOrganisationsOwneridIPamConsumptionsBuilder
 type v4IPamOrganisationsOwneridIPamConsumptionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4IPamOrganisationsOwneridIPamConsumptionsBuilder creates a new V4IPamOrganisationsOwneridIPamConsumptionsBuilder
-func newV4IPamOrganisationsOwneridIPamConsumptionsBuilder(sdk *sdkImpl, ownerid string) V4IPamOrganisationsOwneridIPamConsumptionsBuilder {
+func newV4IPamOrganisationsOwneridIPamConsumptionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4IPamOrganisationsOwneridIPamConsumptionsBuilder {
 	return &v4IPamOrganisationsOwneridIPamConsumptionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -7042,11 +7044,11 @@ type V4IPamOrganisationsTenantidBuilder interface {
 // v4IPamOrganisationsTenantidBuilderImpl implements V4IPamOrganisationsTenantidBuilder
 type v4IPamOrganisationsTenantidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidBuilder creates a new V4IPamOrganisationsTenantidBuilder
-func newV4IPamOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidBuilder {
+func newV4IPamOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidBuilder {
 	return &v4IPamOrganisationsTenantidBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7089,11 +7091,11 @@ type V4IPamOrganisationsTenantidAclBuilder interface {
 // v4IPamOrganisationsTenantidAclBuilderImpl implements V4IPamOrganisationsTenantidAclBuilder
 type v4IPamOrganisationsTenantidAclBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidAclBuilder creates a new V4IPamOrganisationsTenantidAclBuilder
-func newV4IPamOrganisationsTenantidAclBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidAclBuilder {
+func newV4IPamOrganisationsTenantidAclBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidAclBuilder {
 	return &v4IPamOrganisationsTenantidAclBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7122,17 +7124,17 @@ func (b *v4IPamOrganisationsTenantidAclBuilderImpl) Createorupdateowneracl(ctx c
 
 // V4IPamOrganisationsTenantidAclResourcesBuilder provides access to operations
 type V4IPamOrganisationsTenantidAclResourcesBuilder interface {
-	Resourceid(resourceid string) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder
 }
 
 // v4IPamOrganisationsTenantidAclResourcesBuilderImpl implements V4IPamOrganisationsTenantidAclResourcesBuilder
 type v4IPamOrganisationsTenantidAclResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidAclResourcesBuilder creates a new V4IPamOrganisationsTenantidAclResourcesBuilder
-func newV4IPamOrganisationsTenantidAclResourcesBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidAclResourcesBuilder {
+func newV4IPamOrganisationsTenantidAclResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidAclResourcesBuilder {
 	return &v4IPamOrganisationsTenantidAclResourcesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7140,7 +7142,7 @@ func newV4IPamOrganisationsTenantidAclResourcesBuilder(sdk *sdkImpl, tenantid st
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4IPamOrganisationsTenantidAclResourcesBuilderImpl) Resourceid(resourceid string) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder {
+func (b *v4IPamOrganisationsTenantidAclResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder {
 	return newV4IPamOrganisationsTenantidAclResourcesResourceidBuilder(b.sdk, b.tenantid, resourceid)
 }
 
@@ -7154,12 +7156,12 @@ type V4IPamOrganisationsTenantidAclResourcesResourceidBuilder interface {
 // v4IPamOrganisationsTenantidAclResourcesResourceidBuilderImpl implements V4IPamOrganisationsTenantidAclResourcesResourceidBuilder
 type v4IPamOrganisationsTenantidAclResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidAclResourcesResourceidBuilder creates a new V4IPamOrganisationsTenantidAclResourcesResourceidBuilder
-func newV4IPamOrganisationsTenantidAclResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder {
+func newV4IPamOrganisationsTenantidAclResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidAclResourcesResourceidBuilder {
 	return &v4IPamOrganisationsTenantidAclResourcesResourceidBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7190,11 +7192,11 @@ type V4IPamOrganisationsTenantidAssignmentBuilder interface {
 // v4IPamOrganisationsTenantidAssignmentBuilderImpl implements V4IPamOrganisationsTenantidAssignmentBuilder
 type v4IPamOrganisationsTenantidAssignmentBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidAssignmentBuilder creates a new V4IPamOrganisationsTenantidAssignmentBuilder
-func newV4IPamOrganisationsTenantidAssignmentBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidAssignmentBuilder {
+func newV4IPamOrganisationsTenantidAssignmentBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidAssignmentBuilder {
 	return &v4IPamOrganisationsTenantidAssignmentBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7208,17 +7210,17 @@ func (b *v4IPamOrganisationsTenantidAssignmentBuilderImpl) Resources() V4IPamOrg
 
 // V4IPamOrganisationsTenantidAssignmentResourcesBuilder provides access to operations
 type V4IPamOrganisationsTenantidAssignmentResourcesBuilder interface {
-	Resourceid(resourceid string) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder
 }
 
 // v4IPamOrganisationsTenantidAssignmentResourcesBuilderImpl implements V4IPamOrganisationsTenantidAssignmentResourcesBuilder
 type v4IPamOrganisationsTenantidAssignmentResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidAssignmentResourcesBuilder creates a new V4IPamOrganisationsTenantidAssignmentResourcesBuilder
-func newV4IPamOrganisationsTenantidAssignmentResourcesBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidAssignmentResourcesBuilder {
+func newV4IPamOrganisationsTenantidAssignmentResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidAssignmentResourcesBuilder {
 	return &v4IPamOrganisationsTenantidAssignmentResourcesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7226,7 +7228,7 @@ func newV4IPamOrganisationsTenantidAssignmentResourcesBuilder(sdk *sdkImpl, tena
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4IPamOrganisationsTenantidAssignmentResourcesBuilderImpl) Resourceid(resourceid string) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder {
+func (b *v4IPamOrganisationsTenantidAssignmentResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder {
 	return newV4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder(b.sdk, b.tenantid, resourceid)
 }
 
@@ -7238,12 +7240,12 @@ type V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder interface {
 // v4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilderImpl implements V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder
 type v4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder creates a new V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder
-func newV4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder {
+func newV4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilder {
 	return &v4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7258,18 +7260,18 @@ func (b *v4IPamOrganisationsTenantidAssignmentResourcesResourceidBuilderImpl) Li
 
 // V4IPamOrganisationsTenantidAuditBuilder provides access to operations
 type V4IPamOrganisationsTenantidAuditBuilder interface {
-	Auditipamresourceid(auditipamresourceid string) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder
+	Auditipamresourceid(auditipamresourceid ids.AuditIPAMResourceID) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder
 	Listauditsforowner(ctx context.Context) client.Response[[]models.IpamAudit1]
 }
 
 // v4IPamOrganisationsTenantidAuditBuilderImpl implements V4IPamOrganisationsTenantidAuditBuilder
 type v4IPamOrganisationsTenantidAuditBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidAuditBuilder creates a new V4IPamOrganisationsTenantidAuditBuilder
-func newV4IPamOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidAuditBuilder {
+func newV4IPamOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidAuditBuilder {
 	return &v4IPamOrganisationsTenantidAuditBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7277,7 +7279,7 @@ func newV4IPamOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid string) V
 }
 
 // Auditipamresourceid returns builder for auditipamresourceid
-func (b *v4IPamOrganisationsTenantidAuditBuilderImpl) Auditipamresourceid(auditipamresourceid string) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder {
+func (b *v4IPamOrganisationsTenantidAuditBuilderImpl) Auditipamresourceid(auditipamresourceid ids.AuditIPAMResourceID) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder {
 	return newV4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder(b.sdk, b.tenantid, auditipamresourceid)
 }
 
@@ -7294,12 +7296,12 @@ type V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder interface {
 // v4IPamOrganisationsTenantidAuditAuditipamresourceidBuilderImpl implements V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder
 type v4IPamOrganisationsTenantidAuditAuditipamresourceidBuilderImpl struct {
 	sdk                 *sdkImpl
-	tenantid            string
-	auditipamresourceid string
+	tenantid            ids.TenantID
+	auditipamresourceid ids.AuditIPAMResourceID
 }
 
 // newV4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder creates a new V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder
-func newV4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder(sdk *sdkImpl, tenantid string, auditipamresourceid string) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder {
+func newV4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, auditipamresourceid ids.AuditIPAMResourceID) V4IPamOrganisationsTenantidAuditAuditipamresourceidBuilder {
 	return &v4IPamOrganisationsTenantidAuditAuditipamresourceidBuilderImpl{
 		auditipamresourceid: auditipamresourceid,
 		sdk:                 sdk,
@@ -7314,7 +7316,7 @@ func (b *v4IPamOrganisationsTenantidAuditAuditipamresourceidBuilderImpl) Listipa
 
 // V4IPamOrganisationsTenantidRegionsBuilder provides access to operations
 type V4IPamOrganisationsTenantidRegionsBuilder interface {
-	Regionid(regionid string) V4IPamOrganisationsTenantidRegionsRegionidBuilder
+	Regionid(regionid ids.RegionID) V4IPamOrganisationsTenantidRegionsRegionidBuilder
 	Listregionsforowner(ctx context.Context) client.Response[[]models.Region2]
 	Createregionforowner(ctx context.Context, request *models.CreateRegionInput) client.Response[models.Region2]
 }
@@ -7322,11 +7324,11 @@ type V4IPamOrganisationsTenantidRegionsBuilder interface {
 // v4IPamOrganisationsTenantidRegionsBuilderImpl implements V4IPamOrganisationsTenantidRegionsBuilder
 type v4IPamOrganisationsTenantidRegionsBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidRegionsBuilder creates a new V4IPamOrganisationsTenantidRegionsBuilder
-func newV4IPamOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidRegionsBuilder {
+func newV4IPamOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidRegionsBuilder {
 	return &v4IPamOrganisationsTenantidRegionsBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7334,7 +7336,7 @@ func newV4IPamOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenantid string)
 }
 
 // Regionid returns builder for regionid
-func (b *v4IPamOrganisationsTenantidRegionsBuilderImpl) Regionid(regionid string) V4IPamOrganisationsTenantidRegionsRegionidBuilder {
+func (b *v4IPamOrganisationsTenantidRegionsBuilderImpl) Regionid(regionid ids.RegionID) V4IPamOrganisationsTenantidRegionsRegionidBuilder {
 	return newV4IPamOrganisationsTenantidRegionsRegionidBuilder(b.sdk, b.tenantid, regionid)
 }
 
@@ -7360,12 +7362,12 @@ type V4IPamOrganisationsTenantidRegionsRegionidBuilder interface {
 // v4IPamOrganisationsTenantidRegionsRegionidBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidBuilder(sdk *sdkImpl, tenantid string, regionid string) V4IPamOrganisationsTenantidRegionsRegionidBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4IPamOrganisationsTenantidRegionsRegionidBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -7400,7 +7402,7 @@ func (b *v4IPamOrganisationsTenantidRegionsRegionidBuilderImpl) Replaceregionfor
 
 // V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder provides access to operations
 type V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder interface {
-	Networkid(networkid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder
+	Networkid(networkid ids.NetworkID) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder
 	Listnetworksforregion(ctx context.Context) client.Response[[]models.Network]
 	Createnetworkforregion(ctx context.Context, request *models.CreateNetworkInput) client.Response[models.Network]
 }
@@ -7408,12 +7410,12 @@ type V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder interface {
 // v4IPamOrganisationsTenantidRegionsRegionidNetworksBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidNetworksBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder(sdk *sdkImpl, tenantid string, regionid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidNetworksBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -7422,7 +7424,7 @@ func newV4IPamOrganisationsTenantidRegionsRegionidNetworksBuilder(sdk *sdkImpl,
 }
 
 // Networkid returns builder for networkid
-func (b *v4IPamOrganisationsTenantidRegionsRegionidNetworksBuilderImpl) Networkid(networkid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder {
+func (b *v4IPamOrganisationsTenantidRegionsRegionidNetworksBuilderImpl) Networkid(networkid ids.NetworkID) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder {
 	return newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder(b.sdk, b.tenantid, b.regionid, networkid)
 }
 
@@ -7447,13 +7449,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder interfac
 // v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	regionid  string
-	networkid string
+	tenantid  ids.TenantID
+	regionid  ids.RegionID
+	networkid ids.NetworkID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder(sdk *sdkImpl, tenantid string, regionid string, networkid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, networkid ids.NetworkID) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidBuilderImpl{
 		networkid: networkid,
 		regionid:  regionid,
@@ -7490,13 +7492,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder in
 // v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	regionid  string
-	networkid string
+	tenantid  ids.TenantID
+	regionid  ids.RegionID
+	networkid ids.NetworkID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder(sdk *sdkImpl, tenantid string, regionid string, networkid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, networkid ids.NetworkID) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidFreezeBuilderImpl{
 		networkid: networkid,
 		regionid:  regionid,
@@ -7518,13 +7520,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder
 // v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	regionid  string
-	networkid string
+	tenantid  ids.TenantID
+	regionid  ids.RegionID
+	networkid ids.NetworkID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder(sdk *sdkImpl, tenantid string, regionid string, networkid string) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, networkid ids.NetworkID) V4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuilderImpl{
 		networkid: networkid,
 		regionid:  regionid,
@@ -7540,18 +7542,18 @@ func (b *v4IPamOrganisationsTenantidRegionsRegionidNetworksNetworkidUnfreezeBuil
 
 // V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder provides access to operations
 type V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder interface {
-	Resourceid(resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder
 }
 
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder(sdk *sdkImpl, tenantid string, regionid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -7560,7 +7562,7 @@ func newV4IPamOrganisationsTenantidRegionsRegionidResourcesBuilder(sdk *sdkImpl,
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4IPamOrganisationsTenantidRegionsRegionidResourcesBuilderImpl) Resourceid(resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder {
+func (b *v4IPamOrganisationsTenantidRegionsRegionidResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder {
 	return newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder(b.sdk, b.tenantid, b.regionid, resourceid)
 }
 
@@ -7573,13 +7575,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder interf
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBuilderImpl{
 		regionid:   regionid,
 		resourceid: resourceid,
@@ -7606,13 +7608,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignBuilderImpl{
 		regionid:   regionid,
 		resourceid: resourceid,
@@ -7634,14 +7636,14 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversio
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 	ipversion  string
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string, ipversion string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID, ipversion string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidAssignIPversionBuilderImpl{
 		ipversion:  ipversion,
 		regionid:   regionid,
@@ -7664,13 +7666,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder in
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkBuilderImpl{
 		regionid:   regionid,
 		resourceid: resourceid,
@@ -7692,13 +7694,13 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuil
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignBuilderImpl{
 		regionid:   regionid,
 		resourceid: resourceid,
@@ -7720,14 +7722,14 @@ type V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPve
 // v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilderImpl implements V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder
 type v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	regionid   string
-	resourceid string
+	tenantid   ids.TenantID
+	regionid   ids.RegionID
+	resourceid ids.ResourceID
 	ipversion  string
 }
 
 // newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder creates a new V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder
-func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder(sdk *sdkImpl, tenantid string, regionid string, resourceid string, ipversion string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder {
+func newV4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, resourceid ids.ResourceID, ipversion string) V4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilder {
 	return &v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssignIPversionBuilderImpl{
 		ipversion:  ipversion,
 		regionid:   regionid,
@@ -7744,17 +7746,17 @@ func (b *v4IPamOrganisationsTenantidRegionsRegionidResourcesResourceidBulkAssign
 
 // V4IPamOrganisationsTenantidResourcesBuilder provides access to operations
 type V4IPamOrganisationsTenantidResourcesBuilder interface {
-	Resourceid(resourceid string) V4IPamOrganisationsTenantidResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidBuilder
 }
 
 // v4IPamOrganisationsTenantidResourcesBuilderImpl implements V4IPamOrganisationsTenantidResourcesBuilder
 type v4IPamOrganisationsTenantidResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4IPamOrganisationsTenantidResourcesBuilder creates a new V4IPamOrganisationsTenantidResourcesBuilder
-func newV4IPamOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid string) V4IPamOrganisationsTenantidResourcesBuilder {
+func newV4IPamOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4IPamOrganisationsTenantidResourcesBuilder {
 	return &v4IPamOrganisationsTenantidResourcesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -7762,7 +7764,7 @@ func newV4IPamOrganisationsTenantidResourcesBuilder(sdk *sdkImpl, tenantid strin
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4IPamOrganisationsTenantidResourcesBuilderImpl) Resourceid(resourceid string) V4IPamOrganisationsTenantidResourcesResourceidBuilder {
+func (b *v4IPamOrganisationsTenantidResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidBuilder {
 	return newV4IPamOrganisationsTenantidResourcesResourceidBuilder(b.sdk, b.tenantid, resourceid)
 }
 
@@ -7776,12 +7778,12 @@ type V4IPamOrganisationsTenantidResourcesResourceidBuilder interface {
 // v4IPamOrganisationsTenantidResourcesResourceidBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidResourcesResourceidBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7812,12 +7814,12 @@ type V4IPamOrganisationsTenantidResourcesResourceidBulkBuilder interface {
 // v4IPamOrganisationsTenantidResourcesResourceidBulkBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidBulkBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidBulkBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidBulkBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidBulkBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidBulkBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidResourcesResourceidBulkBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidBulkBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidBulkBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidBulkBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7838,12 +7840,12 @@ type V4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder interface
 // v4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidBulkUnassignBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7864,12 +7866,12 @@ type V4IPamOrganisationsTenantidResourcesResourceidIPBuilder interface {
 // v4IPamOrganisationsTenantidResourcesResourceidIPBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidIPBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidIPBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidIPBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidIPBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidIPBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidResourcesResourceidIPBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidIPBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidIPBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidIPBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -7891,13 +7893,13 @@ type V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder interface
 // v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	ipaddress  string
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder(sdk *sdkImpl, tenantid string, resourceid string, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressBuilderImpl{
 		ipaddress:  ipaddress,
 		resourceid: resourceid,
@@ -7924,13 +7926,13 @@ type V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder inte
 // v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	ipaddress  string
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder(sdk *sdkImpl, tenantid string, resourceid string, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressFreezeBuilderImpl{
 		ipaddress:  ipaddress,
 		resourceid: resourceid,
@@ -7952,13 +7954,13 @@ type V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder in
 // v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	ipaddress  string
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder(sdk *sdkImpl, tenantid string, resourceid string, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidIPIPaddressUnfreezeBuilderImpl{
 		ipaddress:  ipaddress,
 		resourceid: resourceid,
@@ -7980,12 +7982,12 @@ type V4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder interface {
 // v4IPamOrganisationsTenantidResourcesResourceidUnassignBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidUnassignBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4IPamOrganisationsTenantidResourcesResourceidUnassignBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidUnassignBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -8006,13 +8008,13 @@ type V4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder inte
 // v4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilderImpl implements V4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder
 type v4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 	ipaddress  string
 }
 
 // newV4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder creates a new V4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder
-func newV4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder(sdk *sdkImpl, tenantid string, resourceid string, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder {
+func newV4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID, ipaddress string) V4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilder {
 	return &v4IPamOrganisationsTenantidResourcesResourceidUnassignIPaddressBuilderImpl{
 		ipaddress:  ipaddress,
 		resourceid: resourceid,
@@ -8074,7 +8076,7 @@ func (b *v4KeycloakConsumptionsBuilderImpl) Listallkeycloakconsumption(ctx conte
 
 // V4KeycloakOrganisationsBuilder provides access to operations
 type V4KeycloakOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4KeycloakOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4KeycloakOrganisationsOwneridBuilder
 }
 
 // v4KeycloakOrganisationsBuilderImpl implements V4KeycloakOrganisationsBuilder
@@ -8088,7 +8090,7 @@ func newV4KeycloakOrganisationsBuilder(sdk *sdkImpl) V4KeycloakOrganisationsBuil
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4KeycloakOrganisationsBuilderImpl) Ownerid(ownerid string) V4KeycloakOrganisationsOwneridBuilder {
+func (b *v4KeycloakOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4KeycloakOrganisationsOwneridBuilder {
 	return newV4KeycloakOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -8100,11 +8102,11 @@ type V4KeycloakOrganisationsOwneridBuilder interface {
 // v4KeycloakOrganisationsOwneridBuilderImpl implements V4KeycloakOrganisationsOwneridBuilder
 type v4KeycloakOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KeycloakOrganisationsOwneridBuilder creates a new V4KeycloakOrganisationsOwneridBuilder
-func newV4KeycloakOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4KeycloakOrganisationsOwneridBuilder {
+func newV4KeycloakOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KeycloakOrganisationsOwneridBuilder {
 	return &v4KeycloakOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8118,17 +8120,17 @@ func (b *v4KeycloakOrganisationsOwneridBuilderImpl) Keycloak() V4KeycloakOrganis
 
 // V4KeycloakOrganisationsOwneridKeycloakBuilder provides access to operations
 type V4KeycloakOrganisationsOwneridKeycloakBuilder interface {
-	Addonkeycloakid(addonkeycloakid string) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder
+	Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder
 }
 
 // v4KeycloakOrganisationsOwneridKeycloakBuilderImpl implements V4KeycloakOrganisationsOwneridKeycloakBuilder
 type v4KeycloakOrganisationsOwneridKeycloakBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KeycloakOrganisationsOwneridKeycloakBuilder creates a new V4KeycloakOrganisationsOwneridKeycloakBuilder
-func newV4KeycloakOrganisationsOwneridKeycloakBuilder(sdk *sdkImpl, ownerid string) V4KeycloakOrganisationsOwneridKeycloakBuilder {
+func newV4KeycloakOrganisationsOwneridKeycloakBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KeycloakOrganisationsOwneridKeycloakBuilder {
 	return &v4KeycloakOrganisationsOwneridKeycloakBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8136,7 +8138,7 @@ func newV4KeycloakOrganisationsOwneridKeycloakBuilder(sdk *sdkImpl, ownerid stri
 }
 
 // Addonkeycloakid returns builder for addonkeycloakid
-func (b *v4KeycloakOrganisationsOwneridKeycloakBuilderImpl) Addonkeycloakid(addonkeycloakid string) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder {
+func (b *v4KeycloakOrganisationsOwneridKeycloakBuilderImpl) Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder {
 	return newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder(b.sdk, b.ownerid, addonkeycloakid)
 }
 
@@ -8148,12 +8150,12 @@ type V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder interface {
 // v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilderImpl implements V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder
 type v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilderImpl struct {
 	sdk             *sdkImpl
-	ownerid         string
-	addonkeycloakid string
+	ownerid         ids.OwnerID
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder creates a new V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder
-func newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder(sdk *sdkImpl, ownerid string, addonkeycloakid string) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder {
+func newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, addonkeycloakid ids.AddonKeycloakID) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilder {
 	return &v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		ownerid:         ownerid,
@@ -8174,12 +8176,12 @@ type V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder int
 // v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilderImpl implements V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder
 type v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilderImpl struct {
 	sdk             *sdkImpl
-	ownerid         string
-	addonkeycloakid string
+	ownerid         ids.OwnerID
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder creates a new V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder
-func newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder(sdk *sdkImpl, ownerid string, addonkeycloakid string) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder {
+func newV4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder(sdk *sdkImpl, ownerid ids.OwnerID, addonkeycloakid ids.AddonKeycloakID) V4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilder {
 	return &v4KeycloakOrganisationsOwneridKeycloakAddonkeycloakidConsumptionBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		ownerid:         ownerid,
@@ -8214,7 +8216,7 @@ func (b *v4KeycloaksBuilderImpl) Organisations() V4KeycloaksOrganisationsBuilder
 
 // V4KeycloaksOrganisationsBuilder provides access to operations
 type V4KeycloaksOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4KeycloaksOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4KeycloaksOrganisationsOwneridBuilder
 }
 
 // v4KeycloaksOrganisationsBuilderImpl implements V4KeycloaksOrganisationsBuilder
@@ -8228,7 +8230,7 @@ func newV4KeycloaksOrganisationsBuilder(sdk *sdkImpl) V4KeycloaksOrganisationsBu
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4KeycloaksOrganisationsBuilderImpl) Ownerid(ownerid string) V4KeycloaksOrganisationsOwneridBuilder {
+func (b *v4KeycloaksOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4KeycloaksOrganisationsOwneridBuilder {
 	return newV4KeycloaksOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -8240,11 +8242,11 @@ type V4KeycloaksOrganisationsOwneridBuilder interface {
 // v4KeycloaksOrganisationsOwneridBuilderImpl implements V4KeycloaksOrganisationsOwneridBuilder
 type v4KeycloaksOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KeycloaksOrganisationsOwneridBuilder creates a new V4KeycloaksOrganisationsOwneridBuilder
-func newV4KeycloaksOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4KeycloaksOrganisationsOwneridBuilder {
+func newV4KeycloaksOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KeycloaksOrganisationsOwneridBuilder {
 	return &v4KeycloaksOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8258,17 +8260,17 @@ func (b *v4KeycloaksOrganisationsOwneridBuilderImpl) Keycloaks() V4KeycloaksOrga
 
 // V4KeycloaksOrganisationsOwneridKeycloaksBuilder provides access to operations
 type V4KeycloaksOrganisationsOwneridKeycloaksBuilder interface {
-	Addonkeycloakid(addonkeycloakid string) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder
+	Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder
 }
 
 // v4KeycloaksOrganisationsOwneridKeycloaksBuilderImpl implements V4KeycloaksOrganisationsOwneridKeycloaksBuilder
 type v4KeycloaksOrganisationsOwneridKeycloaksBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KeycloaksOrganisationsOwneridKeycloaksBuilder creates a new V4KeycloaksOrganisationsOwneridKeycloaksBuilder
-func newV4KeycloaksOrganisationsOwneridKeycloaksBuilder(sdk *sdkImpl, ownerid string) V4KeycloaksOrganisationsOwneridKeycloaksBuilder {
+func newV4KeycloaksOrganisationsOwneridKeycloaksBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KeycloaksOrganisationsOwneridKeycloaksBuilder {
 	return &v4KeycloaksOrganisationsOwneridKeycloaksBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8276,7 +8278,7 @@ func newV4KeycloaksOrganisationsOwneridKeycloaksBuilder(sdk *sdkImpl, ownerid st
 }
 
 // Addonkeycloakid returns builder for addonkeycloakid
-func (b *v4KeycloaksOrganisationsOwneridKeycloaksBuilderImpl) Addonkeycloakid(addonkeycloakid string) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder {
+func (b *v4KeycloaksOrganisationsOwneridKeycloaksBuilderImpl) Addonkeycloakid(addonkeycloakid ids.AddonKeycloakID) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder {
 	return newV4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder(b.sdk, b.ownerid, addonkeycloakid)
 }
 
@@ -8288,12 +8290,12 @@ type V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder interface {
 // v4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilderImpl implements V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder
 type v4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilderImpl struct {
 	sdk             *sdkImpl
-	ownerid         string
-	addonkeycloakid string
+	ownerid         ids.OwnerID
+	addonkeycloakid ids.AddonKeycloakID
 }
 
 // newV4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder creates a new V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder
-func newV4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder(sdk *sdkImpl, ownerid string, addonkeycloakid string) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder {
+func newV4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, addonkeycloakid ids.AddonKeycloakID) V4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilder {
 	return &v4KeycloaksOrganisationsOwneridKeycloaksAddonkeycloakidBuilderImpl{
 		addonkeycloakid: addonkeycloakid,
 		ownerid:         ownerid,
@@ -8354,7 +8356,7 @@ func (b *v4KubernetesAdminBuilderImpl) DeploymentProfiles() V4KubernetesAdminDep
 
 // V4KubernetesAdminDeploymentProfilesBuilder provides access to operations
 type V4KubernetesAdminDeploymentProfilesBuilder interface {
-	Locationid(locationid string) V4KubernetesAdminDeploymentProfilesLocationidBuilder
+	Locationid(locationid ids.LocationID) V4KubernetesAdminDeploymentProfilesLocationidBuilder
 	Listdeploymentprofiles(ctx context.Context) client.Response[[]models.DeploymentProfile]
 	Createdeploymentprofile(ctx context.Context, request *models.WannabeDeploymentProfile) client.Response[models.DeploymentProfile]
 }
@@ -8370,7 +8372,7 @@ func newV4KubernetesAdminDeploymentProfilesBuilder(sdk *sdkImpl) V4KubernetesAdm
 }
 
 // Locationid returns builder for locationid
-func (b *v4KubernetesAdminDeploymentProfilesBuilderImpl) Locationid(locationid string) V4KubernetesAdminDeploymentProfilesLocationidBuilder {
+func (b *v4KubernetesAdminDeploymentProfilesBuilderImpl) Locationid(locationid ids.LocationID) V4KubernetesAdminDeploymentProfilesLocationidBuilder {
 	return newV4KubernetesAdminDeploymentProfilesLocationidBuilder(b.sdk, locationid)
 }
 
@@ -8393,11 +8395,11 @@ type V4KubernetesAdminDeploymentProfilesLocationidBuilder interface {
 // v4KubernetesAdminDeploymentProfilesLocationidBuilderImpl implements V4KubernetesAdminDeploymentProfilesLocationidBuilder
 type v4KubernetesAdminDeploymentProfilesLocationidBuilderImpl struct {
 	sdk        *sdkImpl
-	locationid string
+	locationid ids.LocationID
 }
 
 // newV4KubernetesAdminDeploymentProfilesLocationidBuilder creates a new V4KubernetesAdminDeploymentProfilesLocationidBuilder
-func newV4KubernetesAdminDeploymentProfilesLocationidBuilder(sdk *sdkImpl, locationid string) V4KubernetesAdminDeploymentProfilesLocationidBuilder {
+func newV4KubernetesAdminDeploymentProfilesLocationidBuilder(sdk *sdkImpl, locationid ids.LocationID) V4KubernetesAdminDeploymentProfilesLocationidBuilder {
 	return &v4KubernetesAdminDeploymentProfilesLocationidBuilderImpl{
 		locationid: locationid,
 		sdk:        sdk,
@@ -8416,7 +8418,7 @@ func (b *v4KubernetesAdminDeploymentProfilesLocationidBuilderImpl) Getdeployment
 
 // V4KubernetesOrganisationsBuilder provides access to operations
 type V4KubernetesOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4KubernetesOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridBuilder
 }
 
 // v4KubernetesOrganisationsBuilderImpl implements V4KubernetesOrganisationsBuilder
@@ -8430,7 +8432,7 @@ func newV4KubernetesOrganisationsBuilder(sdk *sdkImpl) V4KubernetesOrganisations
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4KubernetesOrganisationsBuilderImpl) Ownerid(ownerid string) V4KubernetesOrganisationsOwneridBuilder {
+func (b *v4KubernetesOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridBuilder {
 	return newV4KubernetesOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -8445,11 +8447,11 @@ type V4KubernetesOrganisationsOwneridBuilder interface {
 // v4KubernetesOrganisationsOwneridBuilderImpl implements V4KubernetesOrganisationsOwneridBuilder
 type v4KubernetesOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridBuilder creates a new V4KubernetesOrganisationsOwneridBuilder
-func newV4KubernetesOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridBuilder {
+func newV4KubernetesOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridBuilder {
 	return &v4KubernetesOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8478,7 +8480,7 @@ func (b *v4KubernetesOrganisationsOwneridBuilderImpl) Usage() V4KubernetesOrgani
 
 // V4KubernetesOrganisationsOwneridClustersBuilder provides access to operations
 type V4KubernetesOrganisationsOwneridClustersBuilder interface {
-	Clusterid(clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridBuilder
 	Listkubernetesclusters(ctx context.Context, opts ...kubernetes.Option) client.Response[[]models.Cluster1]
 	Createkubernetescluster(ctx context.Context, request *models.ClusterCreationPayload) client.Response[models.Cluster1]
 }
@@ -8486,11 +8488,11 @@ type V4KubernetesOrganisationsOwneridClustersBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersBuilderImpl implements V4KubernetesOrganisationsOwneridClustersBuilder
 type v4KubernetesOrganisationsOwneridClustersBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersBuilder creates a new V4KubernetesOrganisationsOwneridClustersBuilder
-func newV4KubernetesOrganisationsOwneridClustersBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridClustersBuilder {
+func newV4KubernetesOrganisationsOwneridClustersBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridClustersBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -8498,7 +8500,7 @@ func newV4KubernetesOrganisationsOwneridClustersBuilder(sdk *sdkImpl, ownerid st
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4KubernetesOrganisationsOwneridClustersBuilderImpl) Clusterid(clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridBuilder {
+func (b *v4KubernetesOrganisationsOwneridClustersBuilderImpl) Clusterid(clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridBuilder {
 	return newV4KubernetesOrganisationsOwneridClustersClusteridBuilder(b.sdk, b.ownerid, clusterid)
 }
 
@@ -8531,12 +8533,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8612,12 +8614,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridCsiBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridCsiBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridCsiBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridCsiBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8638,12 +8640,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridCsiCephBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8664,12 +8666,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder in
 // v4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridDeploymentEventsBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8690,12 +8692,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder interfac
 // v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8716,12 +8718,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuil
 // v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigPresignedURLBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8742,12 +8744,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder inte
 // v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8762,7 +8764,7 @@ func (b *v4KubernetesOrganisationsOwneridClustersClusteridKubeconfigYAMLBuilderI
 
 // V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder provides access to operations
 type V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder interface {
-	Nodegroupid(nodegroupid string) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder
+	Nodegroupid(nodegroupid ids.NodeGroupID) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder
 	Listkubernetesnodegroups(ctx context.Context, opts ...kubernetes.Option) client.Response[[]models.NodeGroup]
 	Createkubernetesnodegroup(ctx context.Context, request *models.NodeGroupCreationPayload) client.Response[models.NodeGroup]
 }
@@ -8770,12 +8772,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder interfac
 // v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8784,7 +8786,7 @@ func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilder(sdk *
 }
 
 // Nodegroupid returns builder for nodegroupid
-func (b *v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilderImpl) Nodegroupid(nodegroupid string) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder {
+func (b *v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsBuilderImpl) Nodegroupid(nodegroupid ids.NodeGroupID) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder {
 	return newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder(b.sdk, b.ownerid, b.clusterid, nodegroupid)
 }
 
@@ -8809,13 +8811,13 @@ type V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuild
 // v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilderImpl struct {
 	sdk         *sdkImpl
-	ownerid     string
-	clusterid   string
-	nodegroupid string
+	ownerid     ids.OwnerID
+	clusterid   ids.ClusterID
+	nodegroupid ids.NodeGroupID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder(sdk *sdkImpl, ownerid string, clusterid string, nodegroupid string) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID, nodegroupid ids.NodeGroupID) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidBuilderImpl{
 		clusterid:   clusterid,
 		nodegroupid: nodegroupid,
@@ -8852,13 +8854,13 @@ type V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResum
 // v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilderImpl struct {
 	sdk         *sdkImpl
-	ownerid     string
-	clusterid   string
-	nodegroupid string
+	ownerid     ids.OwnerID
+	clusterid   ids.ClusterID
+	nodegroupid ids.NodeGroupID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder(sdk *sdkImpl, ownerid string, clusterid string, nodegroupid string) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID, nodegroupid ids.NodeGroupID) V4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidResumeBuilderImpl{
 		clusterid:   clusterid,
 		nodegroupid: nodegroupid,
@@ -8874,7 +8876,7 @@ func (b *v4KubernetesOrganisationsOwneridClustersClusteridNodeGroupsNodegroupidR
 
 // V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder provides access to operations
 type V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder interface {
-	Nodeid(nodeid string) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder
+	Nodeid(nodeid ids.NodeID) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder
 	Listkubernetesnodes(ctx context.Context) client.Response[[]models.StandaloneNode]
 	Createkubernetesnode(ctx context.Context, request *models.WannabeStandaloneNode) client.Response[models.StandaloneNode]
 }
@@ -8882,12 +8884,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridNodesBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridNodesBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridNodesBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8896,7 +8898,7 @@ func newV4KubernetesOrganisationsOwneridClustersClusteridNodesBuilder(sdk *sdkIm
 }
 
 // Nodeid returns builder for nodeid
-func (b *v4KubernetesOrganisationsOwneridClustersClusteridNodesBuilderImpl) Nodeid(nodeid string) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder {
+func (b *v4KubernetesOrganisationsOwneridClustersClusteridNodesBuilderImpl) Nodeid(nodeid ids.NodeID) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder {
 	return newV4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder(b.sdk, b.ownerid, b.clusterid, nodeid)
 }
 
@@ -8919,13 +8921,13 @@ type V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder interfa
 // v4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
-	nodeid    string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
+	nodeid    ids.NodeID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder(sdk *sdkImpl, ownerid string, clusterid string, nodeid string) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID, nodeid ids.NodeID) V4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridNodesNodeidBuilderImpl{
 		clusterid: clusterid,
 		nodeid:    nodeid,
@@ -8952,12 +8954,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder interface
 // v4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridRedeployBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -8978,12 +8980,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridResumeBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridResumeBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridResumeBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridResumeBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -9005,12 +9007,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder interface {
 // v4KubernetesOrganisationsOwneridClustersClusteridVersionBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridVersionBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridVersionBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridVersionBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -9036,12 +9038,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder interf
 // v4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridVersionCheckBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -9062,12 +9064,12 @@ type V4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder inter
 // v4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilderImpl implements V4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder
 type v4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilderImpl struct {
 	sdk       *sdkImpl
-	ownerid   string
-	clusterid string
+	ownerid   ids.OwnerID
+	clusterid ids.ClusterID
 }
 
 // newV4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder creates a new V4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder
-func newV4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder(sdk *sdkImpl, ownerid string, clusterid string) V4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder {
+func newV4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder(sdk *sdkImpl, ownerid ids.OwnerID, clusterid ids.ClusterID) V4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilder {
 	return &v4KubernetesOrganisationsOwneridClustersClusteridVersionUpdateBuilderImpl{
 		clusterid: clusterid,
 		ownerid:   ownerid,
@@ -9088,11 +9090,11 @@ type V4KubernetesOrganisationsOwneridKubernetesBuilder interface {
 // v4KubernetesOrganisationsOwneridKubernetesBuilderImpl implements V4KubernetesOrganisationsOwneridKubernetesBuilder
 type v4KubernetesOrganisationsOwneridKubernetesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridKubernetesBuilder creates a new V4KubernetesOrganisationsOwneridKubernetesBuilder
-func newV4KubernetesOrganisationsOwneridKubernetesBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridKubernetesBuilder {
+func newV4KubernetesOrganisationsOwneridKubernetesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridKubernetesBuilder {
 	return &v4KubernetesOrganisationsOwneridKubernetesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9112,11 +9114,11 @@ type V4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder interface {
 // v4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilderImpl implements V4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder
 type v4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder creates a new V4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder
-func newV4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder {
+func newV4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilder {
 	return &v4KubernetesOrganisationsOwneridKubernetesConsumptionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9136,11 +9138,11 @@ type V4KubernetesOrganisationsOwneridQuotaBuilder interface {
 // v4KubernetesOrganisationsOwneridQuotaBuilderImpl implements V4KubernetesOrganisationsOwneridQuotaBuilder
 type v4KubernetesOrganisationsOwneridQuotaBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridQuotaBuilder creates a new V4KubernetesOrganisationsOwneridQuotaBuilder
-func newV4KubernetesOrganisationsOwneridQuotaBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridQuotaBuilder {
+func newV4KubernetesOrganisationsOwneridQuotaBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridQuotaBuilder {
 	return &v4KubernetesOrganisationsOwneridQuotaBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9160,11 +9162,11 @@ type V4KubernetesOrganisationsOwneridUsageBuilder interface {
 // v4KubernetesOrganisationsOwneridUsageBuilderImpl implements V4KubernetesOrganisationsOwneridUsageBuilder
 type v4KubernetesOrganisationsOwneridUsageBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4KubernetesOrganisationsOwneridUsageBuilder creates a new V4KubernetesOrganisationsOwneridUsageBuilder
-func newV4KubernetesOrganisationsOwneridUsageBuilder(sdk *sdkImpl, ownerid string) V4KubernetesOrganisationsOwneridUsageBuilder {
+func newV4KubernetesOrganisationsOwneridUsageBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4KubernetesOrganisationsOwneridUsageBuilder {
 	return &v4KubernetesOrganisationsOwneridUsageBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9218,7 +9220,7 @@ func (b *v4LoadbalancerBuilderImpl) Organisations() V4LoadbalancerOrganisationsB
 
 // V4LoadbalancerOrganisationsBuilder provides access to operations
 type V4LoadbalancerOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4LoadbalancerOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4LoadbalancerOrganisationsOwneridBuilder
 }
 
 // v4LoadbalancerOrganisationsBuilderImpl implements V4LoadbalancerOrganisationsBuilder
@@ -9232,7 +9234,7 @@ func newV4LoadbalancerOrganisationsBuilder(sdk *sdkImpl) V4LoadbalancerOrganisat
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4LoadbalancerOrganisationsBuilderImpl) Ownerid(ownerid string) V4LoadbalancerOrganisationsOwneridBuilder {
+func (b *v4LoadbalancerOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4LoadbalancerOrganisationsOwneridBuilder {
 	return newV4LoadbalancerOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -9244,11 +9246,11 @@ type V4LoadbalancerOrganisationsOwneridBuilder interface {
 // v4LoadbalancerOrganisationsOwneridBuilderImpl implements V4LoadbalancerOrganisationsOwneridBuilder
 type v4LoadbalancerOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4LoadbalancerOrganisationsOwneridBuilder creates a new V4LoadbalancerOrganisationsOwneridBuilder
-func newV4LoadbalancerOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4LoadbalancerOrganisationsOwneridBuilder {
+func newV4LoadbalancerOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4LoadbalancerOrganisationsOwneridBuilder {
 	return &v4LoadbalancerOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9268,11 +9270,11 @@ type V4LoadbalancerOrganisationsOwneridLoadbalancerBuilder interface {
 // v4LoadbalancerOrganisationsOwneridLoadbalancerBuilderImpl implements V4LoadbalancerOrganisationsOwneridLoadbalancerBuilder
 type v4LoadbalancerOrganisationsOwneridLoadbalancerBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4LoadbalancerOrganisationsOwneridLoadbalancerBuilder creates a new V4LoadbalancerOrganisationsOwneridLoadbalancerBuilder
-func newV4LoadbalancerOrganisationsOwneridLoadbalancerBuilder(sdk *sdkImpl, ownerid string) V4LoadbalancerOrganisationsOwneridLoadbalancerBuilder {
+func newV4LoadbalancerOrganisationsOwneridLoadbalancerBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4LoadbalancerOrganisationsOwneridLoadbalancerBuilder {
 	return &v4LoadbalancerOrganisationsOwneridLoadbalancerBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9292,11 +9294,11 @@ type V4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder interface
 // v4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilderImpl implements V4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder
 type v4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder creates a new V4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder
-func newV4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder(sdk *sdkImpl, ownerid string) V4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder {
+func newV4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilder {
 	return &v4LoadbalancerOrganisationsOwneridLoadbalancerConsumptionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -9330,7 +9332,7 @@ func (b *v4LoadbalancersBuilderImpl) Organisations() V4LoadbalancersOrganisation
 
 // V4LoadbalancersOrganisationsBuilder provides access to operations
 type V4LoadbalancersOrganisationsBuilder interface {
-	Tenantid(tenantid string) V4LoadbalancersOrganisationsTenantidBuilder
+	Tenantid(tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidBuilder
 }
 
 // v4LoadbalancersOrganisationsBuilderImpl implements V4LoadbalancersOrganisationsBuilder
@@ -9344,7 +9346,7 @@ func newV4LoadbalancersOrganisationsBuilder(sdk *sdkImpl) V4LoadbalancersOrganis
 }
 
 // Tenantid returns builder for tenantid
-func (b *v4LoadbalancersOrganisationsBuilderImpl) Tenantid(tenantid string) V4LoadbalancersOrganisationsTenantidBuilder {
+func (b *v4LoadbalancersOrganisationsBuilderImpl) Tenantid(tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidBuilder(b.sdk, tenantid)
 }
 
@@ -9362,11 +9364,11 @@ type V4LoadbalancersOrganisationsTenantidBuilder interface {
 // v4LoadbalancersOrganisationsTenantidBuilderImpl implements V4LoadbalancersOrganisationsTenantidBuilder
 type v4LoadbalancersOrganisationsTenantidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidBuilder creates a new V4LoadbalancersOrganisationsTenantidBuilder
-func newV4LoadbalancersOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidBuilder {
+func newV4LoadbalancersOrganisationsTenantidBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9410,18 +9412,18 @@ func (b *v4LoadbalancersOrganisationsTenantidBuilderImpl) Servers() V4Loadbalanc
 
 // V4LoadbalancersOrganisationsTenantidAuditBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidAuditBuilder interface {
-	Resourceid(resourceid string) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder
 	Listauditsfortenant(ctx context.Context) client.Response[[]models.LoadBalancerAudit]
 }
 
 // v4LoadbalancersOrganisationsTenantidAuditBuilderImpl implements V4LoadbalancersOrganisationsTenantidAuditBuilder
 type v4LoadbalancersOrganisationsTenantidAuditBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidAuditBuilder creates a new V4LoadbalancersOrganisationsTenantidAuditBuilder
-func newV4LoadbalancersOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidAuditBuilder {
+func newV4LoadbalancersOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidAuditBuilder {
 	return &v4LoadbalancersOrganisationsTenantidAuditBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9429,7 +9431,7 @@ func newV4LoadbalancersOrganisationsTenantidAuditBuilder(sdk *sdkImpl, tenantid
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4LoadbalancersOrganisationsTenantidAuditBuilderImpl) Resourceid(resourceid string) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidAuditBuilderImpl) Resourceid(resourceid ids.ResourceID) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidAuditResourceidBuilder(b.sdk, b.tenantid, resourceid)
 }
 
@@ -9446,12 +9448,12 @@ type V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder interface {
 // v4LoadbalancersOrganisationsTenantidAuditResourceidBuilderImpl implements V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder
 type v4LoadbalancersOrganisationsTenantidAuditResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	resourceid string
+	tenantid   ids.TenantID
+	resourceid ids.ResourceID
 }
 
 // newV4LoadbalancersOrganisationsTenantidAuditResourceidBuilder creates a new V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder
-func newV4LoadbalancersOrganisationsTenantidAuditResourceidBuilder(sdk *sdkImpl, tenantid string, resourceid string) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder {
+func newV4LoadbalancersOrganisationsTenantidAuditResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, resourceid ids.ResourceID) V4LoadbalancersOrganisationsTenantidAuditResourceidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidAuditResourceidBuilderImpl{
 		resourceid: resourceid,
 		sdk:        sdk,
@@ -9466,18 +9468,18 @@ func (b *v4LoadbalancersOrganisationsTenantidAuditResourceidBuilderImpl) Listaud
 
 // V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder interface {
-	Loadbalancerid(loadbalancerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder
+	Loadbalancerid(loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder
 	Listloadbalancersfortenant(ctx context.Context) client.Response[[]models.LoadBalancerOutput]
 }
 
 // v4LoadbalancersOrganisationsTenantidLoadbalancersBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidLoadbalancersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9485,7 +9487,7 @@ func newV4LoadbalancersOrganisationsTenantidLoadbalancersBuilder(sdk *sdkImpl, t
 }
 
 // Loadbalancerid returns builder for loadbalancerid
-func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersBuilderImpl) Loadbalancerid(loadbalancerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersBuilderImpl) Loadbalancerid(loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder {
 	return newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder(b.sdk, b.tenantid, loadbalancerid)
 }
 
@@ -9503,12 +9505,12 @@ type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder inte
 // v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder(sdk *sdkImpl, tenantid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder(sdk *sdkImpl, tenantid ids.TenantID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		sdk:            sdk,
@@ -9528,7 +9530,7 @@ func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridBuilderI
 
 // V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder interface {
-	Clusterid(clusterid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder
 	Listloadbalancerclusters(ctx context.Context) client.Response[[]models.Cluster]
 	Assignloadbalancerclusters(ctx context.Context, request []*models.Cluster) client.Response[models.LoadBalancer]
 }
@@ -9536,12 +9538,12 @@ type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuil
 // v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder(sdk *sdkImpl, tenantid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder(sdk *sdkImpl, tenantid ids.TenantID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		sdk:            sdk,
@@ -9550,7 +9552,7 @@ func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersB
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilderImpl) Clusterid(clusterid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersBuilderImpl) Clusterid(clusterid ids.ClusterID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder {
 	return newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder(b.sdk, b.tenantid, b.loadbalancerid, clusterid)
 }
 
@@ -9573,13 +9575,13 @@ type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClus
 // v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	loadbalancerid string
-	clusterid      string
+	tenantid       ids.TenantID
+	loadbalancerid ids.LoadbalancerID
+	clusterid      ids.ClusterID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder(sdk *sdkImpl, tenantid string, loadbalancerid string, clusterid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder(sdk *sdkImpl, tenantid ids.TenantID, loadbalancerid ids.LoadbalancerID, clusterid ids.ClusterID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClustersClusteridBuilderImpl{
 		clusterid:      clusterid,
 		loadbalancerid: loadbalancerid,
@@ -9600,7 +9602,7 @@ func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridClusters
 
 // V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder interface {
-	Listenerid(listenerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder
+	Listenerid(listenerid ids.ListenerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder
 	Listloadbalancerlisteners(ctx context.Context) client.Response[[]models.Listener]
 	Assignloadbalancerlisteners(ctx context.Context, request []*models.Listener) client.Response[models.LoadBalancer]
 }
@@ -9608,12 +9610,12 @@ type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBui
 // v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder(sdk *sdkImpl, tenantid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder(sdk *sdkImpl, tenantid ids.TenantID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		sdk:            sdk,
@@ -9622,7 +9624,7 @@ func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListeners
 }
 
 // Listenerid returns builder for listenerid
-func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilderImpl) Listenerid(listenerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersBuilderImpl) Listenerid(listenerid ids.ListenerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder {
 	return newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder(b.sdk, b.tenantid, b.loadbalancerid, listenerid)
 }
 
@@ -9645,13 +9647,13 @@ type V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersLis
 // v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilderImpl implements V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder
 type v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	loadbalancerid string
-	listenerid     string
+	tenantid       ids.TenantID
+	loadbalancerid ids.LoadbalancerID
+	listenerid     ids.ListenerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder creates a new V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder
-func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder(sdk *sdkImpl, tenantid string, loadbalancerid string, listenerid string) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder {
+func newV4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder(sdk *sdkImpl, tenantid ids.TenantID, loadbalancerid ids.LoadbalancerID, listenerid ids.ListenerID) V4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilder {
 	return &v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListenersListeneridBuilderImpl{
 		listenerid:     listenerid,
 		loadbalancerid: loadbalancerid,
@@ -9672,7 +9674,7 @@ func (b *v4LoadbalancersOrganisationsTenantidLoadbalancersLoadbalanceridListener
 
 // V4LoadbalancersOrganisationsTenantidNetworksBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidNetworksBuilder interface {
-	Networkid(networkid string) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder
+	Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder
 	Listnetworks(ctx context.Context) client.Response[[]models.Network3]
 	Createnetwork(ctx context.Context) client.Response[models.NetworkIdResponse]
 }
@@ -9680,11 +9682,11 @@ type V4LoadbalancersOrganisationsTenantidNetworksBuilder interface {
 // v4LoadbalancersOrganisationsTenantidNetworksBuilderImpl implements V4LoadbalancersOrganisationsTenantidNetworksBuilder
 type v4LoadbalancersOrganisationsTenantidNetworksBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidNetworksBuilder creates a new V4LoadbalancersOrganisationsTenantidNetworksBuilder
-func newV4LoadbalancersOrganisationsTenantidNetworksBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidNetworksBuilder {
+func newV4LoadbalancersOrganisationsTenantidNetworksBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidNetworksBuilder {
 	return &v4LoadbalancersOrganisationsTenantidNetworksBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9692,7 +9694,7 @@ func newV4LoadbalancersOrganisationsTenantidNetworksBuilder(sdk *sdkImpl, tenant
 }
 
 // Networkid returns builder for networkid
-func (b *v4LoadbalancersOrganisationsTenantidNetworksBuilderImpl) Networkid(networkid string) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidNetworksBuilderImpl) Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder(b.sdk, b.tenantid, networkid)
 }
 
@@ -9715,12 +9717,12 @@ type V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder interface {
 // v4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilderImpl implements V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder
 type v4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	networkid string
+	tenantid  ids.TenantID
+	networkid ids.NetworkID
 }
 
 // newV4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder creates a new V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder
-func newV4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder(sdk *sdkImpl, tenantid string, networkid string) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder {
+func newV4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder(sdk *sdkImpl, tenantid ids.TenantID, networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilderImpl{
 		networkid: networkid,
 		sdk:       sdk,
@@ -9740,17 +9742,17 @@ func (b *v4LoadbalancersOrganisationsTenantidNetworksNetworkidBuilderImpl) Getne
 
 // V4LoadbalancersOrganisationsTenantidParentsBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidParentsBuilder interface {
-	Parentid(parentid string) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder
+	Parentid(parentid ids.ParentID) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder
 }
 
 // v4LoadbalancersOrganisationsTenantidParentsBuilderImpl implements V4LoadbalancersOrganisationsTenantidParentsBuilder
 type v4LoadbalancersOrganisationsTenantidParentsBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidParentsBuilder creates a new V4LoadbalancersOrganisationsTenantidParentsBuilder
-func newV4LoadbalancersOrganisationsTenantidParentsBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidParentsBuilder {
+func newV4LoadbalancersOrganisationsTenantidParentsBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidParentsBuilder {
 	return &v4LoadbalancersOrganisationsTenantidParentsBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9758,7 +9760,7 @@ func newV4LoadbalancersOrganisationsTenantidParentsBuilder(sdk *sdkImpl, tenanti
 }
 
 // Parentid returns builder for parentid
-func (b *v4LoadbalancersOrganisationsTenantidParentsBuilderImpl) Parentid(parentid string) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidParentsBuilderImpl) Parentid(parentid ids.ParentID) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidParentsParentidBuilder(b.sdk, b.tenantid, parentid)
 }
 
@@ -9771,12 +9773,12 @@ type V4LoadbalancersOrganisationsTenantidParentsParentidBuilder interface {
 // v4LoadbalancersOrganisationsTenantidParentsParentidBuilderImpl implements V4LoadbalancersOrganisationsTenantidParentsParentidBuilder
 type v4LoadbalancersOrganisationsTenantidParentsParentidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	parentid string
+	tenantid ids.TenantID
+	parentid ids.ParentID
 }
 
 // newV4LoadbalancersOrganisationsTenantidParentsParentidBuilder creates a new V4LoadbalancersOrganisationsTenantidParentsParentidBuilder
-func newV4LoadbalancersOrganisationsTenantidParentsParentidBuilder(sdk *sdkImpl, tenantid string, parentid string) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder {
+func newV4LoadbalancersOrganisationsTenantidParentsParentidBuilder(sdk *sdkImpl, tenantid ids.TenantID, parentid ids.ParentID) V4LoadbalancersOrganisationsTenantidParentsParentidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidParentsParentidBuilderImpl{
 		parentid: parentid,
 		sdk:      sdk,
@@ -9802,11 +9804,11 @@ type V4LoadbalancersOrganisationsTenantidPulsarBuilder interface {
 // v4LoadbalancersOrganisationsTenantidPulsarBuilderImpl implements V4LoadbalancersOrganisationsTenantidPulsarBuilder
 type v4LoadbalancersOrganisationsTenantidPulsarBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidPulsarBuilder creates a new V4LoadbalancersOrganisationsTenantidPulsarBuilder
-func newV4LoadbalancersOrganisationsTenantidPulsarBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidPulsarBuilder {
+func newV4LoadbalancersOrganisationsTenantidPulsarBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidPulsarBuilder {
 	return &v4LoadbalancersOrganisationsTenantidPulsarBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9820,17 +9822,17 @@ func (b *v4LoadbalancersOrganisationsTenantidPulsarBuilderImpl) Listoutboxmessag
 
 // V4LoadbalancersOrganisationsTenantidRegionsBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidRegionsBuilder interface {
-	Regionid(regionid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder
+	Regionid(regionid ids.RegionID) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder
 }
 
 // v4LoadbalancersOrganisationsTenantidRegionsBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidRegionsBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidRegionsBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -9838,7 +9840,7 @@ func newV4LoadbalancersOrganisationsTenantidRegionsBuilder(sdk *sdkImpl, tenanti
 }
 
 // Regionid returns builder for regionid
-func (b *v4LoadbalancersOrganisationsTenantidRegionsBuilderImpl) Regionid(regionid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidRegionsBuilderImpl) Regionid(regionid ids.RegionID) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder(b.sdk, b.tenantid, regionid)
 }
 
@@ -9851,12 +9853,12 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder interface {
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder(sdk *sdkImpl, tenantid string, regionid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4LoadbalancersOrganisationsTenantidRegionsRegionidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -9876,7 +9878,7 @@ func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidBuilderImpl) Servers
 
 // V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder interface {
-	Loadbalancerid(loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder
+	Loadbalancerid(loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder
 	Listloadbalancersforregion(ctx context.Context) client.Response[[]models.LoadBalancerOutput]
 	Createloadbalancer(ctx context.Context, request *models.CreateLoadBalancerInput) client.Response[models.LoadBalancer]
 }
@@ -9884,12 +9886,12 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder int
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder(sdk *sdkImpl, tenantid string, regionid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -9898,7 +9900,7 @@ func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilder(
 }
 
 // Loadbalancerid returns builder for loadbalancerid
-func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilderImpl) Loadbalancerid(loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersBuilderImpl) Loadbalancerid(loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder {
 	return newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder(b.sdk, b.tenantid, b.regionid, loadbalancerid)
 }
 
@@ -9932,13 +9934,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10022,13 +10024,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAclBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10054,19 +10056,19 @@ func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbal
 
 // V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder interface {
-	Networkid(networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder
+	Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder
 }
 
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10076,7 +10078,7 @@ func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbala
 }
 
 // Networkid returns builder for networkid
-func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilderImpl) Networkid(networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachBuilderImpl) Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder(b.sdk, b.tenantid, b.regionid, b.loadbalancerid, networkid)
 }
 
@@ -10088,14 +10090,14 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
-	networkid      string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
+	networkid      ids.NetworkID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string, networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID, networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAttachNetworkidBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		networkid:      networkid,
@@ -10118,13 +10120,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridAvailableTLSCiphersBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10146,13 +10148,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridCoOwnersBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10176,13 +10178,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10214,13 +10216,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10242,14 +10244,14 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 	versionnumber  int
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string, versionnumber int) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID, versionnumber int) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridConfigurationRollbackVersionnumberBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10266,19 +10268,19 @@ func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbal
 
 // V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder interface {
-	Networkid(networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder
+	Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder
 }
 
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10288,7 +10290,7 @@ func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbala
 }
 
 // Networkid returns builder for networkid
-func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilderImpl) Networkid(networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachBuilderImpl) Networkid(networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder {
 	return newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder(b.sdk, b.tenantid, b.regionid, b.loadbalancerid, networkid)
 }
 
@@ -10300,14 +10302,14 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
-	networkid      string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
+	networkid      ids.NetworkID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string, networkid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID, networkid ids.NetworkID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridDetachNetworkidBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		networkid:      networkid,
@@ -10330,13 +10332,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridNetworksBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10358,13 +10360,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridScaleBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10387,13 +10389,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridStickyNameBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10421,13 +10423,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTimeoutsBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10455,13 +10457,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalance
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilderImpl struct {
 	sdk            *sdkImpl
-	tenantid       string
-	regionid       string
-	loadbalancerid string
+	tenantid       ids.TenantID
+	regionid       ids.RegionID
+	loadbalancerid ids.LoadbalancerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder(sdk *sdkImpl, tenantid string, regionid string, loadbalancerid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, loadbalancerid ids.LoadbalancerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbalanceridTLSCiphersBuilderImpl{
 		loadbalancerid: loadbalancerid,
 		regionid:       regionid,
@@ -10482,7 +10484,7 @@ func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidLoadbalancersLoadbal
 
 // V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder provides access to operations
 type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder interface {
-	Serverid(serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder
+	Serverid(serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder
 	Listserversforregion(ctx context.Context) client.Response[[]models.Server]
 	Createserver(ctx context.Context, request *models.RegisterServerInput) client.Response[models.ServerIdResponse]
 }
@@ -10490,12 +10492,12 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder interface
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder(sdk *sdkImpl, tenantid string, regionid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -10504,7 +10506,7 @@ func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilder(sdk *s
 }
 
 // Serverid returns builder for serverid
-func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilderImpl) Serverid(serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder {
+func (b *v4LoadbalancersOrganisationsTenantidRegionsRegionidServersBuilderImpl) Serverid(serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder {
 	return newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder(b.sdk, b.tenantid, b.regionid, serverid)
 }
 
@@ -10530,13 +10532,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder i
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
-	serverid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
+	serverid ids.ServerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder(sdk *sdkImpl, tenantid string, regionid string, serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -10578,13 +10580,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuil
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
-	serverid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
+	serverid ids.ServerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder(sdk *sdkImpl, tenantid string, regionid string, serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridDrainBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -10606,13 +10608,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalan
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
-	serverid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
+	serverid ids.ServerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder(sdk *sdkImpl, tenantid string, regionid string, serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridLoadbalancerCapacityBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -10634,13 +10636,13 @@ type V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenan
 // v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilderImpl implements V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder
 type v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	regionid string
-	serverid string
+	tenantid ids.TenantID
+	regionid ids.RegionID
+	serverid ids.ServerID
 }
 
 // newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder creates a new V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder
-func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder(sdk *sdkImpl, tenantid string, regionid string, serverid string) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder {
+func newV4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder(sdk *sdkImpl, tenantid ids.TenantID, regionid ids.RegionID, serverid ids.ServerID) V4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilder {
 	return &v4LoadbalancersOrganisationsTenantidRegionsRegionidServersServeridMaintenanceBuilderImpl{
 		regionid: regionid,
 		sdk:      sdk,
@@ -10662,11 +10664,11 @@ type V4LoadbalancersOrganisationsTenantidServersBuilder interface {
 // v4LoadbalancersOrganisationsTenantidServersBuilderImpl implements V4LoadbalancersOrganisationsTenantidServersBuilder
 type v4LoadbalancersOrganisationsTenantidServersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4LoadbalancersOrganisationsTenantidServersBuilder creates a new V4LoadbalancersOrganisationsTenantidServersBuilder
-func newV4LoadbalancersOrganisationsTenantidServersBuilder(sdk *sdkImpl, tenantid string) V4LoadbalancersOrganisationsTenantidServersBuilder {
+func newV4LoadbalancersOrganisationsTenantidServersBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4LoadbalancersOrganisationsTenantidServersBuilder {
 	return &v4LoadbalancersOrganisationsTenantidServersBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -10720,7 +10722,7 @@ func (b *v4MateriaBuilderImpl) Organisations() V4MateriaOrganisationsBuilder {
 
 // V4MateriaOrganisationsBuilder provides access to operations
 type V4MateriaOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4MateriaOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4MateriaOrganisationsOwneridBuilder
 }
 
 // v4MateriaOrganisationsBuilderImpl implements V4MateriaOrganisationsBuilder
@@ -10734,7 +10736,7 @@ func newV4MateriaOrganisationsBuilder(sdk *sdkImpl) V4MateriaOrganisationsBuilde
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4MateriaOrganisationsBuilderImpl) Ownerid(ownerid string) V4MateriaOrganisationsOwneridBuilder {
+func (b *v4MateriaOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4MateriaOrganisationsOwneridBuilder {
 	return newV4MateriaOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -10746,11 +10748,11 @@ type V4MateriaOrganisationsOwneridBuilder interface {
 // v4MateriaOrganisationsOwneridBuilderImpl implements V4MateriaOrganisationsOwneridBuilder
 type v4MateriaOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4MateriaOrganisationsOwneridBuilder creates a new V4MateriaOrganisationsOwneridBuilder
-func newV4MateriaOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4MateriaOrganisationsOwneridBuilder {
+func newV4MateriaOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4MateriaOrganisationsOwneridBuilder {
 	return &v4MateriaOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -10770,11 +10772,11 @@ type V4MateriaOrganisationsOwneridMateriaBuilder interface {
 // v4MateriaOrganisationsOwneridMateriaBuilderImpl implements V4MateriaOrganisationsOwneridMateriaBuilder
 type v4MateriaOrganisationsOwneridMateriaBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4MateriaOrganisationsOwneridMateriaBuilder creates a new V4MateriaOrganisationsOwneridMateriaBuilder
-func newV4MateriaOrganisationsOwneridMateriaBuilder(sdk *sdkImpl, ownerid string) V4MateriaOrganisationsOwneridMateriaBuilder {
+func newV4MateriaOrganisationsOwneridMateriaBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4MateriaOrganisationsOwneridMateriaBuilder {
 	return &v4MateriaOrganisationsOwneridMateriaBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -10788,17 +10790,17 @@ func (b *v4MateriaOrganisationsOwneridMateriaBuilderImpl) Databases() V4MateriaO
 
 // V4MateriaOrganisationsOwneridMateriaDatabasesBuilder provides access to operations
 type V4MateriaOrganisationsOwneridMateriaDatabasesBuilder interface {
-	Kvid(kvid string) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder
+	Kvid(kvid ids.KVID) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder
 }
 
 // v4MateriaOrganisationsOwneridMateriaDatabasesBuilderImpl implements V4MateriaOrganisationsOwneridMateriaDatabasesBuilder
 type v4MateriaOrganisationsOwneridMateriaDatabasesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4MateriaOrganisationsOwneridMateriaDatabasesBuilder creates a new V4MateriaOrganisationsOwneridMateriaDatabasesBuilder
-func newV4MateriaOrganisationsOwneridMateriaDatabasesBuilder(sdk *sdkImpl, ownerid string) V4MateriaOrganisationsOwneridMateriaDatabasesBuilder {
+func newV4MateriaOrganisationsOwneridMateriaDatabasesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4MateriaOrganisationsOwneridMateriaDatabasesBuilder {
 	return &v4MateriaOrganisationsOwneridMateriaDatabasesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -10806,7 +10808,7 @@ func newV4MateriaOrganisationsOwneridMateriaDatabasesBuilder(sdk *sdkImpl, owner
 }
 
 // Kvid returns builder for kvid
-func (b *v4MateriaOrganisationsOwneridMateriaDatabasesBuilderImpl) Kvid(kvid string) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder {
+func (b *v4MateriaOrganisationsOwneridMateriaDatabasesBuilderImpl) Kvid(kvid ids.KVID) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder {
 	return newV4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder(b.sdk, b.ownerid, kvid)
 }
 
@@ -10819,12 +10821,12 @@ type V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder interface {
 // v4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilderImpl implements V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder
 type v4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	kvid    string
+	ownerid ids.OwnerID
+	kvid    ids.KVID
 }
 
 // newV4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder creates a new V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder
-func newV4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder(sdk *sdkImpl, ownerid string, kvid string) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder {
+func newV4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, kvid ids.KVID) V4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilder {
 	return &v4MateriaOrganisationsOwneridMateriaDatabasesKvidBuilderImpl{
 		kvid:    kvid,
 		ownerid: ownerid,
@@ -10890,7 +10892,7 @@ func (b *v4MetabaseConsumptionsBuilderImpl) Listallmetabaseproductconsole(ctx co
 
 // V4MetabaseOrganisationsBuilder provides access to operations
 type V4MetabaseOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4MetabaseOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4MetabaseOrganisationsOwneridBuilder
 }
 
 // v4MetabaseOrganisationsBuilderImpl implements V4MetabaseOrganisationsBuilder
@@ -10904,7 +10906,7 @@ func newV4MetabaseOrganisationsBuilder(sdk *sdkImpl) V4MetabaseOrganisationsBuil
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4MetabaseOrganisationsBuilderImpl) Ownerid(ownerid string) V4MetabaseOrganisationsOwneridBuilder {
+func (b *v4MetabaseOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4MetabaseOrganisationsOwneridBuilder {
 	return newV4MetabaseOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -10916,11 +10918,11 @@ type V4MetabaseOrganisationsOwneridBuilder interface {
 // v4MetabaseOrganisationsOwneridBuilderImpl implements V4MetabaseOrganisationsOwneridBuilder
 type v4MetabaseOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4MetabaseOrganisationsOwneridBuilder creates a new V4MetabaseOrganisationsOwneridBuilder
-func newV4MetabaseOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4MetabaseOrganisationsOwneridBuilder {
+func newV4MetabaseOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4MetabaseOrganisationsOwneridBuilder {
 	return &v4MetabaseOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -10934,17 +10936,17 @@ func (b *v4MetabaseOrganisationsOwneridBuilderImpl) Metabase() V4MetabaseOrganis
 
 // V4MetabaseOrganisationsOwneridMetabaseBuilder provides access to operations
 type V4MetabaseOrganisationsOwneridMetabaseBuilder interface {
-	Addonmetabaseid(addonmetabaseid string) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder
+	Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder
 }
 
 // v4MetabaseOrganisationsOwneridMetabaseBuilderImpl implements V4MetabaseOrganisationsOwneridMetabaseBuilder
 type v4MetabaseOrganisationsOwneridMetabaseBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4MetabaseOrganisationsOwneridMetabaseBuilder creates a new V4MetabaseOrganisationsOwneridMetabaseBuilder
-func newV4MetabaseOrganisationsOwneridMetabaseBuilder(sdk *sdkImpl, ownerid string) V4MetabaseOrganisationsOwneridMetabaseBuilder {
+func newV4MetabaseOrganisationsOwneridMetabaseBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4MetabaseOrganisationsOwneridMetabaseBuilder {
 	return &v4MetabaseOrganisationsOwneridMetabaseBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -10952,7 +10954,7 @@ func newV4MetabaseOrganisationsOwneridMetabaseBuilder(sdk *sdkImpl, ownerid stri
 }
 
 // Addonmetabaseid returns builder for addonmetabaseid
-func (b *v4MetabaseOrganisationsOwneridMetabaseBuilderImpl) Addonmetabaseid(addonmetabaseid string) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder {
+func (b *v4MetabaseOrganisationsOwneridMetabaseBuilderImpl) Addonmetabaseid(addonmetabaseid ids.AddonMetabaseID) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder {
 	return newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder(b.sdk, b.ownerid, addonmetabaseid)
 }
 
@@ -10964,12 +10966,12 @@ type V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder interface {
 // v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilderImpl implements V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder
 type v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilderImpl struct {
 	sdk             *sdkImpl
-	ownerid         string
-	addonmetabaseid string
+	ownerid         ids.OwnerID
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder creates a new V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder
-func newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder(sdk *sdkImpl, ownerid string, addonmetabaseid string) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder {
+func newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, addonmetabaseid ids.AddonMetabaseID) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilder {
 	return &v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		ownerid:         ownerid,
@@ -10990,12 +10992,12 @@ type V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder int
 // v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilderImpl implements V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder
 type v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilderImpl struct {
 	sdk             *sdkImpl
-	ownerid         string
-	addonmetabaseid string
+	ownerid         ids.OwnerID
+	addonmetabaseid ids.AddonMetabaseID
 }
 
 // newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder creates a new V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder
-func newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder(sdk *sdkImpl, ownerid string, addonmetabaseid string) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder {
+func newV4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder(sdk *sdkImpl, ownerid ids.OwnerID, addonmetabaseid ids.AddonMetabaseID) V4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilder {
 	return &v4MetabaseOrganisationsOwneridMetabaseAddonmetabaseidConsumptionBuilderImpl{
 		addonmetabaseid: addonmetabaseid,
 		ownerid:         ownerid,
@@ -11030,7 +11032,7 @@ func (b *v4NetworkgroupsBuilderImpl) Organisations() V4NetworkgroupsOrganisation
 
 // V4NetworkgroupsOrganisationsBuilder provides access to operations
 type V4NetworkgroupsOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4NetworkgroupsOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4NetworkgroupsOrganisationsOwneridBuilder
 }
 
 // v4NetworkgroupsOrganisationsBuilderImpl implements V4NetworkgroupsOrganisationsBuilder
@@ -11044,7 +11046,7 @@ func newV4NetworkgroupsOrganisationsBuilder(sdk *sdkImpl) V4NetworkgroupsOrganis
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4NetworkgroupsOrganisationsBuilderImpl) Ownerid(ownerid string) V4NetworkgroupsOrganisationsOwneridBuilder {
+func (b *v4NetworkgroupsOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4NetworkgroupsOrganisationsOwneridBuilder {
 	return newV4NetworkgroupsOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -11056,11 +11058,11 @@ type V4NetworkgroupsOrganisationsOwneridBuilder interface {
 // v4NetworkgroupsOrganisationsOwneridBuilderImpl implements V4NetworkgroupsOrganisationsOwneridBuilder
 type v4NetworkgroupsOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridBuilder creates a new V4NetworkgroupsOrganisationsOwneridBuilder
-func newV4NetworkgroupsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4NetworkgroupsOrganisationsOwneridBuilder {
+func newV4NetworkgroupsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4NetworkgroupsOrganisationsOwneridBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11074,7 +11076,7 @@ func (b *v4NetworkgroupsOrganisationsOwneridBuilderImpl) Networkgroups() V4Netwo
 
 // V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder provides access to operations
 type V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder interface {
-	Networkgroupid(networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder
+	Networkgroupid(networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder
 	Search() V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder
 	Createnetworkgroup(ctx context.Context, request *models.WannabeNetworkGroup) client.Response[client.Nothing]
 }
@@ -11082,11 +11084,11 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder interface {
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder(sdk *sdkImpl, ownerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11094,7 +11096,7 @@ func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilder(sdk *sdkImpl, ow
 }
 
 // Networkgroupid returns builder for networkgroupid
-func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilderImpl) Networkgroupid(networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder {
+func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsBuilderImpl) Networkgroupid(networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder {
 	return newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder(b.sdk, b.ownerid, networkgroupid)
 }
 
@@ -11120,12 +11122,12 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder inter
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder(sdk *sdkImpl, ownerid string, networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11166,12 +11168,12 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeers
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder(sdk *sdkImpl, ownerid string, networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalPeersBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11186,19 +11188,19 @@ func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidExternalP
 
 // V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder provides access to operations
 type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder interface {
-	Memberid(memberid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder
+	Memberid(memberid ids.MemberID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder
 	Createnetworkgroupmember(ctx context.Context, request *models.WannabeNetworkgroupMember) client.Response[client.Nothing]
 }
 
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder(sdk *sdkImpl, ownerid string, networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11207,7 +11209,7 @@ func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBui
 }
 
 // Memberid returns builder for memberid
-func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilderImpl) Memberid(memberid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder {
+func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersBuilderImpl) Memberid(memberid ids.MemberID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder {
 	return newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder(b.sdk, b.ownerid, b.networkgroupid, memberid)
 }
 
@@ -11225,13 +11227,13 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMember
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
-	memberid       string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
+	memberid       ids.MemberID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder(sdk *sdkImpl, ownerid string, networkgroupid string, memberid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID, memberid ids.MemberID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMemberidBuilderImpl{
 		memberid:       memberid,
 		networkgroupid: networkgroupid,
@@ -11252,7 +11254,7 @@ func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidMembersMe
 
 // V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder provides access to operations
 type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder interface {
-	Peerid(peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder
+	Peerid(peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder
 	Listnetworkgrouppeers(ctx context.Context) client.Response[[]models.Peer]
 	Createnetworkgrouppeer(ctx context.Context, request *models.WannabePeer) client.Response[models.PeerCreated]
 }
@@ -11260,12 +11262,12 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder(sdk *sdkImpl, ownerid string, networkgroupid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11274,7 +11276,7 @@ func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuild
 }
 
 // Peerid returns builder for peerid
-func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilderImpl) Peerid(peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder {
+func (b *v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersBuilderImpl) Peerid(peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder {
 	return newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder(b.sdk, b.ownerid, b.networkgroupid, peerid)
 }
 
@@ -11298,13 +11300,13 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBu
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
-	peerid         string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
+	peerid         ids.PeerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder(sdk *sdkImpl, ownerid string, networkgroupid string, peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID, peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11336,13 +11338,13 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWi
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
-	peerid         string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
+	peerid         ids.PeerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder(sdk *sdkImpl, ownerid string, networkgroupid string, peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID, peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11365,13 +11367,13 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWi
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
-	peerid         string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
+	peerid         ids.PeerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder(sdk *sdkImpl, ownerid string, networkgroupid string, peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID, peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11398,13 +11400,13 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWi
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilderImpl struct {
 	sdk            *sdkImpl
-	ownerid        string
-	networkgroupid string
-	peerid         string
+	ownerid        ids.OwnerID
+	networkgroupid ids.NetworkGroupID
+	peerid         ids.PeerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder(sdk *sdkImpl, ownerid string, networkgroupid string, peerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder(sdk *sdkImpl, ownerid ids.OwnerID, networkgroupid ids.NetworkGroupID, peerid ids.PeerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsNetworkgroupidPeersPeeridWireguardConfigurationPresignedURLBuilderImpl{
 		networkgroupid: networkgroupid,
 		ownerid:        ownerid,
@@ -11426,11 +11428,11 @@ type V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder interface {
 // v4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilderImpl implements V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder
 type v4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder creates a new V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder
-func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder(sdk *sdkImpl, ownerid string) V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder {
+func newV4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilder {
 	return &v4NetworkgroupsOrganisationsOwneridNetworkgroupsSearchBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11496,7 +11498,7 @@ func (b *v4OtelGatewaysBuilderImpl) Createotelgateway(ctx context.Context, reque
 
 // V4OtelOrganisationsBuilder provides access to operations
 type V4OtelOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4OtelOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4OtelOrganisationsOwneridBuilder
 }
 
 // v4OtelOrganisationsBuilderImpl implements V4OtelOrganisationsBuilder
@@ -11510,7 +11512,7 @@ func newV4OtelOrganisationsBuilder(sdk *sdkImpl) V4OtelOrganisationsBuilder {
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4OtelOrganisationsBuilderImpl) Ownerid(ownerid string) V4OtelOrganisationsOwneridBuilder {
+func (b *v4OtelOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4OtelOrganisationsOwneridBuilder {
 	return newV4OtelOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -11522,11 +11524,11 @@ type V4OtelOrganisationsOwneridBuilder interface {
 // v4OtelOrganisationsOwneridBuilderImpl implements V4OtelOrganisationsOwneridBuilder
 type v4OtelOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4OtelOrganisationsOwneridBuilder creates a new V4OtelOrganisationsOwneridBuilder
-func newV4OtelOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4OtelOrganisationsOwneridBuilder {
+func newV4OtelOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4OtelOrganisationsOwneridBuilder {
 	return &v4OtelOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11540,7 +11542,7 @@ func (b *v4OtelOrganisationsOwneridBuilderImpl) Namespaces() V4OtelOrganisations
 
 // V4OtelOrganisationsOwneridNamespacesBuilder provides access to operations
 type V4OtelOrganisationsOwneridNamespacesBuilder interface {
-	Otelid(otelid string) V4OtelOrganisationsOwneridNamespacesOtelidBuilder
+	Otelid(otelid ids.OtelID) V4OtelOrganisationsOwneridNamespacesOtelidBuilder
 	Listotelnamespaces(ctx context.Context) client.Response[[]models.OtelNamespace]
 	Createotelnamespace(ctx context.Context, request *models.WannabeNamespace) client.Response[models.OtelNamespace]
 }
@@ -11548,11 +11550,11 @@ type V4OtelOrganisationsOwneridNamespacesBuilder interface {
 // v4OtelOrganisationsOwneridNamespacesBuilderImpl implements V4OtelOrganisationsOwneridNamespacesBuilder
 type v4OtelOrganisationsOwneridNamespacesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4OtelOrganisationsOwneridNamespacesBuilder creates a new V4OtelOrganisationsOwneridNamespacesBuilder
-func newV4OtelOrganisationsOwneridNamespacesBuilder(sdk *sdkImpl, ownerid string) V4OtelOrganisationsOwneridNamespacesBuilder {
+func newV4OtelOrganisationsOwneridNamespacesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4OtelOrganisationsOwneridNamespacesBuilder {
 	return &v4OtelOrganisationsOwneridNamespacesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11560,7 +11562,7 @@ func newV4OtelOrganisationsOwneridNamespacesBuilder(sdk *sdkImpl, ownerid string
 }
 
 // Otelid returns builder for otelid
-func (b *v4OtelOrganisationsOwneridNamespacesBuilderImpl) Otelid(otelid string) V4OtelOrganisationsOwneridNamespacesOtelidBuilder {
+func (b *v4OtelOrganisationsOwneridNamespacesBuilderImpl) Otelid(otelid ids.OtelID) V4OtelOrganisationsOwneridNamespacesOtelidBuilder {
 	return newV4OtelOrganisationsOwneridNamespacesOtelidBuilder(b.sdk, b.ownerid, otelid)
 }
 
@@ -11583,12 +11585,12 @@ type V4OtelOrganisationsOwneridNamespacesOtelidBuilder interface {
 // v4OtelOrganisationsOwneridNamespacesOtelidBuilderImpl implements V4OtelOrganisationsOwneridNamespacesOtelidBuilder
 type v4OtelOrganisationsOwneridNamespacesOtelidBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	otelid  string
+	ownerid ids.OwnerID
+	otelid  ids.OtelID
 }
 
 // newV4OtelOrganisationsOwneridNamespacesOtelidBuilder creates a new V4OtelOrganisationsOwneridNamespacesOtelidBuilder
-func newV4OtelOrganisationsOwneridNamespacesOtelidBuilder(sdk *sdkImpl, ownerid string, otelid string) V4OtelOrganisationsOwneridNamespacesOtelidBuilder {
+func newV4OtelOrganisationsOwneridNamespacesOtelidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, otelid ids.OtelID) V4OtelOrganisationsOwneridNamespacesOtelidBuilder {
 	return &v4OtelOrganisationsOwneridNamespacesOtelidBuilderImpl{
 		otelid:  otelid,
 		ownerid: ownerid,
@@ -11614,12 +11616,12 @@ type V4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder interface {
 // v4OtelOrganisationsOwneridNamespacesOtelidServicesBuilderImpl implements V4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder
 type v4OtelOrganisationsOwneridNamespacesOtelidServicesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
-	otelid  string
+	ownerid ids.OwnerID
+	otelid  ids.OtelID
 }
 
 // newV4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder creates a new V4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder
-func newV4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder(sdk *sdkImpl, ownerid string, otelid string) V4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder {
+func newV4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, otelid ids.OtelID) V4OtelOrganisationsOwneridNamespacesOtelidServicesBuilder {
 	return &v4OtelOrganisationsOwneridNamespacesOtelidServicesBuilderImpl{
 		otelid:  otelid,
 		ownerid: ownerid,
@@ -11634,7 +11636,7 @@ func (b *v4OtelOrganisationsOwneridNamespacesOtelidServicesBuilderImpl) Createot
 
 // V4OtoroshiBuilder provides access to operations
 type V4OtoroshiBuilder interface {
-	Otoroshiid(otoroshiid string) V4OtoroshiOtoroshiidBuilder
+	Otoroshiid(otoroshiid ids.OtoroshiID) V4OtoroshiOtoroshiidBuilder
 	Consumptions() V4OtoroshiConsumptionsBuilder
 	Organisations() V4OtoroshiOrganisationsBuilder
 }
@@ -11650,7 +11652,7 @@ func newV4OtoroshiBuilder(sdk *sdkImpl) V4OtoroshiBuilder {
 }
 
 // Otoroshiid returns builder for otoroshiid
-func (b *v4OtoroshiBuilderImpl) Otoroshiid(otoroshiid string) V4OtoroshiOtoroshiidBuilder {
+func (b *v4OtoroshiBuilderImpl) Otoroshiid(otoroshiid ids.OtoroshiID) V4OtoroshiOtoroshiidBuilder {
 	return newV4OtoroshiOtoroshiidBuilder(b.sdk, otoroshiid)
 }
 
@@ -11672,11 +11674,11 @@ type V4OtoroshiOtoroshiidBuilder interface {
 // v4OtoroshiOtoroshiidBuilderImpl implements V4OtoroshiOtoroshiidBuilder
 type v4OtoroshiOtoroshiidBuilderImpl struct {
 	sdk        *sdkImpl
-	otoroshiid string
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4OtoroshiOtoroshiidBuilder creates a new V4OtoroshiOtoroshiidBuilder
-func newV4OtoroshiOtoroshiidBuilder(sdk *sdkImpl, otoroshiid string) V4OtoroshiOtoroshiidBuilder {
+func newV4OtoroshiOtoroshiidBuilder(sdk *sdkImpl, otoroshiid ids.OtoroshiID) V4OtoroshiOtoroshiidBuilder {
 	return &v4OtoroshiOtoroshiidBuilderImpl{
 		otoroshiid: otoroshiid,
 		sdk:        sdk,
@@ -11710,7 +11712,7 @@ func (b *v4OtoroshiConsumptionsBuilderImpl) Listallotoroshiproductconsole(ctx co
 
 // V4OtoroshiOrganisationsBuilder provides access to operations
 type V4OtoroshiOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4OtoroshiOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4OtoroshiOrganisationsOwneridBuilder
 }
 
 // v4OtoroshiOrganisationsBuilderImpl implements V4OtoroshiOrganisationsBuilder
@@ -11724,7 +11726,7 @@ func newV4OtoroshiOrganisationsBuilder(sdk *sdkImpl) V4OtoroshiOrganisationsBuil
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4OtoroshiOrganisationsBuilderImpl) Ownerid(ownerid string) V4OtoroshiOrganisationsOwneridBuilder {
+func (b *v4OtoroshiOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4OtoroshiOrganisationsOwneridBuilder {
 	return newV4OtoroshiOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -11736,11 +11738,11 @@ type V4OtoroshiOrganisationsOwneridBuilder interface {
 // v4OtoroshiOrganisationsOwneridBuilderImpl implements V4OtoroshiOrganisationsOwneridBuilder
 type v4OtoroshiOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4OtoroshiOrganisationsOwneridBuilder creates a new V4OtoroshiOrganisationsOwneridBuilder
-func newV4OtoroshiOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4OtoroshiOrganisationsOwneridBuilder {
+func newV4OtoroshiOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4OtoroshiOrganisationsOwneridBuilder {
 	return &v4OtoroshiOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11754,17 +11756,17 @@ func (b *v4OtoroshiOrganisationsOwneridBuilderImpl) Otoroshi() V4OtoroshiOrganis
 
 // V4OtoroshiOrganisationsOwneridOtoroshiBuilder provides access to operations
 type V4OtoroshiOrganisationsOwneridOtoroshiBuilder interface {
-	Otoroshiid(otoroshiid string) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder
+	Otoroshiid(otoroshiid ids.OtoroshiID) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder
 }
 
 // v4OtoroshiOrganisationsOwneridOtoroshiBuilderImpl implements V4OtoroshiOrganisationsOwneridOtoroshiBuilder
 type v4OtoroshiOrganisationsOwneridOtoroshiBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4OtoroshiOrganisationsOwneridOtoroshiBuilder creates a new V4OtoroshiOrganisationsOwneridOtoroshiBuilder
-func newV4OtoroshiOrganisationsOwneridOtoroshiBuilder(sdk *sdkImpl, ownerid string) V4OtoroshiOrganisationsOwneridOtoroshiBuilder {
+func newV4OtoroshiOrganisationsOwneridOtoroshiBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4OtoroshiOrganisationsOwneridOtoroshiBuilder {
 	return &v4OtoroshiOrganisationsOwneridOtoroshiBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -11772,7 +11774,7 @@ func newV4OtoroshiOrganisationsOwneridOtoroshiBuilder(sdk *sdkImpl, ownerid stri
 }
 
 // Otoroshiid returns builder for otoroshiid
-func (b *v4OtoroshiOrganisationsOwneridOtoroshiBuilderImpl) Otoroshiid(otoroshiid string) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder {
+func (b *v4OtoroshiOrganisationsOwneridOtoroshiBuilderImpl) Otoroshiid(otoroshiid ids.OtoroshiID) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder {
 	return newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder(b.sdk, b.ownerid, otoroshiid)
 }
 
@@ -11784,12 +11786,12 @@ type V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder interface {
 // v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilderImpl implements V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder
 type v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	otoroshiid string
+	ownerid    ids.OwnerID
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder creates a new V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder
-func newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder(sdk *sdkImpl, ownerid string, otoroshiid string) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder {
+func newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, otoroshiid ids.OtoroshiID) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilder {
 	return &v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidBuilderImpl{
 		otoroshiid: otoroshiid,
 		ownerid:    ownerid,
@@ -11810,12 +11812,12 @@ type V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder interfac
 // v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilderImpl implements V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder
 type v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	otoroshiid string
+	ownerid    ids.OwnerID
+	otoroshiid ids.OtoroshiID
 }
 
 // newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder creates a new V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder
-func newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder(sdk *sdkImpl, ownerid string, otoroshiid string) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder {
+func newV4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder(sdk *sdkImpl, ownerid ids.OwnerID, otoroshiid ids.OtoroshiID) V4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilder {
 	return &v4OtoroshiOrganisationsOwneridOtoroshiOtoroshiidConsumptionBuilderImpl{
 		otoroshiid: otoroshiid,
 		ownerid:    ownerid,
@@ -11876,7 +11878,7 @@ func (b *v4PasswordRecoveryCompleteBuilderImpl) Updatepasswordrecovery(ctx conte
 
 // V4PostgresqlBuilder provides access to operations
 type V4PostgresqlBuilder interface {
-	Postgresqlid(postgresqlid string) V4PostgresqlPostgresqlidBuilder
+	Postgresqlid(postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidBuilder
 	Organisations() V4PostgresqlOrganisationsBuilder
 }
 
@@ -11891,7 +11893,7 @@ func newV4PostgresqlBuilder(sdk *sdkImpl) V4PostgresqlBuilder {
 }
 
 // Postgresqlid returns builder for postgresqlid
-func (b *v4PostgresqlBuilderImpl) Postgresqlid(postgresqlid string) V4PostgresqlPostgresqlidBuilder {
+func (b *v4PostgresqlBuilderImpl) Postgresqlid(postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidBuilder {
 	return newV4PostgresqlPostgresqlidBuilder(b.sdk, postgresqlid)
 }
 
@@ -11908,11 +11910,11 @@ type V4PostgresqlPostgresqlidBuilder interface {
 // v4PostgresqlPostgresqlidBuilderImpl implements V4PostgresqlPostgresqlidBuilder
 type v4PostgresqlPostgresqlidBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidBuilder creates a new V4PostgresqlPostgresqlidBuilder
-func newV4PostgresqlPostgresqlidBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidBuilder {
+func newV4PostgresqlPostgresqlidBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidBuilder {
 	return &v4PostgresqlPostgresqlidBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -11935,11 +11937,11 @@ type V4PostgresqlPostgresqlidMigrationBuilder interface {
 // v4PostgresqlPostgresqlidMigrationBuilderImpl implements V4PostgresqlPostgresqlidMigrationBuilder
 type v4PostgresqlPostgresqlidMigrationBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidMigrationBuilder creates a new V4PostgresqlPostgresqlidMigrationBuilder
-func newV4PostgresqlPostgresqlidMigrationBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidMigrationBuilder {
+func newV4PostgresqlPostgresqlidMigrationBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidMigrationBuilder {
 	return &v4PostgresqlPostgresqlidMigrationBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -11974,11 +11976,11 @@ type V4PostgresqlPostgresqlidMigrationPostBuilder interface {
 // v4PostgresqlPostgresqlidMigrationPostBuilderImpl implements V4PostgresqlPostgresqlidMigrationPostBuilder
 type v4PostgresqlPostgresqlidMigrationPostBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidMigrationPostBuilder creates a new V4PostgresqlPostgresqlidMigrationPostBuilder
-func newV4PostgresqlPostgresqlidMigrationPostBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidMigrationPostBuilder {
+func newV4PostgresqlPostgresqlidMigrationPostBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidMigrationPostBuilder {
 	return &v4PostgresqlPostgresqlidMigrationPostBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -11998,11 +12000,11 @@ type V4PostgresqlPostgresqlidMigrationPreBuilder interface {
 // v4PostgresqlPostgresqlidMigrationPreBuilderImpl implements V4PostgresqlPostgresqlidMigrationPreBuilder
 type v4PostgresqlPostgresqlidMigrationPreBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidMigrationPreBuilder creates a new V4PostgresqlPostgresqlidMigrationPreBuilder
-func newV4PostgresqlPostgresqlidMigrationPreBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidMigrationPreBuilder {
+func newV4PostgresqlPostgresqlidMigrationPreBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidMigrationPreBuilder {
 	return &v4PostgresqlPostgresqlidMigrationPreBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -12022,11 +12024,11 @@ type V4PostgresqlPostgresqlidMigrationPrivilegesBuilder interface {
 // v4PostgresqlPostgresqlidMigrationPrivilegesBuilderImpl implements V4PostgresqlPostgresqlidMigrationPrivilegesBuilder
 type v4PostgresqlPostgresqlidMigrationPrivilegesBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidMigrationPrivilegesBuilder creates a new V4PostgresqlPostgresqlidMigrationPrivilegesBuilder
-func newV4PostgresqlPostgresqlidMigrationPrivilegesBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidMigrationPrivilegesBuilder {
+func newV4PostgresqlPostgresqlidMigrationPrivilegesBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidMigrationPrivilegesBuilder {
 	return &v4PostgresqlPostgresqlidMigrationPrivilegesBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -12046,11 +12048,11 @@ type V4PostgresqlPostgresqlidMigrationUsersBuilder interface {
 // v4PostgresqlPostgresqlidMigrationUsersBuilderImpl implements V4PostgresqlPostgresqlidMigrationUsersBuilder
 type v4PostgresqlPostgresqlidMigrationUsersBuilderImpl struct {
 	sdk          *sdkImpl
-	postgresqlid string
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlPostgresqlidMigrationUsersBuilder creates a new V4PostgresqlPostgresqlidMigrationUsersBuilder
-func newV4PostgresqlPostgresqlidMigrationUsersBuilder(sdk *sdkImpl, postgresqlid string) V4PostgresqlPostgresqlidMigrationUsersBuilder {
+func newV4PostgresqlPostgresqlidMigrationUsersBuilder(sdk *sdkImpl, postgresqlid ids.PostgresqlID) V4PostgresqlPostgresqlidMigrationUsersBuilder {
 	return &v4PostgresqlPostgresqlidMigrationUsersBuilderImpl{
 		postgresqlid: postgresqlid,
 		sdk:          sdk,
@@ -12064,7 +12066,7 @@ func (b *v4PostgresqlPostgresqlidMigrationUsersBuilderImpl) Listpostgresqlmigrat
 
 // V4PostgresqlOrganisationsBuilder provides access to operations
 type V4PostgresqlOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4PostgresqlOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4PostgresqlOrganisationsOwneridBuilder
 }
 
 // v4PostgresqlOrganisationsBuilderImpl implements V4PostgresqlOrganisationsBuilder
@@ -12078,7 +12080,7 @@ func newV4PostgresqlOrganisationsBuilder(sdk *sdkImpl) V4PostgresqlOrganisations
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4PostgresqlOrganisationsBuilderImpl) Ownerid(ownerid string) V4PostgresqlOrganisationsOwneridBuilder {
+func (b *v4PostgresqlOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4PostgresqlOrganisationsOwneridBuilder {
 	return newV4PostgresqlOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -12090,11 +12092,11 @@ type V4PostgresqlOrganisationsOwneridBuilder interface {
 // v4PostgresqlOrganisationsOwneridBuilderImpl implements V4PostgresqlOrganisationsOwneridBuilder
 type v4PostgresqlOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4PostgresqlOrganisationsOwneridBuilder creates a new V4PostgresqlOrganisationsOwneridBuilder
-func newV4PostgresqlOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4PostgresqlOrganisationsOwneridBuilder {
+func newV4PostgresqlOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4PostgresqlOrganisationsOwneridBuilder {
 	return &v4PostgresqlOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -12108,17 +12110,17 @@ func (b *v4PostgresqlOrganisationsOwneridBuilderImpl) Postgresql() V4PostgresqlO
 
 // V4PostgresqlOrganisationsOwneridPostgresqlBuilder provides access to operations
 type V4PostgresqlOrganisationsOwneridPostgresqlBuilder interface {
-	Postgresqlid(postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder
+	Postgresqlid(postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder
 }
 
 // v4PostgresqlOrganisationsOwneridPostgresqlBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlBuilder(sdk *sdkImpl, ownerid string) V4PostgresqlOrganisationsOwneridPostgresqlBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4PostgresqlOrganisationsOwneridPostgresqlBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -12126,7 +12128,7 @@ func newV4PostgresqlOrganisationsOwneridPostgresqlBuilder(sdk *sdkImpl, ownerid
 }
 
 // Postgresqlid returns builder for postgresqlid
-func (b *v4PostgresqlOrganisationsOwneridPostgresqlBuilderImpl) Postgresqlid(postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder {
+func (b *v4PostgresqlOrganisationsOwneridPostgresqlBuilderImpl) Postgresqlid(postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder {
 	return newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder(b.sdk, b.ownerid, postgresqlid)
 }
 
@@ -12140,12 +12142,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder interface {
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12170,19 +12172,19 @@ func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidBuilderImpl) User
 
 // V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder provides access to operations
 type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder interface {
-	Databaseid(databaseid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder
+	Databaseid(databaseid ids.DatabaseID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder
 	Listdatabases(ctx context.Context) client.Response[[]models.PostgreSQLDatabase1]
 }
 
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12191,7 +12193,7 @@ func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilder(s
 }
 
 // Databaseid returns builder for databaseid
-func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilderImpl) Databaseid(databaseid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder {
+func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesBuilderImpl) Databaseid(databaseid ids.DatabaseID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder {
 	return newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder(b.sdk, b.ownerid, b.postgresqlid, databaseid)
 }
 
@@ -12209,13 +12211,13 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBu
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	databaseid   string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	databaseid   ids.DatabaseID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, databaseid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, databaseid ids.DatabaseID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidDatabasesDatabaseidBuilderImpl{
 		databaseid:   databaseid,
 		ownerid:      ownerid,
@@ -12245,12 +12247,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder interface
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12286,12 +12288,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsDatabasesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12312,12 +12314,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder in
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsSchemasBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12338,12 +12340,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder int
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12358,7 +12360,7 @@ func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidOidsTablesBuilder
 
 // V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder provides access to operations
 type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder interface {
-	Pguserid(pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder
+	Pguserid(pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder
 	Privileges() V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder
 	Listusers(ctx context.Context, opts ...postgresql.Option) client.Response[[]models.PgUserData]
 	Createuser(ctx context.Context) client.Response[models.PgUserData]
@@ -12367,12 +12369,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder interfac
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12381,7 +12383,7 @@ func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilder(sdk *
 }
 
 // Pguserid returns builder for pguserid
-func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilderImpl) Pguserid(pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder {
+func (b *v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersBuilderImpl) Pguserid(pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder {
 	return newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder(b.sdk, b.ownerid, b.postgresqlid, pguserid)
 }
 
@@ -12411,13 +12413,13 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridBuilderImpl{
 		ownerid:      ownerid,
 		pguserid:     pguserid,
@@ -12454,13 +12456,13 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesBuilderImpl{
 		ownerid:      ownerid,
 		pguserid:     pguserid,
@@ -12482,13 +12484,13 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesBuilderImpl{
 		ownerid:      ownerid,
 		pguserid:     pguserid,
@@ -12511,14 +12513,14 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 	objectid     int
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidBuilderImpl{
 		objectid:     objectid,
 		ownerid:      ownerid,
@@ -12546,14 +12548,14 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 	objectid     int
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasBuilderImpl{
 		objectid:     objectid,
 		ownerid:      ownerid,
@@ -12577,14 +12579,14 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 	objectid     int
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidBuilderImpl{
 		objectid:     objectid,
 		ownerid:      ownerid,
@@ -12612,14 +12614,14 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 	objectid     int
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesBuilderImpl{
 		objectid:     objectid,
 		ownerid:      ownerid,
@@ -12642,14 +12644,14 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivileg
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 	objectid     int
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID, objectid int) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridPrivilegesDatabasesObjectidSchemasObjectidTablesObjectidBuilderImpl{
 		objectid:     objectid,
 		ownerid:      ownerid,
@@ -12672,13 +12674,13 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePa
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
-	pguserid     string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
+	pguserid     ids.PGUserID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder(sdk *sdkImpl, ownerid string, postgresqlid string, pguserid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID, pguserid ids.PGUserID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPguseridRotatePasswordBuilderImpl{
 		ownerid:      ownerid,
 		pguserid:     pguserid,
@@ -12702,12 +12704,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilde
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12738,12 +12740,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDataba
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesDatabasesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12764,12 +12766,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchema
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesSchemasBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12790,12 +12792,12 @@ type V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTables
 // v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilderImpl implements V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder
 type v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilderImpl struct {
 	sdk          *sdkImpl
-	ownerid      string
-	postgresqlid string
+	ownerid      ids.OwnerID
+	postgresqlid ids.PostgresqlID
 }
 
 // newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder creates a new V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder
-func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder(sdk *sdkImpl, ownerid string, postgresqlid string) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder {
+func newV4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder(sdk *sdkImpl, ownerid ids.OwnerID, postgresqlid ids.PostgresqlID) V4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilder {
 	return &v4PostgresqlOrganisationsOwneridPostgresqlPostgresqlidUsersPrivilegesTablesBuilderImpl{
 		ownerid:      ownerid,
 		postgresqlid: postgresqlid,
@@ -12906,7 +12908,7 @@ func (b *v4ProvidersBuilderImpl) AddonCellar() V4ProvidersAddonCellarBuilder {
 
 // V4ProvidersAddonCellarBuilder provides access to operations
 type V4ProvidersAddonCellarBuilder interface {
-	Cellarid(cellarid string) V4ProvidersAddonCellarCellaridBuilder
+	Cellarid(cellarid ids.CellarID) V4ProvidersAddonCellarCellaridBuilder
 }
 
 // v4ProvidersAddonCellarBuilderImpl implements V4ProvidersAddonCellarBuilder
@@ -12920,7 +12922,7 @@ func newV4ProvidersAddonCellarBuilder(sdk *sdkImpl) V4ProvidersAddonCellarBuilde
 }
 
 // Cellarid returns builder for cellarid
-func (b *v4ProvidersAddonCellarBuilderImpl) Cellarid(cellarid string) V4ProvidersAddonCellarCellaridBuilder {
+func (b *v4ProvidersAddonCellarBuilderImpl) Cellarid(cellarid ids.CellarID) V4ProvidersAddonCellarCellaridBuilder {
 	return newV4ProvidersAddonCellarCellaridBuilder(b.sdk, cellarid)
 }
 
@@ -12932,11 +12934,11 @@ type V4ProvidersAddonCellarCellaridBuilder interface {
 // v4ProvidersAddonCellarCellaridBuilderImpl implements V4ProvidersAddonCellarCellaridBuilder
 type v4ProvidersAddonCellarCellaridBuilderImpl struct {
 	sdk      *sdkImpl
-	cellarid string
+	cellarid ids.CellarID
 }
 
 // newV4ProvidersAddonCellarCellaridBuilder creates a new V4ProvidersAddonCellarCellaridBuilder
-func newV4ProvidersAddonCellarCellaridBuilder(sdk *sdkImpl, cellarid string) V4ProvidersAddonCellarCellaridBuilder {
+func newV4ProvidersAddonCellarCellaridBuilder(sdk *sdkImpl, cellarid ids.CellarID) V4ProvidersAddonCellarCellaridBuilder {
 	return &v4ProvidersAddonCellarCellaridBuilderImpl{
 		cellarid: cellarid,
 		sdk:      sdk,
@@ -12970,7 +12972,7 @@ func (b *v4PulsarBuilderImpl) Organisations() V4PulsarOrganisationsBuilder {
 
 // V4PulsarOrganisationsBuilder provides access to operations
 type V4PulsarOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4PulsarOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4PulsarOrganisationsOwneridBuilder
 }
 
 // v4PulsarOrganisationsBuilderImpl implements V4PulsarOrganisationsBuilder
@@ -12984,7 +12986,7 @@ func newV4PulsarOrganisationsBuilder(sdk *sdkImpl) V4PulsarOrganisationsBuilder
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4PulsarOrganisationsBuilderImpl) Ownerid(ownerid string) V4PulsarOrganisationsOwneridBuilder {
+func (b *v4PulsarOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4PulsarOrganisationsOwneridBuilder {
 	return newV4PulsarOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -12996,11 +12998,11 @@ type V4PulsarOrganisationsOwneridBuilder interface {
 // v4PulsarOrganisationsOwneridBuilderImpl implements V4PulsarOrganisationsOwneridBuilder
 type v4PulsarOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4PulsarOrganisationsOwneridBuilder creates a new V4PulsarOrganisationsOwneridBuilder
-func newV4PulsarOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4PulsarOrganisationsOwneridBuilder {
+func newV4PulsarOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4PulsarOrganisationsOwneridBuilder {
 	return &v4PulsarOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13020,11 +13022,11 @@ type V4PulsarOrganisationsOwneridPulsarBuilder interface {
 // v4PulsarOrganisationsOwneridPulsarBuilderImpl implements V4PulsarOrganisationsOwneridPulsarBuilder
 type v4PulsarOrganisationsOwneridPulsarBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4PulsarOrganisationsOwneridPulsarBuilder creates a new V4PulsarOrganisationsOwneridPulsarBuilder
-func newV4PulsarOrganisationsOwneridPulsarBuilder(sdk *sdkImpl, ownerid string) V4PulsarOrganisationsOwneridPulsarBuilder {
+func newV4PulsarOrganisationsOwneridPulsarBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4PulsarOrganisationsOwneridPulsarBuilder {
 	return &v4PulsarOrganisationsOwneridPulsarBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13044,11 +13046,11 @@ type V4PulsarOrganisationsOwneridPulsarConsumptionsBuilder interface {
 // v4PulsarOrganisationsOwneridPulsarConsumptionsBuilderImpl implements V4PulsarOrganisationsOwneridPulsarConsumptionsBuilder
 type v4PulsarOrganisationsOwneridPulsarConsumptionsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4PulsarOrganisationsOwneridPulsarConsumptionsBuilder creates a new V4PulsarOrganisationsOwneridPulsarConsumptionsBuilder
-func newV4PulsarOrganisationsOwneridPulsarConsumptionsBuilder(sdk *sdkImpl, ownerid string) V4PulsarOrganisationsOwneridPulsarConsumptionsBuilder {
+func newV4PulsarOrganisationsOwneridPulsarConsumptionsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4PulsarOrganisationsOwneridPulsarConsumptionsBuilder {
 	return &v4PulsarOrganisationsOwneridPulsarConsumptionsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13082,7 +13084,7 @@ func (b *v4StatsBuilderImpl) Organisations() V4StatsOrganisationsBuilder {
 
 // V4StatsOrganisationsBuilder provides access to operations
 type V4StatsOrganisationsBuilder interface {
-	Ownerid(ownerid string) V4StatsOrganisationsOwneridBuilder
+	Ownerid(ownerid ids.OwnerID) V4StatsOrganisationsOwneridBuilder
 }
 
 // v4StatsOrganisationsBuilderImpl implements V4StatsOrganisationsBuilder
@@ -13096,7 +13098,7 @@ func newV4StatsOrganisationsBuilder(sdk *sdkImpl) V4StatsOrganisationsBuilder {
 }
 
 // Ownerid returns builder for ownerid
-func (b *v4StatsOrganisationsBuilderImpl) Ownerid(ownerid string) V4StatsOrganisationsOwneridBuilder {
+func (b *v4StatsOrganisationsBuilderImpl) Ownerid(ownerid ids.OwnerID) V4StatsOrganisationsOwneridBuilder {
 	return newV4StatsOrganisationsOwneridBuilder(b.sdk, ownerid)
 }
 
@@ -13112,11 +13114,11 @@ type V4StatsOrganisationsOwneridBuilder interface {
 // v4StatsOrganisationsOwneridBuilderImpl implements V4StatsOrganisationsOwneridBuilder
 type v4StatsOrganisationsOwneridBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridBuilder creates a new V4StatsOrganisationsOwneridBuilder
-func newV4StatsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridBuilder {
+func newV4StatsOrganisationsOwneridBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridBuilder {
 	return &v4StatsOrganisationsOwneridBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13156,11 +13158,11 @@ type V4StatsOrganisationsOwneridHTTPStatusCodesBuilder interface {
 // v4StatsOrganisationsOwneridHTTPStatusCodesBuilderImpl implements V4StatsOrganisationsOwneridHTTPStatusCodesBuilder
 type v4StatsOrganisationsOwneridHTTPStatusCodesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridHTTPStatusCodesBuilder creates a new V4StatsOrganisationsOwneridHTTPStatusCodesBuilder
-func newV4StatsOrganisationsOwneridHTTPStatusCodesBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridHTTPStatusCodesBuilder {
+func newV4StatsOrganisationsOwneridHTTPStatusCodesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridHTTPStatusCodesBuilder {
 	return &v4StatsOrganisationsOwneridHTTPStatusCodesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13180,11 +13182,11 @@ type V4StatsOrganisationsOwneridRequestsBuilder interface {
 // v4StatsOrganisationsOwneridRequestsBuilderImpl implements V4StatsOrganisationsOwneridRequestsBuilder
 type v4StatsOrganisationsOwneridRequestsBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridRequestsBuilder creates a new V4StatsOrganisationsOwneridRequestsBuilder
-func newV4StatsOrganisationsOwneridRequestsBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridRequestsBuilder {
+func newV4StatsOrganisationsOwneridRequestsBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridRequestsBuilder {
 	return &v4StatsOrganisationsOwneridRequestsBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13204,11 +13206,11 @@ type V4StatsOrganisationsOwneridRequestsLiveBuilder interface {
 // v4StatsOrganisationsOwneridRequestsLiveBuilderImpl implements V4StatsOrganisationsOwneridRequestsLiveBuilder
 type v4StatsOrganisationsOwneridRequestsLiveBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridRequestsLiveBuilder creates a new V4StatsOrganisationsOwneridRequestsLiveBuilder
-func newV4StatsOrganisationsOwneridRequestsLiveBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridRequestsLiveBuilder {
+func newV4StatsOrganisationsOwneridRequestsLiveBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridRequestsLiveBuilder {
 	return &v4StatsOrganisationsOwneridRequestsLiveBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13222,17 +13224,17 @@ func (b *v4StatsOrganisationsOwneridRequestsLiveBuilderImpl) Getrequestslive(ctx
 
 // V4StatsOrganisationsOwneridResourcesBuilder provides access to operations
 type V4StatsOrganisationsOwneridResourcesBuilder interface {
-	Resourceid(resourceid string) V4StatsOrganisationsOwneridResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidBuilder
 }
 
 // v4StatsOrganisationsOwneridResourcesBuilderImpl implements V4StatsOrganisationsOwneridResourcesBuilder
 type v4StatsOrganisationsOwneridResourcesBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridResourcesBuilder creates a new V4StatsOrganisationsOwneridResourcesBuilder
-func newV4StatsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridResourcesBuilder {
+func newV4StatsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridResourcesBuilder {
 	return &v4StatsOrganisationsOwneridResourcesBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13240,7 +13242,7 @@ func newV4StatsOrganisationsOwneridResourcesBuilder(sdk *sdkImpl, ownerid string
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4StatsOrganisationsOwneridResourcesBuilderImpl) Resourceid(resourceid string) V4StatsOrganisationsOwneridResourcesResourceidBuilder {
+func (b *v4StatsOrganisationsOwneridResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidBuilder {
 	return newV4StatsOrganisationsOwneridResourcesResourceidBuilder(b.sdk, b.ownerid, resourceid)
 }
 
@@ -13253,12 +13255,12 @@ type V4StatsOrganisationsOwneridResourcesResourceidBuilder interface {
 // v4StatsOrganisationsOwneridResourcesResourceidBuilderImpl implements V4StatsOrganisationsOwneridResourcesResourceidBuilder
 type v4StatsOrganisationsOwneridResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4StatsOrganisationsOwneridResourcesResourceidBuilder creates a new V4StatsOrganisationsOwneridResourcesResourceidBuilder
-func newV4StatsOrganisationsOwneridResourcesResourceidBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4StatsOrganisationsOwneridResourcesResourceidBuilder {
+func newV4StatsOrganisationsOwneridResourcesResourceidBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidBuilder {
 	return &v4StatsOrganisationsOwneridResourcesResourceidBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -13284,12 +13286,12 @@ type V4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder interface {
 // v4StatsOrganisationsOwneridResourcesResourceidMetricsBuilderImpl implements V4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder
 type v4StatsOrganisationsOwneridResourcesResourceidMetricsBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder creates a new V4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder
-func newV4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder {
+func newV4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidMetricsBuilder {
 	return &v4StatsOrganisationsOwneridResourcesResourceidMetricsBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -13310,12 +13312,12 @@ type V4StatsOrganisationsOwneridResourcesResourceidTokensBuilder interface {
 // v4StatsOrganisationsOwneridResourcesResourceidTokensBuilderImpl implements V4StatsOrganisationsOwneridResourcesResourceidTokensBuilder
 type v4StatsOrganisationsOwneridResourcesResourceidTokensBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4StatsOrganisationsOwneridResourcesResourceidTokensBuilder creates a new V4StatsOrganisationsOwneridResourcesResourceidTokensBuilder
-func newV4StatsOrganisationsOwneridResourcesResourceidTokensBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4StatsOrganisationsOwneridResourcesResourceidTokensBuilder {
+func newV4StatsOrganisationsOwneridResourcesResourceidTokensBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidTokensBuilder {
 	return &v4StatsOrganisationsOwneridResourcesResourceidTokensBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -13336,12 +13338,12 @@ type V4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder interface {
 // v4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilderImpl implements V4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder
 type v4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilderImpl struct {
 	sdk        *sdkImpl
-	ownerid    string
-	resourceid string
+	ownerid    ids.OwnerID
+	resourceid ids.ResourceID
 }
 
 // newV4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder creates a new V4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder
-func newV4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder(sdk *sdkImpl, ownerid string, resourceid string) V4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder {
+func newV4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder(sdk *sdkImpl, ownerid ids.OwnerID, resourceid ids.ResourceID) V4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilder {
 	return &v4StatsOrganisationsOwneridResourcesResourceidTokensReadBuilderImpl{
 		ownerid:    ownerid,
 		resourceid: resourceid,
@@ -13362,11 +13364,11 @@ type V4StatsOrganisationsOwneridTokensBuilder interface {
 // v4StatsOrganisationsOwneridTokensBuilderImpl implements V4StatsOrganisationsOwneridTokensBuilder
 type v4StatsOrganisationsOwneridTokensBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridTokensBuilder creates a new V4StatsOrganisationsOwneridTokensBuilder
-func newV4StatsOrganisationsOwneridTokensBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridTokensBuilder {
+func newV4StatsOrganisationsOwneridTokensBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridTokensBuilder {
 	return &v4StatsOrganisationsOwneridTokensBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13386,11 +13388,11 @@ type V4StatsOrganisationsOwneridTokensReadBuilder interface {
 // v4StatsOrganisationsOwneridTokensReadBuilderImpl implements V4StatsOrganisationsOwneridTokensReadBuilder
 type v4StatsOrganisationsOwneridTokensReadBuilderImpl struct {
 	sdk     *sdkImpl
-	ownerid string
+	ownerid ids.OwnerID
 }
 
 // newV4StatsOrganisationsOwneridTokensReadBuilder creates a new V4StatsOrganisationsOwneridTokensReadBuilder
-func newV4StatsOrganisationsOwneridTokensReadBuilder(sdk *sdkImpl, ownerid string) V4StatsOrganisationsOwneridTokensReadBuilder {
+func newV4StatsOrganisationsOwneridTokensReadBuilder(sdk *sdkImpl, ownerid ids.OwnerID) V4StatsOrganisationsOwneridTokensReadBuilder {
 	return &v4StatsOrganisationsOwneridTokensReadBuilderImpl{
 		ownerid: ownerid,
 		sdk:     sdk,
@@ -13404,7 +13406,7 @@ func (b *v4StatsOrganisationsOwneridTokensReadBuilderImpl) Createorganizationsta
 
 // V4TenantsBuilder provides access to operations
 type V4TenantsBuilder interface {
-	Tenantid(tenantid string) V4TenantsTenantidBuilder
+	Tenantid(tenantid ids.TenantID) V4TenantsTenantidBuilder
 	Listtenants(ctx context.Context) client.Response[[]models.Tenant1]
 	Createtenant(ctx context.Context, request *models.WannabeTenant) client.Response[models.Tenant1]
 }
@@ -13420,7 +13422,7 @@ func newV4TenantsBuilder(sdk *sdkImpl) V4TenantsBuilder {
 }
 
 // Tenantid returns builder for tenantid
-func (b *v4TenantsBuilderImpl) Tenantid(tenantid string) V4TenantsTenantidBuilder {
+func (b *v4TenantsBuilderImpl) Tenantid(tenantid ids.TenantID) V4TenantsTenantidBuilder {
 	return newV4TenantsTenantidBuilder(b.sdk, tenantid)
 }
 
@@ -13450,11 +13452,11 @@ type V4TenantsTenantidBuilder interface {
 // v4TenantsTenantidBuilderImpl implements V4TenantsTenantidBuilder
 type v4TenantsTenantidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidBuilder creates a new V4TenantsTenantidBuilder
-func newV4TenantsTenantidBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidBuilder {
+func newV4TenantsTenantidBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidBuilder {
 	return &v4TenantsTenantidBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -13508,7 +13510,7 @@ func (b *v4TenantsTenantidBuilderImpl) Gettenant(ctx context.Context) client.Res
 
 // V4TenantsTenantidCephClustersBuilder provides access to operations
 type V4TenantsTenantidCephClustersBuilder interface {
-	Clusterid(clusterid string) V4TenantsTenantidCephClustersClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridBuilder
 	Listcephclusters(ctx context.Context) client.Response[[]models.CephCluster]
 	Createcephcluster(ctx context.Context, request *models.WannabeCephCluster) client.Response[models.CephCluster]
 }
@@ -13516,11 +13518,11 @@ type V4TenantsTenantidCephClustersBuilder interface {
 // v4TenantsTenantidCephClustersBuilderImpl implements V4TenantsTenantidCephClustersBuilder
 type v4TenantsTenantidCephClustersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidCephClustersBuilder creates a new V4TenantsTenantidCephClustersBuilder
-func newV4TenantsTenantidCephClustersBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidCephClustersBuilder {
+func newV4TenantsTenantidCephClustersBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidCephClustersBuilder {
 	return &v4TenantsTenantidCephClustersBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -13528,7 +13530,7 @@ func newV4TenantsTenantidCephClustersBuilder(sdk *sdkImpl, tenantid string) V4Te
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4TenantsTenantidCephClustersBuilderImpl) Clusterid(clusterid string) V4TenantsTenantidCephClustersClusteridBuilder {
+func (b *v4TenantsTenantidCephClustersBuilderImpl) Clusterid(clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridBuilder {
 	return newV4TenantsTenantidCephClustersClusteridBuilder(b.sdk, b.tenantid, clusterid)
 }
 
@@ -13556,12 +13558,12 @@ type V4TenantsTenantidCephClustersClusteridBuilder interface {
 // v4TenantsTenantidCephClustersClusteridBuilderImpl implements V4TenantsTenantidCephClustersClusteridBuilder
 type v4TenantsTenantidCephClustersClusteridBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
 }
 
 // newV4TenantsTenantidCephClustersClusteridBuilder creates a new V4TenantsTenantidCephClustersClusteridBuilder
-func newV4TenantsTenantidCephClustersClusteridBuilder(sdk *sdkImpl, tenantid string, clusterid string) V4TenantsTenantidCephClustersClusteridBuilder {
+func newV4TenantsTenantidCephClustersClusteridBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridBuilder {
 	return &v4TenantsTenantidCephClustersClusteridBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -13606,19 +13608,19 @@ func (b *v4TenantsTenantidCephClustersClusteridBuilderImpl) Updatecephcluster(ct
 
 // V4TenantsTenantidCephClustersClusteridCephPoolsBuilder provides access to operations
 type V4TenantsTenantidCephClustersClusteridCephPoolsBuilder interface {
-	Poolid(poolid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder
+	Poolid(poolid ids.PoolID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder
 	Createcephpool(ctx context.Context, request *models.WannabeCephPool) client.Response[models.CephPool]
 }
 
 // v4TenantsTenantidCephClustersClusteridCephPoolsBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephPoolsBuilder
 type v4TenantsTenantidCephClustersClusteridCephPoolsBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephPoolsBuilder creates a new V4TenantsTenantidCephClustersClusteridCephPoolsBuilder
-func newV4TenantsTenantidCephClustersClusteridCephPoolsBuilder(sdk *sdkImpl, tenantid string, clusterid string) V4TenantsTenantidCephClustersClusteridCephPoolsBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephPoolsBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridCephPoolsBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephPoolsBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -13627,7 +13629,7 @@ func newV4TenantsTenantidCephClustersClusteridCephPoolsBuilder(sdk *sdkImpl, ten
 }
 
 // Poolid returns builder for poolid
-func (b *v4TenantsTenantidCephClustersClusteridCephPoolsBuilderImpl) Poolid(poolid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder {
+func (b *v4TenantsTenantidCephClustersClusteridCephPoolsBuilderImpl) Poolid(poolid ids.PoolID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder {
 	return newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder(b.sdk, b.tenantid, b.clusterid, poolid)
 }
 
@@ -13645,13 +13647,13 @@ type V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder interface {
 // v4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder
 type v4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
-	poolid    string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
+	poolid    ids.PoolID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder creates a new V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder
-func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder(sdk *sdkImpl, tenantid string, clusterid string, poolid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID, poolid ids.PoolID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilderImpl{
 		clusterid: clusterid,
 		poolid:    poolid,
@@ -13672,19 +13674,19 @@ func (b *v4TenantsTenantidCephClustersClusteridCephPoolsPoolidBuilderImpl) Delet
 
 // V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder provides access to operations
 type V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder interface {
-	Namespaceid(namespaceid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder
+	Namespaceid(namespaceid ids.NamespaceID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder
 }
 
 // v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder
 type v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
-	poolid    string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
+	poolid    ids.PoolID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder creates a new V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder
-func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder(sdk *sdkImpl, tenantid string, clusterid string, poolid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID, poolid ids.PoolID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilderImpl{
 		clusterid: clusterid,
 		poolid:    poolid,
@@ -13694,7 +13696,7 @@ func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilder(s
 }
 
 // Namespaceid returns builder for namespaceid
-func (b *v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilderImpl) Namespaceid(namespaceid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder {
+func (b *v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesBuilderImpl) Namespaceid(namespaceid ids.NamespaceID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder {
 	return newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder(b.sdk, b.tenantid, b.clusterid, b.poolid, namespaceid)
 }
 
@@ -13706,14 +13708,14 @@ type V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidB
 // v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder
 type v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilderImpl struct {
 	sdk         *sdkImpl
-	tenantid    string
-	clusterid   string
-	poolid      string
-	namespaceid string
+	tenantid    ids.TenantID
+	clusterid   ids.ClusterID
+	poolid      ids.PoolID
+	namespaceid ids.NamespaceID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder creates a new V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder
-func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder(sdk *sdkImpl, tenantid string, clusterid string, poolid string, namespaceid string) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID, poolid ids.PoolID, namespaceid ids.NamespaceID) V4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephPoolsPoolidNamespacesNamespaceidBuilderImpl{
 		clusterid:   clusterid,
 		namespaceid: namespaceid,
@@ -13736,12 +13738,12 @@ type V4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder interface {
 // v4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder
 type v4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder creates a new V4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder
-func newV4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder(sdk *sdkImpl, tenantid string, clusterid string) V4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -13756,19 +13758,19 @@ func (b *v4TenantsTenantidCephClustersClusteridCephRbdNamespacesBuilderImpl) Cre
 
 // V4TenantsTenantidCephClustersClusteridCephXUsersBuilder provides access to operations
 type V4TenantsTenantidCephClustersClusteridCephXUsersBuilder interface {
-	Entityid(entityid string) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder
+	Entityid(entityid ids.EntityID) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder
 	Createcephxuser(ctx context.Context, request *models.WannabeCephXUser) client.Response[models.JustCreatedCephXUser]
 }
 
 // v4TenantsTenantidCephClustersClusteridCephXUsersBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephXUsersBuilder
 type v4TenantsTenantidCephClustersClusteridCephXUsersBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephXUsersBuilder creates a new V4TenantsTenantidCephClustersClusteridCephXUsersBuilder
-func newV4TenantsTenantidCephClustersClusteridCephXUsersBuilder(sdk *sdkImpl, tenantid string, clusterid string) V4TenantsTenantidCephClustersClusteridCephXUsersBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephXUsersBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridCephXUsersBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephXUsersBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -13777,7 +13779,7 @@ func newV4TenantsTenantidCephClustersClusteridCephXUsersBuilder(sdk *sdkImpl, te
 }
 
 // Entityid returns builder for entityid
-func (b *v4TenantsTenantidCephClustersClusteridCephXUsersBuilderImpl) Entityid(entityid string) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder {
+func (b *v4TenantsTenantidCephClustersClusteridCephXUsersBuilderImpl) Entityid(entityid ids.EntityID) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder {
 	return newV4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder(b.sdk, b.tenantid, b.clusterid, entityid)
 }
 
@@ -13795,13 +13797,13 @@ type V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder interface {
 // v4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilderImpl implements V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder
 type v4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
-	entityid  string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
+	entityid  ids.EntityID
 }
 
 // newV4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder creates a new V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder
-func newV4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder(sdk *sdkImpl, tenantid string, clusterid string, entityid string) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder {
+func newV4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID, entityid ids.EntityID) V4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilder {
 	return &v4TenantsTenantidCephClustersClusteridCephXUsersEntityidBuilderImpl{
 		clusterid: clusterid,
 		entityid:  entityid,
@@ -13828,12 +13830,12 @@ type V4TenantsTenantidCephClustersClusteridStoragesBuilder interface {
 // v4TenantsTenantidCephClustersClusteridStoragesBuilderImpl implements V4TenantsTenantidCephClustersClusteridStoragesBuilder
 type v4TenantsTenantidCephClustersClusteridStoragesBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	clusterid string
+	tenantid  ids.TenantID
+	clusterid ids.ClusterID
 }
 
 // newV4TenantsTenantidCephClustersClusteridStoragesBuilder creates a new V4TenantsTenantidCephClustersClusteridStoragesBuilder
-func newV4TenantsTenantidCephClustersClusteridStoragesBuilder(sdk *sdkImpl, tenantid string, clusterid string) V4TenantsTenantidCephClustersClusteridStoragesBuilder {
+func newV4TenantsTenantidCephClustersClusteridStoragesBuilder(sdk *sdkImpl, tenantid ids.TenantID, clusterid ids.ClusterID) V4TenantsTenantidCephClustersClusteridStoragesBuilder {
 	return &v4TenantsTenantidCephClustersClusteridStoragesBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -13848,7 +13850,7 @@ func (b *v4TenantsTenantidCephClustersClusteridStoragesBuilderImpl) Createstorag
 
 // V4TenantsTenantidContainerRegistryBuilder provides access to operations
 type V4TenantsTenantidContainerRegistryBuilder interface {
-	Registryid(registryid string) V4TenantsTenantidContainerRegistryRegistryidBuilder
+	Registryid(registryid ids.RegistryID) V4TenantsTenantidContainerRegistryRegistryidBuilder
 	Listregistries(ctx context.Context) client.Response[[]models.ContainerRegistry]
 	Createregistry(ctx context.Context, request *models.WannabeContainerRegistry) client.Response[models.ContainerRegistryWithTokens]
 }
@@ -13856,11 +13858,11 @@ type V4TenantsTenantidContainerRegistryBuilder interface {
 // v4TenantsTenantidContainerRegistryBuilderImpl implements V4TenantsTenantidContainerRegistryBuilder
 type v4TenantsTenantidContainerRegistryBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidContainerRegistryBuilder creates a new V4TenantsTenantidContainerRegistryBuilder
-func newV4TenantsTenantidContainerRegistryBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidContainerRegistryBuilder {
+func newV4TenantsTenantidContainerRegistryBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidContainerRegistryBuilder {
 	return &v4TenantsTenantidContainerRegistryBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -13868,7 +13870,7 @@ func newV4TenantsTenantidContainerRegistryBuilder(sdk *sdkImpl, tenantid string)
 }
 
 // Registryid returns builder for registryid
-func (b *v4TenantsTenantidContainerRegistryBuilderImpl) Registryid(registryid string) V4TenantsTenantidContainerRegistryRegistryidBuilder {
+func (b *v4TenantsTenantidContainerRegistryBuilderImpl) Registryid(registryid ids.RegistryID) V4TenantsTenantidContainerRegistryRegistryidBuilder {
 	return newV4TenantsTenantidContainerRegistryRegistryidBuilder(b.sdk, b.tenantid, registryid)
 }
 
@@ -13892,12 +13894,12 @@ type V4TenantsTenantidContainerRegistryRegistryidBuilder interface {
 // v4TenantsTenantidContainerRegistryRegistryidBuilderImpl implements V4TenantsTenantidContainerRegistryRegistryidBuilder
 type v4TenantsTenantidContainerRegistryRegistryidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	registryid string
+	tenantid   ids.TenantID
+	registryid ids.RegistryID
 }
 
 // newV4TenantsTenantidContainerRegistryRegistryidBuilder creates a new V4TenantsTenantidContainerRegistryRegistryidBuilder
-func newV4TenantsTenantidContainerRegistryRegistryidBuilder(sdk *sdkImpl, tenantid string, registryid string) V4TenantsTenantidContainerRegistryRegistryidBuilder {
+func newV4TenantsTenantidContainerRegistryRegistryidBuilder(sdk *sdkImpl, tenantid ids.TenantID, registryid ids.RegistryID) V4TenantsTenantidContainerRegistryRegistryidBuilder {
 	return &v4TenantsTenantidContainerRegistryRegistryidBuilderImpl{
 		registryid: registryid,
 		sdk:        sdk,
@@ -13922,7 +13924,7 @@ func (b *v4TenantsTenantidContainerRegistryRegistryidBuilderImpl) Getregistry(ct
 
 // V4TenantsTenantidContainerRegistryRegistryidTokensBuilder provides access to operations
 type V4TenantsTenantidContainerRegistryRegistryidTokensBuilder interface {
-	Tokenid(tokenid string) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder
+	Tokenid(tokenid ids.TokenID) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder
 	Listregistrytokens(ctx context.Context) client.Response[[]models.ContainerRegistryToken]
 	Createregistrytoken(ctx context.Context, request *models.WannabeContainerRegistryToken) client.Response[models.ContainerRegistryTokenWithBiscuit]
 }
@@ -13930,12 +13932,12 @@ type V4TenantsTenantidContainerRegistryRegistryidTokensBuilder interface {
 // v4TenantsTenantidContainerRegistryRegistryidTokensBuilderImpl implements V4TenantsTenantidContainerRegistryRegistryidTokensBuilder
 type v4TenantsTenantidContainerRegistryRegistryidTokensBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	registryid string
+	tenantid   ids.TenantID
+	registryid ids.RegistryID
 }
 
 // newV4TenantsTenantidContainerRegistryRegistryidTokensBuilder creates a new V4TenantsTenantidContainerRegistryRegistryidTokensBuilder
-func newV4TenantsTenantidContainerRegistryRegistryidTokensBuilder(sdk *sdkImpl, tenantid string, registryid string) V4TenantsTenantidContainerRegistryRegistryidTokensBuilder {
+func newV4TenantsTenantidContainerRegistryRegistryidTokensBuilder(sdk *sdkImpl, tenantid ids.TenantID, registryid ids.RegistryID) V4TenantsTenantidContainerRegistryRegistryidTokensBuilder {
 	return &v4TenantsTenantidContainerRegistryRegistryidTokensBuilderImpl{
 		registryid: registryid,
 		sdk:        sdk,
@@ -13944,7 +13946,7 @@ func newV4TenantsTenantidContainerRegistryRegistryidTokensBuilder(sdk *sdkImpl,
 }
 
 // Tokenid returns builder for tokenid
-func (b *v4TenantsTenantidContainerRegistryRegistryidTokensBuilderImpl) Tokenid(tokenid string) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder {
+func (b *v4TenantsTenantidContainerRegistryRegistryidTokensBuilderImpl) Tokenid(tokenid ids.TokenID) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder {
 	return newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder(b.sdk, b.tenantid, b.registryid, tokenid)
 }
 
@@ -13967,13 +13969,13 @@ type V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder interface
 // v4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilderImpl implements V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder
 type v4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	registryid string
-	tokenid    string
+	tenantid   ids.TenantID
+	registryid ids.RegistryID
+	tokenid    ids.TokenID
 }
 
 // newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder creates a new V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder
-func newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder(sdk *sdkImpl, tenantid string, registryid string, tokenid string) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder {
+func newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder(sdk *sdkImpl, tenantid ids.TenantID, registryid ids.RegistryID, tokenid ids.TokenID) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilder {
 	return &v4TenantsTenantidContainerRegistryRegistryidTokensTokenidBuilderImpl{
 		registryid: registryid,
 		sdk:        sdk,
@@ -14000,13 +14002,13 @@ type V4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder inter
 // v4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilderImpl implements V4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder
 type v4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	registryid string
-	tokenid    string
+	tenantid   ids.TenantID
+	registryid ids.RegistryID
+	tokenid    ids.TokenID
 }
 
 // newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder creates a new V4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder
-func newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder(sdk *sdkImpl, tenantid string, registryid string, tokenid string) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder {
+func newV4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder(sdk *sdkImpl, tenantid ids.TenantID, registryid ids.RegistryID, tokenid ids.TokenID) V4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilder {
 	return &v4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilderImpl{
 		registryid: registryid,
 		sdk:        sdk,
@@ -14022,17 +14024,17 @@ func (b *v4TenantsTenantidContainerRegistryRegistryidTokensTokenidRenewBuilderIm
 
 // V4TenantsTenantidMembersBuilder provides access to operations
 type V4TenantsTenantidMembersBuilder interface {
-	IDentityid(identityid string) V4TenantsTenantidMembersIDentityidBuilder
+	IDentityid(identityid ids.IdentityID) V4TenantsTenantidMembersIDentityidBuilder
 }
 
 // v4TenantsTenantidMembersBuilderImpl implements V4TenantsTenantidMembersBuilder
 type v4TenantsTenantidMembersBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidMembersBuilder creates a new V4TenantsTenantidMembersBuilder
-func newV4TenantsTenantidMembersBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidMembersBuilder {
+func newV4TenantsTenantidMembersBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidMembersBuilder {
 	return &v4TenantsTenantidMembersBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14040,7 +14042,7 @@ func newV4TenantsTenantidMembersBuilder(sdk *sdkImpl, tenantid string) V4Tenants
 }
 
 // IDentityid returns builder for identityid
-func (b *v4TenantsTenantidMembersBuilderImpl) IDentityid(identityid string) V4TenantsTenantidMembersIDentityidBuilder {
+func (b *v4TenantsTenantidMembersBuilderImpl) IDentityid(identityid ids.IdentityID) V4TenantsTenantidMembersIDentityidBuilder {
 	return newV4TenantsTenantidMembersIDentityidBuilder(b.sdk, b.tenantid, identityid)
 }
 
@@ -14052,12 +14054,12 @@ type V4TenantsTenantidMembersIDentityidBuilder interface {
 // v4TenantsTenantidMembersIDentityidBuilderImpl implements V4TenantsTenantidMembersIDentityidBuilder
 type v4TenantsTenantidMembersIDentityidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	identityid string
+	tenantid   ids.TenantID
+	identityid ids.IdentityID
 }
 
 // newV4TenantsTenantidMembersIDentityidBuilder creates a new V4TenantsTenantidMembersIDentityidBuilder
-func newV4TenantsTenantidMembersIDentityidBuilder(sdk *sdkImpl, tenantid string, identityid string) V4TenantsTenantidMembersIDentityidBuilder {
+func newV4TenantsTenantidMembersIDentityidBuilder(sdk *sdkImpl, tenantid ids.TenantID, identityid ids.IdentityID) V4TenantsTenantidMembersIDentityidBuilder {
 	return &v4TenantsTenantidMembersIDentityidBuilderImpl{
 		identityid: identityid,
 		sdk:        sdk,
@@ -14072,7 +14074,7 @@ func (b *v4TenantsTenantidMembersIDentityidBuilderImpl) Deletememberfromtenant(c
 
 // V4TenantsTenantidProductsBuilder provides access to operations
 type V4TenantsTenantidProductsBuilder interface {
-	Productid(productid string) V4TenantsTenantidProductsProductidBuilder
+	Productid(productid ids.ProductID) V4TenantsTenantidProductsProductidBuilder
 	Listproducts(ctx context.Context) client.Response[[]models.Product]
 	Createproduct(ctx context.Context, request *models.WannabeProduct) client.Response[models.Product]
 }
@@ -14080,11 +14082,11 @@ type V4TenantsTenantidProductsBuilder interface {
 // v4TenantsTenantidProductsBuilderImpl implements V4TenantsTenantidProductsBuilder
 type v4TenantsTenantidProductsBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidProductsBuilder creates a new V4TenantsTenantidProductsBuilder
-func newV4TenantsTenantidProductsBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidProductsBuilder {
+func newV4TenantsTenantidProductsBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidProductsBuilder {
 	return &v4TenantsTenantidProductsBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14092,7 +14094,7 @@ func newV4TenantsTenantidProductsBuilder(sdk *sdkImpl, tenantid string) V4Tenant
 }
 
 // Productid returns builder for productid
-func (b *v4TenantsTenantidProductsBuilderImpl) Productid(productid string) V4TenantsTenantidProductsProductidBuilder {
+func (b *v4TenantsTenantidProductsBuilderImpl) Productid(productid ids.ProductID) V4TenantsTenantidProductsProductidBuilder {
 	return newV4TenantsTenantidProductsProductidBuilder(b.sdk, b.tenantid, productid)
 }
 
@@ -14119,12 +14121,12 @@ type V4TenantsTenantidProductsProductidBuilder interface {
 // v4TenantsTenantidProductsProductidBuilderImpl implements V4TenantsTenantidProductsProductidBuilder
 type v4TenantsTenantidProductsProductidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
+	tenantid  ids.TenantID
+	productid ids.ProductID
 }
 
 // newV4TenantsTenantidProductsProductidBuilder creates a new V4TenantsTenantidProductsProductidBuilder
-func newV4TenantsTenantidProductsProductidBuilder(sdk *sdkImpl, tenantid string, productid string) V4TenantsTenantidProductsProductidBuilder {
+func newV4TenantsTenantidProductsProductidBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID) V4TenantsTenantidProductsProductidBuilder {
 	return &v4TenantsTenantidProductsProductidBuilderImpl{
 		productid: productid,
 		sdk:       sdk,
@@ -14164,7 +14166,7 @@ func (b *v4TenantsTenantidProductsProductidBuilderImpl) Updateproduct(ctx contex
 
 // V4TenantsTenantidProductsProductidKeysBuilder provides access to operations
 type V4TenantsTenantidProductsProductidKeysBuilder interface {
-	Keyid(keyid string) V4TenantsTenantidProductsProductidKeysKeyidBuilder
+	Keyid(keyid ids.KeyID) V4TenantsTenantidProductsProductidKeysKeyidBuilder
 	Listsigningkeys(ctx context.Context, opts ...tokens.Option) client.Response[[]models.SigningKey]
 	Createsigningkey(ctx context.Context, request *models.WannabeSigningKey) client.Response[models.SigningKey]
 }
@@ -14172,12 +14174,12 @@ type V4TenantsTenantidProductsProductidKeysBuilder interface {
 // v4TenantsTenantidProductsProductidKeysBuilderImpl implements V4TenantsTenantidProductsProductidKeysBuilder
 type v4TenantsTenantidProductsProductidKeysBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
+	tenantid  ids.TenantID
+	productid ids.ProductID
 }
 
 // newV4TenantsTenantidProductsProductidKeysBuilder creates a new V4TenantsTenantidProductsProductidKeysBuilder
-func newV4TenantsTenantidProductsProductidKeysBuilder(sdk *sdkImpl, tenantid string, productid string) V4TenantsTenantidProductsProductidKeysBuilder {
+func newV4TenantsTenantidProductsProductidKeysBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID) V4TenantsTenantidProductsProductidKeysBuilder {
 	return &v4TenantsTenantidProductsProductidKeysBuilderImpl{
 		productid: productid,
 		sdk:       sdk,
@@ -14186,7 +14188,7 @@ func newV4TenantsTenantidProductsProductidKeysBuilder(sdk *sdkImpl, tenantid str
 }
 
 // Keyid returns builder for keyid
-func (b *v4TenantsTenantidProductsProductidKeysBuilderImpl) Keyid(keyid string) V4TenantsTenantidProductsProductidKeysKeyidBuilder {
+func (b *v4TenantsTenantidProductsProductidKeysBuilderImpl) Keyid(keyid ids.KeyID) V4TenantsTenantidProductsProductidKeysKeyidBuilder {
 	return newV4TenantsTenantidProductsProductidKeysKeyidBuilder(b.sdk, b.tenantid, b.productid, keyid)
 }
 
@@ -14209,13 +14211,13 @@ type V4TenantsTenantidProductsProductidKeysKeyidBuilder interface {
 // v4TenantsTenantidProductsProductidKeysKeyidBuilderImpl implements V4TenantsTenantidProductsProductidKeysKeyidBuilder
 type v4TenantsTenantidProductsProductidKeysKeyidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
-	keyid     string
+	tenantid  ids.TenantID
+	productid ids.ProductID
+	keyid     ids.KeyID
 }
 
 // newV4TenantsTenantidProductsProductidKeysKeyidBuilder creates a new V4TenantsTenantidProductsProductidKeysKeyidBuilder
-func newV4TenantsTenantidProductsProductidKeysKeyidBuilder(sdk *sdkImpl, tenantid string, productid string, keyid string) V4TenantsTenantidProductsProductidKeysKeyidBuilder {
+func newV4TenantsTenantidProductsProductidKeysKeyidBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID, keyid ids.KeyID) V4TenantsTenantidProductsProductidKeysKeyidBuilder {
 	return &v4TenantsTenantidProductsProductidKeysKeyidBuilderImpl{
 		keyid:     keyid,
 		productid: productid,
@@ -14242,13 +14244,13 @@ type V4TenantsTenantidProductsProductidKeysKeyidRotateBuilder interface {
 // v4TenantsTenantidProductsProductidKeysKeyidRotateBuilderImpl implements V4TenantsTenantidProductsProductidKeysKeyidRotateBuilder
 type v4TenantsTenantidProductsProductidKeysKeyidRotateBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
-	keyid     string
+	tenantid  ids.TenantID
+	productid ids.ProductID
+	keyid     ids.KeyID
 }
 
 // newV4TenantsTenantidProductsProductidKeysKeyidRotateBuilder creates a new V4TenantsTenantidProductsProductidKeysKeyidRotateBuilder
-func newV4TenantsTenantidProductsProductidKeysKeyidRotateBuilder(sdk *sdkImpl, tenantid string, productid string, keyid string) V4TenantsTenantidProductsProductidKeysKeyidRotateBuilder {
+func newV4TenantsTenantidProductsProductidKeysKeyidRotateBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID, keyid ids.KeyID) V4TenantsTenantidProductsProductidKeysKeyidRotateBuilder {
 	return &v4TenantsTenantidProductsProductidKeysKeyidRotateBuilderImpl{
 		keyid:     keyid,
 		productid: productid,
@@ -14270,12 +14272,12 @@ type V4TenantsTenantidProductsProductidPublishBuilder interface {
 // v4TenantsTenantidProductsProductidPublishBuilderImpl implements V4TenantsTenantidProductsProductidPublishBuilder
 type v4TenantsTenantidProductsProductidPublishBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
+	tenantid  ids.TenantID
+	productid ids.ProductID
 }
 
 // newV4TenantsTenantidProductsProductidPublishBuilder creates a new V4TenantsTenantidProductsProductidPublishBuilder
-func newV4TenantsTenantidProductsProductidPublishBuilder(sdk *sdkImpl, tenantid string, productid string) V4TenantsTenantidProductsProductidPublishBuilder {
+func newV4TenantsTenantidProductsProductidPublishBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID) V4TenantsTenantidProductsProductidPublishBuilder {
 	return &v4TenantsTenantidProductsProductidPublishBuilderImpl{
 		productid: productid,
 		sdk:       sdk,
@@ -14290,7 +14292,7 @@ func (b *v4TenantsTenantidProductsProductidPublishBuilderImpl) Publishproduct(ct
 
 // V4TenantsTenantidProductsProductidResourcesBuilder provides access to operations
 type V4TenantsTenantidProductsProductidResourcesBuilder interface {
-	Resourceid(resourceid string) V4TenantsTenantidProductsProductidResourcesResourceidBuilder
+	Resourceid(resourceid ids.ResourceID) V4TenantsTenantidProductsProductidResourcesResourceidBuilder
 	Listresources(ctx context.Context) client.Response[[]models.Resource]
 	Createresource(ctx context.Context, request *models.WannabeResource) client.Response[models.Resource]
 }
@@ -14298,12 +14300,12 @@ type V4TenantsTenantidProductsProductidResourcesBuilder interface {
 // v4TenantsTenantidProductsProductidResourcesBuilderImpl implements V4TenantsTenantidProductsProductidResourcesBuilder
 type v4TenantsTenantidProductsProductidResourcesBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	productid string
+	tenantid  ids.TenantID
+	productid ids.ProductID
 }
 
 // newV4TenantsTenantidProductsProductidResourcesBuilder creates a new V4TenantsTenantidProductsProductidResourcesBuilder
-func newV4TenantsTenantidProductsProductidResourcesBuilder(sdk *sdkImpl, tenantid string, productid string) V4TenantsTenantidProductsProductidResourcesBuilder {
+func newV4TenantsTenantidProductsProductidResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID) V4TenantsTenantidProductsProductidResourcesBuilder {
 	return &v4TenantsTenantidProductsProductidResourcesBuilderImpl{
 		productid: productid,
 		sdk:       sdk,
@@ -14312,7 +14314,7 @@ func newV4TenantsTenantidProductsProductidResourcesBuilder(sdk *sdkImpl, tenanti
 }
 
 // Resourceid returns builder for resourceid
-func (b *v4TenantsTenantidProductsProductidResourcesBuilderImpl) Resourceid(resourceid string) V4TenantsTenantidProductsProductidResourcesResourceidBuilder {
+func (b *v4TenantsTenantidProductsProductidResourcesBuilderImpl) Resourceid(resourceid ids.ResourceID) V4TenantsTenantidProductsProductidResourcesResourceidBuilder {
 	return newV4TenantsTenantidProductsProductidResourcesResourceidBuilder(b.sdk, b.tenantid, b.productid, resourceid)
 }
 
@@ -14336,13 +14338,13 @@ type V4TenantsTenantidProductsProductidResourcesResourceidBuilder interface {
 // v4TenantsTenantidProductsProductidResourcesResourceidBuilderImpl implements V4TenantsTenantidProductsProductidResourcesResourceidBuilder
 type v4TenantsTenantidProductsProductidResourcesResourceidBuilderImpl struct {
 	sdk        *sdkImpl
-	tenantid   string
-	productid  string
-	resourceid string
+	tenantid   ids.TenantID
+	productid  ids.ProductID
+	resourceid ids.ResourceID
 }
 
 // newV4TenantsTenantidProductsProductidResourcesResourceidBuilder creates a new V4TenantsTenantidProductsProductidResourcesResourceidBuilder
-func newV4TenantsTenantidProductsProductidResourcesResourceidBuilder(sdk *sdkImpl, tenantid string, productid string, resourceid string) V4TenantsTenantidProductsProductidResourcesResourceidBuilder {
+func newV4TenantsTenantidProductsProductidResourcesResourceidBuilder(sdk *sdkImpl, tenantid ids.TenantID, productid ids.ProductID, resourceid ids.ResourceID) V4TenantsTenantidProductsProductidResourcesResourceidBuilder {
 	return &v4TenantsTenantidProductsProductidResourcesResourceidBuilderImpl{
 		productid:  productid,
 		resourceid: resourceid,
@@ -14374,11 +14376,11 @@ type V4TenantsTenantidResourcesBuilder interface {
 // v4TenantsTenantidResourcesBuilderImpl implements V4TenantsTenantidResourcesBuilder
 type v4TenantsTenantidResourcesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidResourcesBuilder creates a new V4TenantsTenantidResourcesBuilder
-func newV4TenantsTenantidResourcesBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidResourcesBuilder {
+func newV4TenantsTenantidResourcesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidResourcesBuilder {
 	return &v4TenantsTenantidResourcesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14392,18 +14394,18 @@ func (b *v4TenantsTenantidResourcesBuilderImpl) Listavailableresources(ctx conte
 
 // V4TenantsTenantidStoragesBuilder provides access to operations
 type V4TenantsTenantidStoragesBuilder interface {
-	Storageid(storageid string) V4TenantsTenantidStoragesStorageidBuilder
+	Storageid(storageid ids.StorageID) V4TenantsTenantidStoragesStorageidBuilder
 	Liststorages(ctx context.Context) client.Response[[]models.Storage1]
 }
 
 // v4TenantsTenantidStoragesBuilderImpl implements V4TenantsTenantidStoragesBuilder
 type v4TenantsTenantidStoragesBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidStoragesBuilder creates a new V4TenantsTenantidStoragesBuilder
-func newV4TenantsTenantidStoragesBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidStoragesBuilder {
+func newV4TenantsTenantidStoragesBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidStoragesBuilder {
 	return &v4TenantsTenantidStoragesBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14411,7 +14413,7 @@ func newV4TenantsTenantidStoragesBuilder(sdk *sdkImpl, tenantid string) V4Tenant
 }
 
 // Storageid returns builder for storageid
-func (b *v4TenantsTenantidStoragesBuilderImpl) Storageid(storageid string) V4TenantsTenantidStoragesStorageidBuilder {
+func (b *v4TenantsTenantidStoragesBuilderImpl) Storageid(storageid ids.StorageID) V4TenantsTenantidStoragesStorageidBuilder {
 	return newV4TenantsTenantidStoragesStorageidBuilder(b.sdk, b.tenantid, storageid)
 }
 
@@ -14430,12 +14432,12 @@ type V4TenantsTenantidStoragesStorageidBuilder interface {
 // v4TenantsTenantidStoragesStorageidBuilderImpl implements V4TenantsTenantidStoragesStorageidBuilder
 type v4TenantsTenantidStoragesStorageidBuilderImpl struct {
 	sdk       *sdkImpl
-	tenantid  string
-	storageid string
+	tenantid  ids.TenantID
+	storageid ids.StorageID
 }
 
 // newV4TenantsTenantidStoragesStorageidBuilder creates a new V4TenantsTenantidStoragesStorageidBuilder
-func newV4TenantsTenantidStoragesStorageidBuilder(sdk *sdkImpl, tenantid string, storageid string) V4TenantsTenantidStoragesStorageidBuilder {
+func newV4TenantsTenantidStoragesStorageidBuilder(sdk *sdkImpl, tenantid ids.TenantID, storageid ids.StorageID) V4TenantsTenantidStoragesStorageidBuilder {
 	return &v4TenantsTenantidStoragesStorageidBuilderImpl{
 		sdk:       sdk,
 		storageid: storageid,
@@ -14460,7 +14462,7 @@ func (b *v4TenantsTenantidStoragesStorageidBuilderImpl) Updatestorage(ctx contex
 
 // V4TenantsTenantidTokensBuilder provides access to operations
 type V4TenantsTenantidTokensBuilder interface {
-	Tokenid(tokenid string) V4TenantsTenantidTokensTokenidBuilder
+	Tokenid(tokenid ids.TokenID) V4TenantsTenantidTokensTokenidBuilder
 	Listtokens(ctx context.Context, opts ...tokens.Option) client.Response[[]models.Token1]
 	Createtoken(ctx context.Context, request *models.WannabeToken) client.Response[models.CreatedToken]
 }
@@ -14468,11 +14470,11 @@ type V4TenantsTenantidTokensBuilder interface {
 // v4TenantsTenantidTokensBuilderImpl implements V4TenantsTenantidTokensBuilder
 type v4TenantsTenantidTokensBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
+	tenantid ids.TenantID
 }
 
 // newV4TenantsTenantidTokensBuilder creates a new V4TenantsTenantidTokensBuilder
-func newV4TenantsTenantidTokensBuilder(sdk *sdkImpl, tenantid string) V4TenantsTenantidTokensBuilder {
+func newV4TenantsTenantidTokensBuilder(sdk *sdkImpl, tenantid ids.TenantID) V4TenantsTenantidTokensBuilder {
 	return &v4TenantsTenantidTokensBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14480,7 +14482,7 @@ func newV4TenantsTenantidTokensBuilder(sdk *sdkImpl, tenantid string) V4TenantsT
 }
 
 // Tokenid returns builder for tokenid
-func (b *v4TenantsTenantidTokensBuilderImpl) Tokenid(tokenid string) V4TenantsTenantidTokensTokenidBuilder {
+func (b *v4TenantsTenantidTokensBuilderImpl) Tokenid(tokenid ids.TokenID) V4TenantsTenantidTokensTokenidBuilder {
 	return newV4TenantsTenantidTokensTokenidBuilder(b.sdk, b.tenantid, tokenid)
 }
 
@@ -14504,12 +14506,12 @@ type V4TenantsTenantidTokensTokenidBuilder interface {
 // v4TenantsTenantidTokensTokenidBuilderImpl implements V4TenantsTenantidTokensTokenidBuilder
 type v4TenantsTenantidTokensTokenidBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	tokenid  string
+	tenantid ids.TenantID
+	tokenid  ids.TokenID
 }
 
 // newV4TenantsTenantidTokensTokenidBuilder creates a new V4TenantsTenantidTokensTokenidBuilder
-func newV4TenantsTenantidTokensTokenidBuilder(sdk *sdkImpl, tenantid string, tokenid string) V4TenantsTenantidTokensTokenidBuilder {
+func newV4TenantsTenantidTokensTokenidBuilder(sdk *sdkImpl, tenantid ids.TenantID, tokenid ids.TokenID) V4TenantsTenantidTokensTokenidBuilder {
 	return &v4TenantsTenantidTokensTokenidBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14540,12 +14542,12 @@ type V4TenantsTenantidTokensTokenidRefreshBuilder interface {
 // v4TenantsTenantidTokensTokenidRefreshBuilderImpl implements V4TenantsTenantidTokensTokenidRefreshBuilder
 type v4TenantsTenantidTokensTokenidRefreshBuilderImpl struct {
 	sdk      *sdkImpl
-	tenantid string
-	tokenid  string
+	tenantid ids.TenantID
+	tokenid  ids.TokenID
 }
 
 // newV4TenantsTenantidTokensTokenidRefreshBuilder creates a new V4TenantsTenantidTokensTokenidRefreshBuilder
-func newV4TenantsTenantidTokensTokenidRefreshBuilder(sdk *sdkImpl, tenantid string, tokenid string) V4TenantsTenantidTokensTokenidRefreshBuilder {
+func newV4TenantsTenantidTokensTokenidRefreshBuilder(sdk *sdkImpl, tenantid ids.TenantID, tokenid ids.TokenID) V4TenantsTenantidTokensTokenidRefreshBuilder {
 	return &v4TenantsTenantidTokensTokenidRefreshBuilderImpl{
 		sdk:      sdk,
 		tenantid: tenantid,
@@ -14626,7 +14628,7 @@ func (b *v4Warp10BuilderImpl) Clusters() V4Warp10ClustersBuilder {
 
 // V4Warp10ClusterBuilder provides access to operations
 type V4Warp10ClusterBuilder interface {
-	Clusterid(clusterid string) V4Warp10ClusterClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4Warp10ClusterClusteridBuilder
 }
 
 // v4Warp10ClusterBuilderImpl implements V4Warp10ClusterBuilder
@@ -14640,7 +14642,7 @@ func newV4Warp10ClusterBuilder(sdk *sdkImpl) V4Warp10ClusterBuilder {
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4Warp10ClusterBuilderImpl) Clusterid(clusterid string) V4Warp10ClusterClusteridBuilder {
+func (b *v4Warp10ClusterBuilderImpl) Clusterid(clusterid ids.ClusterID) V4Warp10ClusterClusteridBuilder {
 	return newV4Warp10ClusterClusteridBuilder(b.sdk, clusterid)
 }
 
@@ -14652,11 +14654,11 @@ type V4Warp10ClusterClusteridBuilder interface {
 // v4Warp10ClusterClusteridBuilderImpl implements V4Warp10ClusterClusteridBuilder
 type v4Warp10ClusterClusteridBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClusterClusteridBuilder creates a new V4Warp10ClusterClusteridBuilder
-func newV4Warp10ClusterClusteridBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClusterClusteridBuilder {
+func newV4Warp10ClusterClusteridBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClusterClusteridBuilder {
 	return &v4Warp10ClusterClusteridBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -14670,7 +14672,7 @@ func (b *v4Warp10ClusterClusteridBuilderImpl) Token() V4Warp10ClusterClusteridTo
 
 // V4Warp10ClusterClusteridTokenBuilder provides access to operations
 type V4Warp10ClusterClusteridTokenBuilder interface {
-	Revocationid(revocationid string) V4Warp10ClusterClusteridTokenRevocationidBuilder
+	Revocationid(revocationid ids.RevocationID) V4Warp10ClusterClusteridTokenRevocationidBuilder
 	Read() V4Warp10ClusterClusteridTokenReadBuilder
 	Revocation() V4Warp10ClusterClusteridTokenRevocationBuilder
 	Write() V4Warp10ClusterClusteridTokenWriteBuilder
@@ -14679,11 +14681,11 @@ type V4Warp10ClusterClusteridTokenBuilder interface {
 // v4Warp10ClusterClusteridTokenBuilderImpl implements V4Warp10ClusterClusteridTokenBuilder
 type v4Warp10ClusterClusteridTokenBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClusterClusteridTokenBuilder creates a new V4Warp10ClusterClusteridTokenBuilder
-func newV4Warp10ClusterClusteridTokenBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClusterClusteridTokenBuilder {
+func newV4Warp10ClusterClusteridTokenBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClusterClusteridTokenBuilder {
 	return &v4Warp10ClusterClusteridTokenBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -14691,7 +14693,7 @@ func newV4Warp10ClusterClusteridTokenBuilder(sdk *sdkImpl, clusterid string) V4W
 }
 
 // Revocationid returns builder for revocationid
-func (b *v4Warp10ClusterClusteridTokenBuilderImpl) Revocationid(revocationid string) V4Warp10ClusterClusteridTokenRevocationidBuilder {
+func (b *v4Warp10ClusterClusteridTokenBuilderImpl) Revocationid(revocationid ids.RevocationID) V4Warp10ClusterClusteridTokenRevocationidBuilder {
 	return newV4Warp10ClusterClusteridTokenRevocationidBuilder(b.sdk, b.clusterid, revocationid)
 }
 
@@ -14719,12 +14721,12 @@ type V4Warp10ClusterClusteridTokenRevocationidBuilder interface {
 // v4Warp10ClusterClusteridTokenRevocationidBuilderImpl implements V4Warp10ClusterClusteridTokenRevocationidBuilder
 type v4Warp10ClusterClusteridTokenRevocationidBuilderImpl struct {
 	sdk          *sdkImpl
-	clusterid    string
-	revocationid string
+	clusterid    ids.ClusterID
+	revocationid ids.RevocationID
 }
 
 // newV4Warp10ClusterClusteridTokenRevocationidBuilder creates a new V4Warp10ClusterClusteridTokenRevocationidBuilder
-func newV4Warp10ClusterClusteridTokenRevocationidBuilder(sdk *sdkImpl, clusterid string, revocationid string) V4Warp10ClusterClusteridTokenRevocationidBuilder {
+func newV4Warp10ClusterClusteridTokenRevocationidBuilder(sdk *sdkImpl, clusterid ids.ClusterID, revocationid ids.RevocationID) V4Warp10ClusterClusteridTokenRevocationidBuilder {
 	return &v4Warp10ClusterClusteridTokenRevocationidBuilderImpl{
 		clusterid:    clusterid,
 		revocationid: revocationid,
@@ -14750,11 +14752,11 @@ type V4Warp10ClusterClusteridTokenReadBuilder interface {
 // v4Warp10ClusterClusteridTokenReadBuilderImpl implements V4Warp10ClusterClusteridTokenReadBuilder
 type v4Warp10ClusterClusteridTokenReadBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClusterClusteridTokenReadBuilder creates a new V4Warp10ClusterClusteridTokenReadBuilder
-func newV4Warp10ClusterClusteridTokenReadBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClusterClusteridTokenReadBuilder {
+func newV4Warp10ClusterClusteridTokenReadBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClusterClusteridTokenReadBuilder {
 	return &v4Warp10ClusterClusteridTokenReadBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -14774,11 +14776,11 @@ type V4Warp10ClusterClusteridTokenRevocationBuilder interface {
 // v4Warp10ClusterClusteridTokenRevocationBuilderImpl implements V4Warp10ClusterClusteridTokenRevocationBuilder
 type v4Warp10ClusterClusteridTokenRevocationBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClusterClusteridTokenRevocationBuilder creates a new V4Warp10ClusterClusteridTokenRevocationBuilder
-func newV4Warp10ClusterClusteridTokenRevocationBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClusterClusteridTokenRevocationBuilder {
+func newV4Warp10ClusterClusteridTokenRevocationBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClusterClusteridTokenRevocationBuilder {
 	return &v4Warp10ClusterClusteridTokenRevocationBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -14798,11 +14800,11 @@ type V4Warp10ClusterClusteridTokenWriteBuilder interface {
 // v4Warp10ClusterClusteridTokenWriteBuilderImpl implements V4Warp10ClusterClusteridTokenWriteBuilder
 type v4Warp10ClusterClusteridTokenWriteBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClusterClusteridTokenWriteBuilder creates a new V4Warp10ClusterClusteridTokenWriteBuilder
-func newV4Warp10ClusterClusteridTokenWriteBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClusterClusteridTokenWriteBuilder {
+func newV4Warp10ClusterClusteridTokenWriteBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClusterClusteridTokenWriteBuilder {
 	return &v4Warp10ClusterClusteridTokenWriteBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -14816,7 +14818,7 @@ func (b *v4Warp10ClusterClusteridTokenWriteBuilderImpl) Createwritetoken(ctx con
 
 // V4Warp10ClustersBuilder provides access to operations
 type V4Warp10ClustersBuilder interface {
-	Clusterid(clusterid string) V4Warp10ClustersClusteridBuilder
+	Clusterid(clusterid ids.ClusterID) V4Warp10ClustersClusteridBuilder
 }
 
 // v4Warp10ClustersBuilderImpl implements V4Warp10ClustersBuilder
@@ -14830,7 +14832,7 @@ func newV4Warp10ClustersBuilder(sdk *sdkImpl) V4Warp10ClustersBuilder {
 }
 
 // Clusterid returns builder for clusterid
-func (b *v4Warp10ClustersBuilderImpl) Clusterid(clusterid string) V4Warp10ClustersClusteridBuilder {
+func (b *v4Warp10ClustersBuilderImpl) Clusterid(clusterid ids.ClusterID) V4Warp10ClustersClusteridBuilder {
 	return newV4Warp10ClustersClusteridBuilder(b.sdk, clusterid)
 }
 
@@ -14842,11 +14844,11 @@ type V4Warp10ClustersClusteridBuilder interface {
 // v4Warp10ClustersClusteridBuilderImpl implements V4Warp10ClustersClusteridBuilder
 type v4Warp10ClustersClusteridBuilderImpl struct {
 	sdk       *sdkImpl
-	clusterid string
+	clusterid ids.ClusterID
 }
 
 // newV4Warp10ClustersClusteridBuilder creates a new V4Warp10ClustersClusteridBuilder
-func newV4Warp10ClustersClusteridBuilder(sdk *sdkImpl, clusterid string) V4Warp10ClustersClusteridBuilder {
+func newV4Warp10ClustersClusteridBuilder(sdk *sdkImpl, clusterid ids.ClusterID) V4Warp10ClustersClusteridBuilder {
 	return &v4Warp10ClustersClusteridBuilderImpl{
 		clusterid: clusterid,
 		sdk:       sdk,
@@ -0,0 +1,259 @@
+// Package catalog builds an inventory of tenants, products and resources.
+//
+// A Collector walks the base service concurrently, with a bounded number of
+// requests in flight, and assembles a Graph that can be queried and exported
+// as JSON or Graphviz DOT.
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	base "go.clever-cloud.dev/sdk/services/base"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Collector builds catalog graphs
+type Collector struct {
+	client *client.Client
+	tracer trace.Tracer
+
+	concurrency int
+	tenantIDs   []ids.TenantID
+	details     bool
+	available   bool
+}
+
+// Option defines configuration options for the Collector
+type Option func(*Collector)
+
+// WithConcurrency limits how many requests are in flight at once
+func WithConcurrency(n int) Option {
+	return func(c *Collector) {
+		c.concurrency = n
+	}
+}
+
+// WithTenants restricts the graph to some tenants instead of every listed one
+func WithTenants(tenantIDs ...ids.TenantID) Option {
+	return func(c *Collector) {
+		c.tenantIDs = tenantIDs
+	}
+}
+
+// WithDetails fetches every tenant and resource individually, for the
+// fields such as members, branches and quota that listings may leave out
+func WithDetails() Option {
+	return func(c *Collector) {
+		c.details = true
+	}
+}
+
+// WithAvailable also collects the products and resources available to each tenant
+func WithAvailable() Option {
+	return func(c *Collector) {
+		c.available = true
+	}
+}
+
+// New creates a Collector
+func New(c *client.Client, tracer trace.Tracer, opts ...Option) *Collector {
+	collector := &Collector{
+		client:      c,
+		tracer:      tracer,
+		concurrency: 8,
+	}
+	for _, opt := range opts {
+		opt(collector)
+	}
+	return collector
+}
+
+// walk runs tasks with a bounded number of them running at once.
+// Tasks may schedule other tasks; wait returns once all are done.
+type walk struct {
+	ctx context.Context
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	mu   sync.Mutex
+	errs []error
+}
+
+func (w *walk) do(task func(ctx context.Context) error) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		select {
+		case w.sem <- struct{}{}:
+		case <-w.ctx.Done():
+			w.fail(w.ctx.Err())
+			return
+		}
+		err := task(w.ctx)
+		<-w.sem
+
+		if err != nil {
+			w.fail(err)
+		}
+	}()
+}
+
+func (w *walk) fail(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.errs = append(w.errs, err)
+}
+
+func (w *walk) wait() error {
+	w.wg.Wait()
+	return errors.Join(w.errs...)
+}
+
+// Collect walks the tenants, their products and resources and returns the graph.
+// When some requests fail the graph holds everything else that was collected
+// and the errors are joined.
+func (c *Collector) Collect(ctx context.Context) (*Graph, error) {
+	ctx, span := c.tracer.Start(ctx, "catalog.Collect")
+	defer span.End()
+
+	graph := &Graph{CollectedAt: time.Now()}
+	w := &walk{ctx: ctx, sem: make(chan struct{}, max(c.concurrency, 1))}
+	var mu sync.Mutex
+
+	addTenant := func(tenant models.Tenant1) {
+		node := &TenantNode{Tenant: tenant}
+		mu.Lock()
+		graph.Tenants = append(graph.Tenants, node)
+		mu.Unlock()
+		c.walkTenant(w, &mu, node)
+	}
+
+	if len(c.tenantIDs) > 0 {
+		for _, tenantID := range c.tenantIDs {
+			w.do(func(ctx context.Context) error {
+				response := base.Gettenant(ctx, c.client, c.tracer, tenantID)
+				if response.HasError() {
+					return fmt.Errorf("catalog: tenant %s: %w", tenantID, response.Error())
+				}
+				addTenant(*response.Payload())
+				return nil
+			})
+		}
+	} else {
+		w.do(func(ctx context.Context) error {
+			response := base.Listtenants(ctx, c.client, c.tracer)
+			if response.HasError() {
+				return fmt.Errorf("catalog: list tenants: %w", response.Error())
+			}
+			for _, tenant := range *response.Payload() {
+				if c.details {
+					w.do(func(ctx context.Context) error {
+						response := base.Gettenant(ctx, c.client, c.tracer, ids.TenantID(tenant.ID))
+						if response.HasError() {
+							return fmt.Errorf("catalog: tenant %s: %w", tenant.ID, response.Error())
+						}
+						addTenant(*response.Payload())
+						return nil
+					})
+				} else {
+					addTenant(tenant)
+				}
+			}
+			return nil
+		})
+	}
+
+	err := w.wait()
+	graph.sort()
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.SetAttributes(attribute.Int("tenants", len(graph.Tenants)))
+	return graph, err
+}
+
+// walkTenant schedules the collection of the products of a tenant and of
+// what is available to it. mu guards the nodes of the graph.
+func (c *Collector) walkTenant(w *walk, mu *sync.Mutex, tenant *TenantNode) {
+	tenantID := ids.TenantID(tenant.Tenant.ID)
+
+	w.do(func(ctx context.Context) error {
+		response := base.Listproducts(ctx, c.client, c.tracer, tenantID)
+		if response.HasError() {
+			return fmt.Errorf("catalog: products of tenant %s: %w", tenantID, response.Error())
+		}
+		for _, product := range *response.Payload() {
+			node := &ProductNode{Product: product}
+			mu.Lock()
+			tenant.Products = append(tenant.Products, node)
+			mu.Unlock()
+			c.walkProduct(w, mu, tenantID, node)
+		}
+		return nil
+	})
+
+	if !c.available {
+		return
+	}
+	w.do(func(ctx context.Context) error {
+		response := base.Listavailableproducts(ctx, c.client, c.tracer, base.WithTenantid(string(tenantID)))
+		if response.HasError() {
+			return fmt.Errorf("catalog: available products of tenant %s: %w", tenantID, response.Error())
+		}
+		mu.Lock()
+		tenant.AvailableProducts = *response.Payload()
+		mu.Unlock()
+		return nil
+	})
+	w.do(func(ctx context.Context) error {
+		response := base.Listavailableresources(ctx, c.client, c.tracer, tenantID)
+		if response.HasError() {
+			return fmt.Errorf("catalog: available resources of tenant %s: %w", tenantID, response.Error())
+		}
+		mu.Lock()
+		tenant.AvailableResources = *response.Payload()
+		mu.Unlock()
+		return nil
+	})
+}
+
+// walkProduct schedules the collection of the resources of a product
+func (c *Collector) walkProduct(w *walk, mu *sync.Mutex, tenantID ids.TenantID, product *ProductNode) {
+	productID := product.Product.ID
+
+	w.do(func(ctx context.Context) error {
+		response := base.Listresources(ctx, c.client, c.tracer, tenantID, ids.ProductID(productID))
+		if response.HasError() {
+			return fmt.Errorf("catalog: resources of product %s: %w", productID, response.Error())
+		}
+		for _, resource := range *response.Payload() {
+			node := &ResourceNode{Resource: resource}
+			mu.Lock()
+			product.Resources = append(product.Resources, node)
+			mu.Unlock()
+
+			if c.details {
+				w.do(func(ctx context.Context) error {
+					response := base.Getresource(ctx, c.client, c.tracer, tenantID, ids.ProductID(productID), ids.ResourceID(resource.ID))
+					if response.HasError() {
+						return fmt.Errorf("catalog: resource %s: %w", resource.ID, response.Error())
+					}
+					mu.Lock()
+					node.Resource = *response.Payload()
+					mu.Unlock()
+					return nil
+				})
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// WriteJSON writes the graph as an indented JSON document
+func (g *Graph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+// Resources are labeled with their latest state and failing ones are drawn in red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintln(out, "digraph catalog {")
+	fmt.Fprintln(out, "\trankdir=LR;")
+	for _, tenant := range g.Tenants {
+		tenantNode := "tenant:" + tenant.Tenant.ID
+		fmt.Fprintf(out, "\t%s [shape=box, label=%s];\n", strconv.Quote(tenantNode), strconv.Quote(label(tenant.Tenant.Name, tenant.Tenant.ID)))
+
+		for _, product := range tenant.Products {
+			productNode := "product:" + product.Product.ID
+			fmt.Fprintf(out, "\t%s [shape=ellipse, label=%s];\n", strconv.Quote(productNode), strconv.Quote(label(product.Product.Name, product.Product.ID)))
+			fmt.Fprintf(out, "\t%s -> %s;\n", strconv.Quote(tenantNode), strconv.Quote(productNode))
+
+			for _, resource := range product.Resources {
+				resourceNode := "resource:" + resource.Resource.ID
+				text := label(resource.Resource.Name, resource.Resource.ID)
+				if state := resource.State(); state != "" {
+					text += "\n" + string(state)
+				}
+				attrs := "shape=note, label=" + strconv.Quote(text)
+				if slices.Contains(FailingStates, resource.State()) {
+					attrs += ", color=red"
+				}
+				fmt.Fprintf(out, "\t%s [%s];\n", strconv.Quote(resourceNode), attrs)
+				fmt.Fprintf(out, "\t%s -> %s;\n", strconv.Quote(productNode), strconv.Quote(resourceNode))
+			}
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	return out.Flush()
+}
+
+func label(name string, id string) string {
+	if name == "" {
+		return id
+	}
+	return name + "\n" + id
+}
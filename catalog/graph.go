@@ -0,0 +1,147 @@
+package catalog
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// Graph is the inventory of tenants, their products and the resources of these products
+type Graph struct {
+	CollectedAt time.Time     `json:"collectedAt"`
+	Tenants     []*TenantNode `json:"tenants"`
+}
+
+// TenantNode is a tenant and what it owns
+type TenantNode struct {
+	Tenant   models.Tenant1 `json:"tenant"`
+	Products []*ProductNode `json:"products"`
+	// AvailableProducts and AvailableResources are what the tenant can use,
+	// including what other tenants share with it. They are only collected
+	// with WithAvailable.
+	AvailableProducts  []models.ProductOutput `json:"availableProducts,omitempty"`
+	AvailableResources []models.Resource      `json:"availableResources,omitempty"`
+}
+
+// ProductNode is a product and its resources
+type ProductNode struct {
+	Product   models.Product  `json:"product"`
+	Resources []*ResourceNode `json:"resources"`
+}
+
+// ResourceNode is a resource of a product
+type ResourceNode struct {
+	Resource models.Resource `json:"resource"`
+}
+
+// State returns the state of the latest status of the resource, empty if it has none
+func (r *ResourceNode) State() models.IdentifiedStateType {
+	return LatestState(r.Resource.Statuses)
+}
+
+// LatestState returns the state of the most recent status
+func LatestState(statuses []models.DefaultIdentifiedStatus) models.IdentifiedStateType {
+	latest, _ := LatestStatus(statuses)
+	return latest.State
+}
+
+// LatestStatus returns the most recent status, ok is false when there are none
+func LatestStatus(statuses []models.DefaultIdentifiedStatus) (latest models.DefaultIdentifiedStatus, ok bool) {
+	for _, status := range statuses {
+		if !ok || status.Date.After(latest.Date) {
+			latest, ok = status, true
+		}
+	}
+	return latest, ok
+}
+
+// FailingStates are the states of resources that are no longer served normally
+var FailingStates = []models.IdentifiedStateType{
+	models.IdentifiedStateTypeMODERATED,
+	models.IdentifiedStateTypeSoftDeleted,
+	models.IdentifiedStateTypeDELETED,
+}
+
+// Tenant returns a tenant of the graph
+func (g *Graph) Tenant(tenantID ids.TenantID) (*TenantNode, bool) {
+	for _, tenant := range g.Tenants {
+		if ids.TenantID(tenant.Tenant.ID) == tenantID {
+			return tenant, true
+		}
+	}
+	return nil, false
+}
+
+// ResourceFilter selects resources in queries
+type ResourceFilter func(tenant *TenantNode, product *ProductNode, resource *ResourceNode) bool
+
+// Resources returns the resources of every tenant matching all the filters
+func (g *Graph) Resources(filters ...ResourceFilter) []*ResourceNode {
+	var resources []*ResourceNode
+	for _, tenant := range g.Tenants {
+		for _, product := range tenant.Products {
+			for _, resource := range product.Resources {
+				if matches(tenant, product, resource, filters) {
+					resources = append(resources, resource)
+				}
+			}
+		}
+	}
+	return resources
+}
+
+func matches(tenant *TenantNode, product *ProductNode, resource *ResourceNode, filters []ResourceFilter) bool {
+	for _, filter := range filters {
+		if !filter(tenant, product, resource) {
+			return false
+		}
+	}
+	return true
+}
+
+// Failing selects resources whose latest state is one of FailingStates
+func Failing() ResourceFilter {
+	return InState(FailingStates...)
+}
+
+// InState selects resources whose latest state is one of states
+func InState(states ...models.IdentifiedStateType) ResourceFilter {
+	return func(_ *TenantNode, _ *ProductNode, resource *ResourceNode) bool {
+		return slices.Contains(states, resource.State())
+	}
+}
+
+// Tagged selects resources carrying tag, directly or through their product
+func Tagged(tag string) ResourceFilter {
+	return func(_ *TenantNode, product *ProductNode, resource *ResourceNode) bool {
+		return slices.Contains(resource.Resource.Tags, tag) || slices.Contains(product.Product.Tags, tag)
+	}
+}
+
+// OfKind selects resources of a kind
+func OfKind(kind string) ResourceFilter {
+	return func(_ *TenantNode, _ *ProductNode, resource *ResourceNode) bool {
+		return resource.Resource.Kind == kind
+	}
+}
+
+// InTenant selects resources owned by one of the tenants
+func InTenant(tenantIDs ...ids.TenantID) ResourceFilter {
+	return func(tenant *TenantNode, _ *ProductNode, _ *ResourceNode) bool {
+		return slices.Contains(tenantIDs, ids.TenantID(tenant.Tenant.ID))
+	}
+}
+
+// sort orders every level of the graph by identifier, so exports are stable
+func (g *Graph) sort() {
+	slices.SortFunc(g.Tenants, func(a, b *TenantNode) int { return strings.Compare(a.Tenant.ID, b.Tenant.ID) })
+	for _, tenant := range g.Tenants {
+		slices.SortFunc(tenant.Products, func(a, b *ProductNode) int { return strings.Compare(a.Product.ID, b.Product.ID) })
+		for _, product := range tenant.Products {
+			slices.SortFunc(product.Resources, func(a, b *ResourceNode) int { return strings.Compare(a.Resource.ID, b.Resource.ID) })
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+func testGraph() *Graph {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	status := func(state models.IdentifiedStateType, at time.Duration) models.DefaultIdentifiedStatus {
+		return models.DefaultIdentifiedStatus{Date: t0.Add(at), State: state}
+	}
+
+	return &Graph{Tenants: []*TenantNode{
+		{
+			Tenant: models.Tenant1{ID: "tenant_a", Name: "Alpha"},
+			Products: []*ProductNode{{
+				Product: models.Product{ID: "product_db", Name: "Databases", Tags: []string{"data"}},
+				Resources: []*ResourceNode{
+					{Resource: models.Resource{ID: "res_1", Name: "main", Kind: "postgresql", Statuses: []models.DefaultIdentifiedStatus{
+						status(models.IdentifiedStateTypeMODERATED, time.Hour),
+						status(models.IdentifiedStateTypePROVISIONED, 0),
+					}}},
+					{Resource: models.Resource{ID: "res_2", Name: "cache", Kind: "redis", Tags: []string{"prod"}, Statuses: []models.DefaultIdentifiedStatus{
+						status(models.IdentifiedStateTypePENDING, 0),
+						status(models.IdentifiedStateTypePROVISIONED, time.Hour),
+					}}},
+				},
+			}},
+		},
+		{
+			Tenant: models.Tenant1{ID: "tenant_b", Name: "Beta"},
+			Products: []*ProductNode{{
+				Product: models.Product{ID: "product_web", Name: "Web"},
+				Resources: []*ResourceNode{
+					{Resource: models.Resource{ID: "res_3", Name: "site", Kind: "static", Tags: []string{"prod"}}},
+				},
+			}},
+		},
+	}}
+}
+
+func resourceIDs(resources []*ResourceNode) string {
+	var parts []string
+	for _, resource := range resources {
+		parts = append(parts, resource.Resource.ID)
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestQueries(t *testing.T) {
+	g := testGraph()
+
+	tests := []struct {
+		name    string
+		filters []ResourceFilter
+		want    string
+	}{
+		{"all", nil, "res_1,res_2,res_3"},
+		{"failing", []ResourceFilter{Failing()}, "res_1"},
+		{"tagged across tenants", []ResourceFilter{Tagged("prod")}, "res_2,res_3"},
+		{"tagged through product", []ResourceFilter{Tagged("data")}, "res_1,res_2"},
+		{"combined", []ResourceFilter{Tagged("prod"), InTenant("tenant_b")}, "res_3"},
+		{"kind", []ResourceFilter{OfKind("redis"), InState(models.IdentifiedStateTypePROVISIONED)}, "res_2"},
+	}
+	for _, tt := range tests {
+		if got := resourceIDs(g.Resources(tt.filters...)); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	g := testGraph()
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	for _, want := range []string{
+		`"tenant:tenant_a" -> "product:product_db";`,
+		`"product:product_db" -> "resource:res_1";`,
+		`"resource:res_1" [shape=note, label="main\nres_1\nMODERATED", color=red];`,
+	} {
+		if !strings.Contains(dot.String(), want) {
+			t.Errorf("DOT output misses %s:\n%s", want, dot.String())
+		}
+	}
+
+	var data bytes.Buffer
+	if err := g.WriteJSON(&data); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded Graph
+	if err := json.Unmarshal(data.Bytes(), &decoded); err != nil {
+		t.Fatalf("JSON output does not decode: %v", err)
+	}
+	if got := resourceIDs(decoded.Resources()); got != "res_1,res_2,res_3" {
+		t.Errorf("decoded resources = %s", got)
+	}
+}
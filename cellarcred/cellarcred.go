@@ -0,0 +1,183 @@
+// Package cellarcred manages the S3 credentials of Cellar add-ons.
+//
+// Credentials are fetched and renewed through the Cellar API. The API's
+// credentials file endpoint returns no content, so the files S3 tools read
+// are rendered here instead: AWS shared credentials and config files, rclone
+// remotes, s3cmd configuration and environment variables.
+//
+// A Rotator renews the key and exports the new one. Renewing invalidates the
+// old key at once, so the Rotator first checks that its verifier accepts the
+// current key: a renewal is never started with a verifier that could not
+// confirm the new key.
+package cellarcred
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	cellar "go.clever-cloud.dev/sdk/services/cellar"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrNoVerifier is returned by Rotate when the Rotator has no Verifier
+var ErrNoVerifier = errors.New("cellarcred: no verifier to check the new key")
+
+// ErrNotVerified is returned when a renewed key is not accepted before the
+// verification timeout. The old key is invalid by then: the new one has
+// been exported anyway.
+var ErrNotVerified = errors.New("cellarcred: new key not accepted, the old key is already invalid")
+
+// Get returns the current credentials of a Cellar add-on
+func Get(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, cellarID ids.CellarID) (models.CellarCredentials, error) {
+	return payload(cellar.Getcellarcredentials(ctx, c, tracer, ownerID, cellarID))
+}
+
+// Renew replaces the key of a Cellar add-on and returns the new credentials
+func Renew(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, cellarID ids.CellarID) (models.CellarCredentials, error) {
+	return payload(cellar.Renewcellarcredentials(ctx, c, tracer, ownerID, cellarID))
+}
+
+func payload[T any](response client.Response[T]) (T, error) {
+	var zero T
+	if response.HasError() {
+		return zero, response.Error()
+	}
+	if response.Payload() == nil {
+		return zero, nil
+	}
+	return *response.Payload(), nil
+}
+
+// Verifier checks that credentials are accepted by Cellar
+type Verifier func(ctx context.Context, credentials models.CellarCredentials) error
+
+// Rotation is the outcome of a key rotation
+type Rotation struct {
+	Previous models.CellarCredentials
+	Next     models.CellarCredentials
+	// Verified reports whether the new key was accepted
+	Verified bool
+}
+
+// Rotator renews the key of a Cellar add-on
+type Rotator struct {
+	tracer   trace.Tracer
+	ownerID  ids.OwnerID
+	cellarID ids.CellarID
+
+	// Verify checks the current key before it is renewed, then the new key
+	Verify Verifier
+	// VerifyTimeout bounds how long the new key is retried, as it may take
+	// a moment to be accepted by every Cellar node
+	VerifyTimeout time.Duration
+	// VerifyInterval is the delay between verification attempts
+	VerifyInterval time.Duration
+	// Exporters write the new key where consumers read it
+	Exporters []Exporter
+
+	// API calls, replaced in tests
+	get   func(ctx context.Context) (models.CellarCredentials, error)
+	renew func(ctx context.Context) (models.CellarCredentials, error)
+}
+
+// NewRotator creates a Rotator for the key of a Cellar add-on. verify is
+// required, S3Verifier checks keys against Cellar itself.
+func NewRotator(c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, cellarID ids.CellarID, verify Verifier, exporters ...Exporter) *Rotator {
+	return &Rotator{
+		tracer:         tracer,
+		ownerID:        ownerID,
+		cellarID:       cellarID,
+		Verify:         verify,
+		VerifyTimeout:  time.Minute,
+		VerifyInterval: 2 * time.Second,
+		Exporters:      exporters,
+		get: func(ctx context.Context) (models.CellarCredentials, error) {
+			return Get(ctx, c, tracer, ownerID, cellarID)
+		},
+		renew: func(ctx context.Context) (models.CellarCredentials, error) {
+			return Renew(ctx, c, tracer, ownerID, cellarID)
+		},
+	}
+}
+
+// Rotate checks that the current key is accepted, renews it and verifies the
+// new key, retrying until VerifyTimeout, then exports it. The old key is
+// invalid once renewed, so if verification fails the new key is exported
+// anyway and ErrNotVerified is returned for it to be looked into.
+func (r *Rotator) Rotate(ctx context.Context) (Rotation, error) {
+	ctx, span := r.tracer.Start(ctx, "cellarcred.Rotate", trace.WithAttributes(
+		attribute.String("ownerId", string(r.ownerID)),
+		attribute.String("cellarId", string(r.cellarID)),
+	))
+	defer span.End()
+
+	var rotation Rotation
+	if r.Verify == nil {
+		span.RecordError(ErrNoVerifier)
+		return rotation, ErrNoVerifier
+	}
+
+	previous, err := r.get(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return rotation, err
+	}
+	rotation.Previous = previous
+
+	// A verifier rejecting the current key would reject the new one too,
+	// after the current one is gone
+	if err := r.Verify(ctx, previous); err != nil {
+		err = fmt.Errorf("cellarcred: current key not accepted, not renewing it: %w", err)
+		span.RecordError(err)
+		return rotation, err
+	}
+
+	next, err := r.renew(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return rotation, err
+	}
+	rotation.Next = next
+	span.SetAttributes(attribute.String("keyId", next.KeyID))
+
+	var errs []error
+	if err := r.verify(ctx, next); err != nil {
+		errs = append(errs, err)
+	}
+	rotation.Verified = len(errs) == 0
+
+	for _, export := range r.Exporters {
+		if err := export(next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		return rotation, err
+	}
+	return rotation, nil
+}
+
+// verify retries Verify until it succeeds or VerifyTimeout elapses
+func (r *Rotator) verify(ctx context.Context, credentials models.CellarCredentials) error {
+	ctx, cancel := context.WithTimeout(ctx, r.VerifyTimeout)
+	defer cancel()
+
+	for {
+		err := r.Verify(ctx, credentials)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrNotVerified, err)
+		case <-time.After(r.VerifyInterval):
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package cellarcred
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var (
+	oldKey = models.CellarCredentials{Host: "cellar-c2.services.clever-cloud.com", KeyID: "OLD", KeySecret: "old/secret"}
+	newKey = models.CellarCredentials{Host: "cellar-c2.services.clever-cloud.com", KeyID: "NEW", KeySecret: "new+secret"}
+)
+
+func TestSetINI(t *testing.T) {
+	data := []byte("# shared\n[default]\naws_access_key_id = A\n\n[cellar]\n; comment\naws_access_key_id = OLD\nregion = us-east-1\n\n[other]\nx = 1\n")
+	got := string(setINI(data, "cellar", awsCredentialsSettings(newKey)))
+	want := "# shared\n[default]\naws_access_key_id = A\n\n[cellar]\n; comment\naws_access_key_id = NEW\nregion = us-east-1\naws_secret_access_key = new+secret\n\n[other]\nx = 1\n"
+	if got != want {
+		t.Errorf("updated section:\n%s\nwant:\n%s", got, want)
+	}
+
+	got = string(setINI([]byte("[default]\nx = 1"), "profile cellar", awsConfigSettings(newKey)))
+	want = "[default]\nx = 1\n\n[profile cellar]\nendpoint_url = https://cellar-c2.services.clever-cloud.com\n"
+	if got != want {
+		t.Errorf("added section:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSetEnv(t *testing.T) {
+	data := []byte("# app\nPORT=8080\nexport AWS_ACCESS_KEY_ID=OLD\n")
+	got := string(setEnv(data, []setting{{"AWS_ACCESS_KEY_ID", "NEW"}, {"AWS_SECRET_ACCESS_KEY", "a b'c"}}))
+	want := "# app\nPORT=8080\nexport AWS_ACCESS_KEY_ID=NEW\nAWS_SECRET_ACCESS_KEY='a b'\\''c'\n"
+	if got != want {
+		t.Errorf("dotenv:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	if got := S3cmdConfig(newKey); !strings.Contains(got, "host_bucket = %(bucket)s.cellar-c2.services.clever-cloud.com\n") || !strings.Contains(got, "secret_key = new+secret\n") {
+		t.Errorf("s3cmd config:\n%s", got)
+	}
+	if got := RcloneConfig(newKey, "cellar"); !strings.HasPrefix(got, "[cellar]\ntype = s3\nprovider = Other\n") {
+		t.Errorf("rclone config:\n%s", got)
+	}
+	if got := AWSConfig(newKey, "default"); !strings.HasPrefix(got, "[default]\n") {
+		t.Errorf("default AWS profile:\n%s", got)
+	}
+	env := Env(newKey)
+	if env[0] != "CELLAR_ADDON_HOST=cellar-c2.services.clever-cloud.com" || env[5] != "AWS_ENDPOINT_URL_S3=https://cellar-c2.services.clever-cloud.com" {
+		t.Errorf("env = %q", env)
+	}
+}
+
+func TestAWSExporter(t *testing.T) {
+	dir := t.TempDir()
+	credentials, config := filepath.Join(dir, "aws", "credentials"), filepath.Join(dir, "aws", "config")
+	if err := AWSExporter(credentials, config, "cellar")(newKey); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(credentials)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("credentials file: %v, %v", info, err)
+	}
+	data, _ := os.ReadFile(credentials)
+	if string(data) != AWSCredentials(newKey, "cellar") {
+		t.Errorf("credentials file:\n%s", data)
+	}
+	data, _ = os.ReadFile(config)
+	if !strings.HasPrefix(string(data), "[profile cellar]\n") {
+		t.Errorf("config file:\n%s", data)
+	}
+}
+
+func rotator(verify Verifier, exporters ...Exporter) *Rotator {
+	r := NewRotator(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "cellar_1", verify, exporters...)
+	r.VerifyTimeout, r.VerifyInterval = 50*time.Millisecond, time.Millisecond
+	r.get = func(ctx context.Context) (models.CellarCredentials, error) { return oldKey, nil }
+	r.renew = func(ctx context.Context) (models.CellarCredentials, error) { return newKey, nil }
+	return r
+}
+
+func TestRotate(t *testing.T) {
+	attempts := 0
+	verify := func(ctx context.Context, c models.CellarCredentials) error {
+		if c.KeyID == "OLD" {
+			return nil
+		}
+		if attempts++; attempts < 3 {
+			return errors.New("InvalidAccessKeyId")
+		}
+		return nil
+	}
+	var exported []string
+	export := func(c models.CellarCredentials) error {
+		exported = append(exported, c.KeyID)
+		return nil
+	}
+
+	rotation, err := rotator(verify, export).Rotate(context.Background())
+	if err != nil || !rotation.Verified || rotation.Previous.KeyID != "OLD" || rotation.Next.KeyID != "NEW" {
+		t.Fatalf("Rotate = %+v, %v", rotation, err)
+	}
+	if attempts != 3 || len(exported) != 1 || exported[0] != "NEW" {
+		t.Errorf("%d verification attempts, exported %q", attempts, exported)
+	}
+}
+
+func TestRotateNotVerified(t *testing.T) {
+	verify := func(ctx context.Context, c models.CellarCredentials) error {
+		if c.KeyID == "NEW" {
+			return errors.New("SignatureDoesNotMatch")
+		}
+		return nil
+	}
+	var exported []string
+	export := func(c models.CellarCredentials) error {
+		exported = append(exported, c.KeyID)
+		return nil
+	}
+
+	rotation, err := rotator(verify, export).Rotate(context.Background())
+	if !errors.Is(err, ErrNotVerified) || rotation.Verified {
+		t.Fatalf("Rotate = %+v, %v, want ErrNotVerified", rotation, err)
+	}
+	if len(exported) != 1 || exported[0] != "NEW" {
+		t.Errorf("exported %q: the old key is invalid, the new one must replace it", exported)
+	}
+}
+
+func TestRotateChecksCurrentKey(t *testing.T) {
+	renewed := false
+	verify := func(ctx context.Context, c models.CellarCredentials) error {
+		return errors.New("connection refused")
+	}
+
+	r := rotator(verify)
+	r.renew = func(ctx context.Context) (models.CellarCredentials, error) {
+		renewed = true
+		return newKey, nil
+	}
+	if _, err := r.Rotate(context.Background()); err == nil || renewed {
+		t.Errorf("Rotate = %v, renewed = %v: a verifier rejecting the current key must stop the renewal", err, renewed)
+	}
+
+	if _, err := rotator(nil).Rotate(context.Background()); !errors.Is(err, ErrNoVerifier) {
+		t.Errorf("Rotate without verifier = %v, want ErrNoVerifier", err)
+	}
+}
+
+func TestSignV4(t *testing.T) {
+	// get-vanilla from the AWS Signature Version 4 test suite
+	req, _ := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	signV4(req, "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "us-east-1", "service", emptyHash, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %s\nwant %s", got, want)
+	}
+}
@@ -0,0 +1,230 @@
+package cellarcred
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// Endpoint returns the HTTPS URL of the Cellar host
+func Endpoint(credentials models.CellarCredentials) string {
+	if strings.Contains(credentials.Host, "://") {
+		return credentials.Host
+	}
+	return "https://" + credentials.Host
+}
+
+// host returns the Cellar host without a scheme
+func host(credentials models.CellarCredentials) string {
+	if _, h, ok := strings.Cut(credentials.Host, "://"); ok {
+		return strings.TrimSuffix(h, "/")
+	}
+	return credentials.Host
+}
+
+// Env returns the variables Cellar add-ons expose to applications, along
+// with the ones AWS SDKs and CLIs read, as KEY=value pairs
+func Env(credentials models.CellarCredentials) []string {
+	var env []string
+	for _, s := range envSettings(credentials) {
+		env = append(env, s.key+"="+s.value)
+	}
+	return env
+}
+
+func envSettings(credentials models.CellarCredentials) []setting {
+	return []setting{
+		{"CELLAR_ADDON_HOST", host(credentials)},
+		{"CELLAR_ADDON_KEY_ID", credentials.KeyID},
+		{"CELLAR_ADDON_KEY_SECRET", credentials.KeySecret},
+		{"AWS_ACCESS_KEY_ID", credentials.KeyID},
+		{"AWS_SECRET_ACCESS_KEY", credentials.KeySecret},
+		{"AWS_ENDPOINT_URL_S3", Endpoint(credentials)},
+	}
+}
+
+// WriteShellEnv writes the variables of Env as shell export statements
+func WriteShellEnv(w io.Writer, credentials models.CellarCredentials) error {
+	for _, s := range envSettings(credentials) {
+		if _, err := fmt.Fprintf(w, "export %s=%s\n", s.key, quoteEnv(s.value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AWSCredentials returns the section of a profile in an AWS shared credentials file
+func AWSCredentials(credentials models.CellarCredentials, profile string) string {
+	return string(setINI(nil, profile, awsCredentialsSettings(credentials)))
+}
+
+// AWSConfig returns the section of a profile in an AWS config file
+func AWSConfig(credentials models.CellarCredentials, profile string) string {
+	return string(setINI(nil, awsConfigSection(profile), awsConfigSettings(credentials)))
+}
+
+func awsCredentialsSettings(credentials models.CellarCredentials) []setting {
+	return []setting{
+		{"aws_access_key_id", credentials.KeyID},
+		{"aws_secret_access_key", credentials.KeySecret},
+	}
+}
+
+func awsConfigSettings(credentials models.CellarCredentials) []setting {
+	return []setting{{"endpoint_url", Endpoint(credentials)}}
+}
+
+// awsConfigSection returns the section of a profile in the config file,
+// where profiles but the default one are prefixed
+func awsConfigSection(profile string) string {
+	if profile == "default" {
+		return profile
+	}
+	return "profile " + profile
+}
+
+// RcloneConfig returns the section of an S3 remote in an rclone config file
+func RcloneConfig(credentials models.CellarCredentials, remote string) string {
+	return string(setINI(nil, remote, rcloneSettings(credentials)))
+}
+
+func rcloneSettings(credentials models.CellarCredentials) []setting {
+	return []setting{
+		{"type", "s3"},
+		{"provider", "Other"},
+		{"access_key_id", credentials.KeyID},
+		{"secret_access_key", credentials.KeySecret},
+		{"endpoint", Endpoint(credentials)},
+	}
+}
+
+// S3cmdConfig returns an s3cmd .s3cfg file
+func S3cmdConfig(credentials models.CellarCredentials) string {
+	return string(setINI(nil, "default", s3cmdSettings(credentials)))
+}
+
+func s3cmdSettings(credentials models.CellarCredentials) []setting {
+	return []setting{
+		{"access_key", credentials.KeyID},
+		{"secret_key", credentials.KeySecret},
+		{"host_base", host(credentials)},
+		{"host_bucket", "%(bucket)s." + host(credentials)},
+		{"use_https", "True"},
+	}
+}
+
+// Exporter writes credentials where consumers read them
+type Exporter func(models.CellarCredentials) error
+
+// AWSExporter sets a profile in an AWS shared credentials file and config
+// file, keeping their other profiles and settings. Empty paths default to
+// the ones the AWS CLI uses.
+func AWSExporter(credentialsPath, configPath, profile string) Exporter {
+	return func(credentials models.CellarCredentials) error {
+		credentialsPath, err := pathOr(credentialsPath, "AWS_SHARED_CREDENTIALS_FILE", ".aws", "credentials")
+		if err != nil {
+			return err
+		}
+		configPath, err := pathOr(configPath, "AWS_CONFIG_FILE", ".aws", "config")
+		if err != nil {
+			return err
+		}
+		if err := updateFile(credentialsPath, func(data []byte) []byte {
+			return setINI(data, profile, awsCredentialsSettings(credentials))
+		}); err != nil {
+			return err
+		}
+		return updateFile(configPath, func(data []byte) []byte {
+			return setINI(data, awsConfigSection(profile), awsConfigSettings(credentials))
+		})
+	}
+}
+
+// RcloneExporter sets an S3 remote in an rclone config file, keeping its
+// other remotes. An empty path defaults to the one rclone uses.
+func RcloneExporter(path, remote string) Exporter {
+	return func(credentials models.CellarCredentials) error {
+		path, err := pathOr(path, "RCLONE_CONFIG", ".config", "rclone", "rclone.conf")
+		if err != nil {
+			return err
+		}
+		return updateFile(path, func(data []byte) []byte {
+			return setINI(data, remote, rcloneSettings(credentials))
+		})
+	}
+}
+
+// S3cmdExporter sets the credentials and host of an s3cmd config file,
+// keeping its other settings. An empty path defaults to ~/.s3cfg.
+func S3cmdExporter(path string) Exporter {
+	return func(credentials models.CellarCredentials) error {
+		path, err := pathOr(path, "S3CMD_CONFIG", ".s3cfg")
+		if err != nil {
+			return err
+		}
+		return updateFile(path, func(data []byte) []byte {
+			return setINI(data, "default", s3cmdSettings(credentials))
+		})
+	}
+}
+
+// EnvFileExporter sets the variables of Env in a dotenv file, keeping its
+// other lines
+func EnvFileExporter(path string) Exporter {
+	return func(credentials models.CellarCredentials) error {
+		return updateFile(path, func(data []byte) []byte {
+			return setEnv(data, envSettings(credentials))
+		})
+	}
+}
+
+// pathOr returns path, or the file named by an environment variable, or a
+// file under the home directory
+func pathOr(path, variable string, home ...string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	if path = os.Getenv(variable); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append([]string{dir}, home...)...), nil
+}
+
+// updateFile rewrites a file readable by its owner only, atomically
+func updateFile(path string, update func([]byte) []byte) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(update(data)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
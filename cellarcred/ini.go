@@ -0,0 +1,133 @@
+package cellarcred
+
+import (
+	"bytes"
+	"strings"
+)
+
+// setting is a key and its value in a configuration file
+type setting struct {
+	key   string
+	value string
+}
+
+// setINI sets keys of an INI section, keeping the other sections, keys and
+// comments as they are. Missing keys are added at the end of the section,
+// and a missing section at the end of the file.
+func setINI(data []byte, section string, settings []setting) []byte {
+	lines := splitLines(data)
+	done := make([]bool, len(settings))
+
+	start, end := -1, len(lines)
+	for i, line := range lines {
+		name, ok := sectionName(line)
+		if !ok {
+			continue
+		}
+		if start >= 0 {
+			end = i
+			break
+		}
+		if name == section {
+			start = i
+		}
+	}
+
+	if start < 0 {
+		if len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) != "" {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "["+section+"]")
+		for _, s := range settings {
+			lines = append(lines, s.key+" = "+s.value)
+		}
+		return joinLines(lines)
+	}
+
+	for i := start + 1; i < end; i++ {
+		key, _, ok := strings.Cut(lines[i], "=")
+		if !ok || isComment(lines[i]) {
+			continue
+		}
+		for j, s := range settings {
+			if strings.TrimSpace(key) == s.key {
+				lines[i], done[j] = s.key+" = "+s.value, true
+			}
+		}
+	}
+
+	// Insert missing keys after the last non-blank line of the section
+	at := end
+	for at > start+1 && strings.TrimSpace(lines[at-1]) == "" {
+		at--
+	}
+	var missing []string
+	for j, s := range settings {
+		if !done[j] {
+			missing = append(missing, s.key+" = "+s.value)
+		}
+	}
+	lines = append(lines[:at], append(missing, lines[at:]...)...)
+	return joinLines(lines)
+}
+
+// setEnv sets variables of a dotenv file, keeping the other lines
+func setEnv(data []byte, settings []setting) []byte {
+	lines := splitLines(data)
+	done := make([]bool, len(settings))
+	for i, line := range lines {
+		assignment, export := strings.CutPrefix(strings.TrimSpace(line), "export ")
+		key, _, ok := strings.Cut(assignment, "=")
+		if !ok || isComment(line) {
+			continue
+		}
+		for j, s := range settings {
+			if strings.TrimSpace(key) != s.key {
+				continue
+			}
+			lines[i], done[j] = s.key+"="+quoteEnv(s.value), true
+			if export {
+				lines[i] = "export " + lines[i]
+			}
+		}
+	}
+	for j, s := range settings {
+		if !done[j] {
+			lines = append(lines, s.key+"="+quoteEnv(s.value))
+		}
+	}
+	return joinLines(lines)
+}
+
+// quoteEnv single-quotes values that a shell or a dotenv parser would change
+func quoteEnv(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\"'`$\\#;&|<>(){}*?[]~!") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func sectionName(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+		return "", false
+	}
+	return strings.TrimSpace(line[1 : len(line)-1]), true
+}
+
+func isComment(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";")
+}
+
+func splitLines(data []byte) []string {
+	text := strings.TrimRight(string(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))), "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+func joinLines(lines []string) []byte {
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,131 @@
+package cellarcred
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// emptyHash is the SHA-256 of an empty payload
+const emptyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// S3Verifier checks credentials by listing the buckets of the key with a
+// signed S3 request. A nil client means http.DefaultClient.
+func S3Verifier(httpClient *http.Client) Verifier {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return func(ctx context.Context, credentials models.CellarCredentials) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, Endpoint(credentials)+"/", nil)
+		if err != nil {
+			return err
+		}
+		signV4(req, credentials.KeyID, credentials.KeySecret, "us-east-1", "s3", emptyHash, time.Now())
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return fmt.Errorf("cellarcred: verification request returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+		}
+		return nil
+	}
+}
+
+// signV4 signs a request with AWS Signature Version 4, covering the host and
+// x-amz-* headers
+func signV4(req *http.Request, keyID, secret, region, service, payloadHash string, now time.Time) {
+	now = now.UTC()
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	if service == "s3" {
+		req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		if name = strings.ToLower(name); strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format("20060102T150405Z") + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	for _, part := range []string{region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", keyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the query parameters sorted by name, then value
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	var pairs []string
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name)+"="+uriEncode(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes everything but unreserved characters
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~", c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
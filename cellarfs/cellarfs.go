@@ -0,0 +1,406 @@
+// Package cellarfs reads and writes Cellar objects without an S3 client.
+//
+// A Store lists, stats, reads, writes, copies, moves and deletes objects of a
+// Cellar add-on. Listings and metadata go through the Cellar API; contents go
+// through the presigned download and upload URLs it issues. Objects open as
+// files that read with range requests and support Seek and ReadAt, and FS
+// adapts a bucket to io/fs so that key prefixes walk like directories.
+package cellarfs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	cellar "go.clever-cloud.dev/sdk/services/cellar"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrChanged is returned when an object is replaced while it is being read
+var ErrChanged = errors.New("cellarfs: object changed while reading")
+
+// Location is an object in a bucket
+type Location struct {
+	Bucket string
+	Key    string
+}
+
+func (l Location) String() string {
+	return l.Bucket + "/" + l.Key
+}
+
+// Store accesses the buckets of one Cellar add-on
+type Store struct {
+	client   *client.Client
+	tracer   trace.Tracer
+	ownerID  ids.OwnerID
+	cellarID ids.CellarID
+
+	httpClient  *http.Client
+	urlExpiry   time.Duration
+	concurrency int
+	pageSize    int
+	metadata    bool
+
+	// API calls, replaced in tests
+	list         func(ctx context.Context, bucket, prefix, cursor string) (models.ListObjectsResponse, error)
+	stat         func(ctx context.Context, bucket, key string) (models.CellarObjectDetails, error)
+	signDownload func(ctx context.Context, bucket, key string) (models.SignedUrlResponse, error)
+	signUpload   func(ctx context.Context, bucket, key string) (models.SignedUrlResponse, error)
+	remove       func(ctx context.Context, bucket, key string) error
+}
+
+// Option defines configuration options for the Store
+type Option func(*Store)
+
+// WithHTTPClient sets the HTTP client used on presigned URLs
+func WithHTTPClient(h *http.Client) Option {
+	return func(s *Store) {
+		s.httpClient = h
+	}
+}
+
+// WithURLExpiry sets how long the presigned URLs issued for the Store are valid
+func WithURLExpiry(d time.Duration) Option {
+	return func(s *Store) {
+		s.urlExpiry = d
+	}
+}
+
+// WithConcurrency sets how many objects RemoveAll deletes at once
+func WithConcurrency(n int) Option {
+	return func(s *Store) {
+		s.concurrency = n
+	}
+}
+
+// WithPageSize sets how many entries are asked for per listing page
+func WithPageSize(n int) Option {
+	return func(s *Store) {
+		s.pageSize = n
+	}
+}
+
+// WithMetadata makes listings include the user-defined metadata of objects
+func WithMetadata() Option {
+	return func(s *Store) {
+		s.metadata = true
+	}
+}
+
+// New creates a Store for a Cellar add-on
+func New(c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, cellarID ids.CellarID, opts ...Option) *Store {
+	s := &Store{
+		client:      c,
+		tracer:      tracer,
+		ownerID:     ownerID,
+		cellarID:    cellarID,
+		httpClient:  http.DefaultClient,
+		urlExpiry:   15 * time.Minute,
+		concurrency: 8,
+		pageSize:    1000,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.concurrency = max(s.concurrency, 1)
+
+	s.list = func(ctx context.Context, bucket, prefix, cursor string) (models.ListObjectsResponse, error) {
+		opts := []cellar.Option{cellar.WithCount(s.pageSize)}
+		if prefix != "" {
+			opts = append(opts, cellar.WithPrefix(prefix))
+		}
+		if cursor != "" {
+			opts = append(opts, cellar.WithCursor(cursor))
+		}
+		if s.metadata {
+			opts = append(opts, cellar.WithWithmetadata(true))
+		}
+		return payload(cellar.Getcellarbucketobjects(ctx, s.client, s.tracer, s.ownerID, s.cellarID, bucket, opts...))
+	}
+	s.stat = func(ctx context.Context, bucket, key string) (models.CellarObjectDetails, error) {
+		return payload(cellar.Getcellarbucketobject(ctx, s.client, s.tracer, s.ownerID, s.cellarID, bucket, key))
+	}
+	s.signDownload = func(ctx context.Context, bucket, key string) (models.SignedUrlResponse, error) {
+		return payload(cellar.Createdownloadurl(ctx, s.client, s.tracer, s.ownerID, s.cellarID, bucket, s.signedURLRequest(key)))
+	}
+	s.signUpload = func(ctx context.Context, bucket, key string) (models.SignedUrlResponse, error) {
+		return payload(cellar.Createuploadurl(ctx, s.client, s.tracer, s.ownerID, s.cellarID, bucket, s.signedURLRequest(key)))
+	}
+	s.remove = func(ctx context.Context, bucket, key string) error {
+		_, err := payload(cellar.Deletecellarbucketobject(ctx, s.client, s.tracer, s.ownerID, s.cellarID, bucket, key))
+		return err
+	}
+	return s
+}
+
+func (s *Store) signedURLRequest(key string) *models.SignedUrlRequest {
+	expiresIn := int(s.urlExpiry / time.Second)
+	return &models.SignedUrlRequest{ObjectKey: key, ExpiresIn: &expiresIn}
+}
+
+// payload returns the payload of a response, with fs.ErrNotExist for 404s
+func payload[T any](response client.Response[T]) (T, error) {
+	var zero T
+	if response.HasError() {
+		if response.IsNotFoundError() {
+			return zero, fmt.Errorf("%w: %w", fs.ErrNotExist, response.Error())
+		}
+		return zero, response.Error()
+	}
+	if response.Payload() == nil {
+		return zero, nil
+	}
+	return *response.Payload(), nil
+}
+
+// Buckets lists the buckets of the add-on
+func (s *Store) Buckets(ctx context.Context) ([]models.BucketInfo, error) {
+	response, err := payload(cellar.Listcellarbuckets(ctx, s.client, s.tracer, s.ownerID, s.cellarID))
+	return response.Buckets, err
+}
+
+// CreateBucket creates a bucket
+func (s *Store) CreateBucket(ctx context.Context, name string, versioning bool) (models.Bucket, error) {
+	return payload(cellar.Createcellarbucket(ctx, s.client, s.tracer, s.ownerID, s.cellarID, &models.WannabeBucket{Name: name, Versioning: versioning}))
+}
+
+// List returns the objects and the directories right under a prefix, going
+// through every page. A prefix ending with a slash lists a directory.
+func (s *Store) List(ctx context.Context, bucket, prefix string) ([]models.CellarObject, []models.CellarDirectory, error) {
+	var (
+		objects     []models.CellarObject
+		directories []models.CellarDirectory
+		cursor      string
+	)
+	for {
+		page, err := s.list(ctx, bucket, prefix, cursor)
+		if err != nil {
+			return objects, directories, err
+		}
+		objects = append(objects, page.Content...)
+		directories = append(directories, page.Directories...)
+		if page.Cursor == nil || *page.Cursor == "" || *page.Cursor == cursor {
+			return objects, directories, nil
+		}
+		cursor = *page.Cursor
+	}
+}
+
+// Walk calls fn for every object whose key starts with prefix, descending
+// into directories. It stops at the first error fn returns.
+func (s *Store) Walk(ctx context.Context, bucket, prefix string, fn func(models.CellarObject) error) error {
+	objects, directories, err := s.List(ctx, bucket, prefix)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err := fn(object); err != nil {
+			return err
+		}
+	}
+	for _, directory := range directories {
+		if directory.Key == prefix {
+			continue
+		}
+		if err := s.Walk(ctx, bucket, directory.Key, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stat returns the details of an object. Missing objects give fs.ErrNotExist.
+func (s *Store) Stat(ctx context.Context, bucket, key string) (models.CellarObjectDetails, error) {
+	return s.stat(ctx, bucket, key)
+}
+
+// Put uploads an object. size is the length of body, or -1 when unknown, in
+// which case body is read in memory first as presigned uploads need a length.
+// contentType is sent when not empty.
+func (s *Store) Put(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string) error {
+	return s.PutWithMetadata(ctx, bucket, key, body, size, contentType, nil)
+}
+
+// PutWithMetadata uploads an object like Put, with user-defined metadata
+// sent as x-amz-meta-* headers
+func (s *Store) PutWithMetadata(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string, metadata map[string]string) error {
+	ctx, span := s.tracer.Start(ctx, "cellarfs.Put", trace.WithAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("key", key),
+		attribute.Int64("size", size),
+	))
+	defer span.End()
+
+	err := s.put(ctx, bucket, key, body, size, contentType, metadata)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (s *Store) put(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string, metadata map[string]string) error {
+	signed, err := s.signUpload(ctx, bucket, key)
+	if err != nil {
+		return fmt.Errorf("cellarfs: sign upload of %s/%s: %w", bucket, key, err)
+	}
+	if body == nil {
+		body, size = http.NoBody, 0
+	}
+	if size < 0 {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("cellarfs: read %s/%s: %w", bucket, key, err)
+		}
+		body, size = bytes.NewReader(data), int64(len(data))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, signed.URL, io.LimitReader(body, size))
+	if err != nil {
+		return err
+	}
+	// A zero ContentLength only means empty with http.NoBody
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for name, value := range metadata {
+		req.Header.Set("X-Amz-Meta-"+name, value)
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cellarfs: upload %s/%s: %w", bucket, key, err)
+	}
+	defer res.Body.Close()
+	return checkStatus(res, http.MethodPut, Location{bucket, key})
+}
+
+// Delete deletes an object. Missing objects give fs.ErrNotExist.
+func (s *Store) Delete(ctx context.Context, bucket, key string) error {
+	if err := s.remove(ctx, bucket, key); err != nil {
+		return fmt.Errorf("cellarfs: delete %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
+
+// Copy copies an object, possibly to another bucket, streaming it through
+// the presigned URLs. The content type of the source is kept.
+func (s *Store) Copy(ctx context.Context, src, dst Location) error {
+	ctx, span := s.tracer.Start(ctx, "cellarfs.Copy", trace.WithAttributes(
+		attribute.String("src", src.String()),
+		attribute.String("dst", dst.String()),
+	))
+	defer span.End()
+
+	err := s.copy(ctx, src, dst)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (s *Store) copy(ctx context.Context, src, dst Location) error {
+	if src == dst {
+		return nil
+	}
+	object, err := s.Open(ctx, src.Bucket, src.Key)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+	return s.put(ctx, dst.Bucket, dst.Key, object, object.Size(), object.Details().ContentType, nil)
+}
+
+// Move copies an object then deletes the source
+func (s *Store) Move(ctx context.Context, src, dst Location) error {
+	if src == dst {
+		return nil
+	}
+	if err := s.Copy(ctx, src, dst); err != nil {
+		return err
+	}
+	return s.Delete(ctx, src.Bucket, src.Key)
+}
+
+// RemoveAll deletes an object and every object under it as a directory, the
+// way os.RemoveAll does, with bounded concurrency. An empty name empties the
+// bucket. Objects already gone are not errors.
+func (s *Store) RemoveAll(ctx context.Context, bucket, name string) error {
+	ctx, span := s.tracer.Start(ctx, "cellarfs.RemoveAll", trace.WithAttributes(
+		attribute.String("bucket", bucket),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	var keys []string
+	prefix := ""
+	if name = strings.TrimSuffix(name, "/"); name != "" {
+		keys = append(keys, name)
+		prefix = name + "/"
+	}
+	err := s.Walk(ctx, bucket, prefix, func(object models.CellarObject) error {
+		keys = append(keys, object.Key)
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	span.SetAttributes(attribute.Int("objects", len(keys)))
+
+	var (
+		mu    sync.Mutex
+		errs  []error
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, s.concurrency)
+	)
+	for _, key := range keys {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := s.Delete(ctx, bucket, key); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	err = errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+// checkStatus turns a failed response on a presigned URL into an error
+func checkStatus(res *http.Response, method string, location Location) error {
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode <= 299:
+		return nil
+	case res.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("cellarfs: %s %s: %w", method, location, fs.ErrNotExist)
+	case res.StatusCode == http.StatusPreconditionFailed:
+		return fmt.Errorf("%w: %s", ErrChanged, location)
+	}
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("cellarfs: %s %s: unexpected status %s: %s", method, location, res.Status, body)
+}
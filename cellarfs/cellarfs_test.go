@@ -0,0 +1,277 @@
+package cellarfs
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var modTime = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+// fakeCellar keeps buckets in memory and serves presigned URLs over HTTP
+type fakeCellar struct {
+	mu      sync.Mutex
+	objects map[string]map[string][]byte
+	server  *httptest.Server
+	ranges  int
+}
+
+func newFakeCellar(t *testing.T, objects map[string]map[string]string) *fakeCellar {
+	f := &fakeCellar{objects: map[string]map[string][]byte{}}
+	for bucket, keys := range objects {
+		f.objects[bucket] = map[string][]byte{}
+		for key, content := range keys {
+			f.objects[bucket][key] = []byte(content)
+		}
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func etag(content []byte) string {
+	return fmt.Sprintf("%x", md5.Sum(content))
+}
+
+func (f *fakeCellar) serve(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	// Copies stream the body of a PUT from a GET, read it before locking
+	body, _ := io.ReadAll(r.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		content, ok := f.objects[bucket][key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("Range") != "" {
+			f.ranges++
+		}
+		w.Header().Set("ETag", strconv.Quote(etag(content)))
+		http.ServeContent(w, r, key, modTime, bytes.NewReader(content))
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			http.Error(w, "length required", http.StatusLengthRequired)
+			return
+		}
+		if f.objects[bucket] == nil {
+			f.objects[bucket] = map[string][]byte{}
+		}
+		f.objects[bucket][key] = body
+	}
+}
+
+func (f *fakeCellar) store(opts ...Option) *Store {
+	s := New(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "cellar_1", append([]Option{WithPageSize(2)}, opts...)...)
+	s.list = f.list
+	s.stat = f.stat
+	s.signDownload = f.sign
+	s.signUpload = f.sign
+	s.remove = f.remove
+	return s
+}
+
+func (f *fakeCellar) list(ctx context.Context, bucket, prefix, cursor string) (models.ListObjectsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Entries are listed one level deep in key order, two per page
+	type entry struct {
+		key string
+		dir bool
+	}
+	var entries []entry
+	for key := range f.objects[bucket] {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			e := entry{prefix + rest[:i+1], true}
+			if !slices.Contains(entries, e) {
+				entries = append(entries, e)
+			}
+			continue
+		}
+		entries = append(entries, entry{key, false})
+	}
+	slices.SortFunc(entries, func(a, b entry) int { return strings.Compare(a.key, b.key) })
+
+	start := 0
+	if cursor != "" {
+		start, _ = strconv.Atoi(cursor)
+	}
+	end := min(start+2, len(entries))
+	var page models.ListObjectsResponse
+	for _, e := range entries[start:end] {
+		if e.dir {
+			page.Directories = append(page.Directories, models.CellarDirectory{Key: e.key})
+			continue
+		}
+		content := f.objects[bucket][e.key]
+		page.Content = append(page.Content, models.CellarObject{Key: e.key, ContentLength: len(content), ETag: etag(content), UpdatedAt: modTime})
+	}
+	if end < len(entries) {
+		next := strconv.Itoa(end)
+		page.Cursor = &next
+	}
+	return page, nil
+}
+
+func (f *fakeCellar) stat(ctx context.Context, bucket, key string) (models.CellarObjectDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	content, ok := f.objects[bucket][key]
+	if !ok {
+		return models.CellarObjectDetails{}, fmt.Errorf("%w: %s", fs.ErrNotExist, key)
+	}
+	return models.CellarObjectDetails{Key: key, ContentLength: len(content), ETag: etag(content), UpdatedAt: modTime, ContentType: "text/plain"}, nil
+}
+
+func (f *fakeCellar) sign(ctx context.Context, bucket, key string) (models.SignedUrlResponse, error) {
+	return models.SignedUrlResponse{URL: f.server.URL + "/" + bucket + "/" + key, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (f *fakeCellar) remove(ctx context.Context, bucket, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.objects[bucket][key]; !ok {
+		return fs.ErrNotExist
+	}
+	delete(f.objects[bucket], key)
+	return nil
+}
+
+func (f *fakeCellar) keys(bucket string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Sorted(maps.Keys(f.objects[bucket]))
+}
+
+func TestFS(t *testing.T) {
+	cellar := newFakeCellar(t, map[string]map[string]string{
+		"site": {
+			"index.html":          "<h1>hello</h1>",
+			"assets/app.js":       "console.log('hello')",
+			"assets/app.css":      "body{}",
+			"assets/img/logo.svg": "<svg/>",
+			"robots.txt":          "",
+		},
+	})
+	fsys := cellar.store().FS(context.Background(), "site")
+
+	if err := fstest.TestFS(fsys, "index.html", "assets/app.js", "assets/img/logo.svg", "robots.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	var walked []string
+	err := fs.WalkDir(fsys, "assets", func(path string, d fs.DirEntry, err error) error {
+		walked = append(walked, path)
+		return err
+	})
+	if err != nil || !slices.Equal(walked, []string{"assets", "assets/app.css", "assets/app.js", "assets/img", "assets/img/logo.svg"}) {
+		t.Errorf("walked %q, %v", walked, err)
+	}
+
+	if _, err := fsys.Open("missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("open missing error = %v", err)
+	}
+}
+
+func TestObjectRanges(t *testing.T) {
+	cellar := newFakeCellar(t, map[string]map[string]string{"data": {"digits": "0123456789"}})
+	store := cellar.store()
+	ctx := context.Background()
+
+	object, err := store.Open(ctx, "data", "digits")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer object.Close()
+
+	p := make([]byte, 3)
+	if n, err := object.ReadAt(p, 4); n != 3 || err != nil || string(p) != "456" {
+		t.Errorf("ReadAt = %d, %v, %q", n, err, p)
+	}
+	if n, err := object.ReadAt(p, 8); n != 2 || err != io.EOF || string(p[:n]) != "89" {
+		t.Errorf("ReadAt past the end = %d, %v, %q", n, err, p[:n])
+	}
+
+	if _, err := object.Seek(-4, io.SeekEnd); err != nil {
+		t.Fatalf("Seek failed: %v", err)
+	}
+	if rest, err := io.ReadAll(object); err != nil || string(rest) != "6789" {
+		t.Errorf("read after seek = %q, %v", rest, err)
+	}
+
+	body, err := store.OpenRange(ctx, "data", "digits", 2, 5)
+	if err != nil {
+		t.Fatalf("OpenRange failed: %v", err)
+	}
+	if content, err := io.ReadAll(body); err != nil || string(content) != "23456" {
+		t.Errorf("OpenRange read %q, %v", content, err)
+	}
+	body.Close()
+	if cellar.ranges != 4 {
+		t.Errorf("%d range requests, want 4", cellar.ranges)
+	}
+
+	// Reads fail once the object is replaced
+	if err := store.Put(ctx, "data", "digits", strings.NewReader("changed"), -1, ""); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if _, err := object.ReadAt(p, 0); !errors.Is(err, ErrChanged) {
+		t.Errorf("ReadAt on a changed object error = %v, want ErrChanged", err)
+	}
+}
+
+func TestCopyMoveRemoveAll(t *testing.T) {
+	cellar := newFakeCellar(t, map[string]map[string]string{
+		"src": {"a/1": "one", "a/2": "two", "a/b/3": "three", "a": "file", "ab": "keep"},
+	})
+	store := cellar.store(WithConcurrency(2))
+	ctx := context.Background()
+
+	if err := store.Copy(ctx, Location{"src", "a/1"}, Location{"dst", "copy/1"}); err != nil {
+		t.Fatalf("Copy failed: %v", err)
+	}
+	if err := store.Move(ctx, Location{"src", "a/2"}, Location{"dst", "moved/2"}); err != nil {
+		t.Fatalf("Move failed: %v", err)
+	}
+	if keys := cellar.keys("dst"); !slices.Equal(keys, []string{"copy/1", "moved/2"}) {
+		t.Errorf("dst keys = %q", keys)
+	}
+	if string(cellar.objects["dst"]["moved/2"]) != "two" {
+		t.Errorf("moved content = %q", cellar.objects["dst"]["moved/2"])
+	}
+
+	if err := store.RemoveAll(ctx, "src", "a"); err != nil {
+		t.Fatalf("RemoveAll failed: %v", err)
+	}
+	if keys := cellar.keys("src"); !slices.Equal(keys, []string{"ab"}) {
+		t.Errorf("src keys after RemoveAll = %q", keys)
+	}
+	if err := store.RemoveAll(ctx, "src", "missing/"); err != nil {
+		t.Errorf("RemoveAll of a missing prefix failed: %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+package cellarfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// FS is a bucket seen as a read-only io/fs file system. Slashes in keys
+// separate directories, and a directory exists as long as an object has its
+// key as prefix. Files implement io.Seeker and io.ReaderAt.
+type FS struct {
+	store  *Store
+	ctx    context.Context
+	bucket string
+}
+
+var (
+	_ fs.StatFS    = (*FS)(nil)
+	_ fs.ReadDirFS = (*FS)(nil)
+)
+
+// FS returns a bucket as a file system. ctx bounds every request made
+// through it, as io/fs methods take no context.
+func (s *Store) FS(ctx context.Context, bucket string) *FS {
+	return &FS{store: s, ctx: ctx, bucket: bucket}
+}
+
+// Open opens an object as a file, or a key prefix as a directory
+func (f *FS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return &dir{fsys: f, name: name, info: dirInfo(name)}, nil
+	}
+
+	object, err := f.store.Open(f.ctx, f.bucket, name)
+	if err == nil {
+		return &file{Object: object, info: objectInfo(name, object.Details())}, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	if ok, err := f.isDir(name); err != nil || !ok {
+		if err == nil {
+			err = fs.ErrNotExist
+		}
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	return &dir{fsys: f, name: name, info: dirInfo(name)}, nil
+}
+
+// Stat returns the information of an object or a directory
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return dirInfo(name), nil
+	}
+
+	details, err := f.store.Stat(f.ctx, f.bucket, name)
+	if err == nil {
+		return objectInfo(name, details), nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	if ok, err := f.isDir(name); err != nil || !ok {
+		if err == nil {
+			err = fs.ErrNotExist
+		}
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return dirInfo(name), nil
+}
+
+// ReadDir lists a directory, sorted by name
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	entries, err := f.readDir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	if len(entries) == 0 && name != "." {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	return entries, nil
+}
+
+func (f *FS) readDir(name string) ([]fs.DirEntry, error) {
+	prefix := dirPrefix(name)
+	objects, directories, err := f.store.List(f.ctx, f.bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]fs.DirEntry, 0, len(objects)+len(directories))
+	for _, directory := range directories {
+		if directory.Key == prefix {
+			continue
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(dirInfo(directory.Key)))
+	}
+	for _, object := range objects {
+		// Skip the markers some tools create for empty directories
+		if object.Key == prefix || strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(listedInfo(object)))
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
+
+// isDir reports whether any object lives under name as a directory
+func (f *FS) isDir(name string) (bool, error) {
+	page, err := f.store.list(f.ctx, f.bucket, dirPrefix(name), "")
+	if err != nil {
+		return false, err
+	}
+	return len(page.Content) > 0 || len(page.Directories) > 0, nil
+}
+
+func dirPrefix(name string) string {
+	if name == "." {
+		return ""
+	}
+	return name + "/"
+}
+
+// file is an object opened through FS
+type file struct {
+	*Object
+	info fs.FileInfo
+}
+
+func (f *file) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+// dir is a directory opened through FS, listed on the first ReadDir
+type dir struct {
+	fsys    *FS
+	name    string
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	listed  bool
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *dir) Close() error {
+	return nil
+}
+
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.listed {
+		entries, err := d.fsys.readDir(d.name)
+		if err != nil {
+			return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: err}
+		}
+		d.entries, d.listed = entries, true
+	}
+
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(d.entries))
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
+
+// info describes an object or a directory. Sys returns the
+// models.CellarObject or models.CellarObjectDetails of objects.
+type info struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+	sys     any
+}
+
+func dirInfo(key string) info {
+	return info{name: path.Base(strings.TrimSuffix(key, "/")), dir: true}
+}
+
+func objectInfo(key string, details models.CellarObjectDetails) info {
+	return info{name: path.Base(key), size: int64(details.ContentLength), modTime: details.UpdatedAt, sys: details}
+}
+
+func listedInfo(object models.CellarObject) info {
+	return info{name: path.Base(object.Key), size: int64(object.ContentLength), modTime: object.UpdatedAt, sys: object}
+}
+
+func (i info) Name() string       { return i.name }
+func (i info) Size() int64        { return i.size }
+func (i info) ModTime() time.Time { return i.modTime }
+func (i info) IsDir() bool        { return i.dir }
+func (i info) Sys() any           { return i.sys }
+
+func (i info) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
@@ -0,0 +1,223 @@
+package cellarfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// urlMargin is how long before its expiry a presigned URL is renewed
+const urlMargin = time.Minute
+
+// Object is an object opened for reading. It reads through a presigned URL
+// with range requests that only succeed while the object keeps the ETag it
+// had when opened, failing with ErrChanged otherwise.
+//
+// Read and Seek share an offset and must not be called concurrently;
+// ReadAt may be called concurrently with everything but Close.
+type Object struct {
+	store    *Store
+	ctx      context.Context
+	location Location
+	details  models.CellarObjectDetails
+
+	mu        sync.Mutex
+	url       string
+	expiresAt time.Time
+
+	offset int64
+	body   io.ReadCloser
+}
+
+// Open opens an object for reading. ctx bounds every request the Object
+// makes, including those of later reads.
+func (s *Store) Open(ctx context.Context, bucket, key string) (*Object, error) {
+	details, err := s.stat(ctx, bucket, key)
+	if err != nil {
+		return nil, fmt.Errorf("cellarfs: open %s/%s: %w", bucket, key, err)
+	}
+	return &Object{store: s, ctx: ctx, location: Location{bucket, key}, details: details}, nil
+}
+
+// OpenRange returns length bytes of an object from offset, or the rest of it
+// when length is negative, in a single range request
+func (s *Store) OpenRange(ctx context.Context, bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	o, err := s.Open(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 || offset+length > o.Size() {
+		length = o.Size() - offset
+	}
+	return o.get(offset, length)
+}
+
+// Details returns the details of the object as of Open
+func (o *Object) Details() models.CellarObjectDetails {
+	return o.details
+}
+
+// Size returns the length of the object
+func (o *Object) Size() int64 {
+	return int64(o.details.ContentLength)
+}
+
+// Read reads from the current offset, keeping one request open across calls
+func (o *Object) Read(p []byte) (int, error) {
+	if o.offset >= o.Size() {
+		return 0, io.EOF
+	}
+	if o.body == nil {
+		body, err := o.get(o.offset, o.Size()-o.offset)
+		if err != nil {
+			return 0, err
+		}
+		o.body = body
+	}
+
+	n, err := o.body.Read(p)
+	o.offset += int64(n)
+	if errors.Is(err, io.EOF) {
+		o.body.Close()
+		o.body = nil
+		if o.offset < o.Size() {
+			return n, io.ErrUnexpectedEOF
+		}
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+	return n, nil
+}
+
+// Seek moves the offset of the next Read. The open request is dropped when
+// the offset changes.
+func (o *Object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.Size()
+	default:
+		return o.offset, fmt.Errorf("cellarfs: seek %s: invalid whence %d", o.location, whence)
+	}
+	if offset < 0 {
+		return o.offset, fmt.Errorf("cellarfs: seek %s: negative offset %d", o.location, offset)
+	}
+	if offset != o.offset && o.body != nil {
+		o.body.Close()
+		o.body = nil
+	}
+	o.offset = offset
+	return offset, nil
+}
+
+// ReadAt reads len(p) bytes from off in its own range request
+func (o *Object) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("cellarfs: read %s: negative offset %d", o.location, off)
+	}
+	if off >= o.Size() {
+		return 0, io.EOF
+	}
+	length := min(int64(len(p)), o.Size()-off)
+	if length == 0 {
+		return 0, nil
+	}
+	body, err := o.get(off, length)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	n, err := io.ReadFull(body, p[:length])
+	if err == nil && n < len(p) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Close drops the open request, if any
+func (o *Object) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	err := o.body.Close()
+	o.body = nil
+	return err
+}
+
+// downloadURL returns a presigned download URL, reusing the last one until
+// it is about to expire
+func (o *Object) downloadURL() (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.url != "" && time.Until(o.expiresAt) > urlMargin {
+		return o.url, nil
+	}
+	signed, err := o.store.signDownload(o.ctx, o.location.Bucket, o.location.Key)
+	if err != nil {
+		return "", fmt.Errorf("cellarfs: sign download of %s: %w", o.location, err)
+	}
+	o.url, o.expiresAt = signed.URL, signed.ExpiresAt
+	return o.url, nil
+}
+
+// get requests length bytes from offset
+func (o *Object) get(offset, length int64) (io.ReadCloser, error) {
+	if length <= 0 {
+		return http.NoBody, nil
+	}
+	url, err := o.downloadURL()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(o.ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-"+strconv.FormatInt(offset+length-1, 10))
+	if o.details.ETag != "" {
+		req.Header.Set("If-Match", quoteETag(o.details.ETag))
+	}
+
+	res, err := o.store.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cellarfs: download %s: %w", o.location, err)
+	}
+	if err := checkStatus(res, http.MethodGet, o.location); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	// A server ignoring the range answers 200 with the whole object
+	if res.StatusCode != http.StatusPartialContent && offset > 0 {
+		if _, err := io.CopyN(io.Discard, res.Body, offset); err != nil {
+			res.Body.Close()
+			return nil, fmt.Errorf("cellarfs: download %s: %w", o.location, err)
+		}
+	}
+	return readCloser{io.LimitReader(res.Body, length), res.Body}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// quoteETag returns an ETag as HTTP headers carry it, the API giving it bare
+func quoteETag(etag string) string {
+	if strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return strconv.Quote(etag)
+}
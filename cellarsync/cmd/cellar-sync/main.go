@@ -0,0 +1,27 @@
+// Command cellar-sync synchronizes a local directory and a Cellar bucket
+// prefix, in either direction.
+//
+//	cellar-sync -owner orga_xxx -cellar cellar_xxx -delete ./public cellar://site/
+//	cellar-sync -owner orga_xxx -cellar cellar_xxx cellar://backups/db ./restore
+//
+// Credentials are read from the clever-tools configuration, as with the SDK.
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	client "go.clever-cloud.dev/client"
+	cellarsync "go.clever-cloud.dev/sdk/cellarsync"
+	otel "go.opentelemetry.io/otel"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	c := client.New(client.WithAutoAuthConfig())
+	code := cellarsync.Main(ctx, c, otel.Tracer("cellar-sync"), os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
@@ -0,0 +1,146 @@
+package cellarsync
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	client "go.clever-cloud.dev/client"
+	cellarfs "go.clever-cloud.dev/sdk/cellarfs"
+	ids "go.clever-cloud.dev/sdk/ids"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+const usage = `usage: cellar-sync -owner <id> -cellar <id> [flags] <src> <dst>
+
+One of src and dst is a local directory, the other a bucket prefix written
+cellar://<bucket>/<prefix>. The destination is made to match the source.
+
+`
+
+// patterns is a flag that can be repeated
+type patterns []string
+
+func (p *patterns) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *patterns) Set(v string) error {
+	*p = append(*p, v)
+	return nil
+}
+
+// Main runs the cellar-sync command with args, the program name excluded,
+// and returns the process exit code. Actions are written to stdout, usage
+// and errors to stderr.
+func Main(ctx context.Context, c *client.Client, tracer trace.Tracer, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("cellar-sync", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+	var (
+		ownerID     = flags.String("owner", "", "ID of the organisation owning the Cellar add-on")
+		cellarID    = flags.String("cellar", "", "ID of the Cellar add-on")
+		compare     = flags.String("compare", ModTime.String(), "how files of the same size are compared: modtime, size or checksum")
+		deleteExtra = flags.Bool("delete", false, "delete destination files missing from the source")
+		dryRun      = flags.Bool("dry-run", false, "print the actions without running them")
+		concurrency = flags.Int("concurrency", 4, "number of files transferred at once")
+		filter      Filter
+	)
+	flags.Var((*patterns)(&filter.Include), "include", "only sync paths matching the glob, can be repeated")
+	flags.Var((*patterns)(&filter.Exclude), "exclude", "skip paths matching the glob, can be repeated")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *ownerID == "" || *cellarID == "" || flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+
+	comparison, err := ParseCompare(*compare)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	opts := []Option{
+		WithCompare(comparison),
+		WithFilter(filter),
+		WithConcurrency(*concurrency),
+		WithObserver(func(a Action) { printAction(stdout, stderr, a, *dryRun) }),
+	}
+	if *deleteExtra {
+		opts = append(opts, WithDelete())
+	}
+	if *dryRun {
+		opts = append(opts, WithDryRun())
+	}
+
+	store := cellarfs.New(c, tracer, ids.OwnerID(*ownerID), ids.CellarID(*cellarID), cellarfs.WithMetadata())
+	syncer := New(store, tracer, opts...)
+
+	src, dst := flags.Arg(0), flags.Arg(1)
+	var report Report
+	if bucket, prefix, ok := parseBucket(dst); ok {
+		report, err = syncer.Upload(ctx, src, bucket, prefix)
+	} else if bucket, prefix, ok := parseBucket(src); ok {
+		report, err = syncer.Download(ctx, bucket, prefix, dst)
+	} else {
+		fmt.Fprintln(stderr, "cellar-sync: one of src and dst must be a cellar://<bucket>/<prefix>")
+		return 2
+	}
+
+	printSummary(stdout, report)
+	if err != nil {
+		// Failed actions were already printed
+		if report.Err() == nil {
+			fmt.Fprintln(stderr, err)
+		}
+		return 1
+	}
+	return 0
+}
+
+// parseBucket parses cellar://<bucket>/<prefix>
+func parseBucket(s string) (bucket, prefix string, ok bool) {
+	rest, ok := strings.CutPrefix(s, "cellar://")
+	if !ok {
+		return "", "", false
+	}
+	bucket, prefix, _ = strings.Cut(rest, "/")
+	return bucket, prefix, bucket != ""
+}
+
+func printAction(stdout, stderr io.Writer, a Action, dryRun bool) {
+	if a.Err != nil {
+		fmt.Fprintf(stderr, "failed to %s %s: %v\n", a.Kind, a.Path, a.Err)
+		return
+	}
+	prefix := ""
+	if dryRun {
+		prefix = "would "
+	}
+	fmt.Fprintf(stdout, "%s%s %s (%s)\n", prefix, a.Kind, a.Path, a.Reason)
+}
+
+func printSummary(w io.Writer, report Report) {
+	var transferred, deleted, failed int
+	for _, a := range report.Actions {
+		switch {
+		case a.Err != nil:
+			failed++
+		case a.Kind == Delete:
+			deleted++
+		default:
+			transferred++
+		}
+	}
+	if report.DryRun {
+		fmt.Fprintf(w, "dry run: %d to transfer, %d to delete\n", transferred, deleted)
+		return
+	}
+	fmt.Fprintf(w, "%d transferred (%d bytes), %d deleted, %d failed\n", transferred, report.Bytes, deleted, failed)
+}
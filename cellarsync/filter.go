@@ -0,0 +1,77 @@
+package cellarsync
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Filter selects the paths a sync considers. Patterns use path.Match syntax
+// plus ** for any number of directories. A pattern without a slash matches
+// the base name at any depth; one with a slash matches the whole path.
+type Filter struct {
+	// Include keeps only the matching paths, or every path when empty
+	Include []string
+	// Exclude drops the matching paths, even included ones
+	Exclude []string
+}
+
+// Validate reports malformed patterns
+func (f Filter) Validate() error {
+	for _, pattern := range append(append([]string(nil), f.Include...), f.Exclude...) {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return fmt.Errorf("cellarsync: invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Match reports whether a slash-separated relative path is selected
+func (f Filter) Match(name string) bool {
+	for _, pattern := range f.Exclude {
+		if ok, _ := matchGlob(pattern, name); ok {
+			return false
+		}
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, pattern := range f.Include {
+		if ok, _ := matchGlob(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func matchGlob(pattern, name string) (bool, error) {
+	if !strings.Contains(pattern, "/") {
+		return path.Match(pattern, path.Base(name))
+	}
+	return matchSegments(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+// matchSegments matches path segments, ** standing for zero or more of them
+func matchSegments(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if ok, err := matchSegments(pattern[1:], name[i:]); ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if !ok || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
@@ -0,0 +1,617 @@
+// Package cellarsync synchronizes a local directory and a Cellar bucket.
+//
+// A Syncer makes a destination match its source the way `rclone sync` does:
+// files missing or different at the destination are transferred and, with
+// WithDelete, files the source lacks are deleted. Files compare by size and
+// modification time by default, or by size only, or by checksum against the
+// ETag. A Filter restricts the files considered and a dry run only reports
+// the plan.
+//
+// Syncs resume after an interruption: transfers that completed compare equal
+// on the next run, and downloads go through partial files that are continued
+// with range requests as long as the object is unchanged.
+//
+// Main runs the same as a command, see cmd/cellar-sync.
+package cellarsync
+
+import (
+	"cmp"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cellarfs "go.clever-cloud.dev/sdk/cellarfs"
+	models "go.clever-cloud.dev/sdk/models"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// partialSuffix ends the name of the files downloads are written to
+const partialSuffix = ".cellarsync-partial"
+
+// Compare is how files are found to differ once their sizes match
+type Compare int
+
+const (
+	// ModTime compares modification times. Uploads only replace objects
+	// older than their file, as objects take their upload time; downloads set
+	// the modification time of files to that of their object.
+	ModTime Compare = iota
+	// Size only compares sizes
+	Size
+	// Checksum compares the MD5 of files with the ETag of objects, falling
+	// back on ModTime for multipart uploads whose ETag is not an MD5
+	Checksum
+)
+
+var compareNames = []string{"modtime", "size", "checksum"}
+
+func (c Compare) String() string {
+	if int(c) < len(compareNames) {
+		return compareNames[c]
+	}
+	return "Compare(" + strconv.Itoa(int(c)) + ")"
+}
+
+// ParseCompare parses modtime, size or checksum
+func ParseCompare(s string) (Compare, error) {
+	if i := slices.Index(compareNames, s); i >= 0 {
+		return Compare(i), nil
+	}
+	return 0, fmt.Errorf("cellarsync: unknown comparison %q, want one of %s", s, strings.Join(compareNames, ", "))
+}
+
+// Kind is what an action does
+type Kind string
+
+const (
+	Upload   Kind = "upload"
+	Download Kind = "download"
+	Delete   Kind = "delete"
+)
+
+// Action is a file transferred or deleted by a sync
+type Action struct {
+	Kind Kind
+	// Path is slash-separated and relative to the synchronized directory and prefix
+	Path string
+	Size int64
+	// Reason is why the action is needed: missing, size, modtime, checksum or extraneous
+	Reason string
+	Err    error
+}
+
+// Report is the outcome of a sync, its actions sorted by path
+type Report struct {
+	Actions []Action
+	DryRun  bool
+	// Bytes is the size of the files transferred
+	Bytes int64
+}
+
+// Err joins the errors of failed actions
+func (r Report) Err() error {
+	var errs []error
+	for _, a := range r.Actions {
+		if a.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", a.Kind, a.Path, a.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// object is an object opened for reading, as cellarfs.Object
+type object interface {
+	io.ReadSeekCloser
+	Size() int64
+	Details() models.CellarObjectDetails
+}
+
+// Syncer synchronizes directories and bucket prefixes
+type Syncer struct {
+	tracer trace.Tracer
+
+	// Store calls, replaced in tests
+	walk   func(ctx context.Context, bucket, prefix string, fn func(models.CellarObject) error) error
+	open   func(ctx context.Context, bucket, key string) (object, error)
+	put    func(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string, metadata map[string]string) error
+	remove func(ctx context.Context, bucket, key string) error
+
+	compare      Compare
+	filter       Filter
+	delete       bool
+	dryRun       bool
+	concurrency  int
+	modifyWindow time.Duration
+	observe      func(Action)
+}
+
+// Option defines configuration options for the Syncer
+type Option func(*Syncer)
+
+// WithCompare sets how files of the same size are compared
+func WithCompare(c Compare) Option {
+	return func(s *Syncer) {
+		s.compare = c
+	}
+}
+
+// WithFilter sets which files are synchronized. Files excluded at the
+// destination are never deleted.
+func WithFilter(f Filter) Option {
+	return func(s *Syncer) {
+		s.filter = f
+	}
+}
+
+// WithDelete deletes destination files missing from the source
+func WithDelete() Option {
+	return func(s *Syncer) {
+		s.delete = true
+	}
+}
+
+// WithDryRun only plans actions, without running them
+func WithDryRun() Option {
+	return func(s *Syncer) {
+		s.dryRun = true
+	}
+}
+
+// WithConcurrency sets how many actions run at once
+func WithConcurrency(n int) Option {
+	return func(s *Syncer) {
+		s.concurrency = n
+	}
+}
+
+// WithModifyWindow sets how far apart modification times can be and still
+// be equal, the default being a second
+func WithModifyWindow(d time.Duration) Option {
+	return func(s *Syncer) {
+		s.modifyWindow = d
+	}
+}
+
+// WithObserver sets a function called after each action, or for each
+// planned action in a dry run. Calls are not concurrent.
+func WithObserver(observe func(Action)) Option {
+	return func(s *Syncer) {
+		s.observe = observe
+	}
+}
+
+// New creates a Syncer
+func New(store *cellarfs.Store, tracer trace.Tracer, opts ...Option) *Syncer {
+	s := &Syncer{
+		tracer:       tracer,
+		walk:         store.Walk,
+		put:          store.PutWithMetadata,
+		remove:       store.Delete,
+		concurrency:  4,
+		modifyWindow: time.Second,
+	}
+	s.open = func(ctx context.Context, bucket, key string) (object, error) {
+		o, err := store.Open(ctx, bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		return o, nil
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.concurrency = max(s.concurrency, 1)
+	return s
+}
+
+// entry is a file or an object being synchronized
+type entry struct {
+	size    int64
+	modTime time.Time
+	// etag is the ETag of objects, local checksums being computed on demand
+	etag string
+	// local is the path of files
+	local string
+}
+
+// Upload makes the objects under prefix match the files of dir
+func (s *Syncer) Upload(ctx context.Context, dir, bucket, prefix string) (Report, error) {
+	return s.sync(ctx, Upload, dir, bucket, prefix)
+}
+
+// Download makes the files of dir match the objects under prefix
+func (s *Syncer) Download(ctx context.Context, bucket, prefix, dir string) (Report, error) {
+	return s.sync(ctx, Download, dir, bucket, prefix)
+}
+
+func (s *Syncer) sync(ctx context.Context, kind Kind, dir, bucket, prefix string) (Report, error) {
+	name := "cellarsync.Upload"
+	if kind == Download {
+		name = "cellarsync.Download"
+	}
+	ctx, span := s.tracer.Start(ctx, name, trace.WithAttributes(
+		attribute.String("dir", dir),
+		attribute.String("bucket", bucket),
+		attribute.String("prefix", prefix),
+		attribute.String("compare", s.compare.String()),
+		attribute.Bool("dryRun", s.dryRun),
+	))
+	defer span.End()
+
+	report, err := s.run(ctx, kind, dir, bucket, dirPrefix(prefix))
+	if err == nil {
+		err = report.Err()
+	}
+	span.SetAttributes(attribute.Int("actions", len(report.Actions)), attribute.Int64("bytes", report.Bytes))
+	if err != nil {
+		span.RecordError(err)
+	}
+	return report, err
+}
+
+func (s *Syncer) run(ctx context.Context, kind Kind, dir, bucket, prefix string) (Report, error) {
+	report := Report{DryRun: s.dryRun}
+	if err := s.filter.Validate(); err != nil {
+		return report, err
+	}
+
+	local, err := s.listLocal(dir, kind == Download)
+	if err != nil {
+		return report, err
+	}
+	remote, err := s.listRemote(ctx, bucket, prefix)
+	if err != nil {
+		return report, err
+	}
+
+	src, dst := local, remote
+	if kind == Download {
+		src, dst = remote, local
+	}
+	if report.Actions, err = s.plan(kind, src, dst); err != nil {
+		return report, err
+	}
+	if s.dryRun {
+		for _, a := range report.Actions {
+			if s.observe != nil {
+				s.observe(a)
+			}
+		}
+		return report, nil
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, s.concurrency)
+	)
+	for i := range report.Actions {
+		// Actions left when interrupted fail with the context error
+		if err := ctx.Err(); err != nil {
+			report.Actions[i].Err = err
+			continue
+		}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			a := &report.Actions[i]
+			a.Err = s.apply(ctx, kind, *a, dir, bucket, prefix, src[a.Path])
+
+			mu.Lock()
+			defer mu.Unlock()
+			if a.Err == nil && a.Kind != Delete {
+				report.Bytes += a.Size
+			}
+			if s.observe != nil {
+				s.observe(*a)
+			}
+		}()
+	}
+	wg.Wait()
+	return report, nil
+}
+
+// plan lists the actions making dst match src
+func (s *Syncer) plan(kind Kind, src, dst map[string]entry) ([]Action, error) {
+	var actions []Action
+	for name, from := range src {
+		reason := "missing"
+		if to, ok := dst[name]; ok {
+			var err error
+			if reason, err = s.differs(kind, from, to); err != nil {
+				return nil, err
+			}
+		}
+		if reason != "" {
+			actions = append(actions, Action{Kind: kind, Path: name, Size: from.size, Reason: reason})
+		}
+	}
+	if s.delete {
+		for name, to := range dst {
+			if _, ok := src[name]; !ok {
+				actions = append(actions, Action{Kind: Delete, Path: name, Size: to.size, Reason: "extraneous"})
+			}
+		}
+	}
+	slices.SortFunc(actions, func(a, b Action) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	return actions, nil
+}
+
+// differs returns why dst needs replacing by src, or an empty string
+func (s *Syncer) differs(kind Kind, src, dst entry) (string, error) {
+	if src.size != dst.size {
+		return "size", nil
+	}
+	switch s.compare {
+	case Size:
+		return "", nil
+	case Checksum:
+		file, object := src, dst
+		if kind == Download {
+			file, object = dst, src
+		}
+		if sum, ok := md5ETag(object.etag); ok {
+			local, err := md5File(file.local)
+			if err != nil {
+				return "", err
+			}
+			if local != sum {
+				return "checksum", nil
+			}
+			return "", nil
+		}
+	}
+
+	if kind == Upload {
+		if src.modTime.After(dst.modTime.Add(s.modifyWindow)) {
+			return "modtime", nil
+		}
+		return "", nil
+	}
+	if d := src.modTime.Sub(dst.modTime); d > s.modifyWindow || d < -s.modifyWindow {
+		return "modtime", nil
+	}
+	return "", nil
+}
+
+// apply runs an action of a sync in the direction of kind
+func (s *Syncer) apply(ctx context.Context, kind Kind, a Action, dir, bucket, prefix string, src entry) error {
+	key := prefix + a.Path
+	local := filepath.Join(dir, filepath.FromSlash(a.Path))
+
+	switch {
+	case a.Kind == Upload:
+		return s.upload(ctx, bucket, key, local)
+	case a.Kind == Download:
+		return s.download(ctx, bucket, key, local, src.modTime)
+	case kind == Download:
+		if err := os.Remove(local); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	default:
+		if err := s.remove(ctx, bucket, key); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+}
+
+// upload writes a file to an object, recording its modification time in the
+// mtime metadata read by objectModTime
+func (s *Syncer) upload(ctx context.Context, bucket, key, local string) error {
+	f, err := os.Open(local)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	metadata := map[string]string{"mtime": formatModTime(info.ModTime())}
+	return s.put(ctx, bucket, key, f, info.Size(), mime.TypeByExtension(path.Ext(key)), metadata)
+}
+
+// download writes an object to a partial file named after its ETag, resuming
+// it when it exists, then moves it to local. Partial files of previous
+// versions of the object are removed.
+func (s *Syncer) download(ctx context.Context, bucket, key, local string, modTime time.Time) error {
+	object, err := s.open(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	if err := os.MkdirAll(filepath.Dir(local), 0o755); err != nil {
+		return err
+	}
+	partial := partialName(local, object.Details().ETag)
+	if err := removeStalePartials(local, partial); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if object.Details().ETag == "" || offset > object.Size() {
+		if offset, err = 0, f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	if _, err := object.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, object); err != nil {
+		if errors.Is(err, cellarfs.ErrChanged) {
+			f.Close()
+			os.Remove(partial)
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chtimes(partial, modTime, modTime); err != nil {
+		return err
+	}
+	return os.Rename(partial, local)
+}
+
+// partialName returns the partial file of a download, tied to the ETag of
+// the object so that a partial file of a replaced object is not resumed
+func partialName(local, etag string) string {
+	sum := md5.Sum([]byte(etag))
+	return filepath.Join(filepath.Dir(local), "."+filepath.Base(local)+"."+hex.EncodeToString(sum[:4])+partialSuffix)
+}
+
+// removeStalePartials removes the partial files of local other than partial,
+// left by downloads of objects replaced since
+func removeStalePartials(local, partial string) error {
+	entries, err := os.ReadDir(filepath.Dir(local))
+	if err != nil {
+		return err
+	}
+	// partialName appends 8 hex digits between the prefix and the suffix
+	prefix := "." + filepath.Base(local) + "."
+	for _, e := range entries {
+		name := e.Name()
+		if len(name) != len(prefix)+8+len(partialSuffix) || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, partialSuffix) {
+			continue
+		}
+		stale := filepath.Join(filepath.Dir(local), name)
+		if stale == partial {
+			continue
+		}
+		if err := os.Remove(stale); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// listLocal lists the files of dir selected by the filter, ignoring partial
+// downloads. A missing dir is empty when allowed.
+func (s *Syncer) listLocal(dir string, allowMissing bool) (map[string]entry, error) {
+	entries := map[string]entry{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == dir && allowMissing && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if !d.Type().IsRegular() || strings.HasSuffix(p, partialSuffix) {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if !s.filter.Match(name) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries[name] = entry{size: info.Size(), modTime: info.ModTime(), local: p}
+		return nil
+	})
+	return entries, err
+}
+
+// listRemote lists the objects under prefix selected by the filter
+func (s *Syncer) listRemote(ctx context.Context, bucket, prefix string) (map[string]entry, error) {
+	entries := map[string]entry{}
+	err := s.walk(ctx, bucket, prefix, func(object models.CellarObject) error {
+		name := strings.TrimPrefix(object.Key, prefix)
+		if name == "" || strings.HasSuffix(name, "/") || !s.filter.Match(name) {
+			return nil
+		}
+		entries[name] = entry{size: int64(object.ContentLength), modTime: objectModTime(object), etag: object.ETag}
+		return nil
+	})
+	return entries, err
+}
+
+// objectModTime returns the modification time rclone records in the mtime
+// metadata, or the upload time
+func objectModTime(object models.CellarObject) time.Time {
+	if object.Metadata != nil {
+		if mtime, ok := (*object.Metadata)["mtime"]; ok {
+			if seconds, err := strconv.ParseFloat(mtime, 64); err == nil {
+				return time.Unix(0, int64(seconds*float64(time.Second)))
+			}
+		}
+	}
+	return object.UpdatedAt
+}
+
+// formatModTime formats a modification time as rclone does in the mtime
+// metadata: seconds since the epoch with a nanosecond fraction
+func formatModTime(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+// md5ETag returns the MD5 an ETag holds, which multipart ETags do not
+func md5ETag(etag string) (string, bool) {
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	if len(etag) != md5.Size*2 {
+		return "", false
+	}
+	if _, err := hex.DecodeString(etag); err != nil {
+		return "", false
+	}
+	return strings.ToLower(etag), true
+}
+
+func md5File(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func dirPrefix(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return ""
+	}
+	return prefix + "/"
+}
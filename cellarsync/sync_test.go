@@ -0,0 +1,307 @@
+package cellarsync
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var t0 = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func TestFilter(t *testing.T) {
+	f := Filter{Include: []string{"*.html", "assets/**"}, Exclude: []string{"*.tmp", "assets/**/private/*"}}
+	for name, want := range map[string]bool{
+		"index.html":                 true,
+		"blog/post.html":             true,
+		"assets/app.js":              true,
+		"assets/img/logo.svg":        true,
+		"assets/draft.tmp":           false,
+		"assets/img/private/key.pem": false,
+		"assets/private/key.pem":     false,
+		"README.md":                  false,
+	} {
+		if got := f.Match(name); got != want {
+			t.Errorf("Match(%q) = %v, want %v", name, got, want)
+		}
+	}
+	if err := (Filter{Exclude: []string{"[a-"}}).Validate(); err == nil {
+		t.Error("Validate accepted a malformed pattern")
+	}
+}
+
+// remoteObject is an object of fakeBucket
+type remoteObject struct {
+	content  []byte
+	updated  time.Time
+	metadata map[string]string
+}
+
+func etagOf(content []byte) string {
+	sum := md5.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// fakeBucket keeps objects in memory. failAfter makes the next open return
+// a reader failing after that many bytes.
+type fakeBucket struct {
+	mu        sync.Mutex
+	objects   map[string]remoteObject
+	failAfter int
+	seeks     []int64
+}
+
+type fakeObject struct {
+	reader  *bytes.Reader
+	bucket  *fakeBucket
+	details models.CellarObjectDetails
+	limit   int
+}
+
+func (o *fakeObject) Read(p []byte) (int, error) {
+	if o.limit >= 0 {
+		read := int(o.Size()) - o.reader.Len()
+		if read >= o.limit {
+			return 0, errors.New("connection reset")
+		}
+		p = p[:min(len(p), o.limit-read)]
+	}
+	return o.reader.Read(p)
+}
+
+func (o *fakeObject) Seek(offset int64, whence int) (int64, error) {
+	o.bucket.mu.Lock()
+	o.bucket.seeks = append(o.bucket.seeks, offset)
+	o.bucket.mu.Unlock()
+	return o.reader.Seek(offset, whence)
+}
+
+func (o *fakeObject) Size() int64                         { return o.reader.Size() }
+func (o *fakeObject) Close() error                        { return nil }
+func (o *fakeObject) Details() models.CellarObjectDetails { return o.details }
+
+func (b *fakeBucket) syncer(opts ...Option) *Syncer {
+	s := New(nil, noop.NewTracerProvider().Tracer("test"), opts...)
+	s.walk = func(ctx context.Context, bucket, prefix string, fn func(models.CellarObject) error) error {
+		b.mu.Lock()
+		var objects []models.CellarObject
+		for _, key := range slices.Sorted(maps.Keys(b.objects)) {
+			if strings.HasPrefix(key, prefix) {
+				o := b.objects[key]
+				object := models.CellarObject{Key: key, ContentLength: len(o.content), ETag: etagOf(o.content), UpdatedAt: o.updated}
+				if o.metadata != nil {
+					object.Metadata = &o.metadata
+				}
+				objects = append(objects, object)
+			}
+		}
+		b.mu.Unlock()
+		for _, object := range objects {
+			if err := fn(object); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s.open = func(ctx context.Context, bucket, key string) (object, error) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		o, ok := b.objects[key]
+		if !ok {
+			return nil, fs.ErrNotExist
+		}
+		limit := -1
+		if b.failAfter > 0 {
+			limit, b.failAfter = b.failAfter, 0
+		}
+		details := models.CellarObjectDetails{Key: key, ContentLength: len(o.content), ETag: etagOf(o.content), UpdatedAt: o.updated}
+		return &fakeObject{reader: bytes.NewReader(o.content), bucket: b, details: details, limit: limit}, nil
+	}
+	s.put = func(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string, metadata map[string]string) error {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.objects[key] = remoteObject{content: content, updated: time.Now(), metadata: metadata}
+		return nil
+	}
+	s.remove = func(ctx context.Context, bucket, key string) error {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.objects, key)
+		return nil
+	}
+	return s
+}
+
+func writeFile(t *testing.T, dir, name, content string, modTime time.Time) {
+	t.Helper()
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(p, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func summary(report Report) []string {
+	var actions []string
+	for _, a := range report.Actions {
+		actions = append(actions, string(a.Kind)+" "+a.Path+" "+a.Reason)
+	}
+	return actions
+}
+
+func TestUpload(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "index.html", "<h1>new</h1>", t0.Add(time.Hour))
+	writeFile(t, dir, "same.css", "body{}", t0.Add(-time.Hour))
+	writeFile(t, dir, "assets/app.js", "app()", t0)
+	writeFile(t, dir, "draft.tmp", "draft", t0)
+
+	bucket := &fakeBucket{objects: map[string]remoteObject{
+		"site/index.html": {content: []byte("<h1>old</h1>"), updated: t0},
+		"site/same.css":   {content: []byte("body{}"), updated: t0},
+		"site/gone.html":  {content: []byte("gone"), updated: t0},
+		"site/keep.tmp":   {content: []byte("excluded"), updated: t0},
+		"other/file":      {content: []byte("outside the prefix"), updated: t0},
+	}}
+	opts := []Option{WithDelete(), WithFilter(Filter{Exclude: []string{"*.tmp"}})}
+	want := []string{"upload assets/app.js missing", "delete gone.html extraneous", "upload index.html modtime"}
+
+	report, err := bucket.syncer(append(opts, WithDryRun())...).Upload(context.Background(), dir, "site", "site")
+	if err != nil || !slices.Equal(summary(report), want) {
+		t.Fatalf("dry run = %q, %v, want %q", summary(report), err, want)
+	}
+	if len(bucket.objects) != 5 {
+		t.Fatalf("dry run changed the bucket: %v", slices.Sorted(maps.Keys(bucket.objects)))
+	}
+
+	var observed []string
+	report, err = bucket.syncer(append(opts, WithObserver(func(a Action) { observed = append(observed, a.Path) }))...).Upload(context.Background(), dir, "site", "site/")
+	if err != nil || !slices.Equal(summary(report), want) {
+		t.Fatalf("sync = %q, %v, want %q", summary(report), err, want)
+	}
+	if len(observed) != 3 || report.Bytes != int64(len("app()")+len("<h1>new</h1>")) {
+		t.Errorf("observed %q, %d bytes", observed, report.Bytes)
+	}
+	keys := slices.Sorted(maps.Keys(bucket.objects))
+	if !slices.Equal(keys, []string{"other/file", "site/assets/app.js", "site/index.html", "site/keep.tmp", "site/same.css"}) {
+		t.Errorf("bucket keys = %q", keys)
+	}
+	metadata := bucket.objects["site/index.html"].metadata
+	uploaded := models.CellarObject{Metadata: &metadata}
+	if got := objectModTime(uploaded); !got.Equal(t0.Add(time.Hour)) {
+		t.Errorf("uploaded modification time = %s, want the one of the file", got)
+	}
+
+	report, err = bucket.syncer(opts...).Upload(context.Background(), dir, "site", "site")
+	if err != nil || len(report.Actions) != 0 {
+		t.Errorf("second sync = %q, %v, want nothing to do", summary(report), err)
+	}
+}
+
+func TestDownloadResumes(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("0123456789abcdef")
+	bucket := &fakeBucket{objects: map[string]remoteObject{"backups/db.dump": {content: content, updated: t0}}, failAfter: 6}
+	ctx := context.Background()
+
+	if _, err := bucket.syncer().Download(ctx, "backups", "backups", dir); err == nil {
+		t.Fatal("interrupted download succeeded")
+	}
+	target := filepath.Join(dir, "db.dump")
+	partial := partialName(target, etagOf(content))
+	if data, err := os.ReadFile(partial); err != nil || string(data) != "012345" {
+		t.Fatalf("partial file = %q, %v", data, err)
+	}
+
+	report, err := bucket.syncer().Download(ctx, "backups", "backups", dir)
+	if err != nil || !slices.Equal(summary(report), []string{"download db.dump missing"}) {
+		t.Fatalf("resumed sync = %q, %v", summary(report), err)
+	}
+	if !slices.Contains(bucket.seeks, 6) {
+		t.Errorf("download not resumed at the end of the partial file, seeks = %v", bucket.seeks)
+	}
+	if data, err := os.ReadFile(target); err != nil || !bytes.Equal(data, content) {
+		t.Errorf("downloaded %q, %v", data, err)
+	}
+	if _, err := os.Stat(partial); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("partial file left behind: %v", err)
+	}
+	if info, err := os.Stat(target); err != nil || !info.ModTime().Equal(t0) {
+		t.Errorf("modification time not set from the object: %v, %v", info.ModTime(), err)
+	}
+
+	report, err = bucket.syncer().Download(ctx, "backups", "backups", dir)
+	if err != nil || len(report.Actions) != 0 {
+		t.Errorf("second sync = %q, %v, want nothing to do", summary(report), err)
+	}
+}
+
+func TestDownloadRemovesStalePartials(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("new content")
+	bucket := &fakeBucket{objects: map[string]remoteObject{"db.dump": {content: content, updated: t0}}}
+	target := filepath.Join(dir, "db.dump")
+	// left by an interrupted download of a previous version of the object
+	stale := partialName(target, etagOf([]byte("old content")))
+	other := partialName(filepath.Join(dir, "db"), "etag")
+	for _, name := range []string{stale, other} {
+		if err := os.WriteFile(name, []byte("old"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := bucket.syncer().Download(context.Background(), "b", "", dir); err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	if _, err := os.Stat(stale); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("stale partial file left behind: %v", err)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("partial file of another file removed: %v", err)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "data", "aaaa", t0)
+	bucket := &fakeBucket{objects: map[string]remoteObject{"data": {content: []byte("bbbb"), updated: t0}}}
+
+	report, err := bucket.syncer().Download(context.Background(), "b", "", dir)
+	if err != nil || len(report.Actions) != 0 {
+		t.Fatalf("modtime sync = %q, %v, want nothing to do", summary(report), err)
+	}
+	report, err = bucket.syncer(WithCompare(Checksum)).Download(context.Background(), "b", "", dir)
+	if err != nil || !slices.Equal(summary(report), []string{"download data checksum"}) {
+		t.Fatalf("checksum sync = %q, %v", summary(report), err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "data")); string(data) != "bbbb" {
+		t.Errorf("downloaded %q", data)
+	}
+
+	if _, ok := md5ETag(`"d41d8cd98f00b204e9800998ecf8427e-2"`); ok {
+		t.Error("multipart ETag taken for an MD5")
+	}
+}
@@ -0,0 +1,125 @@
+package cephprovision
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// ErrInvalidGrant is returned when a grant names an invalid pool or namespace
+var ErrInvalidGrant = errors.New("cephprovision: invalid grant")
+
+// Access is the level of access a grant gives to RBD images
+type Access int
+
+const (
+	ReadOnly Access = iota
+	ReadWrite
+)
+
+func (a Access) String() string {
+	if a == ReadWrite {
+		return "rw"
+	}
+	return "read-only"
+}
+
+// Grant gives access to the RBD images of a pool, or of one namespace of it
+type Grant struct {
+	Pool      string
+	Namespace string
+	Access    Access
+}
+
+// RBDPool grants access to the RBD images of a whole pool
+func RBDPool(pool string, access Access) Grant {
+	return Grant{Pool: pool, Access: access}
+}
+
+// RBDNamespace grants access to the RBD images of a namespace of a pool
+func RBDNamespace(pool, namespace string, access Access) Grant {
+	return Grant{Pool: pool, Namespace: namespace, Access: access}
+}
+
+func (g Grant) String() string {
+	if g.Namespace == "" {
+		return fmt.Sprintf("rbd %s on pool %s", g.Access, g.Pool)
+	}
+	return fmt.Sprintf("rbd %s on namespace %s/%s", g.Access, g.Pool, g.Namespace)
+}
+
+func (g Grant) validate() error {
+	if err := validName(g.Pool); err != nil {
+		return fmt.Errorf("%w: %s: pool: %v", ErrInvalidGrant, g, err)
+	}
+	if g.Namespace != "" {
+		if err := validName(g.Namespace); err != nil {
+			return fmt.Errorf("%w: %s: namespace: %v", ErrInvalidGrant, g, err)
+		}
+	}
+	if g.Access != ReadOnly && g.Access != ReadWrite {
+		return fmt.Errorf("%w: unknown access %d", ErrInvalidGrant, g.Access)
+	}
+	return nil
+}
+
+// validName rejects names that would change the meaning of a capability string
+func validName(name string) error {
+	if name == "" {
+		return errors.New("empty name")
+	}
+	if i := strings.IndexAny(name, " \t\n,=;'\"*"); i >= 0 {
+		return fmt.Errorf("%q contains %q", name, name[i])
+	}
+	return nil
+}
+
+// profile returns the capability of the grant, the same for the OSDs and
+// the managers: the manager one is needed by rbd commands such as
+// `rbd perf image` and trash purge schedules, and read-only grants get the
+// read-only profile there too so they cannot change schedules.
+func (g Grant) profile() string {
+	profile := "profile rbd"
+	if g.Access == ReadOnly {
+		profile = "profile rbd-read-only"
+	}
+	c := profile + " pool=" + g.Pool
+	if g.Namespace != "" {
+		c += " namespace=" + g.Namespace
+	}
+	return c
+}
+
+// Capabilities returns the CephX capabilities giving the grants. Grants on
+// the same pool and namespace are merged, keeping the widest access.
+func Capabilities(grants ...Grant) ([]models.CephCapability, error) {
+	if len(grants) == 0 {
+		return nil, fmt.Errorf("%w: no grant", ErrInvalidGrant)
+	}
+
+	merged := make([]Grant, 0, len(grants))
+	for _, g := range grants {
+		if err := g.validate(); err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(merged, func(m Grant) bool { return m.Pool == g.Pool && m.Namespace == g.Namespace })
+		if i < 0 {
+			merged = append(merged, g)
+			continue
+		}
+		merged[i].Access = max(merged[i].Access, g.Access)
+	}
+
+	profiles := make([]string, len(merged))
+	for i, g := range merged {
+		profiles[i] = g.profile()
+	}
+	return []models.CephCapability{
+		{Entity: "mon", Cap: "profile rbd"},
+		{Entity: "osd", Cap: strings.Join(profiles, ", ")},
+		{Entity: "mgr", Cap: strings.Join(profiles, ", ")},
+	}, nil
+}
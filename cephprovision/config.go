@@ -0,0 +1,85 @@
+package cephprovision
+
+import (
+	"fmt"
+	"strings"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// Credentials are what a client needs to reach the provisioned images
+type Credentials struct {
+	Monitors []string
+	// Entity is the full CephX name, such as client.app
+	Entity string
+	Key    string
+	Caps   models.Caps
+}
+
+// KeyringPath is where ceph tools look for the keyring of an entity by default
+func (c Credentials) KeyringPath() string {
+	return "/etc/ceph/ceph." + c.Entity + ".keyring"
+}
+
+// Config returns a ceph.conf pointing the entity to its keyring at keyringPath,
+// or at KeyringPath when empty
+func (c Credentials) Config(keyringPath string) string {
+	if keyringPath == "" {
+		keyringPath = c.KeyringPath()
+	}
+	var b strings.Builder
+	b.WriteString("[global]\n")
+	fmt.Fprintf(&b, "mon_host = %s\n", strings.Join(c.Monitors, ","))
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "[%s]\n", c.Entity)
+	fmt.Fprintf(&b, "keyring = %s\n", keyringPath)
+	return b.String()
+}
+
+// Keyring returns the keyring of the entity, with its key and capabilities
+func (c Credentials) Keyring() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s]\n", c.Entity)
+	fmt.Fprintf(&b, "\tkey = %s\n", c.Key)
+	for _, capability := range []struct {
+		entity string
+		cap    *string
+	}{
+		{"mds", c.Caps.Mds},
+		{"mgr", c.Caps.Mgr},
+		{"mon", c.Caps.Mon},
+		{"osd", c.Caps.Osd},
+	} {
+		if capability.cap != nil && *capability.cap != "" {
+			fmt.Fprintf(&b, "\tcaps %s = %q\n", capability.entity, *capability.cap)
+		}
+	}
+	return b.String()
+}
+
+// caps turns requested capabilities into the shape the API returns them in
+func caps(capabilities []models.CephCapability) models.Caps {
+	var c models.Caps
+	for _, capability := range capabilities {
+		value := capability.Cap
+		switch capability.Entity {
+		case "mds":
+			c.Mds = &value
+		case "mgr":
+			c.Mgr = &value
+		case "mon":
+			c.Mon = &value
+		case "osd":
+			c.Osd = &value
+		}
+	}
+	return c
+}
+
+// entityName returns the full CephX name of an entity, which defaults to the client type
+func entityName(entity string) string {
+	if strings.Contains(entity, ".") {
+		return entity
+	}
+	return "client." + entity
+}
@@ -0,0 +1,237 @@
+// Package cephprovision provisions Ceph RBD storage for applications.
+//
+// A Provisioner creates a pool and, optionally, an RBD namespace in it, then
+// issues a CephX user whose capabilities are generated from grants such as
+// "rbd read-only on namespace X" instead of raw capability strings. The
+// result carries a ready-to-use ceph.conf and keyring. Teardown deletes what
+// was provisioned in reverse order, and a failed Provision rolls back the
+// steps that completed.
+package cephprovision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	storage "go.clever-cloud.dev/sdk/services/storage"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrNoMonitor is returned when the cluster has no monitor to put in ceph.conf
+var ErrNoMonitor = errors.New("cephprovision: cluster has no monitor")
+
+// Plan describes the storage to provision
+type Plan struct {
+	TenantID  ids.TenantID
+	ClusterID ids.ClusterID
+	Pool      models.WannabeCephPool
+	// Namespace creates an RBD namespace in the pool and scopes the user to it
+	Namespace bool
+	// Access is the access of the user to the provisioned pool or namespace
+	Access Access
+	// Grants gives the user access to other, existing pools or namespaces
+	Grants []Grant
+}
+
+// Provisioned is the storage created for a Plan
+type Provisioned struct {
+	TenantID  ids.TenantID
+	ClusterID ids.ClusterID
+	Pool      string
+	// Namespace is empty when the plan did not ask for one
+	Namespace string
+	// Entity is the CephX user as named by the API
+	Entity      string
+	Credentials Credentials
+}
+
+// Grant returns the grant of the user on the provisioned pool or namespace
+func (p Provisioned) Grant(access Access) Grant {
+	return Grant{Pool: p.Pool, Namespace: p.Namespace, Access: access}
+}
+
+// Provisioner provisions and tears down Ceph storage
+type Provisioner struct {
+	client *client.Client
+	tracer trace.Tracer
+
+	// API calls, replaced in tests
+	cluster         func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID) (models.CephCluster, error)
+	createPool      func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool *models.WannabeCephPool) (models.CephPool, error)
+	createNamespace func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) (models.CephRBDNamespace, error)
+	createUser      func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, capabilities []models.CephCapability) (models.JustCreatedCephXUser, error)
+	deleteUser      func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, entity string) error
+	deleteNamespace func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool, namespace string) error
+	deletePool      func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) error
+}
+
+// New creates a Provisioner
+func New(c *client.Client, tracer trace.Tracer) *Provisioner {
+	p := &Provisioner{client: c, tracer: tracer}
+	p.cluster = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID) (models.CephCluster, error) {
+		return payload(storage.Getcephcluster(ctx, p.client, p.tracer, tenantID, clusterID))
+	}
+	p.createPool = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool *models.WannabeCephPool) (models.CephPool, error) {
+		return payload(storage.Createcephpool(ctx, p.client, p.tracer, tenantID, clusterID, pool))
+	}
+	p.createNamespace = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) (models.CephRBDNamespace, error) {
+		return payload(storage.Createcephrbdnamespace(ctx, p.client, p.tracer, tenantID, clusterID, &models.WannabeCephRBDNamespace{
+			Pool: models.CephPool{Name: pool},
+		}))
+	}
+	p.createUser = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, capabilities []models.CephCapability) (models.JustCreatedCephXUser, error) {
+		return payload(storage.Createcephxuser(ctx, p.client, p.tracer, tenantID, clusterID, &models.WannabeCephXUser{
+			Capabilities: capabilities,
+		}))
+	}
+	p.deleteUser = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, entity string) error {
+		return errorOf(storage.Deletecephxuser(ctx, p.client, p.tracer, tenantID, clusterID, ids.EntityID(entity)))
+	}
+	p.deleteNamespace = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool, namespace string) error {
+		return errorOf(storage.Deletecephrbdnamespace(ctx, p.client, p.tracer, tenantID, clusterID, ids.PoolID(pool), ids.NamespaceID(namespace)))
+	}
+	p.deletePool = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) error {
+		return errorOf(storage.Deletecephpool(ctx, p.client, p.tracer, tenantID, clusterID, ids.PoolID(pool)))
+	}
+	return p
+}
+
+func payload[T any](response client.Response[T]) (T, error) {
+	if response.HasError() {
+		var zero T
+		return zero, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+func errorOf[T any](response client.Response[T]) error {
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
+
+// Provision creates the pool, the namespace and the user of a plan. When a
+// step fails, the completed ones are torn down and their errors joined to
+// the returned one.
+func (p *Provisioner) Provision(ctx context.Context, plan Plan) (Provisioned, error) {
+	ctx, span := p.tracer.Start(ctx, "cephprovision.Provision", trace.WithAttributes(
+		attribute.String("tenantId", string(plan.TenantID)),
+		attribute.String("clusterId", string(plan.ClusterID)),
+		attribute.Bool("namespace", plan.Namespace),
+		attribute.String("access", plan.Access.String()),
+	))
+	defer span.End()
+
+	provisioned, err := p.provision(ctx, plan)
+	if err != nil {
+		span.RecordError(err)
+		if rollback := p.Teardown(ctx, provisioned); rollback != nil {
+			err = errors.Join(err, fmt.Errorf("cephprovision: rollback: %w", rollback))
+		}
+		return Provisioned{}, err
+	}
+	span.SetAttributes(
+		attribute.String("pool", provisioned.Pool),
+		attribute.String("entity", provisioned.Entity),
+	)
+	return provisioned, nil
+}
+
+// provision runs the steps of a plan, returning what it created so far on failure
+func (p *Provisioner) provision(ctx context.Context, plan Plan) (Provisioned, error) {
+	provisioned := Provisioned{TenantID: plan.TenantID, ClusterID: plan.ClusterID}
+
+	// Check what does not depend on the created pool before creating anything
+	for _, g := range plan.Grants {
+		if err := g.validate(); err != nil {
+			return provisioned, err
+		}
+	}
+	cluster, err := p.cluster(ctx, plan.TenantID, plan.ClusterID)
+	if err != nil {
+		return provisioned, fmt.Errorf("cephprovision: get cluster %s: %w", plan.ClusterID, err)
+	}
+	if len(cluster.Monitors) == 0 {
+		return provisioned, fmt.Errorf("%w: %s", ErrNoMonitor, plan.ClusterID)
+	}
+
+	pool, err := p.createPool(ctx, plan.TenantID, plan.ClusterID, &plan.Pool)
+	if err != nil {
+		return provisioned, fmt.Errorf("cephprovision: create pool: %w", err)
+	}
+	provisioned.Pool = pool.Name
+
+	if plan.Namespace {
+		namespace, err := p.createNamespace(ctx, plan.TenantID, plan.ClusterID, pool.Name)
+		if err != nil {
+			return provisioned, fmt.Errorf("cephprovision: create namespace in pool %s: %w", pool.Name, err)
+		}
+		provisioned.Namespace = namespace.ID
+	}
+
+	capabilities, err := Capabilities(append([]Grant{provisioned.Grant(plan.Access)}, plan.Grants...)...)
+	if err != nil {
+		return provisioned, err
+	}
+	user, err := p.createUser(ctx, plan.TenantID, plan.ClusterID, capabilities)
+	if err != nil {
+		return provisioned, fmt.Errorf("cephprovision: create user: %w", err)
+	}
+	provisioned.Entity = user.Entity
+
+	// Fall back on the requested capabilities when the API does not echo them
+	userCaps := user.Caps
+	if userCaps == (models.Caps{}) {
+		userCaps = caps(capabilities)
+	}
+	provisioned.Credentials = Credentials{
+		Monitors: cluster.Monitors,
+		Entity:   entityName(user.Entity),
+		Key:      user.Key,
+		Caps:     userCaps,
+	}
+	return provisioned, nil
+}
+
+// Teardown deletes the user, the namespace and the pool of a provisioned
+// plan, in that order. Parts that were not provisioned are skipped. It keeps
+// going past failures, except that a pool whose namespace or user could not
+// be deleted is kept, as deleting it would fail too.
+func (p *Provisioner) Teardown(ctx context.Context, provisioned Provisioned) error {
+	ctx, span := p.tracer.Start(ctx, "cephprovision.Teardown", trace.WithAttributes(
+		attribute.String("tenantId", string(provisioned.TenantID)),
+		attribute.String("clusterId", string(provisioned.ClusterID)),
+		attribute.String("pool", provisioned.Pool),
+		attribute.String("namespace", provisioned.Namespace),
+		attribute.String("entity", provisioned.Entity),
+	))
+	defer span.End()
+
+	var errs []error
+	if provisioned.Entity != "" {
+		if err := p.deleteUser(ctx, provisioned.TenantID, provisioned.ClusterID, provisioned.Entity); err != nil {
+			errs = append(errs, fmt.Errorf("delete user %s: %w", provisioned.Entity, err))
+		}
+	}
+	if provisioned.Namespace != "" {
+		if err := p.deleteNamespace(ctx, provisioned.TenantID, provisioned.ClusterID, provisioned.Pool, provisioned.Namespace); err != nil {
+			errs = append(errs, fmt.Errorf("delete namespace %s/%s: %w", provisioned.Pool, provisioned.Namespace, err))
+		}
+	}
+	if provisioned.Pool != "" && len(errs) == 0 {
+		if err := p.deletePool(ctx, provisioned.TenantID, provisioned.ClusterID, provisioned.Pool); err != nil {
+			errs = append(errs, fmt.Errorf("delete pool %s: %w", provisioned.Pool, err))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
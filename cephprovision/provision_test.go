@@ -0,0 +1,159 @@
+package cephprovision
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestCapabilities(t *testing.T) {
+	capabilities, err := Capabilities(
+		RBDNamespace("rbd", "app", ReadOnly),
+		RBDPool("images", ReadWrite),
+		RBDNamespace("rbd", "app", ReadWrite),
+	)
+	if err != nil {
+		t.Fatalf("Capabilities failed: %v", err)
+	}
+	want := []models.CephCapability{
+		{Entity: "mon", Cap: "profile rbd"},
+		{Entity: "osd", Cap: "profile rbd pool=rbd namespace=app, profile rbd pool=images"},
+		{Entity: "mgr", Cap: "profile rbd pool=rbd namespace=app, profile rbd pool=images"},
+	}
+	if !slices.Equal(capabilities, want) {
+		t.Errorf("capabilities = %+v, want %+v", capabilities, want)
+	}
+
+	capabilities, err = Capabilities(RBDPool("images", ReadOnly))
+	if err != nil || capabilities[1].Cap != "profile rbd-read-only pool=images" || capabilities[2].Cap != "profile rbd-read-only pool=images" {
+		t.Errorf("read-only capabilities = %+v, %v", capabilities, err)
+	}
+
+	for _, grants := range [][]Grant{
+		nil,
+		{RBDPool("", ReadOnly)},
+		{RBDPool("images, allow *", ReadWrite)},
+		{RBDNamespace("rbd", "app namespace=other", ReadOnly)},
+		{{Pool: "rbd", Access: Access(7)}},
+	} {
+		if _, err := Capabilities(grants...); !errors.Is(err, ErrInvalidGrant) {
+			t.Errorf("Capabilities(%+v) error = %v, want ErrInvalidGrant", grants, err)
+		}
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	mon, osd := "profile rbd", "profile rbd pool=rbd"
+	credentials := Credentials{
+		Monitors: []string{"10.0.0.1:6789", "10.0.0.2:6789"},
+		Entity:   entityName("app"),
+		Key:      "AQBkey==",
+		Caps:     models.Caps{Mon: &mon, Osd: &osd},
+	}
+
+	config := credentials.Config("")
+	for _, line := range []string{"mon_host = 10.0.0.1:6789,10.0.0.2:6789", "[client.app]", "keyring = /etc/ceph/ceph.client.app.keyring"} {
+		if !strings.Contains(config, line+"\n") {
+			t.Errorf("config misses %q:\n%s", line, config)
+		}
+	}
+
+	want := "[client.app]\n\tkey = AQBkey==\n\tcaps mon = \"profile rbd\"\n\tcaps osd = \"profile rbd pool=rbd\"\n"
+	if keyring := credentials.Keyring(); keyring != want {
+		t.Errorf("keyring = %q, want %q", keyring, want)
+	}
+}
+
+func fakeProvisioner(calls *[]string) *Provisioner {
+	p := New(nil, noop.NewTracerProvider().Tracer("test"))
+	p.cluster = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID) (models.CephCluster, error) {
+		return models.CephCluster{ID: string(clusterID), Monitors: []string{"10.0.0.1:6789"}}, nil
+	}
+	p.createPool = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool *models.WannabeCephPool) (models.CephPool, error) {
+		*calls = append(*calls, "create pool")
+		return models.CephPool{Name: "pool_1"}, nil
+	}
+	p.createNamespace = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) (models.CephRBDNamespace, error) {
+		*calls = append(*calls, "create namespace in "+pool)
+		return models.CephRBDNamespace{ID: "ns_1"}, nil
+	}
+	p.createUser = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, capabilities []models.CephCapability) (models.JustCreatedCephXUser, error) {
+		*calls = append(*calls, "create user")
+		return models.JustCreatedCephXUser{Entity: "client.user_1", Key: "AQBkey=="}, nil
+	}
+	p.deleteUser = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, entity string) error {
+		*calls = append(*calls, "delete user "+entity)
+		return nil
+	}
+	p.deleteNamespace = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool, namespace string) error {
+		*calls = append(*calls, "delete namespace "+pool+"/"+namespace)
+		return nil
+	}
+	p.deletePool = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool string) error {
+		*calls = append(*calls, "delete pool "+pool)
+		return nil
+	}
+	return p
+}
+
+func TestProvisionAndTeardown(t *testing.T) {
+	var calls []string
+	p := fakeProvisioner(&calls)
+	plan := Plan{TenantID: "tenant_1", ClusterID: "cluster_1", Namespace: true, Access: ReadWrite}
+
+	provisioned, err := p.Provision(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("Provision failed: %v", err)
+	}
+	if provisioned.Pool != "pool_1" || provisioned.Namespace != "ns_1" || provisioned.Credentials.Entity != "client.user_1" {
+		t.Errorf("provisioned = %+v", provisioned)
+	}
+	if osd := provisioned.Credentials.Caps.Osd; osd == nil || *osd != "profile rbd pool=pool_1 namespace=ns_1" {
+		t.Errorf("osd caps = %v", osd)
+	}
+
+	if err := p.Teardown(context.Background(), provisioned); err != nil {
+		t.Fatalf("Teardown failed: %v", err)
+	}
+	want := []string{"create pool", "create namespace in pool_1", "create user", "delete user client.user_1", "delete namespace pool_1/ns_1", "delete pool pool_1"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
+
+func TestProvisionRollsBack(t *testing.T) {
+	var calls []string
+	p := fakeProvisioner(&calls)
+	p.createUser = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, capabilities []models.CephCapability) (models.JustCreatedCephXUser, error) {
+		return models.JustCreatedCephXUser{}, errors.New("quota exceeded")
+	}
+	namespaceErr := errors.New("namespace busy")
+	p.deleteNamespace = func(ctx context.Context, tenantID ids.TenantID, clusterID ids.ClusterID, pool, namespace string) error {
+		calls = append(calls, "delete namespace")
+		return namespaceErr
+	}
+
+	_, err := p.Provision(context.Background(), Plan{TenantID: "tenant_1", ClusterID: "cluster_1", Namespace: true})
+	if err == nil || !errors.Is(err, namespaceErr) {
+		t.Fatalf("error = %v, want the creation and rollback errors", err)
+	}
+	want := []string{"create pool", "create namespace in pool_1", "delete namespace"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %q, want %q, the pool must be kept while its namespace remains", calls, want)
+	}
+
+	calls = nil
+	p = fakeProvisioner(&calls)
+	if _, err := p.Provision(context.Background(), Plan{Grants: []Grant{RBDPool("bad pool", ReadOnly)}}); !errors.Is(err, ErrInvalidGrant) {
+		t.Errorf("error = %v, want ErrInvalidGrant", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("resources created for an invalid plan: %q", calls)
+	}
+}
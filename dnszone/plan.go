@@ -0,0 +1,233 @@
+package dnszone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	dns "go.clever-cloud.dev/sdk/services/dns"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrNoResource is returned by Import and Apply without a resource, as
+// records are always created for a resource
+var ErrNoResource = errors.New("dnszone: no resource to import the zone into")
+
+// Plan lists the changes needed to make live records match a zone file
+type Plan struct {
+	Create []Record
+	Delete []models.DnsRecord1
+}
+
+// Empty reports whether the plan has nothing to apply
+func (p Plan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Delete) == 0
+}
+
+// Print writes a human readable summary of the plan
+func (p Plan) Print(w io.Writer) error {
+	for _, record := range p.Delete {
+		if _, err := fmt.Fprintf(w, "- %s %d %s %s\n", record.Name, record.TTL, record.Type, record.Content); err != nil {
+			return err
+		}
+	}
+	for _, record := range p.Create {
+		if _, err := fmt.Fprintf(w, "+ %s %d %s %s\n", record.Name, record.TTL, record.Type, record.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff computes the plan turning live into desired.
+// A record whose TTL changed is deleted and created again. SOA records are
+// managed by the platform and are ignored on both sides.
+func Diff(live []models.DnsRecord1, desired []Record) Plan {
+	wanted := map[string]Record{}
+	for _, record := range desired {
+		if record.Type == models.DNSRecordTypeSOA {
+			continue
+		}
+		wanted[record.key()] = record
+	}
+
+	var plan Plan
+	kept := map[string]bool{}
+	for _, record := range live {
+		if record.Type == models.DNSRecordTypeSOA {
+			continue
+		}
+		current := FromDnsRecord(record)
+		want, ok := wanted[current.key()]
+		if ok && want.TTL == current.TTL && !kept[current.key()] {
+			kept[current.key()] = true
+			continue
+		}
+		plan.Delete = append(plan.Delete, record)
+	}
+
+	for _, record := range desired {
+		if record.Type == models.DNSRecordTypeSOA || kept[record.key()] {
+			continue
+		}
+		kept[record.key()] = true
+		plan.Create = append(plan.Create, record)
+	}
+
+	return plan
+}
+
+// Export renders the records of a resource as a zone file.
+// When resourceID is empty, every record of the tenant is exported.
+func Export(ctx context.Context, c *client.Client, tracer trace.Tracer, w io.Writer, tenantID ids.TenantID, resourceID ids.ResourceID, origin string) error {
+	live, err := listRecords(ctx, c, tracer, tenantID, resourceID)
+	if err != nil {
+		return err
+	}
+
+	records := make([]Record, 0, len(live))
+	for _, record := range live {
+		records = append(records, FromDnsRecord(record))
+	}
+	return Write(w, origin, records)
+}
+
+// Import parses a zone file and computes the plan against the live records of a resource
+func Import(ctx context.Context, c *client.Client, tracer trace.Tracer, r io.Reader, tenantID ids.TenantID, resourceID ids.ResourceID, origin string) (Plan, error) {
+	if resourceID == "" {
+		return Plan{}, ErrNoResource
+	}
+
+	desired, err := Parse(r, origin)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	live, err := listRecords(ctx, c, tracer, tenantID, resourceID)
+	if err != nil {
+		return Plan{}, err
+	}
+	return Diff(live, desired), nil
+}
+
+// Apply runs a plan against a resource, creations first so that a name is
+// never left without records, and deletes nothing when they fail.
+// When a plan removes every record of a name and type, the whole set is
+// deleted in one call.
+func Apply(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID, plan Plan) error {
+	if resourceID == "" {
+		return ErrNoResource
+	}
+
+	ctx, span := tracer.Start(ctx, "dnszone.Apply", trace.WithAttributes(
+		attribute.String("tenantId", string(tenantID)),
+		attribute.String("resourceId", string(resourceID)),
+		attribute.Int("create", len(plan.Create)),
+		attribute.Int("delete", len(plan.Delete)),
+	))
+	defer span.End()
+
+	if len(plan.Create) > 0 {
+		if err := createRecords(ctx, c, tracer, tenantID, resourceID, plan.Create); err != nil {
+			err = fmt.Errorf("create records, nothing deleted: %w", err)
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	if err := applyDeletes(ctx, c, tracer, tenantID, resourceID, plan.Delete); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+func applyDeletes(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID, deletes []models.DnsRecord1) error {
+	if len(deletes) == 0 {
+		return nil
+	}
+
+	live, err := listRecords(ctx, c, tracer, tenantID, resourceID)
+	if err != nil {
+		return err
+	}
+
+	type rrset struct {
+		name       string
+		recordType models.DNSRecordType
+	}
+	// Listed after the creations: a set that just gained records is never
+	// deleted as a whole
+	total := map[rrset]int{}
+	for _, record := range live {
+		total[rrset{record.Name, record.Type}]++
+	}
+	deleted := map[rrset][]models.DnsRecord1{}
+	var order []rrset
+	for _, record := range deletes {
+		set := rrset{record.Name, record.Type}
+		if _, ok := deleted[set]; !ok {
+			order = append(order, set)
+		}
+		deleted[set] = append(deleted[set], record)
+	}
+
+	var errs []error
+	for _, set := range order {
+		records := deleted[set]
+		if len(records) == total[set] {
+			response := dns.Deletednsrecordsfortypeandname(ctx, c, tracer, tenantID, resourceID, set.recordType.String(), set.name)
+			if response.HasError() {
+				errs = append(errs, fmt.Errorf("delete %s %s: %w", set.name, set.recordType, response.Error()))
+			}
+			continue
+		}
+		for _, record := range records {
+			response := dns.Deletednsrecord(ctx, c, tracer, tenantID, resourceID, ids.RecordID(record.ID))
+			if response.HasError() {
+				errs = append(errs, fmt.Errorf("delete %s %s %s: %w", record.Name, record.Type, record.Content, response.Error()))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func listRecords(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID) ([]models.DnsRecord1, error) {
+	var response client.Response[[]models.DnsRecord1]
+	if resourceID == "" {
+		response = dns.Listdnsrecordsforowner(ctx, c, tracer, tenantID)
+	} else {
+		response = dns.Listdnsrecordsforresource(ctx, c, tracer, tenantID, resourceID)
+	}
+	if response.HasError() {
+		return nil, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+// recordInput is the payload of a record creation
+type recordInput struct {
+	Content string               `json:"content"`
+	Name    models.DnsDomainName `json:"name"`
+	TTL     int                  `json:"ttl"`
+	Type    models.DNSRecordType `json:"type"`
+}
+
+// createRecords creates records in one call
+func createRecords(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID, records []Record) error {
+	body := make([]recordInput, 0, len(records))
+	for _, record := range records {
+		body = append(body, recordInput{Content: record.Content, Name: record.Name, TTL: record.TTL, Type: record.Type})
+	}
+
+	response := dns.Creatednsrecords(ctx, c, tracer, tenantID, resourceID, body)
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
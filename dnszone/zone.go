@@ -0,0 +1,382 @@
+// Package dnszone converts DNS records to and from RFC 1035 zone files.
+//
+// Records are rendered as a BIND-style master file, zone files are parsed
+// back into records, and a Plan computes the creations and deletions needed
+// to make the live records match a zone file.
+package dnszone
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// DefaultTTL is used when a zone file sets neither $TTL nor a record TTL
+const DefaultTTL = 3600
+
+// Record is a resource record as found in a zone file.
+// Name is fully qualified, lower case and without the trailing dot.
+type Record struct {
+	Name    models.DnsDomainName
+	Type    models.DNSRecordType
+	TTL     int
+	Content string
+}
+
+// FromDnsRecord converts a record returned by the API
+func FromDnsRecord(record models.DnsRecord1) Record {
+	return Record{
+		Name:    normalizeName(record.Name),
+		Type:    record.Type,
+		TTL:     record.TTL,
+		Content: record.Content,
+	}
+}
+
+// key identifies a record regardless of its TTL
+func (r Record) key() string {
+	return r.Name + "\x00" + r.Type.String() + "\x00" + r.Content
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// Write renders records as a zone file for origin.
+// Owner names inside origin are written relative to it.
+func Write(w io.Writer, origin string, records []Record) error {
+	origin = normalizeName(origin)
+
+	sorted := slices.Clone(records)
+	slices.SortFunc(sorted, func(a, b Record) int {
+		return cmp.Or(
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.Content, b.Content),
+		)
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n", origin)
+	fmt.Fprintf(bw, "$TTL %d\n", DefaultTTL)
+
+	for _, record := range sorted {
+		fmt.Fprintf(bw, "%s\t%d\tIN\t%s\t%s\n",
+			relativeName(record.Name, origin), record.TTL, record.Type, renderContent(record))
+	}
+	return bw.Flush()
+}
+
+func relativeName(name string, origin string) string {
+	switch {
+	case name == origin:
+		return "@"
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	default:
+		return name + "."
+	}
+}
+
+func renderContent(record Record) string {
+	if record.Type != models.DNSRecordTypeTXT {
+		return record.Content
+	}
+
+	// Character strings are limited to 255 bytes, longer values are split
+	var parts []string
+	content := record.Content
+	for len(content) > 255 {
+		parts = append(parts, quote(content[:255]))
+		content = content[255:]
+	}
+	parts = append(parts, quote(content))
+	return strings.Join(parts, " ")
+}
+
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// ParseError reports a malformed zone file line
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("dnszone: line %d: %s", e.Line, e.Msg)
+}
+
+// Parse reads a zone file. origin is used until a $ORIGIN directive overrides it.
+func Parse(r io.Reader, origin string) ([]Record, error) {
+	p := &parser{
+		origin: normalizeName(origin),
+		ttl:    DefaultTTL,
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		pending   []token
+		startLine int
+		depth     int
+		line      int
+	)
+	for scanner.Scan() {
+		line++
+		tokens, opened, err := tokenize(scanner.Text())
+		if err != nil {
+			return nil, &ParseError{Line: line, Msg: err.Error()}
+		}
+		if depth == 0 {
+			startLine = line
+		}
+		depth += opened
+		if depth < 0 {
+			return nil, &ParseError{Line: line, Msg: "unbalanced parenthesis"}
+		}
+		pending = append(pending, tokens...)
+		if depth > 0 {
+			continue
+		}
+
+		if len(pending) > 0 {
+			if err := p.entry(pending); err != nil {
+				return nil, &ParseError{Line: startLine, Msg: err.Error()}
+			}
+		}
+		pending = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, &ParseError{Line: startLine, Msg: "unterminated parenthesis"}
+	}
+
+	return p.records, nil
+}
+
+type token struct {
+	text   string
+	quoted bool
+	// leading is true for a token starting at column 0, i.e. an owner name
+	leading bool
+}
+
+// tokenize splits a line into tokens and returns the parenthesis balance
+func tokenize(line string) ([]token, int, error) {
+	var (
+		tokens []token
+		depth  int
+	)
+
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ';':
+			return tokens, depth, nil
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			depth--
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for ; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' && i+1 < len(line) {
+					i++
+				}
+				sb.WriteByte(line[i])
+			}
+			if i >= len(line) {
+				return nil, 0, fmt.Errorf("unterminated quoted string")
+			}
+			i++
+			tokens = append(tokens, token{text: sb.String(), quoted: true})
+		default:
+			start := i
+			for i < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[i])) {
+				i++
+			}
+			tokens = append(tokens, token{text: line[start:i], leading: start == 0})
+		}
+	}
+	return tokens, depth, nil
+}
+
+type parser struct {
+	origin   string
+	ttl      int
+	previous string
+	records  []Record
+}
+
+func (p *parser) entry(tokens []token) error {
+	first := tokens[0]
+	if !first.quoted && strings.HasPrefix(first.text, "$") {
+		return p.directive(tokens)
+	}
+
+	name := p.previous
+	if first.leading {
+		name = p.qualify(first.text)
+		tokens = tokens[1:]
+	}
+	if name == "" {
+		return fmt.Errorf("record without owner name")
+	}
+	p.previous = name
+
+	// TTL and class may appear in either order before the type
+	ttl := p.ttl
+	for len(tokens) > 0 && !tokens[0].quoted {
+		text := strings.ToUpper(tokens[0].text)
+		if isClass(text) {
+			if text != "IN" {
+				return fmt.Errorf("unsupported class %s", text)
+			}
+		} else if value, err := parseTTL(text); err == nil {
+			ttl = value
+		} else {
+			break
+		}
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("missing record type")
+	}
+
+	recordType := models.DNSRecordType(strings.ToUpper(tokens[0].text))
+	content, err := p.content(recordType, tokens[1:])
+	if err != nil {
+		return err
+	}
+
+	p.records = append(p.records, Record{Name: name, Type: recordType, TTL: ttl, Content: content})
+	return nil
+}
+
+func (p *parser) directive(tokens []token) error {
+	switch strings.ToUpper(tokens[0].text) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$ORIGIN expects one domain name")
+		}
+		p.origin = p.qualify(tokens[1].text)
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("$TTL expects one value")
+		}
+		ttl, err := parseTTL(tokens[1].text)
+		if err != nil {
+			return err
+		}
+		p.ttl = ttl
+	default:
+		return fmt.Errorf("unsupported directive %s", tokens[0].text)
+	}
+	return nil
+}
+
+func (p *parser) content(recordType models.DNSRecordType, tokens []token) (string, error) {
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("missing %s record data", recordType)
+	}
+
+	fields := make([]string, len(tokens))
+	for i, t := range tokens {
+		fields[i] = t.text
+	}
+
+	// Domain name fields are stored fully qualified with a trailing dot
+	switch recordType {
+	case models.DNSRecordTypeCNAME, models.DNSRecordTypeNS, models.DNSRecordTypePTR, models.DNSRecordTypeDNAME:
+		fields[0] = p.qualify(fields[0]) + "."
+	case models.DNSRecordTypeMX:
+		if len(fields) != 2 {
+			return "", fmt.Errorf("MX expects preference and exchange")
+		}
+		fields[1] = p.qualify(fields[1]) + "."
+	case models.DNSRecordTypeSRV:
+		if len(fields) != 4 {
+			return "", fmt.Errorf("SRV expects priority, weight, port and target")
+		}
+		fields[3] = p.qualify(fields[3]) + "."
+	case models.DNSRecordTypeTXT:
+		return strings.Join(fields, ""), nil
+	}
+
+	for i, t := range tokens {
+		if t.quoted {
+			fields[i] = quote(fields[i])
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// qualify resolves a zone file name against the current origin
+func (p *parser) qualify(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return normalizeName(name)
+	case p.origin == "":
+		return normalizeName(name)
+	default:
+		return normalizeName(name + "." + p.origin)
+	}
+}
+
+func isClass(s string) bool {
+	switch s {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// parseTTL accepts plain seconds and BIND unit suffixes such as 1h30m
+func parseTTL(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("negative TTL %s", s)
+		}
+		return n, nil
+	}
+
+	units := map[byte]int{'S': 1, 'M': 60, 'H': 3600, 'D': 86400, 'W': 604800}
+	total, current := 0, ""
+	for i := 0; i < len(s); i++ {
+		c := s[i] &^ 0x20
+		if s[i] >= '0' && s[i] <= '9' {
+			current += string(s[i])
+			continue
+		}
+		unit, ok := units[c]
+		if !ok || current == "" {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		n, _ := strconv.Atoi(current)
+		total += n * unit
+		current = ""
+	}
+	if current != "" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
@@ -0,0 +1,144 @@
+package dnszone
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const sampleZone = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+	IN	NS	ns1
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.10
+	IN	300	AAAA	2001:db8::10
+blog		CNAME	www
+_sip._tcp	SRV	10 60 5060 sip.example.org.
+txt		TXT	"v=spf1 include:_spf.example.com" " ~all"
+`
+
+func TestParse(t *testing.T) {
+	records, err := Parse(strings.NewReader(sampleZone), "ignored.test")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := []Record{
+		{Name: "example.com", Type: models.DNSRecordTypeSOA, TTL: 3600, Content: "ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 3600"},
+		{Name: "example.com", Type: models.DNSRecordTypeNS, TTL: 3600, Content: "ns1.example.com."},
+		{Name: "example.com", Type: models.DNSRecordTypeMX, TTL: 3600, Content: "10 mail.example.com."},
+		{Name: "www.example.com", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.10"},
+		{Name: "www.example.com", Type: models.DNSRecordTypeAAAA, TTL: 300, Content: "2001:db8::10"},
+		{Name: "blog.example.com", Type: models.DNSRecordTypeCNAME, TTL: 3600, Content: "www.example.com."},
+		{Name: "_sip._tcp.example.com", Type: models.DNSRecordTypeSRV, TTL: 3600, Content: "10 60 5060 sip.example.org."},
+		{Name: "txt.example.com", Type: models.DNSRecordTypeTXT, TTL: 3600, Content: "v=spf1 include:_spf.example.com ~all"},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d: %+v", len(records), len(want), records)
+	}
+	for i := range want {
+		if records[i] != want[i] {
+			t.Errorf("record %d = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"unterminated parenthesis": "@ IN SOA a. b. ( 1 2 3 4 5\n",
+		"unterminated string":      "@ IN TXT \"oops\n",
+		"missing owner":            " IN A 192.0.2.1\n",
+		"unsupported directive":    "$INCLUDE other.zone\n",
+		"missing rdata":            "www IN A\n",
+	}
+	for name, zone := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(zone), "example.com"); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestWriteParseRoundTrip(t *testing.T) {
+	records := []Record{
+		{Name: "example.com", Type: models.DNSRecordTypeA, TTL: 60, Content: "192.0.2.1"},
+		{Name: "www.example.com", Type: models.DNSRecordTypeCNAME, TTL: 300, Content: "example.com."},
+		{Name: "other.org", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.2"},
+		{Name: "long.example.com", Type: models.DNSRecordTypeTXT, TTL: 300, Content: strings.Repeat("x", 300) + ` "quoted"`},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "example.com.", records); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "@\t60\tIN\tA\t192.0.2.1") {
+		t.Errorf("apex record not written relative to origin:\n%s", buf.String())
+	}
+
+	parsed, err := Parse(&buf, "")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	got := map[Record]bool{}
+	for _, r := range parsed {
+		got[r] = true
+	}
+	for _, r := range records {
+		if !got[r] {
+			t.Errorf("record %+v lost in round trip, got %+v", r, parsed)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	live := []models.DnsRecord1{
+		{ID: "1", Name: "www.example.com", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.1"},
+		{ID: "2", Name: "www.example.com", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.2"},
+		{ID: "3", Name: "Mail.Example.com.", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.3"},
+		{ID: "4", Name: "example.com", Type: models.DNSRecordTypeSOA, TTL: 3600, Content: "platform managed"},
+	}
+	desired := []Record{
+		{Name: "www.example.com", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.1"},
+		{Name: "mail.example.com", Type: models.DNSRecordTypeA, TTL: 60, Content: "192.0.2.3"},
+		{Name: "new.example.com", Type: models.DNSRecordTypeA, TTL: 300, Content: "192.0.2.4"},
+		{Name: "example.com", Type: models.DNSRecordTypeSOA, TTL: 3600, Content: "from zone file"},
+	}
+
+	plan := Diff(live, desired)
+
+	var deleted []string
+	for _, r := range plan.Delete {
+		deleted = append(deleted, r.ID)
+	}
+	if strings.Join(deleted, ",") != "2,3" {
+		t.Errorf("deleted = %v, want [2 3]", deleted)
+	}
+
+	if len(plan.Create) != 2 || plan.Create[0].Name != "mail.example.com" || plan.Create[1].Name != "new.example.com" {
+		t.Errorf("created = %+v, want mail (new TTL) and new", plan.Create)
+	}
+}
+
+func TestRequireResource(t *testing.T) {
+	tracer := noop.NewTracerProvider().Tracer("test")
+	if _, err := Import(context.Background(), nil, tracer, strings.NewReader(""), "orga_1", "", "example.com."); !errors.Is(err, ErrNoResource) {
+		t.Errorf("Import = %v, want ErrNoResource", err)
+	}
+	if err := Apply(context.Background(), nil, tracer, "orga_1", "", Plan{Create: []Record{{Name: "www.example.com"}}}); !errors.Is(err, ErrNoResource) {
+		t.Errorf("Apply = %v, want ErrNoResource", err)
+	}
+}
@@ -0,0 +1,197 @@
+package envsync
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Var is an environment variable
+type Var struct {
+	Name  string
+	Value string
+}
+
+// ParseError reports a malformed .env line
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("envsync: line %d: %s", e.Line, e.Msg)
+}
+
+// ParseDotenv reads a .env file.
+//
+// Lines are NAME=value, optionally prefixed by "export". Blank lines and lines
+// starting with # are ignored. Unquoted values end at " #". Single quoted
+// values are literal, double quoted values understand \n, \t, \" and \\ and
+// may span several lines. A later definition of a name overrides an earlier one.
+func ParseDotenv(r io.Reader) ([]Var, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		vars  []Var
+		index = map[string]int{}
+		line  int
+	)
+	next := func() (string, bool) {
+		if !scanner.Scan() {
+			return "", false
+		}
+		line++
+		return scanner.Text(), true
+	}
+
+	for {
+		text, ok := next()
+		if !ok {
+			break
+		}
+		start := line
+
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		trimmed = strings.TrimPrefix(trimmed, "export ")
+
+		name, rest, found := strings.Cut(trimmed, "=")
+		name = strings.TrimSpace(name)
+		if !found {
+			return nil, &ParseError{Line: start, Msg: "missing '='"}
+		}
+		if !validName(name) {
+			return nil, &ParseError{Line: start, Msg: fmt.Sprintf("invalid variable name %q", name)}
+		}
+
+		rest = strings.TrimLeft(rest, " \t")
+		var value string
+		switch {
+		case strings.HasPrefix(rest, `"`):
+			raw := rest[1:]
+			for {
+				end := closingQuote(raw)
+				if end >= 0 {
+					value = unescape(raw[:end])
+					break
+				}
+				more, ok := next()
+				if !ok {
+					return nil, &ParseError{Line: start, Msg: "unterminated double quoted value"}
+				}
+				raw += "\n" + more
+			}
+		case strings.HasPrefix(rest, "'"):
+			end := strings.Index(rest[1:], "'")
+			if end < 0 {
+				return nil, &ParseError{Line: start, Msg: "unterminated single quoted value"}
+			}
+			value = rest[1 : end+1]
+		default:
+			if i := strings.Index(rest, " #"); i >= 0 {
+				rest = rest[:i]
+			}
+			value = strings.TrimSpace(rest)
+		}
+
+		if i, ok := index[name]; ok {
+			vars[i].Value = value
+			continue
+		}
+		index[name] = len(vars)
+		vars = append(vars, Var{Name: name, Value: value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// WriteDotenv writes variables in .env format, quoting values when needed
+func WriteDotenv(w io.Writer, vars []Var) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range vars {
+		fmt.Fprintf(bw, "%s=%s\n", v.Name, quoteValue(v.Value))
+	}
+	return bw.Flush()
+}
+
+func validName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c == '_', c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+		case c >= '0' && c <= '9' && i > 0:
+		case (c == '.' || c == '-') && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// closingQuote returns the index of the first unescaped double quote, or -1
+func closingQuote(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+func unescape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+func quoteValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\r\"'#\\$`=") {
+		return value
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, c := range value {
+		switch c {
+		case '"', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			// Newlines are kept as is so multiline values stay readable
+			sb.WriteRune(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
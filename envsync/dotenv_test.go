@@ -0,0 +1,165 @@
+package envsync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const sampleDotenv = `# database
+export DB_HOST=db.example.com
+DB_PORT = 5432 # default port
+PASSWORD='p@ss #not a comment'
+GREETING="hello\n\"world\""
+CERT="-----BEGIN CERT-----
+abc
+-----END CERT-----"
+EMPTY=
+DB_PORT=5433
+`
+
+func TestParseDotenv(t *testing.T) {
+	vars, err := ParseDotenv(strings.NewReader(sampleDotenv))
+	if err != nil {
+		t.Fatalf("ParseDotenv failed: %v", err)
+	}
+
+	want := []Var{
+		{Name: "DB_HOST", Value: "db.example.com"},
+		{Name: "DB_PORT", Value: "5433"},
+		{Name: "PASSWORD", Value: "p@ss #not a comment"},
+		{Name: "GREETING", Value: "hello\n\"world\""},
+		{Name: "CERT", Value: "-----BEGIN CERT-----\nabc\n-----END CERT-----"},
+		{Name: "EMPTY", Value: ""},
+	}
+	if len(vars) != len(want) {
+		t.Fatalf("got %d vars, want %d: %+v", len(vars), len(want), vars)
+	}
+	for i := range want {
+		if vars[i] != want[i] {
+			t.Errorf("var %d = %+v, want %+v", i, vars[i], want[i])
+		}
+	}
+}
+
+func TestParseDotenvErrors(t *testing.T) {
+	for _, input := range []string{"NOEQUAL\n", "1BAD=x\n", "OPEN=\"never closed\n", "OPEN='never closed\n"} {
+		if _, err := ParseDotenv(strings.NewReader(input)); err == nil {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestDotenvRoundTrip(t *testing.T) {
+	vars := []Var{
+		{Name: "PLAIN", Value: "value"},
+		{Name: "SPACES", Value: "a b  c"},
+		{Name: "QUOTES", Value: `say "hi" it's \ok`},
+		{Name: "MULTI", Value: "line1\nline2\ttab"},
+		{Name: "EMPTY", Value: ""},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDotenv(&buf, vars); err != nil {
+		t.Fatalf("WriteDotenv failed: %v", err)
+	}
+
+	parsed, err := ParseDotenv(&buf)
+	if err != nil {
+		t.Fatalf("ParseDotenv failed: %v\n%s", err, buf.String())
+	}
+	for i := range vars {
+		if parsed[i] != vars[i] {
+			t.Errorf("var %d = %+v, want %+v", i, parsed[i], vars[i])
+		}
+	}
+}
+
+func TestQuoteValueRoundTrip(t *testing.T) {
+	for _, value := range []string{
+		"$HOME",
+		"price: $5 and ${NOT_EXPANDED}",
+		`C:\path\to`,
+		`trailing\`,
+		`\n is not a newline`,
+		"line1\\nline2\nline3",
+		"$\\\n\"'",
+	} {
+		line := "VAR=" + quoteValue(value) + "\n"
+		vars, err := ParseDotenv(strings.NewReader(line))
+		if err != nil || len(vars) != 1 || vars[0].Value != value {
+			t.Errorf("%q parsed as %+v, %v, want %q", line, vars, err, value)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	live := []models.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}, {Name: "C", Value: "3"}}
+	desired := []Var{{Name: "A", Value: "1"}, {Name: "B", Value: "changed"}, {Name: "D", Value: "4"}}
+
+	merge := Diff(live, desired, ModeMerge)
+	if got := kinds(merge); got != "A:unchanged B:update C:unchanged D:add" {
+		t.Errorf("merge = %s", got)
+	}
+	if len(merge.Result()) != 4 {
+		t.Errorf("merge result = %+v, want 4 variables", merge.Result())
+	}
+
+	replace := Diff(live, desired, ModeReplace)
+	if got := kinds(replace); got != "A:unchanged B:update C:remove D:add" {
+		t.Errorf("replace = %s", got)
+	}
+	if len(replace.Result()) != 3 {
+		t.Errorf("replace result = %+v, want 3 variables", replace.Result())
+	}
+
+	reordered := []models.EnvVar{live[2], live[0], live[1]}
+	if Fingerprint(reordered) != merge.Fingerprint {
+		t.Errorf("fingerprint should not depend on order")
+	}
+
+	var buf bytes.Buffer
+	if err := replace.Print(&buf, true); err != nil {
+		t.Fatalf("Print failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "changed") || !strings.Contains(buf.String(), "~ B: (masked, 1 bytes) -> (masked, 7 bytes)") {
+		t.Errorf("masked output leaks values:\n%s", buf.String())
+	}
+}
+
+func kinds(p Plan) string {
+	var parts []string
+	for _, c := range p.Changes {
+		parts = append(parts, c.Name+":"+c.Kind.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestApplyConflict(t *testing.T) {
+	live := []models.EnvVar{{Name: "A", Value: "1"}}
+	replaced := false
+	s := New(nil, noop.NewTracerProvider().Tracer("test"), "addon_1")
+	s.list = func(ctx context.Context) ([]models.EnvVar, error) {
+		return live, nil
+	}
+	s.replace = func(ctx context.Context, vars []*models.WannabeEnvVar) ([]models.EnvVar, error) {
+		replaced = true
+		return nil, nil
+	}
+
+	plan, err := s.Plan(context.Background(), []Var{{Name: "A", Value: "2"}}, ModeMerge)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	// someone else changes the variables between the plan and its application
+	live = []models.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}
+
+	if _, err := s.Apply(context.Background(), plan); !errors.Is(err, ErrConflict) || replaced {
+		t.Errorf("Apply = %v, replaced %v, want ErrConflict without writing", err, replaced)
+	}
+}
@@ -0,0 +1,291 @@
+// Package envsync synchronizes configuration provider variables with .env files.
+//
+// Replaceconfigurationproviderenv overwrites every variable of the add-on, so
+// a Plan is computed against the live variables first and Apply refuses to
+// run it if the variables changed in the meantime.
+package envsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	configurationprovider "go.clever-cloud.dev/sdk/services/configuration_provider"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrConflict is returned by Apply when the live variables changed since the plan was made
+var ErrConflict = errors.New("envsync: variables changed since the plan was computed")
+
+// Mode selects how desired variables are combined with the live ones
+type Mode string
+
+const (
+	// ModeMerge adds and updates variables, keeping live variables absent from the file
+	ModeMerge Mode = "merge"
+	// ModeReplace makes the live variables exactly match the file
+	ModeReplace Mode = "replace"
+)
+
+// String returns the underlying string value
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ChangeKind describes what happens to a variable
+type ChangeKind string
+
+const (
+	ChangeAdd       ChangeKind = "add"
+	ChangeUpdate    ChangeKind = "update"
+	ChangeRemove    ChangeKind = "remove"
+	ChangeUnchanged ChangeKind = "unchanged"
+)
+
+// String returns the underlying string value
+func (k ChangeKind) String() string {
+	return string(k)
+}
+
+// Change is the planned change of one variable
+type Change struct {
+	Name string
+	Kind ChangeKind
+	Old  string
+	New  string
+}
+
+// Plan is the result of comparing desired variables with the live ones
+type Plan struct {
+	AddonID ids.AddonID
+	Mode    Mode
+	Changes []Change
+	// Fingerprint identifies the live variables the plan was computed against
+	Fingerprint string
+}
+
+// HasChanges reports whether applying the plan would modify anything
+func (p Plan) HasChanges() bool {
+	return slices.ContainsFunc(p.Changes, func(c Change) bool { return c.Kind != ChangeUnchanged })
+}
+
+// Result returns the variables the add-on holds once the plan is applied
+func (p Plan) Result() []Var {
+	var vars []Var
+	for _, c := range p.Changes {
+		switch c.Kind {
+		case ChangeAdd, ChangeUpdate:
+			vars = append(vars, Var{Name: c.Name, Value: c.New})
+		case ChangeUnchanged:
+			vars = append(vars, Var{Name: c.Name, Value: c.Old})
+		}
+	}
+	return vars
+}
+
+// Print writes the plan as a diff. When mask is true, values are replaced by
+// their length so that nothing of the secrets is disclosed.
+func (p Plan) Print(w io.Writer, mask bool) error {
+	show := func(value string) string {
+		if mask {
+			return Mask(value)
+		}
+		return fmt.Sprintf("%q", value)
+	}
+
+	for _, c := range p.Changes {
+		var line string
+		switch c.Kind {
+		case ChangeAdd:
+			line = fmt.Sprintf("+ %s=%s", c.Name, show(c.New))
+		case ChangeUpdate:
+			line = fmt.Sprintf("~ %s: %s -> %s", c.Name, show(c.Old), show(c.New))
+		case ChangeRemove:
+			line = fmt.Sprintf("- %s", c.Name)
+		default:
+			continue
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Mask returns a constant marker with the length of a value. A hash would
+// let anyone holding the output check guesses of short or common values.
+func Mask(value string) string {
+	if value == "" {
+		return "(empty)"
+	}
+	return fmt.Sprintf("(masked, %d bytes)", len(value))
+}
+
+// Diff compares desired variables with the live ones.
+// Changes are sorted by name.
+func Diff(live []models.EnvVar, desired []Var, mode Mode) Plan {
+	current := map[string]string{}
+	for _, v := range live {
+		current[v.Name] = v.Value
+	}
+	wanted := map[string]string{}
+	for _, v := range desired {
+		wanted[v.Name] = v.Value
+	}
+
+	var changes []Change
+	for name, value := range wanted {
+		old, ok := current[name]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Name: name, Kind: ChangeAdd, New: value})
+		case old != value:
+			changes = append(changes, Change{Name: name, Kind: ChangeUpdate, Old: old, New: value})
+		default:
+			changes = append(changes, Change{Name: name, Kind: ChangeUnchanged, Old: old, New: value})
+		}
+	}
+	for name, value := range current {
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+		kind := ChangeUnchanged
+		if mode == ModeReplace {
+			kind = ChangeRemove
+		}
+		changes = append(changes, Change{Name: name, Kind: kind, Old: value, New: value})
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return Plan{Mode: mode, Changes: changes, Fingerprint: Fingerprint(live)}
+}
+
+// Fingerprint hashes a set of variables independently of their order
+func Fingerprint(vars []models.EnvVar) string {
+	sorted := slices.Clone(vars)
+	slices.SortFunc(sorted, func(a, b models.EnvVar) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	h := sha256.New()
+	for _, v := range sorted {
+		fmt.Fprintf(h, "%d:%s%d:%s", len(v.Name), v.Name, len(v.Value), v.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Syncer plans and applies variable changes on a configuration provider add-on
+type Syncer struct {
+	client  *client.Client
+	tracer  trace.Tracer
+	addonID ids.AddonID
+
+	// API calls, replaced in tests
+	list    func(ctx context.Context) ([]models.EnvVar, error)
+	replace func(ctx context.Context, vars []*models.WannabeEnvVar) ([]models.EnvVar, error)
+}
+
+// New creates a Syncer for a configuration provider add-on
+func New(c *client.Client, tracer trace.Tracer, addonID ids.AddonID) *Syncer {
+	s := &Syncer{client: c, tracer: tracer, addonID: addonID}
+	s.list = func(ctx context.Context) ([]models.EnvVar, error) {
+		response := configurationprovider.Listconfigurationproviderenv(ctx, s.client, s.tracer, s.addonID)
+		if response.HasError() {
+			return nil, response.Error()
+		}
+		return *response.Payload(), nil
+	}
+	s.replace = func(ctx context.Context, vars []*models.WannabeEnvVar) ([]models.EnvVar, error) {
+		response := configurationprovider.Replaceconfigurationproviderenv(ctx, s.client, s.tracer, s.addonID, vars)
+		if response.HasError() {
+			return nil, response.Error()
+		}
+		return *response.Payload(), nil
+	}
+	return s
+}
+
+// Live returns the current variables of the add-on
+func (s *Syncer) Live(ctx context.Context) ([]models.EnvVar, error) {
+	return s.list(ctx)
+}
+
+// Export writes the live variables as a .env file
+func (s *Syncer) Export(ctx context.Context, w io.Writer) error {
+	live, err := s.Live(ctx)
+	if err != nil {
+		return err
+	}
+
+	vars := make([]Var, 0, len(live))
+	for _, v := range live {
+		vars = append(vars, Var{Name: v.Name, Value: v.Value})
+	}
+	return WriteDotenv(w, vars)
+}
+
+// Plan computes the changes needed to apply desired with the given mode
+func (s *Syncer) Plan(ctx context.Context, desired []Var, mode Mode) (Plan, error) {
+	live, err := s.Live(ctx)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	plan := Diff(live, desired, mode)
+	plan.AddonID = s.addonID
+	return plan, nil
+}
+
+// Apply writes the result of a plan.
+// It fails with ErrConflict when the live variables no longer match the plan.
+func (s *Syncer) Apply(ctx context.Context, plan Plan) ([]models.EnvVar, error) {
+	ctx, span := s.tracer.Start(ctx, "envsync.Apply", trace.WithAttributes(
+		attribute.String("addonId", string(s.addonID)),
+		attribute.String("mode", plan.Mode.String()),
+	))
+	defer span.End()
+
+	if plan.AddonID != "" && plan.AddonID != s.addonID {
+		err := fmt.Errorf("envsync: plan was computed for %s, not %s", plan.AddonID, s.addonID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	live, err := s.Live(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if Fingerprint(live) != plan.Fingerprint {
+		span.RecordError(ErrConflict)
+		return nil, ErrConflict
+	}
+	if !plan.HasChanges() {
+		return live, nil
+	}
+
+	result := plan.Result()
+	body := make([]*models.WannabeEnvVar, 0, len(result))
+	for _, v := range result {
+		body = append(body, &models.WannabeEnvVar{Name: v.Name, Value: v.Value})
+	}
+
+	replaced, err := s.replace(ctx, body)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return replaced, nil
+}
@@ -0,0 +1,109 @@
+package functiondeploy
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+)
+
+// History records the deployments the pipeline made live, the live one
+// first. The API does not tell which deployment is live, and the update
+// time of a deployment cannot tell either: editing a deployment, for
+// instance tagging it, updates it too.
+type History interface {
+	Load() ([]ids.DeploymentID, error)
+	Save([]ids.DeploymentID) error
+}
+
+// promote records a deployment as live, moving it first
+func promote(h History, deploymentID ids.DeploymentID) error {
+	promoted, err := h.Load()
+	if err != nil {
+		return err
+	}
+	promoted = slices.DeleteFunc(promoted, func(id ids.DeploymentID) bool { return id == deploymentID })
+	return h.Save(append([]ids.DeploymentID{deploymentID}, promoted...))
+}
+
+// FileHistory is a History backed by a JSON file, for pipelines run by
+// successive processes
+type FileHistory struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileHistory creates a History that writes promotions to path
+func NewFileHistory(path string) *FileHistory {
+	return &FileHistory{Path: path}
+}
+
+// Load reads the history file, empty when it does not exist
+func (h *FileHistory) Load() ([]ids.DeploymentID, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	data, err := os.ReadFile(h.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []ids.DeploymentID
+	if err := json.Unmarshal(data, &promoted); err != nil {
+		return nil, err
+	}
+	return promoted, nil
+}
+
+// Save atomically replaces the history file
+func (h *FileHistory) Save(promoted []ids.DeploymentID) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	data, err := json.MarshalIndent(promoted, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(h.Path), filepath.Base(h.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), h.Path)
+}
+
+// memoryHistory keeps promotions in memory when no History is configured
+type memoryHistory struct {
+	mu       sync.Mutex
+	promoted []ids.DeploymentID
+}
+
+func (h *memoryHistory) Load() ([]ids.DeploymentID, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.promoted), nil
+}
+
+func (h *memoryHistory) Save(promoted []ids.DeploymentID) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.promoted = slices.Clone(promoted)
+	return nil
+}
@@ -0,0 +1,413 @@
+// Package functiondeploy runs the deployment pipeline of a function.
+//
+// A Pipeline creates a deployment, uploads the artifact to the URL returned
+// by the API, triggers the deployment and waits for it to become READY. It
+// then prunes deployments beyond a retention count. Rollback re-triggers the
+// previous successful deployment.
+//
+// The live deployment is the one the pipeline made READY last, as recorded
+// in its History: pruning always keeps it and rolling back replaces it with
+// the one live before. The API does not expose it, so deployments made live
+// by other means are not known to the pipeline.
+package functiondeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	function "go.clever-cloud.dev/sdk/services/function"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrDeploymentFailed is returned when a deployment ends in ERROR
+var ErrDeploymentFailed = errors.New("functiondeploy: deployment failed")
+
+// ErrNoPreviousDeployment is returned by Rollback when there is nothing to roll back to
+var ErrNoPreviousDeployment = errors.New("functiondeploy: no previous successful deployment")
+
+// Pipeline deploys artifacts to one function
+type Pipeline struct {
+	client     *client.Client
+	tracer     trace.Tracer
+	ownerID    ids.OwnerID
+	functionID ids.FunctionID
+
+	httpClient   *http.Client
+	pollInterval time.Duration
+	timeout      time.Duration
+	retention    int
+	history      History
+
+	// API calls, replaced in tests
+	create  func(ctx context.Context, opts *models.DeploymentCreateOpts) (models.DeploymentCreationResponse, error)
+	trigger func(ctx context.Context, deploymentID ids.DeploymentID) error
+	get     func(ctx context.Context, deploymentID ids.DeploymentID) (models.Deployment1, error)
+	list    func(ctx context.Context) ([]models.Deployment1, error)
+	replace func(ctx context.Context, deploymentID ids.DeploymentID, opts *models.DeploymentUpdateOpts) (models.Deployment1, error)
+	remove  func(ctx context.Context, deploymentID ids.DeploymentID) error
+}
+
+// Option defines configuration options for the Pipeline
+type Option func(*Pipeline)
+
+// WithHTTPClient sets the HTTP client used to upload artifacts
+func WithHTTPClient(h *http.Client) Option {
+	return func(p *Pipeline) {
+		p.httpClient = h
+	}
+}
+
+// WithPollInterval sets the delay between two status checks
+func WithPollInterval(d time.Duration) Option {
+	return func(p *Pipeline) {
+		p.pollInterval = d
+	}
+}
+
+// WithTimeout sets how long to wait for a deployment to become READY
+func WithTimeout(d time.Duration) Option {
+	return func(p *Pipeline) {
+		p.timeout = d
+	}
+}
+
+// WithRetention sets how many deployments are kept after a successful deploy.
+// Zero disables pruning.
+func WithRetention(n int) Option {
+	return func(p *Pipeline) {
+		p.retention = n
+	}
+}
+
+// WithHistory sets where the deployments made live are recorded, in memory
+// by default
+func WithHistory(h History) Option {
+	return func(p *Pipeline) {
+		p.history = h
+	}
+}
+
+// New creates a Pipeline for an existing function
+func New(c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, functionID ids.FunctionID, opts ...Option) *Pipeline {
+	p := &Pipeline{
+		client:       c,
+		tracer:       tracer,
+		ownerID:      ownerID,
+		functionID:   functionID,
+		httpClient:   http.DefaultClient,
+		pollInterval: 2 * time.Second,
+		timeout:      10 * time.Minute,
+		history:      &memoryHistory{},
+	}
+
+	p.create = func(ctx context.Context, opts *models.DeploymentCreateOpts) (models.DeploymentCreationResponse, error) {
+		return payload(function.Createfunctiondeployment(ctx, p.client, p.tracer, p.ownerID, p.functionID, opts))
+	}
+	p.trigger = func(ctx context.Context, deploymentID ids.DeploymentID) error {
+		return errorOf(function.Triggerdeployment(ctx, p.client, p.tracer, p.ownerID, p.functionID, deploymentID))
+	}
+	p.get = func(ctx context.Context, deploymentID ids.DeploymentID) (models.Deployment1, error) {
+		return payload(function.Getfunctiondeployment(ctx, p.client, p.tracer, p.ownerID, p.functionID, deploymentID))
+	}
+	p.list = func(ctx context.Context) ([]models.Deployment1, error) {
+		return payload(function.Listdeployments(ctx, p.client, p.tracer, p.ownerID, p.functionID))
+	}
+	p.replace = func(ctx context.Context, deploymentID ids.DeploymentID, opts *models.DeploymentUpdateOpts) (models.Deployment1, error) {
+		return payload(function.Replacedeployment(ctx, p.client, p.tracer, p.ownerID, p.functionID, deploymentID, opts))
+	}
+	p.remove = func(ctx context.Context, deploymentID ids.DeploymentID) error {
+		return errorOf(function.Deletedeployment(ctx, p.client, p.tracer, p.ownerID, p.functionID, deploymentID))
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+func payload[T any](response client.Response[T]) (T, error) {
+	if response.HasError() {
+		var zero T
+		return zero, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+func errorOf[T any](response client.Response[T]) error {
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
+
+// Create creates a function and returns a Pipeline for it
+func Create(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerID ids.OwnerID, fn *models.FunctionCreateOpts, opts ...Option) (*Pipeline, error) {
+	response := function.Createfunction(ctx, c, tracer, ownerID, fn)
+	if response.HasError() {
+		return nil, response.Error()
+	}
+	return New(c, tracer, ownerID, ids.FunctionID(response.Payload().ID), opts...), nil
+}
+
+// Artifact is the code to deploy
+type Artifact struct {
+	Platform models.FunctionPlatform
+	// Body is the WASM module or JavaScript bundle
+	Body io.Reader
+	// Size is the length of Body, or zero when unknown
+	Size        int64
+	Name        *models.DeploymentName
+	Description *models.DeploymentDescription
+	Tag         *models.DeploymentTag
+}
+
+// Deploy creates a deployment, uploads the artifact, triggers it and waits until it is READY.
+// Old deployments are pruned once the new one is live.
+func (p *Pipeline) Deploy(ctx context.Context, artifact Artifact) (models.Deployment1, error) {
+	ctx, span := p.tracer.Start(ctx, "functiondeploy.Deploy", trace.WithAttributes(
+		attribute.String("ownerId", string(p.ownerID)),
+		attribute.String("functionId", string(p.functionID)),
+		attribute.String("platform", artifact.Platform.String()),
+	))
+	defer span.End()
+
+	deployment, err := p.deploy(ctx, artifact)
+	if err != nil {
+		span.RecordError(err)
+		return deployment, err
+	}
+	span.SetAttributes(attribute.String("deploymentId", deployment.ID))
+
+	if p.retention > 0 {
+		if err := p.Prune(ctx, p.retention); err != nil {
+			span.RecordError(err)
+			return deployment, err
+		}
+	}
+
+	return deployment, nil
+}
+
+func (p *Pipeline) deploy(ctx context.Context, artifact Artifact) (models.Deployment1, error) {
+	deployment, err := p.create(ctx, &models.DeploymentCreateOpts{
+		Platform:    artifact.Platform,
+		Name:        artifact.Name,
+		Description: artifact.Description,
+		Tag:         artifact.Tag,
+	})
+	if err != nil {
+		return models.Deployment1{}, err
+	}
+
+	if err := p.upload(ctx, deployment.UploadURL, artifact); err != nil {
+		return models.Deployment1{}, fmt.Errorf("functiondeploy: upload deployment %s: %w", deployment.ID, err)
+	}
+
+	return p.run(ctx, ids.DeploymentID(deployment.ID), time.Time{})
+}
+
+// Live returns the READY deployments the pipeline made live, the live one
+// first, skipping those deleted or failed since
+func (p *Pipeline) Live(ctx context.Context) ([]models.Deployment1, error) {
+	promoted, err := p.history.Load()
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := p.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var live []models.Deployment1
+	for _, id := range promoted {
+		i := slices.IndexFunc(deployments, func(d models.Deployment1) bool { return ids.DeploymentID(d.ID) == id })
+		if i >= 0 && deployments[i].Status == models.FunctionDeploymentStatusREADY {
+			live = append(live, deployments[i])
+		}
+	}
+	return live, nil
+}
+
+func (p *Pipeline) upload(ctx context.Context, uploadURL string, artifact Artifact) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, artifact.Body)
+	if err != nil {
+		return err
+	}
+	if artifact.Size > 0 {
+		req.ContentLength = artifact.Size
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", res.Status, body)
+	}
+	return nil
+}
+
+// run triggers a deployment, waits until it is READY again after since, the
+// time it was last updated before being triggered, and records it as live
+func (p *Pipeline) run(ctx context.Context, deploymentID ids.DeploymentID, since time.Time) (models.Deployment1, error) {
+	if err := p.trigger(ctx, deploymentID); err != nil {
+		return models.Deployment1{}, err
+	}
+	deployment, err := p.wait(ctx, deploymentID, since)
+	if err != nil {
+		return deployment, err
+	}
+	if err := promote(p.history, deploymentID); err != nil {
+		return deployment, fmt.Errorf("functiondeploy: record deployment %s as live: %w", deploymentID, err)
+	}
+	return deployment, nil
+}
+
+// Wait polls a deployment until it is READY or ERROR
+func (p *Pipeline) Wait(ctx context.Context, deploymentID ids.DeploymentID) (models.Deployment1, error) {
+	return p.wait(ctx, deploymentID, time.Time{})
+}
+
+// wait polls a deployment until it is READY or ERROR. A deployment that
+// was neither updated after since nor seen in another status is still in
+// the state it had before being triggered and is polled again.
+func (p *Pipeline) wait(ctx context.Context, deploymentID ids.DeploymentID, since time.Time) (models.Deployment1, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	transitioned := false
+	for {
+		deployment, err := p.get(ctx, deploymentID)
+		if err != nil {
+			return models.Deployment1{}, err
+		}
+
+		if transitioned || deployment.UpdatedAt.After(since) {
+			switch deployment.Status {
+			case models.FunctionDeploymentStatusREADY:
+				return deployment, nil
+			case models.FunctionDeploymentStatusERROR:
+				reason := "no reason given"
+				if deployment.ErrorReason != nil {
+					reason = *deployment.ErrorReason
+				}
+				return deployment, fmt.Errorf("%w: %s: %s", ErrDeploymentFailed, deployment.ID, reason)
+			}
+		}
+		if deployment.Status != models.FunctionDeploymentStatusREADY && deployment.Status != models.FunctionDeploymentStatusERROR {
+			transitioned = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return deployment, fmt.Errorf("functiondeploy: deployment %s still %s: %w", deployment.ID, deployment.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tag sets the tag of a deployment
+func (p *Pipeline) Tag(ctx context.Context, deploymentID ids.DeploymentID, tag models.DeploymentTag) (models.Deployment1, error) {
+	deployment, err := p.get(ctx, deploymentID)
+	if err != nil {
+		return models.Deployment1{}, err
+	}
+
+	// Replace overwrites every field, keep the ones not being changed
+	return p.replace(ctx, deploymentID, &models.DeploymentUpdateOpts{
+		Name:        deployment.Name,
+		Description: deployment.Description,
+		Tag:         &tag,
+	})
+}
+
+// Deployments lists the deployments of the function, most recent first
+func (p *Pipeline) Deployments(ctx context.Context) ([]models.Deployment1, error) {
+	deployments, err := p.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments = slices.Clone(deployments)
+	slices.SortStableFunc(deployments, func(a, b models.Deployment1) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return deployments, nil
+}
+
+// Prune deletes deployments beyond the keep most recent ones.
+// The live deployment is always kept: when the pipeline has not made any
+// deployment live yet, no READY deployment is deleted.
+func (p *Pipeline) Prune(ctx context.Context, keep int) error {
+	deployments, err := p.Deployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	promoted, err := p.Live(ctx)
+	if err != nil {
+		return err
+	}
+	var live models.DeploymentID
+	if len(promoted) > 0 {
+		live = promoted[0].ID
+	}
+
+	var errs []error
+	for i, deployment := range deployments {
+		if i < keep || deployment.ID == live || live == "" && deployment.Status == models.FunctionDeploymentStatusREADY {
+			continue
+		}
+		if err := p.remove(ctx, ids.DeploymentID(deployment.ID)); err != nil {
+			errs = append(errs, fmt.Errorf("delete deployment %s: %w", deployment.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Rollback re-triggers the READY deployment that was live before the
+// current one and waits until it is READY again
+func (p *Pipeline) Rollback(ctx context.Context) (models.Deployment1, error) {
+	ctx, span := p.tracer.Start(ctx, "functiondeploy.Rollback", trace.WithAttributes(
+		attribute.String("ownerId", string(p.ownerID)),
+		attribute.String("functionId", string(p.functionID)),
+	))
+	defer span.End()
+
+	// Successive rollbacks alternate between the last two live deployments
+	ready, err := p.Live(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return models.Deployment1{}, err
+	}
+	if len(ready) < 2 {
+		span.RecordError(ErrNoPreviousDeployment)
+		return models.Deployment1{}, ErrNoPreviousDeployment
+	}
+
+	previous := ready[1]
+	span.SetAttributes(attribute.String("deploymentId", string(previous.ID)))
+
+	deployment, err := p.run(ctx, ids.DeploymentID(previous.ID), previous.UpdatedAt)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return deployment, err
+}
@@ -0,0 +1,263 @@
+package functiondeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeFunction stores the deployments of a function. A triggered deployment
+// stays in its previous state for stale polls, then is DEPLOYING for one poll
+// before becoming READY, or ERROR when failing.
+type fakeFunction struct {
+	clock       time.Time
+	deployments map[ids.DeploymentID]*models.Deployment1
+	pending     map[ids.DeploymentID]int
+	failing     map[ids.DeploymentID]bool
+	stale       int
+	uploads     map[string]string
+	polls       int
+	deleted     []ids.DeploymentID
+}
+
+func newFakeFunction() *fakeFunction {
+	return &fakeFunction{
+		clock:       epoch,
+		deployments: map[ids.DeploymentID]*models.Deployment1{},
+		pending:     map[ids.DeploymentID]int{},
+		failing:     map[ids.DeploymentID]bool{},
+		uploads:     map[string]string{},
+	}
+}
+
+func (f *fakeFunction) tick() time.Time {
+	f.clock = f.clock.Add(time.Minute)
+	return f.clock
+}
+
+// add stores a deployment created and last triggered at increasing times
+func (f *fakeFunction) add(id string, status models.FunctionDeploymentStatus) {
+	now := f.tick()
+	f.deployments[ids.DeploymentID(id)] = &models.Deployment1{ID: id, Status: status, CreatedAt: now, UpdatedAt: now}
+}
+
+func (f *fakeFunction) pipeline(t *testing.T, opts ...Option) *Pipeline {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		f.uploads[strings.TrimPrefix(r.URL.Path, "/")] = string(body)
+	}))
+	t.Cleanup(server.Close)
+
+	opts = append([]Option{WithHTTPClient(server.Client()), WithPollInterval(time.Millisecond)}, opts...)
+	p := New(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "function_1", opts...)
+
+	p.create = func(ctx context.Context, opts *models.DeploymentCreateOpts) (models.DeploymentCreationResponse, error) {
+		id := fmt.Sprintf("deployment_%d", len(f.deployments)+1)
+		f.add(id, models.FunctionDeploymentStatusWaitingForUpload)
+		d := f.deployments[ids.DeploymentID(id)]
+		return models.DeploymentCreationResponse{ID: d.ID, Status: d.Status, CreatedAt: d.CreatedAt, UpdatedAt: d.UpdatedAt, UploadURL: server.URL + "/" + id}, nil
+	}
+	p.trigger = func(ctx context.Context, deploymentID ids.DeploymentID) error {
+		if _, ok := f.deployments[deploymentID]; !ok {
+			return errors.New("not found")
+		}
+		f.pending[deploymentID] = f.stale + 1
+		return nil
+	}
+	p.get = func(ctx context.Context, deploymentID ids.DeploymentID) (models.Deployment1, error) {
+		d, ok := f.deployments[deploymentID]
+		if !ok {
+			return models.Deployment1{}, errors.New("not found")
+		}
+		f.polls++
+		if n, ok := f.pending[deploymentID]; ok {
+			switch {
+			case n > 1:
+				f.pending[deploymentID] = n - 1
+			case n == 1:
+				f.pending[deploymentID] = 0
+				d.Status, d.UpdatedAt = models.FunctionDeploymentStatusDEPLOYING, f.tick()
+			default:
+				delete(f.pending, deploymentID)
+				d.Status, d.UpdatedAt = models.FunctionDeploymentStatusREADY, f.tick()
+				if f.failing[deploymentID] {
+					d.Status = models.FunctionDeploymentStatusERROR
+				}
+			}
+		}
+		return *d, nil
+	}
+	p.list = func(ctx context.Context) ([]models.Deployment1, error) {
+		var deployments []models.Deployment1
+		for _, d := range f.deployments {
+			deployments = append(deployments, *d)
+		}
+		return deployments, nil
+	}
+	p.replace = func(ctx context.Context, deploymentID ids.DeploymentID, opts *models.DeploymentUpdateOpts) (models.Deployment1, error) {
+		d, ok := f.deployments[deploymentID]
+		if !ok {
+			return models.Deployment1{}, errors.New("not found")
+		}
+		d.Name, d.Description, d.Tag, d.UpdatedAt = opts.Name, opts.Description, opts.Tag, f.tick()
+		return *d, nil
+	}
+	p.remove = func(ctx context.Context, deploymentID ids.DeploymentID) error {
+		delete(f.deployments, deploymentID)
+		f.deleted = append(f.deleted, deploymentID)
+		return nil
+	}
+	return p
+}
+
+func (f *fakeFunction) remaining() []string {
+	var remaining []string
+	for id := range f.deployments {
+		remaining = append(remaining, string(id))
+	}
+	slices.Sort(remaining)
+	return remaining
+}
+
+func TestDeploy(t *testing.T) {
+	f := newFakeFunction()
+	f.add("deployment_1", models.FunctionDeploymentStatusREADY)
+	f.add("deployment_2", models.FunctionDeploymentStatusERROR)
+	p := f.pipeline(t, WithRetention(2))
+
+	deployment, err := p.Deploy(context.Background(), Artifact{
+		Platform: models.FunctionPlatform("JAVA_SCRIPT"),
+		Body:     strings.NewReader("export default {}"),
+	})
+	if err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+	if deployment.ID != "deployment_3" || deployment.Status != models.FunctionDeploymentStatusREADY {
+		t.Errorf("Deploy = %s %s, want deployment_3 READY", deployment.ID, deployment.Status)
+	}
+	if got := f.uploads["deployment_3"]; got != "export default {}" {
+		t.Errorf("uploaded %q", got)
+	}
+	if got := f.remaining(); !slices.Equal(got, []string{"deployment_2", "deployment_3"}) {
+		t.Errorf("after pruning %q remain, want the 2 most recent", got)
+	}
+}
+
+func TestDeployFailure(t *testing.T) {
+	f := newFakeFunction()
+	f.failing["deployment_1"] = true
+
+	_, err := f.pipeline(t).Deploy(context.Background(), Artifact{Body: strings.NewReader("")})
+	if !errors.Is(err, ErrDeploymentFailed) {
+		t.Errorf("Deploy = %v, want ErrDeploymentFailed", err)
+	}
+}
+
+// deploy runs the pipeline for a new artifact
+func deploy(t *testing.T, p *Pipeline) models.Deployment1 {
+	t.Helper()
+	deployment, err := p.Deploy(context.Background(), Artifact{Body: strings.NewReader("export default {}")})
+	if err != nil {
+		t.Fatalf("Deploy: %v", err)
+	}
+	return deployment
+}
+
+func TestPruneKeepsLive(t *testing.T) {
+	f := newFakeFunction()
+	p := f.pipeline(t)
+	deploy(t, p)
+	deploy(t, p)
+	deploy(t, p)
+	// deployment_2 was rolled back to: it is live despite not being the most recent
+	if _, err := p.Rollback(context.Background()); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+
+	// tagging updates deployment_1, which must not make it look live
+	if _, err := p.Tag(context.Background(), "deployment_1", models.DeploymentTag("v1")); err != nil {
+		t.Fatalf("Tag: %v", err)
+	}
+	if err := p.Prune(context.Background(), 1); err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+	if got := f.remaining(); !slices.Equal(got, []string{"deployment_2", "deployment_3"}) {
+		t.Errorf("after pruning %q remain, want the most recent and the live one", got)
+	}
+}
+
+func TestPruneWithoutHistory(t *testing.T) {
+	f := newFakeFunction()
+	f.add("deployment_1", models.FunctionDeploymentStatusREADY)
+	f.add("deployment_2", models.FunctionDeploymentStatusERROR)
+	f.add("deployment_3", models.FunctionDeploymentStatusREADY)
+
+	if err := f.pipeline(t).Prune(context.Background(), 1); err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+	if got := f.remaining(); !slices.Equal(got, []string{"deployment_1", "deployment_3"}) {
+		t.Errorf("after pruning %q remain, want every READY deployment: the live one is unknown", got)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	f := newFakeFunction()
+	p := f.pipeline(t, WithHistory(NewFileHistory(filepath.Join(t.TempDir(), "history.json"))))
+	deploy(t, p)
+	deploy(t, p)
+	f.add("deployment_3", models.FunctionDeploymentStatusERROR)
+	// the API keeps returning the deployment as it was before the trigger
+	f.stale = 2
+	f.polls = 0
+
+	deployment, err := p.Rollback(context.Background())
+	if err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	previous := f.deployments["deployment_1"]
+	if deployment.ID != "deployment_1" || !deployment.UpdatedAt.Equal(previous.UpdatedAt) || previous.UpdatedAt.Before(f.deployments["deployment_2"].UpdatedAt) {
+		t.Errorf("Rollback = %s updated %s, want deployment_1 triggered again", deployment.ID, deployment.UpdatedAt)
+	}
+	if f.polls != 4 {
+		t.Errorf("polled %d times, want the 2 stale polls, DEPLOYING and READY", f.polls)
+	}
+
+	// rolling back again returns to the deployment live before
+	deployment, err = p.Rollback(context.Background())
+	if err != nil || deployment.ID != "deployment_2" {
+		t.Errorf("second Rollback = %s, %v, want deployment_2", deployment.ID, err)
+	}
+
+	// the history outlives the pipeline
+	p = f.pipeline(t, WithHistory(NewFileHistory(p.history.(*FileHistory).Path)))
+	if deployment, err = p.Rollback(context.Background()); err != nil || deployment.ID != "deployment_1" {
+		t.Errorf("Rollback from a new pipeline = %s, %v, want deployment_1", deployment.ID, err)
+	}
+}
+
+func TestRollbackWithoutPrevious(t *testing.T) {
+	f := newFakeFunction()
+	f.add("deployment_1", models.FunctionDeploymentStatusREADY)
+	f.add("deployment_2", models.FunctionDeploymentStatusERROR)
+	p := f.pipeline(t)
+	deploy(t, p)
+
+	// deployment_1 is READY but was never made live by the pipeline
+	if _, err := p.Rollback(context.Background()); !errors.Is(err, ErrNoPreviousDeployment) {
+		t.Errorf("Rollback = %v, want ErrNoPreviousDeployment", err)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/swaggest/openapi-go/openapi31"
+	naming "go.clever-cloud.dev/sdk/internal/naming"
 	"gopkg.in/yaml.v3"
 )
 
@@ -38,7 +39,10 @@ type BuilderOperation struct {
 
 type Param struct {
 	Name string
-	Type string
+	// Label names the builder method and its argument; it differs from Name
+	// for placeholder names such as p1
+	Label string
+	Type  string
 }
 
 // BuilderNode represents a node in the builder hierarchy
@@ -180,9 +184,15 @@ func extractOperations(spec *openapi31.Spec) []BuilderOperation {
 					continue
 				}
 				if string(param.In) == "path" {
+					label := naming.PathParamName(param.Name, path, getSchemaRef(param.Schema))
+					paramType := schemaMapToGoType(param.Schema)
+					if idType := naming.IDTypeName(naming.ParamName(label)); paramType == "string" && idType != "" {
+						paramType = "ids." + idType
+					}
 					pathParams = append(pathParams, Param{
-						Name: param.Name,
-						Type: schemaMapToGoType(param.Schema),
+						Name:  param.Name,
+						Label: label,
+						Type:  paramType,
 					})
 				} else if string(param.In) == "query" {
 					hasQueryParams = true
@@ -205,6 +215,11 @@ func extractOperations(spec *openapi31.Spec) []BuilderOperation {
 					}
 					break
 				}
+				// Inline schemas have no model: the payload is taken as is rather than dropped
+				if !hasRequestBody && len(op.RequestBody.RequestBody.Content) > 0 {
+					requestType = "any"
+					hasRequestBody = true
+				}
 			}
 
 			// Extract response type - check 200, 201, 202, 204 status codes
@@ -407,7 +422,7 @@ func schemaMapToGoType(schema map[string]any) string {
 			case "string", "int", "int64", "float64", "bool", "any":
 				return strings.ToLower(modelName)
 			default:
-				return "*models." + toGoStructName(modelName)
+				return "*models." + naming.GoStructName(modelName)
 			}
 		}
 	}
@@ -449,9 +464,9 @@ func schemaMapToGoTypeForResponse(schema map[string]any) string {
 			modelName := parts[len(parts)-1]
 			switch strings.ToLower(modelName) {
 			case "string", "int", "int64", "float64", "bool", "any":
-				return "any"
+				return "json.RawMessage"
 			default:
-				return "models." + toGoStructName(modelName)
+				return "models." + naming.GoStructName(modelName)
 			}
 		}
 	}
@@ -462,13 +477,13 @@ func schemaMapToGoTypeForResponse(schema map[string]any) string {
 			parts := strings.Split(itemsRef, "/")
 			if len(parts) > 0 {
 				modelName := parts[len(parts)-1]
-				return "[]models." + toGoStructName(modelName)
+				return "[]models." + naming.GoStructName(modelName)
 			}
 		}
 	}
 
-	// Primitives and inline objects become 'any'
-	return "any"
+	// Primitives and inline objects have no model, their payload is kept raw
+	return "json.RawMessage"
 }
 
 func buildPathTree(operations []BuilderOperation) *BuilderNode {
@@ -494,17 +509,19 @@ func buildPathTree(operations []BuilderOperation) *BuilderNode {
 				// Extract parameter name from {paramName}
 				paramName = strings.Trim(segment, "{}")
 
-				// Find parameter type from operation
+				// Use ":param" as key for all parameters at this position
+				key = ":" + paramName
+
+				// Find parameter label and type from operation
 				paramType = "string" // default
 				for _, p := range op.PathParams {
 					if p.Name == paramName {
+						paramName = p.Label
 						paramType = p.Type
 						break
 					}
 				}
-
-				// Use ":param" as key for all parameters at this position
-				key = ":" + paramName
+				segment = "{" + paramName + "}"
 			} else {
 				key = segment
 			}
@@ -828,6 +845,11 @@ func parseGoType(typeName string) *Statement {
 		return Index().Add(parseGoType(elementType))
 	}
 
+	// Handle identifier types
+	if idType, ok := strings.CutPrefix(typeName, "ids."); ok {
+		return Qual("go.clever-cloud.dev/sdk/ids", idType)
+	}
+
 	// Map basic types
 	switch typeName {
 	case "string":
@@ -904,7 +926,7 @@ func parseModelType(typeName string) *Statement {
 
 	// For models types, always return pointer
 	// Apply same Go struct name conversion as model generator
-	return Op("*").Qual("go.clever-cloud.dev/sdk/models", toGoStructName(typeName))
+	return Op("*").Qual("go.clever-cloud.dev/sdk/models", naming.GoStructName(typeName))
 }
 
 // parseModelTypeForResponse is like parseModelType but doesn't add pointers
@@ -918,21 +940,24 @@ func parseModelTypeForResponse(typeName string) *Statement {
 	if typeName == "client.Nothing" {
 		return Qual("go.clever-cloud.dev/client", "Nothing")
 	}
+	if typeName == "json.RawMessage" {
+		return Qual("encoding/json", "RawMessage")
+	}
 
 	// Handle array types
 	if elementType, ok := strings.CutPrefix(typeName, "[]"); ok {
 		if modelName, ok0 := strings.CutPrefix(elementType, "*models."); ok0 {
-			return Index().Op("*").Qual("go.clever-cloud.dev/sdk/models", toGoStructName(modelName))
+			return Index().Op("*").Qual("go.clever-cloud.dev/sdk/models", naming.GoStructName(modelName))
 		}
 		if modelName, ok0 := strings.CutPrefix(elementType, "models."); ok0 {
-			return Index().Qual("go.clever-cloud.dev/sdk/models", toGoStructName(modelName))
+			return Index().Qual("go.clever-cloud.dev/sdk/models", naming.GoStructName(modelName))
 		}
 		if modelName, ok0 := strings.CutPrefix(elementType, "*"); ok0 {
 			switch modelName {
 			case "string", "int", "int64", "float64", "bool", "any":
 				return Index().Add(parseGoType(modelName))
 			default:
-				return Index().Op("*").Qual("go.clever-cloud.dev/sdk/models", toGoStructName(modelName))
+				return Index().Op("*").Qual("go.clever-cloud.dev/sdk/models", naming.GoStructName(modelName))
 			}
 		}
 		return Index().Add(parseGoType(elementType))
@@ -958,7 +983,7 @@ func parseModelTypeForResponse(typeName string) *Statement {
 	}
 
 	// For models types, DON'T add pointer (services return value types in Response[T])
-	return Qual("go.clever-cloud.dev/sdk/models", toGoStructName(typeName))
+	return Qual("go.clever-cloud.dev/sdk/models", naming.GoStructName(typeName))
 }
 
 func toPascalCase(s string) string {
@@ -980,59 +1005,6 @@ func toPascalCase(s string) string {
 	return fixIDSuffixes(result)
 }
 
-// toGoStructName converts OpenAPI schema names to Go struct names
-// Must match the logic in generate-models/main.go
-func toGoStructName(name string) string {
-	// Preserve exact casing for certain names to avoid conflicts
-	if name == "WireGuard" {
-		return "WireGuard"
-	}
-	return toPascalCaseForTypes(name)
-}
-
-// toPascalCaseForTypes converts schema names to PascalCase for type names
-// Must match the logic in generate-models/main.go
-func toPascalCaseForTypes(s string) string {
-	// Handle special cases and clean input
-	s = strings.ReplaceAll(s, ".", "_DOT_")
-	s = strings.ReplaceAll(s, "+", "_PLUS_")
-	s = strings.ReplaceAll(s, "*", "_STAR_")
-	s = strings.ReplaceAll(s, "/", "_SLASH_")
-
-	// Check if string contains separators (underscore, hyphen, space)
-	hasSeparators := strings.ContainsAny(s, "_- ")
-
-	if !hasSeparators {
-		// No separators - assume already in PascalCase or single word
-		// Just ensure first letter is uppercase
-		if len(s) > 0 {
-			return strings.ToUpper(s[:1]) + s[1:]
-		}
-		return s
-	}
-
-	// Split on underscores, hyphens, and spaces
-	parts := strings.FieldsFunc(s, func(r rune) bool {
-		return r == '_' || r == '-' || r == ' '
-	})
-
-	for i, part := range parts {
-		if len(part) > 0 {
-			// Capitalize first letter of each part
-			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
-		}
-	}
-
-	result := strings.Join(parts, "")
-
-	// Ensure it starts with a letter or underscore (valid Go identifier)
-	if len(result) > 0 && (result[0] >= '0' && result[0] <= '9') {
-		result = "_" + result
-	}
-
-	return result
-}
-
 func toCamelCase(s string) string {
 	// Remove special characters and convert to camelCase
 	s = strings.Trim(s, "{}")
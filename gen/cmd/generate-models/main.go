@@ -14,6 +14,7 @@ import (
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/swaggest/openapi-go/openapi31"
+	naming "go.clever-cloud.dev/sdk/internal/naming"
 	"gopkg.in/yaml.v3"
 )
 
@@ -372,7 +373,7 @@ func processSchema(name string, schema Schema) (*ModelStruct, error) {
 	if getSchemaTitle(schema) != "" && len(getSchemaProperties(schema)) == 0 {
 		log.Printf("Warning: Schema %s has only a title and no type definition - using 'any'", name)
 		model := &ModelStruct{
-			Name:        toGoStructName(name),
+			Name:        naming.GoStructName(name),
 			Comment:     formatComment(getSchemaDescription(schema)),
 			IsTypeAlias: true,
 			AliasType:   "any",
@@ -402,7 +403,7 @@ func processMapSchema(name string, schema Schema, additionalPropertiesSchema Sch
 	// Create a type alias for the map
 	mapType := fmt.Sprintf("map[string]%s", valueType)
 	model := &ModelStruct{
-		Name:        toGoStructName(name),
+		Name:        naming.GoStructName(name),
 		Comment:     formatComment(getSchemaDescription(schema)),
 		IsTypeAlias: true,
 		AliasType:   mapType,
@@ -429,7 +430,7 @@ func processEnumSchema(name string, schema Schema) (*ModelStruct, error) {
 	}
 
 	model := &ModelStruct{
-		Name:       toGoStructName(name),
+		Name:       naming.GoStructName(name),
 		Comment:    formatComment(getSchemaDescription(schema)),
 		IsEnum:     true,
 		EnumType:   enumType,
@@ -454,7 +455,7 @@ func processTypeAliasSchema(name string, schema Schema) (*ModelStruct, error) {
 
 	// Create a type alias
 	model := &ModelStruct{
-		Name:        toGoStructName(name),
+		Name:        naming.GoStructName(name),
 		Comment:     formatComment(getSchemaDescription(schema)),
 		IsTypeAlias: true,
 		AliasType:   goType,
@@ -471,7 +472,7 @@ func processOneOfSchema(name string, schema Schema) (*ModelStruct, error) {
 		if ref := getSchemaRef(oneOfSchema); ref != "" {
 			// Extract type name from $ref
 			refName := strings.TrimPrefix(ref, "#/components/schemas/")
-			unionTypes = append(unionTypes, toGoStructName(refName))
+			unionTypes = append(unionTypes, naming.GoStructName(refName))
 		} else if len(getSchemaType(oneOfSchema)) > 0 {
 			// Get the Go type for primitive types
 			goType, _, err := getGoType(oneOfSchema, true)
@@ -483,7 +484,7 @@ func processOneOfSchema(name string, schema Schema) (*ModelStruct, error) {
 	}
 
 	model := &ModelStruct{
-		Name:       toGoStructName(name),
+		Name:       naming.GoStructName(name),
 		Comment:    formatComment(getSchemaDescription(schema)),
 		IsUnion:    true,
 		UnionTypes: unionTypes,
@@ -494,7 +495,7 @@ func processOneOfSchema(name string, schema Schema) (*ModelStruct, error) {
 
 func processObjectSchema(name string, schema Schema) (*ModelStruct, error) {
 	model := &ModelStruct{
-		Name:    toGoStructName(name),
+		Name:    naming.GoStructName(name),
 		Comment: formatComment(getSchemaDescription(schema)),
 		Fields:  make([]ModelField, 0),
 	}
@@ -568,14 +569,14 @@ func getGoType(schema Schema, isRequired bool) (string, bool, error) {
 	// Handle $ref types
 	if ref := getSchemaRef(schema); ref != "" {
 		refName := strings.TrimPrefix(ref, "#/components/schemas/")
-		return toGoStructName(refName), !isRequired, nil
+		return naming.GoStructName(refName), !isRequired, nil
 	}
 
 	schemaTypes := getSchemaType(schema)
 	if len(schemaTypes) == 0 {
 		// Check title for enum references
 		if title := getSchemaTitle(schema); title != "" {
-			return toGoStructName(title), !isRequired, nil
+			return naming.GoStructName(title), !isRequired, nil
 		}
 		return "any", !isRequired, nil
 	}
@@ -590,7 +591,7 @@ func getGoType(schema Schema, isRequired bool) (string, bool, error) {
 		}
 		// Check if this is an enum reference
 		if title := getSchemaTitle(schema); title != "" {
-			return toGoStructName(title), isPointer, nil
+			return naming.GoStructName(title), isPointer, nil
 		}
 		return "string", isPointer, nil
 
@@ -630,14 +631,6 @@ func buildJSONTag(propName string, isRequired bool) string {
 	return fmt.Sprintf("`json:\"%s,omitempty\"`", propName)
 }
 
-func toGoStructName(name string) string {
-	// Preserve exact casing for certain names to avoid conflicts
-	if name == "WireGuard" {
-		return "WireGuard"
-	}
-	return toPascalCase(name)
-}
-
 func toGoFieldName(name string) string {
 	// Handle special cases for better Go naming
 	switch strings.ToLower(name) {
@@ -12,6 +12,7 @@ import (
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/swaggest/openapi-go/openapi31"
+	naming "go.clever-cloud.dev/sdk/internal/naming"
 	"gopkg.in/yaml.v3"
 )
 
@@ -43,6 +44,20 @@ var SERVICE_NAME_EXCEPTIONS = map[string]string{
 	// "otoroshi":        "product", // Move otoroshi operations to product package
 }
 
+// IDS_PACKAGE is the package of the identifier types of path parameters
+const IDS_PACKAGE = "go.clever-cloud.dev/sdk/ids"
+
+// ID_PREFIXES lists the prefixes the API uses for some identifiers, which
+// the Validate method of their type checks
+var ID_PREFIXES = map[string][]string{
+	"ApplicationID":  {"app_"},
+	"CellarID":       {"cellar_"},
+	"NetworkGroupID": {"ng_"},
+	"OwnerID":        {"orga_", "user_"},
+	"PostgresqlID":   {"postgresql_"},
+	"TenantID":       {"orga_", "user_"},
+}
+
 // OPERATION_ID_OVERRIDES fixes duplicate operationId issues in the OpenAPI spec
 // Key format: "METHOD:path" -> new operationId
 var OPERATION_ID_OVERRIDES = map[string]string{
@@ -99,7 +114,13 @@ type ServiceOperation struct {
 }
 
 type ServiceParam struct {
-	Name        string
+	Name string
+	// AttributeKey is the span attribute key of a path parameter. Unlike Name
+	// it is not altered to dodge Go reserved words.
+	AttributeKey string
+	// IDType is the identifier type of a path parameter in the ids package,
+	// empty when it is not an identifier
+	IDType      string
 	GoName      string
 	Type        string
 	Required    bool
@@ -112,6 +133,7 @@ func main() {
 	var (
 		specFile       = flag.String("spec", "openapi.json", "Path to OpenAPI specification file")
 		outputDir      = flag.String("output", "services", "Output directory for generated services")
+		idsDir         = flag.String("ids-output", "ids", "Output directory for generated identifier types")
 		exceptionsFile = flag.String("exceptions", "", "Path to service mapping exceptions file")
 	)
 	flag.Parse()
@@ -186,6 +208,11 @@ func main() {
 		}
 	}
 
+	// Generate the identifier types of path parameters
+	if err := generateIDsFile(*idsDir, operations); err != nil {
+		log.Fatalf("Failed to generate identifier types: %v", err)
+	}
+
 	fmt.Printf("Generated %d service operations in %d packages\n", len(operations), len(packages))
 }
 
@@ -299,6 +326,18 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 				continue
 			}
 			paramName := param.Name
+			paramType := mapSchemaMapToGoType(param.Schema)
+			attributeKey := paramName
+			idType := ""
+			if string(param.In) == "path" {
+				// Path parameters are named after what they identify, in lowerCamelCase,
+				// and that name is also their span attribute key
+				paramName = naming.ParamName(naming.PathParamName(paramName, path, getSchemaRef(param.Schema)))
+				attributeKey = paramName
+				if paramType == "string" {
+					idType = naming.IDTypeName(paramName)
+				}
+			}
 			// Handle Go reserved words and invalid identifiers
 			if isReservedWord(paramName) {
 				paramName = paramName + "Param"
@@ -316,13 +355,15 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 			}
 
 			serviceParam := ServiceParam{
-				Name:        paramName,
-				GoName:      toCamelCase(paramName),
-				Type:        mapSchemaMapToGoType(param.Schema),
-				Required:    required,
-				Description: paramDesc,
-				IsPath:      string(param.In) == "path",
-				IsQuery:     string(param.In) == "query",
+				Name:         paramName,
+				AttributeKey: attributeKey,
+				IDType:       idType,
+				GoName:       toCamelCase(paramName),
+				Type:         paramType,
+				Required:     required,
+				Description:  paramDesc,
+				IsPath:       string(param.In) == "path",
+				IsQuery:      string(param.In) == "query",
 			}
 
 			if string(param.In) == "path" {
@@ -338,9 +379,9 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 			for _, mediaType := range operation.RequestBody.RequestBody.Content {
 				schemaRef := getSchemaRef(mediaType.Schema)
 				if schemaRef != "" {
-					typeName := extractTypeFromRef(schemaRef)
+					typeName := naming.RefTypeName(schemaRef)
 					op.RequestBodyType = typeName
-					op.RequestBodyGoType = "*models." + toGoStructName(typeName)
+					op.RequestBodyGoType = "*models." + naming.GoStructName(typeName)
 					op.HasRequestBody = true
 					break
 				}
@@ -349,14 +390,20 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 				if schemaType == "array" {
 					itemsRef := getSchemaItemsRef(mediaType.Schema)
 					if itemsRef != "" {
-						itemType := extractTypeFromRef(itemsRef)
+						itemType := naming.RefTypeName(itemsRef)
 						op.RequestBodyType = "[]*" + itemType
-						op.RequestBodyGoType = "[]*models." + toGoStructName(itemType)
+						op.RequestBodyGoType = "[]*models." + naming.GoStructName(itemType)
 						op.HasRequestBody = true
 						break
 					}
 				}
 			}
+			// Inline schemas have no model: the payload is taken as is rather than dropped
+			if !op.HasRequestBody && len(operation.RequestBody.RequestBody.Content) > 0 {
+				op.RequestBodyType = "any"
+				op.RequestBodyGoType = "any"
+				op.HasRequestBody = true
+			}
 		}
 
 		// Extract response type - check 200, 201, 202, 204 status codes
@@ -379,7 +426,7 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 					for _, mediaType := range resp.Content {
 						schemaRef := getSchemaRef(mediaType.Schema)
 						if schemaRef != "" {
-							op.ResponseType = extractTypeFromRef(schemaRef)
+							op.ResponseType = naming.RefTypeName(schemaRef)
 							break
 						}
 						// Handle array responses
@@ -387,15 +434,17 @@ func extractOperationsFromPath(path string, pathItem openapi31.PathItem) []Servi
 						if schemaType == "array" {
 							itemsRef := getSchemaItemsRef(mediaType.Schema)
 							if itemsRef != "" {
-								itemType := extractTypeFromRef(itemsRef)
+								itemType := naming.RefTypeName(itemsRef)
 								op.ResponseType = "[]" + itemType
 								break
 							}
 						}
 					}
-					if op.ResponseType != "" {
-						break
+					// Inline schemas have no model: the payload is kept raw rather than dropped
+					if op.ResponseType == "" {
+						op.ResponseType = "RAW"
 					}
+					break
 				}
 			}
 		}
@@ -441,6 +490,55 @@ func generatePackage(outputDir, packageName string, operations []ServiceOperatio
 	return nil
 }
 
+// generateIDsFile generates a type for each kind of identifier path
+// parameters take, with a Validate method checking its prefixes
+func generateIDsFile(idsDir string, operations []ServiceOperation) error {
+	if err := os.MkdirAll(idsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create ids directory: %v", err)
+	}
+
+	// Remember a parameter name of each type for its documentation
+	paramNames := map[string]string{}
+	for _, op := range operations {
+		for _, p := range op.PathParams {
+			if _, ok := paramNames[p.IDType]; p.IDType != "" && !ok {
+				paramNames[p.IDType] = p.AttributeKey
+			}
+		}
+	}
+	var idTypes []string
+	for idType := range paramNames {
+		idTypes = append(idTypes, idType)
+	}
+	sort.Strings(idTypes)
+
+	f := NewFile("ids")
+	f.HeaderComment("Code generated by generate-services. DO NOT EDIT.")
+
+	for _, idType := range idTypes {
+		prefixes := ID_PREFIXES[idType]
+		f.Commentf("%s is the type of %s path parameters", idType, paramNames[idType])
+		f.Type().Id(idType).String()
+		f.Line()
+
+		validateArgs := []Code{Lit(idType), String().Call(Id("id"))}
+		if len(prefixes) == 0 {
+			f.Commentf("Validate checks that the %s is not empty", idType)
+		} else {
+			f.Commentf("Validate checks that the %s is not empty and starts with %s", idType, strings.Join(prefixes, " or "))
+			for _, prefix := range prefixes {
+				validateArgs = append(validateArgs, Lit(prefix))
+			}
+		}
+		f.Func().Params(Id("id").Id(idType)).Id("Validate").Params().Error().Block(
+			Return(Id("validate").Call(validateArgs...)),
+		)
+		f.Line()
+	}
+
+	return f.Save(filepath.Join(idsDir, "types.go"))
+}
+
 func generateOperationFile(packageDir string, op ServiceOperation) error {
 	f := NewFile(op.PackageName)
 	f.HeaderComment("Code generated by generate-services. DO NOT EDIT.")
@@ -494,6 +592,10 @@ func generateOperationFile(packageDir string, op ServiceOperation) error {
 		Id("tracer").Qual("go.opentelemetry.io/otel/trace", "Tracer"),
 	}
 	for _, p := range op.PathParams {
+		if p.IDType != "" {
+			params = append(params, Id(p.Name).Qual(IDS_PACKAGE, p.IDType))
+			continue
+		}
 		params = append(params, Id(p.Name).Id(p.Type))
 	}
 	if op.HasRequestBody {
@@ -506,17 +608,21 @@ func generateOperationFile(packageDir string, op ServiceOperation) error {
 	// Build trace attributes
 	var traceAttrs []Code
 	for _, p := range op.PathParams {
+		if p.IDType != "" {
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "String").Call(Lit(p.AttributeKey), String().Call(Id(p.Name))))
+			continue
+		}
 		switch p.Type {
 		case "string":
-			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "String").Call(Lit(p.Name), Id(p.Name)))
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "String").Call(Lit(p.AttributeKey), Id(p.Name)))
 		case "int":
-			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Int").Call(Lit(p.Name), Id(p.Name)))
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Int").Call(Lit(p.AttributeKey), Id(p.Name)))
 		case "int64":
-			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Int64").Call(Lit(p.Name), Id(p.Name)))
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Int64").Call(Lit(p.AttributeKey), Id(p.Name)))
 		case "bool":
-			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Bool").Call(Lit(p.Name), Id(p.Name)))
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "Bool").Call(Lit(p.AttributeKey), Id(p.Name)))
 		default:
-			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "String").Call(Lit(p.Name), Qual("fmt", "Sprintf").Call(Lit("%v"), Id(p.Name))))
+			traceAttrs = append(traceAttrs, Qual("go.opentelemetry.io/otel/attribute", "String").Call(Lit(p.AttributeKey), Qual("fmt", "Sprintf").Call(Lit("%v"), Id(p.Name))))
 		}
 	}
 
@@ -608,6 +714,9 @@ func formatResponseTypeJen(t string) Code {
 	if t == "NOTHING" {
 		return Qual("go.clever-cloud.dev/client", "Nothing")
 	}
+	if t == "RAW" {
+		return Qual("encoding/json", "RawMessage")
+	}
 	if after, ok := strings.CutPrefix(t, "[]"); ok {
 		return Index().Qual("go.clever-cloud.dev/sdk/models", after)
 	}
@@ -712,6 +821,17 @@ func generateOptionsFile(packageDir, packageName string, operations []ServiceOpe
 		buildBody = append(buildBody, Var().Id("params").Index().String())
 
 		for _, qp := range queryParams {
+			// Arrays repeat the parameter for each value, as form style
+			// parameters explode by default
+			if qp.Type == "[]string" {
+				buildBody = append(buildBody, If(Id("options").Dot(qp.GoName).Op("!=").Nil()).Block(
+					For(List(Id("_"), Id("v")).Op(":=").Range().Op("*").Id("options").Dot(qp.GoName)).Block(
+						Id("params").Op("=").Append(Id("params"), Qual("fmt", "Sprintf").Call(Lit(qp.Name+"=%s"), Qual("net/url", "QueryEscape").Call(Id("v")))),
+					),
+				))
+				continue
+			}
+
 			var formatStr string
 			var formatVerb string
 			switch qp.Type {
@@ -849,7 +969,7 @@ func getSchemaItemsRef(schema map[string]any) string {
 
 func mapSchemaMapToGoType(schema map[string]any) string {
 	if ref := getSchemaRef(schema); ref != "" {
-		return "*models." + extractTypeFromRef(ref)
+		return "*models." + naming.RefTypeName(ref)
 	}
 
 	switch getSchemaType(schema) {
@@ -880,67 +1000,6 @@ func mapSchemaMapToGoType(schema map[string]any) string {
 	}
 }
 
-func extractTypeFromRef(ref string) string {
-	// Extract type name from $ref like "#/components/schemas/Topic"
-	parts := strings.Split(ref, "/")
-	if len(parts) > 0 {
-		typeName := parts[len(parts)-1]
-		// Apply the same naming conventions as the model generator
-		return toGoStructName(typeName)
-	}
-	return "any"
-}
-
-func toGoStructName(name string) string {
-	// Preserve exact casing for certain names to avoid conflicts
-	if name == "WireGuard" {
-		return "WireGuard"
-	}
-	// Use the same PascalCase logic as the model generator
-	return toPascalCaseForTypes(name)
-}
-
-func toPascalCaseForTypes(s string) string {
-	// Handle special cases and clean input
-	s = strings.ReplaceAll(s, ".", "_DOT_")
-	s = strings.ReplaceAll(s, "+", "_PLUS_")
-	s = strings.ReplaceAll(s, "*", "_STAR_")
-	s = strings.ReplaceAll(s, "/", "_SLASH_")
-
-	// Check if string contains separators (underscore, hyphen, space)
-	hasSeparators := strings.ContainsAny(s, "_- ")
-
-	if !hasSeparators {
-		// No separators - assume already in PascalCase or single word
-		// Just ensure first letter is uppercase
-		if len(s) > 0 {
-			return strings.ToUpper(s[:1]) + s[1:]
-		}
-		return s
-	}
-
-	// Split on underscores, hyphens, and spaces
-	parts := strings.FieldsFunc(s, func(r rune) bool {
-		return r == '_' || r == '-' || r == ' '
-	})
-
-	for i, part := range parts {
-		if len(part) > 0 {
-			// Capitalize first letter of each part
-			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
-		}
-	}
-
-	result := strings.Join(parts, "")
-
-	// Ensure it starts with a letter or underscore (valid Go identifier)
-	if len(result) > 0 && (result[0] >= '0' && result[0] <= '9') {
-		result = "_" + result
-	}
-
-	return result
-}
-
 func derivePackageFromPath(path string) string {
 	// Extract package name from path like /v4/addon-providers/addon-pulsar/...
 	parts := strings.Split(strings.Trim(path, "/"), "/")
@@ -0,0 +1,195 @@
+// Package naming holds the naming rules shared by the generators, so that
+// models, services and builders give the same names to the same schemas and
+// path parameters.
+package naming
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// PARAM_WORD_EXCEPTIONS spells product names that the word splitting of
+// path parameter names would otherwise cut in two
+var PARAM_WORD_EXCEPTIONS = map[string]string{
+	"postgreSQL": "postgresql",
+}
+
+// ID_INITIALISMS spells the words of identifier type names that are initialisms
+var ID_INITIALISMS = map[string]string{
+	"ai":   "AI",
+	"ip":   "IP",
+	"ipam": "IPAM",
+	"kv":   "KV",
+	"pg":   "PG",
+}
+
+// GoStructName converts an OpenAPI schema name to a Go struct name
+func GoStructName(name string) string {
+	// Preserve exact casing for certain names to avoid conflicts
+	if name == "WireGuard" {
+		return "WireGuard"
+	}
+	return pascalCase(name)
+}
+
+// RefTypeName returns the Go struct name of the schema a $ref points to,
+// such as "#/components/schemas/Topic"
+func RefTypeName(ref string) string {
+	parts := strings.Split(ref, "/")
+	return GoStructName(parts[len(parts)-1])
+}
+
+func pascalCase(s string) string {
+	// Handle special cases and clean input
+	s = strings.ReplaceAll(s, ".", "_DOT_")
+	s = strings.ReplaceAll(s, "+", "_PLUS_")
+	s = strings.ReplaceAll(s, "*", "_STAR_")
+	s = strings.ReplaceAll(s, "/", "_SLASH_")
+
+	// Check if string contains separators (underscore, hyphen, space)
+	hasSeparators := strings.ContainsAny(s, "_- ")
+
+	if !hasSeparators {
+		// No separators - assume already in PascalCase or single word
+		// Just ensure first letter is uppercase
+		if len(s) > 0 {
+			return strings.ToUpper(s[:1]) + s[1:]
+		}
+		return s
+	}
+
+	// Split on underscores, hyphens, and spaces
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+
+	for i, part := range parts {
+		if len(part) > 0 {
+			// Capitalize first letter of each part
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		}
+	}
+
+	result := strings.Join(parts, "")
+
+	// Ensure it starts with a letter or underscore (valid Go identifier)
+	if len(result) > 0 && (result[0] >= '0' && result[0] <= '9') {
+		result = "_" + result
+	}
+
+	return result
+}
+
+// placeholderParamPattern matches the meaningless names some path parameters
+// have in the spec, such as p1
+var placeholderParamPattern = regexp.MustCompile(`^p[0-9]+$`)
+
+// PathParamName replaces a placeholder path parameter name with one derived
+// from the $ref of its schema, empty when it has none, or from the path
+// segment that precedes it: the {p1} of /iam/tokens/{p1} becomes tokenId.
+func PathParamName(name, path, schemaRef string) string {
+	if !placeholderParamPattern.MatchString(name) {
+		return name
+	}
+	if schemaRef != "" {
+		return RefTypeName(schemaRef)
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "{"+name+"}" || i == 0 {
+			continue
+		}
+		previous := segments[i-1]
+		if previous == "" || strings.HasPrefix(previous, "{") {
+			break
+		}
+		return Singular(previous) + "Id"
+	}
+	return name
+}
+
+// Singular returns the singular form of a path segment such as "tokens" or "policies"
+func Singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "ss"):
+		return word
+	default:
+		return strings.TrimSuffix(word, "s")
+	}
+}
+
+// SplitWords splits an identifier on separators and case changes, keeping
+// acronyms together: hypervisor_name gives hypervisor, name and
+// vmIPAddress gives vm, IP, Address.
+func SplitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '_' || r == '-' || r == ' ' || r == '.' {
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+		if i == start || !unicode.IsUpper(r) {
+			continue
+		}
+		previous := runes[i-1]
+		acronymEnd := unicode.IsUpper(previous) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if !unicode.IsUpper(previous) || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// ParamName returns the lowerCamelCase form of a parameter name,
+// with "Id" rather than "ID" as the generated signatures always had:
+// CellarId gives cellarId and postgreSQLId gives postgresqlId.
+func ParamName(name string) string {
+	for word, replacement := range PARAM_WORD_EXCEPTIONS {
+		name = strings.ReplaceAll(name, word, replacement)
+	}
+
+	var result strings.Builder
+	for i, word := range SplitWords(name) {
+		word = strings.ToLower(word)
+		if i > 0 {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		result.WriteString(word)
+	}
+	return result.String()
+}
+
+// IDTypeName returns the name of the identifier type of a path parameter in
+// the ids package, empty when its name does not end with Id:
+// networkGroupId gives NetworkGroupID and aiId gives AIID.
+func IDTypeName(paramName string) string {
+	name, ok := strings.CutSuffix(paramName, "Id")
+	if !ok || name == "" {
+		return ""
+	}
+
+	var result strings.Builder
+	for _, word := range SplitWords(name) {
+		word = strings.ToLower(word)
+		if initialism, ok := ID_INITIALISMS[word]; ok {
+			result.WriteString(initialism)
+			continue
+		}
+		result.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return result.String() + "ID"
+}
@@ -6,6 +6,7 @@ require (
 	github.com/miton18/helper v0.0.1
 	go.clever-cloud.dev/client v0.1.1
 	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/metric v1.24.0
 	go.opentelemetry.io/otel/trace v1.24.0
 )
 
@@ -17,6 +18,5 @@ require (
 	github.com/sirupsen/logrus v1.9.3 // indirect
 	github.com/stretchr/testify v1.10.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/httptrace/otelhttptrace v0.46.1 // indirect
-	go.opentelemetry.io/otel/metric v1.24.0 // indirect
 	golang.org/x/sys v0.16.0 // indirect
 )
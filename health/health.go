@@ -0,0 +1,140 @@
+// Package health normalizes the statuses of API models into a common model.
+//
+// Every supported model is mapped to a Status with one of a few states and a
+// reason, so that resources of different products can be shown and compared
+// together. A Registry holds the mapping of each type; RegisterModels adds
+// the API models supported by this package. Snapshots of statuses can be
+// diffed to detect changes.
+package health
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// State is the normalized health of an object.
+// States are ordered from the best to the worst.
+type State int
+
+const (
+	Healthy State = iota
+	Pending
+	Unknown
+	Degraded
+	Failed
+)
+
+func (s State) String() string {
+	switch s {
+	case Healthy:
+		return "healthy"
+	case Pending:
+		return "pending"
+	case Degraded:
+		return "degraded"
+	case Failed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the state as its name
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a state name
+func (s *State) UnmarshalText(text []byte) error {
+	for _, state := range []State{Healthy, Pending, Unknown, Degraded, Failed} {
+		if state.String() == string(text) {
+			*s = state
+			return nil
+		}
+	}
+	return fmt.Errorf("health: unknown state %q", text)
+}
+
+// Status is the normalized health of one object
+type Status struct {
+	// Kind is the type of object, such as "resource" or "kubernetes"
+	Kind  string `json:"kind"`
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	State State  `json:"state"`
+	// Reason is the raw status the state was derived from, or an error message
+	Reason string `json:"reason"`
+	// Since is when the object entered its state, zero when unknown
+	Since time.Time `json:"since,omitzero"`
+}
+
+// Key identifies the object of a status in snapshots
+func (s Status) Key() string {
+	return s.Kind + "/" + s.ID
+}
+
+// Worst returns the worst state of the statuses, Healthy if there are none
+func Worst(statuses ...Status) State {
+	worst := Healthy
+	for _, status := range statuses {
+		worst = max(worst, status.State)
+	}
+	return worst
+}
+
+// Registry maps values of registered types to statuses.
+// RegisterModels fills it with the API models supported by this package.
+type Registry struct {
+	mu      sync.RWMutex
+	mappers map[reflect.Type]func(any) Status
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{mappers: map[reflect.Type]func(any) Status{}}
+}
+
+// Register sets how values of type T are mapped to a status.
+// It replaces any mapping previously registered for T.
+func Register[T any](r *Registry, mapper func(T) Status) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mappers[reflect.TypeFor[T]()] = func(v any) Status { return mapper(v.(T)) }
+}
+
+// Of returns the status of v, which may be a value or a pointer to a value
+// of a registered type. ok is false when no mapping is registered.
+func (r *Registry) Of(v any) (status Status, ok bool) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		if _, direct := r.lookup(value.Type()); !direct {
+			v = value.Elem().Interface()
+		}
+	}
+
+	mapper, ok := r.lookup(reflect.TypeOf(v))
+	if !ok {
+		return Status{}, false
+	}
+	return mapper(v), true
+}
+
+func (r *Registry) lookup(t reflect.Type) (func(any) Status, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	mapper, ok := r.mappers[t]
+	return mapper, ok
+}
+
+// All returns the statuses of the values of a registered type, skipping the others
+func All[T any](r *Registry, values []T) []Status {
+	statuses := make([]Status, 0, len(values))
+	for _, v := range values {
+		if status, ok := r.Of(v); ok {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+func TestOf(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reason := "upload rejected"
+	attempt, maxAttempt := 5, 5
+	lastError := "connection refused"
+
+	tests := []struct {
+		name   string
+		value  any
+		state  State
+		reason string
+	}{
+		{"resource uses the latest status", models.Resource{ID: "r", Statuses: []models.DefaultIdentifiedStatus{
+			{Date: t0.Add(time.Hour), State: models.IdentifiedStateTypePROVISIONED},
+			{Date: t0, State: models.IdentifiedStateTypePENDING},
+		}}, Healthy, "PROVISIONED"},
+		{"resource without status", &models.Resource{ID: "r"}, Unknown, "no status"},
+		{"tenant", models.Tenant1{ID: "t", Statuses: []models.DefaultIdentifiedStatus{{State: models.IdentifiedStateTypeMODERATED}}}, Failed, "MODERATED"},
+		{"product soft deleted", models.Product{ID: "p", Statuses: []models.DefaultIdentifiedStatus{{State: models.IdentifiedStateTypeSoftDeleted}}}, Failed, "SOFT_DELETED"},
+		{"cluster", models.Cluster1{ID: "k", Status: models.ClusterStatusTypeFAILED}, Failed, "FAILED"},
+		{"cluster with unknown status", models.Cluster1{ID: "k", Status: "HIBERNATING"}, Unknown, "HIBERNATING"},
+		{"cellar", models.Cellar{ID: "c", Status: models.CellarStatusACTIVE}, Healthy, "ACTIVE"},
+		{"drain retrying", models.Drain{ID: "d",
+			Status:    models.DrainStatus{Status: models.DrainStatusTypeENABLED},
+			Execution: models.DrainExecution{Status: models.DrainExecutionStatusRETRYING, LastError: &lastError},
+		}, Degraded, lastError},
+		{"drain out of attempts", models.Drain{ID: "d",
+			Status:    models.DrainStatus{Status: models.DrainStatusTypeENABLED},
+			Execution: models.DrainExecution{Status: models.DrainExecutionStatusRETRYING, Attempt: &attempt, MaxAttempt: &maxAttempt},
+		}, Failed, "RETRYING"},
+		{"function deployment error", models.Deployment1{ID: "f", Status: models.FunctionDeploymentStatusERROR, ErrorReason: &reason}, Failed, reason},
+	}
+	r := NewRegistry()
+	RegisterModels(r)
+	for _, tt := range tests {
+		status, ok := r.Of(tt.value)
+		if !ok {
+			t.Errorf("%s: no mapping", tt.name)
+			continue
+		}
+		if status.State != tt.state || status.Reason != tt.reason {
+			t.Errorf("%s: got %s (%s), want %s (%s)", tt.name, status.State, status.Reason, tt.state, tt.reason)
+		}
+	}
+
+	if _, ok := r.Of(42); ok {
+		t.Errorf("unregistered types should not map")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := NewSnapshot(
+		Status{Kind: "resource", ID: "a", State: Healthy, Reason: "PROVISIONED"},
+		Status{Kind: "resource", ID: "b", State: Healthy, Reason: "PROVISIONED"},
+		Status{Kind: "drain", ID: "c", State: Degraded, Reason: "timeout"},
+	)
+	after := NewSnapshot(
+		Status{Kind: "resource", ID: "a", State: Failed, Reason: "DELETED"},
+		Status{Kind: "drain", ID: "c", State: Degraded, Reason: "connection refused"},
+		Status{Kind: "kubernetes", ID: "d", State: Pending, Reason: "DEPLOYING"},
+	)
+
+	changes := Diff(before, after)
+	want := []struct {
+		key      string
+		kind     ChangeKind
+		worsened bool
+	}{
+		{"drain/c", Updated, false},
+		{"kubernetes/d", Appeared, false},
+		{"resource/a", Transitioned, true},
+		{"resource/b", Disappeared, false},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i, w := range want {
+		if changes[i].Key != w.key || changes[i].Kind != w.kind || changes[i].Worsened() != w.worsened {
+			t.Errorf("change %d = %s %s worsened=%v, want %s %s worsened=%v",
+				i, changes[i].Key, changes[i].Kind, changes[i].Worsened(), w.key, w.kind, w.worsened)
+		}
+	}
+
+	if got := Worst(after.Sorted()...); got != Failed {
+		t.Errorf("Worst = %s, want failed", got)
+	}
+
+	data, err := json.Marshal(after.Statuses["resource/a"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Status
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.State != Failed {
+		t.Errorf("state does not round trip through JSON: %s, %v", data, err)
+	}
+}
@@ -0,0 +1,193 @@
+package health
+
+import (
+	"slices"
+
+	catalog "go.clever-cloud.dev/sdk/catalog"
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// RegisterModels registers the mappers of the API models supported by this package
+func RegisterModels(r *Registry) {
+	Register(r, FromResource)
+	Register(r, FromProduct)
+	Register(r, FromTenant)
+	Register(r, FromCluster)
+	Register(r, FromCellar)
+	Register(r, FromCellarAccount)
+	Register(r, FromDrain)
+	Register(r, FromFunctionDeployment)
+}
+
+// identifiedStates maps the states shared by base models that are served
+// normally, the ones in catalog.FailingStates being Failed
+var identifiedStates = map[models.IdentifiedStateType]State{
+	models.IdentifiedStateTypePROVISIONED: Healthy,
+	models.IdentifiedStateTypeVALIDATED:   Healthy,
+	models.IdentifiedStateTypePATCHED:     Healthy,
+	models.IdentifiedStateTypePENDING:     Pending,
+	models.IdentifiedStateTypeQUEUED:      Pending,
+}
+
+// FromIdentified returns the status described by the most recent of statuses
+func FromIdentified(kind string, id string, name string, statuses []models.DefaultIdentifiedStatus) Status {
+	status := Status{Kind: kind, ID: id, Name: name, State: Unknown, Reason: "no status"}
+
+	latest, ok := catalog.LatestStatus(statuses)
+	if !ok {
+		return status
+	}
+
+	status.State = mapState(identifiedStates, latest.State)
+	if slices.Contains(catalog.FailingStates, latest.State) {
+		status.State = Failed
+	}
+	status.Reason = string(latest.State)
+	status.Since = latest.Date
+	return status
+}
+
+// FromResource returns the status of a base resource
+func FromResource(r models.Resource) Status {
+	return FromIdentified("resource", r.ID, r.Name, r.Statuses)
+}
+
+// FromProduct returns the status of a base product
+func FromProduct(p models.Product) Status {
+	return FromIdentified("product", p.ID, p.Name, p.Statuses)
+}
+
+// FromTenant returns the status of a tenant
+func FromTenant(t models.Tenant1) Status {
+	return FromIdentified("tenant", t.ID, t.Name, t.Statuses)
+}
+
+var clusterStates = map[models.ClusterStatusType]State{
+	models.ClusterStatusTypeACTIVE:      Healthy,
+	models.ClusterStatusTypeToDeploy:    Pending,
+	models.ClusterStatusTypeDEPLOYING:   Pending,
+	models.ClusterStatusTypeToRedeploy:  Pending,
+	models.ClusterStatusTypeREDEPLOYING: Pending,
+	models.ClusterStatusTypeToUpgrade:   Pending,
+	models.ClusterStatusTypeUPDATING:    Pending,
+	models.ClusterStatusTypeToDelete:    Degraded,
+	models.ClusterStatusTypeDELETING:    Degraded,
+	models.ClusterStatusTypeDELETED:     Failed,
+	models.ClusterStatusTypeFAILED:      Failed,
+}
+
+// FromCluster returns the status of a Kubernetes cluster
+func FromCluster(c models.Cluster1) Status {
+	return Status{
+		Kind:   "kubernetes",
+		ID:     c.ID,
+		Name:   c.Name,
+		State:  mapState(clusterStates, c.Status),
+		Reason: string(c.Status),
+	}
+}
+
+var cellarStates = map[models.CellarStatus]State{
+	models.CellarStatusACTIVE:   Healthy,
+	models.CellarStatusToDelete: Degraded,
+	models.CellarStatusDELETING: Degraded,
+	models.CellarStatusDELETED:  Failed,
+}
+
+// FromCellar returns the status of a Cellar add-on
+func FromCellar(c models.Cellar) Status {
+	return Status{
+		Kind:   "cellar",
+		ID:     c.ID,
+		Name:   c.Name,
+		State:  mapState(cellarStates, c.Status),
+		Reason: string(c.Status),
+	}
+}
+
+// FromCellarAccount returns the status of a Cellar account
+func FromCellarAccount(c models.Cellar1) Status {
+	return Status{
+		Kind:   "cellar",
+		ID:     c.ID,
+		State:  mapState(cellarStates, c.Status),
+		Reason: string(c.Status),
+	}
+}
+
+var drainStates = map[models.DrainStatusType]State{
+	models.DrainStatusTypeENABLED:   Healthy,
+	models.DrainStatusTypeCREATED:   Pending,
+	models.DrainStatusTypeENABLING:  Pending,
+	models.DrainStatusTypeDISABLING: Degraded,
+	models.DrainStatusTypeDISABLED:  Degraded,
+	models.DrainStatusTypeDELETED:   Failed,
+}
+
+// FromDrain returns the status of a log drain.
+// An enabled drain retrying deliveries is degraded, and failed once it
+// has used all its attempts or reports an error.
+func FromDrain(d models.Drain) Status {
+	status := Status{
+		Kind:   "drain",
+		ID:     d.ID,
+		State:  mapState(drainStates, d.Status.Status),
+		Reason: string(d.Status.Status),
+		Since:  d.Status.Date,
+	}
+
+	if d.Status.ErrorReason != nil {
+		status.State, status.Reason = Failed, *d.Status.ErrorReason
+		return status
+	}
+
+	execution := d.Execution
+	if status.State != Healthy || execution.Status != models.DrainExecutionStatusRETRYING {
+		return status
+	}
+	status.State, status.Reason = Degraded, string(execution.Status)
+	if execution.LastError != nil {
+		status.Reason = *execution.LastError
+	}
+	if execution.RetryingSince != nil {
+		status.Since = *execution.RetryingSince
+	}
+	if execution.Attempt != nil && execution.MaxAttempt != nil && *execution.Attempt >= *execution.MaxAttempt {
+		status.State = Failed
+	}
+	return status
+}
+
+var functionDeploymentStates = map[models.FunctionDeploymentStatus]State{
+	models.FunctionDeploymentStatusREADY:            Healthy,
+	models.FunctionDeploymentStatusWaitingForUpload: Pending,
+	models.FunctionDeploymentStatusPACKAGING:        Pending,
+	models.FunctionDeploymentStatusDEPLOYING:        Pending,
+	models.FunctionDeploymentStatusERROR:            Failed,
+}
+
+// FromFunctionDeployment returns the status of a function deployment
+func FromFunctionDeployment(d models.Deployment1) Status {
+	status := Status{
+		Kind:   "function-deployment",
+		ID:     d.ID,
+		State:  mapState(functionDeploymentStates, d.Status),
+		Reason: string(d.Status),
+		Since:  d.UpdatedAt,
+	}
+	if d.Name != nil {
+		status.Name = *d.Name
+	}
+	if status.State == Failed && d.ErrorReason != nil {
+		status.Reason = *d.ErrorReason
+	}
+	return status
+}
+
+// mapState looks a raw status up, unmapped values being Unknown
+func mapState[T comparable](states map[T]State, raw T) State {
+	if state, ok := states[raw]; ok {
+		return state
+	}
+	return Unknown
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"sort"
+	"time"
+)
+
+// Snapshot is the status of a set of objects at a point in time
+type Snapshot struct {
+	TakenAt  time.Time         `json:"takenAt"`
+	Statuses map[string]Status `json:"statuses"`
+}
+
+// NewSnapshot takes a snapshot of statuses
+func NewSnapshot(statuses ...Status) Snapshot {
+	s := Snapshot{TakenAt: time.Now(), Statuses: make(map[string]Status, len(statuses))}
+	for _, status := range statuses {
+		s.Statuses[status.Key()] = status
+	}
+	return s
+}
+
+// Take takes a snapshot of values of types registered in r, skipping the others
+func (r *Registry) Take(values ...any) Snapshot {
+	statuses := make([]Status, 0, len(values))
+	for _, v := range values {
+		if status, ok := r.Of(v); ok {
+			statuses = append(statuses, status)
+		}
+	}
+	return NewSnapshot(statuses...)
+}
+
+// Sorted returns the statuses of the snapshot ordered from the worst state,
+// then by key
+func (s Snapshot) Sorted() []Status {
+	statuses := make([]Status, 0, len(s.Statuses))
+	for _, status := range s.Statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].State != statuses[j].State {
+			return statuses[i].State > statuses[j].State
+		}
+		return statuses[i].Key() < statuses[j].Key()
+	})
+	return statuses
+}
+
+// Count returns the number of objects in each state
+func (s Snapshot) Count() map[State]int {
+	counts := map[State]int{}
+	for _, status := range s.Statuses {
+		counts[status.State]++
+	}
+	return counts
+}
+
+// ChangeKind tells how the status of an object changed between two snapshots
+type ChangeKind int
+
+const (
+	Appeared ChangeKind = iota
+	Disappeared
+	// Transitioned means the state changed
+	Transitioned
+	// Updated means the state is the same but the reason changed
+	Updated
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Appeared:
+		return "appeared"
+	case Disappeared:
+		return "disappeared"
+	case Transitioned:
+		return "transitioned"
+	case Updated:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
+
+// Change is the difference in the status of one object between two snapshots
+type Change struct {
+	Kind ChangeKind
+	Key  string
+	// Before is nil for objects that appeared and After for objects that disappeared
+	Before *Status
+	After  *Status
+}
+
+// Worsened reports whether the object is in a worse state than before
+func (c Change) Worsened() bool {
+	switch {
+	case c.After == nil:
+		return false
+	case c.Before == nil:
+		return c.After.State > Pending
+	default:
+		return c.After.State > c.Before.State
+	}
+}
+
+// Diff returns the changes from before to after, ordered by key
+func Diff(before Snapshot, after Snapshot) []Change {
+	var changes []Change
+	for key, was := range before.Statuses {
+		is, ok := after.Statuses[key]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Kind: Disappeared, Key: key, Before: &was})
+		case is.State != was.State:
+			changes = append(changes, Change{Kind: Transitioned, Key: key, Before: &was, After: &is})
+		case is.Reason != was.Reason:
+			changes = append(changes, Change{Kind: Updated, Key: key, Before: &was, After: &is})
+		}
+	}
+	for key, is := range after.Statuses {
+		if _, ok := before.Statuses[key]; !ok {
+			changes = append(changes, Change{Kind: Appeared, Key: key, After: &is})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
@@ -0,0 +1,52 @@
+// Package ids defines the identifier types of path parameters.
+//
+// Services and builders take identifiers as distinct types, so passing a
+// resource ID where a tenant ID is expected does not compile. The types are
+// generated by generate-services in types.go; converting a string to one of
+// them is explicit, and Parse also checks the prefix the API uses for it.
+package ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalid is returned for empty identifiers and identifiers without the
+// prefix the API uses for their kind
+var ErrInvalid = errors.New("ids: invalid identifier")
+
+// ID is implemented by the identifier types
+type ID interface {
+	~string
+	Validate() error
+}
+
+// Parse returns s as an identifier of type T, or an error wrapping
+// ErrInvalid when it is not valid for T
+//
+//	appID, err := ids.Parse[ids.ApplicationID]("app_2bc3d4a8-...")
+func Parse[T ID](s string) (T, error) {
+	id := T(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// validate checks that id is not empty and, when prefixes are given, that
+// it starts with one of them
+func validate(kind string, id string, prefixes ...string) error {
+	if id == "" {
+		return fmt.Errorf("%w: empty %s", ErrInvalid, kind)
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(id, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s %q does not start with %s", ErrInvalid, kind, id, strings.Join(prefixes, " or "))
+}
@@ -0,0 +1,49 @@
+package ids
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) error
+		id      string
+		wantErr bool
+	}{
+		{name: "organisation", parse: parseAs[TenantID], id: "orga_5b2c9b4e"},
+		{name: "user", parse: parseAs[OwnerID], id: "user_1f0d8a2c"},
+		{name: "application", parse: parseAs[ApplicationID], id: "app_7c41e0a3"},
+		{name: "wrong prefix", parse: parseAs[TenantID], id: "app_7c41e0a3", wantErr: true},
+		{name: "empty", parse: parseAs[ResourceID], id: "", wantErr: true},
+		{name: "no prefix", parse: parseAs[ResourceID], id: "0b6fd2a3-5a34-4bb8-9c39-5a6c7e1b2d40"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse(tt.id)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Parse(%q) error = %v, want error: %v", tt.id, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalid) {
+				t.Errorf("error %v does not wrap ErrInvalid", err)
+			}
+		})
+	}
+}
+
+func TestParseReturnsID(t *testing.T) {
+	id, err := Parse[CellarID]("cellar_e7f1c5c0")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if id != CellarID("cellar_e7f1c5c0") {
+		t.Errorf("got %q", id)
+	}
+}
+
+func parseAs[T ID](s string) error {
+	_, err := Parse[T](s)
+	return err
+}
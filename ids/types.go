@@ -0,0 +1,427 @@
+// Code generated by generate-services. DO NOT EDIT.
+
+package ids
+
+// AIID is the type of aiId path parameters
+type AIID string
+
+// Validate checks that the AIID is not empty
+func (id AIID) Validate() error {
+	return validate("AIID", string(id))
+}
+
+// AddonCumulocityID is the type of addonCumulocityId path parameters
+type AddonCumulocityID string
+
+// Validate checks that the AddonCumulocityID is not empty
+func (id AddonCumulocityID) Validate() error {
+	return validate("AddonCumulocityID", string(id))
+}
+
+// AddonID is the type of addonId path parameters
+type AddonID string
+
+// Validate checks that the AddonID is not empty
+func (id AddonID) Validate() error {
+	return validate("AddonID", string(id))
+}
+
+// AddonKeycloakID is the type of addonKeycloakId path parameters
+type AddonKeycloakID string
+
+// Validate checks that the AddonKeycloakID is not empty
+func (id AddonKeycloakID) Validate() error {
+	return validate("AddonKeycloakID", string(id))
+}
+
+// AddonMatomoID is the type of addonMatomoId path parameters
+type AddonMatomoID string
+
+// Validate checks that the AddonMatomoID is not empty
+func (id AddonMatomoID) Validate() error {
+	return validate("AddonMatomoID", string(id))
+}
+
+// AddonMetabaseID is the type of addonMetabaseId path parameters
+type AddonMetabaseID string
+
+// Validate checks that the AddonMetabaseID is not empty
+func (id AddonMetabaseID) Validate() error {
+	return validate("AddonMetabaseID", string(id))
+}
+
+// AddonTsID is the type of addonTsId path parameters
+type AddonTsID string
+
+// Validate checks that the AddonTsID is not empty
+func (id AddonTsID) Validate() error {
+	return validate("AddonTsID", string(id))
+}
+
+// ApikeyID is the type of apikeyId path parameters
+type ApikeyID string
+
+// Validate checks that the ApikeyID is not empty
+func (id ApikeyID) Validate() error {
+	return validate("ApikeyID", string(id))
+}
+
+// ApplicationID is the type of applicationId path parameters
+type ApplicationID string
+
+// Validate checks that the ApplicationID is not empty and starts with app_
+func (id ApplicationID) Validate() error {
+	return validate("ApplicationID", string(id), "app_")
+}
+
+// AuditIPAMResourceID is the type of auditIpamResourceId path parameters
+type AuditIPAMResourceID string
+
+// Validate checks that the AuditIPAMResourceID is not empty
+func (id AuditIPAMResourceID) Validate() error {
+	return validate("AuditIPAMResourceID", string(id))
+}
+
+// BudgetID is the type of budgetId path parameters
+type BudgetID string
+
+// Validate checks that the BudgetID is not empty
+func (id BudgetID) Validate() error {
+	return validate("BudgetID", string(id))
+}
+
+// CellarID is the type of cellarId path parameters
+type CellarID string
+
+// Validate checks that the CellarID is not empty and starts with cellar_
+func (id CellarID) Validate() error {
+	return validate("CellarID", string(id), "cellar_")
+}
+
+// ClusterID is the type of clusterId path parameters
+type ClusterID string
+
+// Validate checks that the ClusterID is not empty
+func (id ClusterID) Validate() error {
+	return validate("ClusterID", string(id))
+}
+
+// DatabaseID is the type of databaseId path parameters
+type DatabaseID string
+
+// Validate checks that the DatabaseID is not empty
+func (id DatabaseID) Validate() error {
+	return validate("DatabaseID", string(id))
+}
+
+// DeploymentID is the type of deploymentId path parameters
+type DeploymentID string
+
+// Validate checks that the DeploymentID is not empty
+func (id DeploymentID) Validate() error {
+	return validate("DeploymentID", string(id))
+}
+
+// DrainID is the type of drainId path parameters
+type DrainID string
+
+// Validate checks that the DrainID is not empty
+func (id DrainID) Validate() error {
+	return validate("DrainID", string(id))
+}
+
+// EmailAddressID is the type of emailAddressId path parameters
+type EmailAddressID string
+
+// Validate checks that the EmailAddressID is not empty
+func (id EmailAddressID) Validate() error {
+	return validate("EmailAddressID", string(id))
+}
+
+// EndpointID is the type of endpointId path parameters
+type EndpointID string
+
+// Validate checks that the EndpointID is not empty
+func (id EndpointID) Validate() error {
+	return validate("EndpointID", string(id))
+}
+
+// EntityID is the type of entityId path parameters
+type EntityID string
+
+// Validate checks that the EntityID is not empty
+func (id EntityID) Validate() error {
+	return validate("EntityID", string(id))
+}
+
+// FunctionID is the type of functionId path parameters
+type FunctionID string
+
+// Validate checks that the FunctionID is not empty
+func (id FunctionID) Validate() error {
+	return validate("FunctionID", string(id))
+}
+
+// IdentityID is the type of identityId path parameters
+type IdentityID string
+
+// Validate checks that the IdentityID is not empty
+func (id IdentityID) Validate() error {
+	return validate("IdentityID", string(id))
+}
+
+// ImageID is the type of imageId path parameters
+type ImageID string
+
+// Validate checks that the ImageID is not empty
+func (id ImageID) Validate() error {
+	return validate("ImageID", string(id))
+}
+
+// KVID is the type of kvId path parameters
+type KVID string
+
+// Validate checks that the KVID is not empty
+func (id KVID) Validate() error {
+	return validate("KVID", string(id))
+}
+
+// KeyID is the type of keyId path parameters
+type KeyID string
+
+// Validate checks that the KeyID is not empty
+func (id KeyID) Validate() error {
+	return validate("KeyID", string(id))
+}
+
+// ListenerID is the type of listenerId path parameters
+type ListenerID string
+
+// Validate checks that the ListenerID is not empty
+func (id ListenerID) Validate() error {
+	return validate("ListenerID", string(id))
+}
+
+// LoadbalancerID is the type of loadbalancerId path parameters
+type LoadbalancerID string
+
+// Validate checks that the LoadbalancerID is not empty
+func (id LoadbalancerID) Validate() error {
+	return validate("LoadbalancerID", string(id))
+}
+
+// LocationID is the type of locationId path parameters
+type LocationID string
+
+// Validate checks that the LocationID is not empty
+func (id LocationID) Validate() error {
+	return validate("LocationID", string(id))
+}
+
+// MemberID is the type of memberId path parameters
+type MemberID string
+
+// Validate checks that the MemberID is not empty
+func (id MemberID) Validate() error {
+	return validate("MemberID", string(id))
+}
+
+// NamespaceID is the type of namespaceId path parameters
+type NamespaceID string
+
+// Validate checks that the NamespaceID is not empty
+func (id NamespaceID) Validate() error {
+	return validate("NamespaceID", string(id))
+}
+
+// NetworkGroupID is the type of networkGroupId path parameters
+type NetworkGroupID string
+
+// Validate checks that the NetworkGroupID is not empty and starts with ng_
+func (id NetworkGroupID) Validate() error {
+	return validate("NetworkGroupID", string(id), "ng_")
+}
+
+// NetworkID is the type of networkId path parameters
+type NetworkID string
+
+// Validate checks that the NetworkID is not empty
+func (id NetworkID) Validate() error {
+	return validate("NetworkID", string(id))
+}
+
+// NodeGroupID is the type of nodeGroupId path parameters
+type NodeGroupID string
+
+// Validate checks that the NodeGroupID is not empty
+func (id NodeGroupID) Validate() error {
+	return validate("NodeGroupID", string(id))
+}
+
+// NodeID is the type of nodeId path parameters
+type NodeID string
+
+// Validate checks that the NodeID is not empty
+func (id NodeID) Validate() error {
+	return validate("NodeID", string(id))
+}
+
+// OtelID is the type of otelId path parameters
+type OtelID string
+
+// Validate checks that the OtelID is not empty
+func (id OtelID) Validate() error {
+	return validate("OtelID", string(id))
+}
+
+// OtoroshiID is the type of otoroshiId path parameters
+type OtoroshiID string
+
+// Validate checks that the OtoroshiID is not empty
+func (id OtoroshiID) Validate() error {
+	return validate("OtoroshiID", string(id))
+}
+
+// OwnerID is the type of ownerId path parameters
+type OwnerID string
+
+// Validate checks that the OwnerID is not empty and starts with orga_ or user_
+func (id OwnerID) Validate() error {
+	return validate("OwnerID", string(id), "orga_", "user_")
+}
+
+// PGUserID is the type of pgUserId path parameters
+type PGUserID string
+
+// Validate checks that the PGUserID is not empty
+func (id PGUserID) Validate() error {
+	return validate("PGUserID", string(id))
+}
+
+// ParentID is the type of parentId path parameters
+type ParentID string
+
+// Validate checks that the ParentID is not empty
+func (id ParentID) Validate() error {
+	return validate("ParentID", string(id))
+}
+
+// PeerID is the type of peerId path parameters
+type PeerID string
+
+// Validate checks that the PeerID is not empty
+func (id PeerID) Validate() error {
+	return validate("PeerID", string(id))
+}
+
+// PoolID is the type of poolId path parameters
+type PoolID string
+
+// Validate checks that the PoolID is not empty
+func (id PoolID) Validate() error {
+	return validate("PoolID", string(id))
+}
+
+// PostgresqlID is the type of postgresqlId path parameters
+type PostgresqlID string
+
+// Validate checks that the PostgresqlID is not empty and starts with postgresql_
+func (id PostgresqlID) Validate() error {
+	return validate("PostgresqlID", string(id), "postgresql_")
+}
+
+// ProductID is the type of productId path parameters
+type ProductID string
+
+// Validate checks that the ProductID is not empty
+func (id ProductID) Validate() error {
+	return validate("ProductID", string(id))
+}
+
+// PulsarID is the type of pulsarId path parameters
+type PulsarID string
+
+// Validate checks that the PulsarID is not empty
+func (id PulsarID) Validate() error {
+	return validate("PulsarID", string(id))
+}
+
+// RecordID is the type of recordId path parameters
+type RecordID string
+
+// Validate checks that the RecordID is not empty
+func (id RecordID) Validate() error {
+	return validate("RecordID", string(id))
+}
+
+// RegionID is the type of regionId path parameters
+type RegionID string
+
+// Validate checks that the RegionID is not empty
+func (id RegionID) Validate() error {
+	return validate("RegionID", string(id))
+}
+
+// RegistryID is the type of registryId path parameters
+type RegistryID string
+
+// Validate checks that the RegistryID is not empty
+func (id RegistryID) Validate() error {
+	return validate("RegistryID", string(id))
+}
+
+// ResourceID is the type of resourceId path parameters
+type ResourceID string
+
+// Validate checks that the ResourceID is not empty
+func (id ResourceID) Validate() error {
+	return validate("ResourceID", string(id))
+}
+
+// RevocationID is the type of revocationId path parameters
+type RevocationID string
+
+// Validate checks that the RevocationID is not empty
+func (id RevocationID) Validate() error {
+	return validate("RevocationID", string(id))
+}
+
+// ServerID is the type of serverId path parameters
+type ServerID string
+
+// Validate checks that the ServerID is not empty
+func (id ServerID) Validate() error {
+	return validate("ServerID", string(id))
+}
+
+// StorageID is the type of storageId path parameters
+type StorageID string
+
+// Validate checks that the StorageID is not empty
+func (id StorageID) Validate() error {
+	return validate("StorageID", string(id))
+}
+
+// TenantID is the type of tenantId path parameters
+type TenantID string
+
+// Validate checks that the TenantID is not empty and starts with orga_ or user_
+func (id TenantID) Validate() error {
+	return validate("TenantID", string(id), "orga_", "user_")
+}
+
+// TokenID is the type of tokenId path parameters
+type TokenID string
+
+// Validate checks that the TokenID is not empty
+func (id TokenID) Validate() error {
+	return validate("TokenID", string(id))
+}
+
+// VirtualMachineID is the type of virtualMachineId path parameters
+type VirtualMachineID string
+
+// Validate checks that the VirtualMachineID is not empty
+func (id VirtualMachineID) Validate() error {
+	return validate("VirtualMachineID", string(id))
+}
@@ -0,0 +1,379 @@
+// Package ipambulk runs IPAM operations over sets of addresses.
+//
+// It freezes and unfreezes the addresses of a resource in bulk, continuing
+// past failures and reporting the outcome of each address.
+//
+// It also moves public IPs to another resource: each address is frozen,
+// unassigned from the source, assigned to the target and unfrozen. When a
+// step fails, the completed steps are compensated in reverse order. Plan
+// returns the same steps without running them.
+//
+// The assign endpoint only takes an IP version and picks the address itself,
+// so every assignment is checked against the address being moved. An
+// unexpected address is released at once and the step fails with
+// ErrUnexpectedAddress; when that happens while compensating, the moved
+// address is no longer assigned and the report says so.
+package ipambulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	ipam "go.clever-cloud.dev/sdk/services/ipam"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Result is the outcome of a bulk operation on one address
+type Result struct {
+	IP  string
+	Err error
+}
+
+// Errors joins the errors of failed results, or returns nil when all succeeded
+func Errors(results []Result) error {
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.IP, r.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Resource runs bulk operations on the addresses of one resource
+type Resource struct {
+	tracer     trace.Tracer
+	tenantID   ids.TenantID
+	resourceID ids.ResourceID
+
+	// API calls, replaced in tests
+	freeze   func(ctx context.Context, resourceID ids.ResourceID, ip string) error
+	unfreeze func(ctx context.Context, resourceID ids.ResourceID, ip string) error
+	unassign func(ctx context.Context, resourceID ids.ResourceID, ip string) error
+	assign   func(ctx context.Context, regionID ids.RegionID, resourceID ids.ResourceID, version string) (models.AssignedIpAddress, error)
+	list     func(ctx context.Context) ([]models.AssignedIpAddress, error)
+}
+
+// New creates a Resource for the addresses of a tenant resource
+func New(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, resourceID ids.ResourceID) *Resource {
+	return &Resource{
+		tracer:     tracer,
+		tenantID:   tenantID,
+		resourceID: resourceID,
+		freeze: func(ctx context.Context, resourceID ids.ResourceID, ip string) error {
+			return errorOf(ipam.Freezeipaddress(ctx, c, tracer, tenantID, resourceID, ip))
+		},
+		unfreeze: func(ctx context.Context, resourceID ids.ResourceID, ip string) error {
+			return errorOf(ipam.Unfreezeipaddress(ctx, c, tracer, tenantID, resourceID, ip))
+		},
+		unassign: func(ctx context.Context, resourceID ids.ResourceID, ip string) error {
+			return errorOf(ipam.Unassignipaddressfromresource(ctx, c, tracer, tenantID, resourceID, ip))
+		},
+		assign: func(ctx context.Context, regionID ids.RegionID, resourceID ids.ResourceID, version string) (models.AssignedIpAddress, error) {
+			response := ipam.Assignipaddresstoresource(ctx, c, tracer, tenantID, regionID, resourceID, version)
+			if response.HasError() {
+				return models.AssignedIpAddress{}, response.Error()
+			}
+			return *response.Payload(), nil
+		},
+		list: func(ctx context.Context) ([]models.AssignedIpAddress, error) {
+			response := ipam.Listipaddressesforresource(ctx, c, tracer, tenantID, resourceID)
+			if response.HasError() {
+				return nil, response.Error()
+			}
+			return *response.Payload(), nil
+		},
+	}
+}
+
+// Freeze freezes every address, continuing past failures
+func (r *Resource) Freeze(ctx context.Context, ips []string) []Result {
+	return r.each(ctx, "ipambulk.Freeze", ips, func(ctx context.Context, ip string) error {
+		return r.freeze(ctx, r.resourceID, ip)
+	})
+}
+
+// Unfreeze unfreezes every address, continuing past failures
+func (r *Resource) Unfreeze(ctx context.Context, ips []string) []Result {
+	return r.each(ctx, "ipambulk.Unfreeze", ips, func(ctx context.Context, ip string) error {
+		return r.unfreeze(ctx, r.resourceID, ip)
+	})
+}
+
+func (r *Resource) each(ctx context.Context, operation string, ips []string, fn func(context.Context, string) error) []Result {
+	ctx, span := r.tracer.Start(ctx, operation, trace.WithAttributes(
+		attribute.String("tenantId", string(r.tenantID)),
+		attribute.String("resourceId", string(r.resourceID)),
+		attribute.Int("ipCount", len(ips)),
+	))
+	defer span.End()
+
+	results := make([]Result, 0, len(ips))
+	for _, ip := range ips {
+		results = append(results, Result{IP: ip, Err: fn(ctx, ip)})
+	}
+	if err := Errors(results); err != nil {
+		span.RecordError(err)
+	}
+	return results
+}
+
+// ErrUnexpectedAddress is returned when the API assigns another address than the one being moved
+var ErrUnexpectedAddress = errors.New("ipambulk: unexpected address assigned")
+
+// Move describes addresses moving from the resource to another one of the tenant
+type Move struct {
+	// RegionID is the IPAM region the addresses are assigned in
+	RegionID         ids.RegionID
+	TargetResourceID ids.ResourceID
+	IPs              []string
+}
+
+// StepKind identifies a step of a move
+type StepKind string
+
+const (
+	StepFreeze   StepKind = "freeze"
+	StepUnassign StepKind = "unassign"
+	StepAssign   StepKind = "assign"
+	StepUnfreeze StepKind = "unfreeze"
+)
+
+// Step is a single API call of a move, planned or run
+type Step struct {
+	Kind       StepKind
+	IP         string
+	ResourceID ids.ResourceID
+	// Compensation is true for steps undoing an earlier step after a failure
+	Compensation bool
+	Err          error
+}
+
+// String returns a human readable description of the step
+func (s Step) String() string {
+	line := fmt.Sprintf("%s %s on %s", s.Kind, s.IP, s.ResourceID)
+	if s.Compensation {
+		line = "undo: " + line
+	}
+	if s.Err != nil {
+		line += fmt.Sprintf(" (failed: %v)", s.Err)
+	}
+	return line
+}
+
+// Report lists the steps of a move in order
+type Report struct {
+	Move   Move
+	DryRun bool
+	Steps  []Step
+	// Moved lists the addresses now assigned to the target
+	Moved []string
+	// Lost lists the addresses a failed compensation left assigned to no resource
+	Lost []string
+}
+
+// Plan validates a move against the addresses assigned to the resource and
+// returns the steps it would run, without changing anything
+func (r *Resource) Plan(ctx context.Context, move Move) (*Report, error) {
+	ctx, span := r.moveSpan(ctx, "ipambulk.Plan", move)
+	defer span.End()
+
+	if err := r.validate(ctx, move); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := &Report{Move: move, DryRun: true}
+	for _, ip := range move.IPs {
+		for _, a := range r.actions(move, ip) {
+			report.Steps = append(report.Steps, a.step)
+		}
+	}
+	return report, nil
+}
+
+// Move moves the addresses one at a time. When a step fails, the completed
+// steps for that address are compensated in reverse order and the run stops;
+// addresses moved before the failure stay on the target.
+func (r *Resource) Move(ctx context.Context, move Move) (*Report, error) {
+	ctx, span := r.moveSpan(ctx, "ipambulk.Move", move)
+	defer span.End()
+
+	if err := r.validate(ctx, move); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	report := &Report{Move: move}
+	for _, ip := range move.IPs {
+		if err := r.moveOne(ctx, move, ip, report); err != nil {
+			span.RecordError(err)
+			return report, err
+		}
+		report.Moved = append(report.Moved, ip)
+	}
+	return report, nil
+}
+
+func (r *Resource) moveSpan(ctx context.Context, operation string, move Move) (context.Context, trace.Span) {
+	return r.tracer.Start(ctx, operation, trace.WithAttributes(
+		attribute.String("tenantId", string(r.tenantID)),
+		attribute.String("resourceId", string(r.resourceID)),
+		attribute.String("targetResourceId", string(move.TargetResourceID)),
+		attribute.Int("ipCount", len(move.IPs)),
+	))
+}
+
+// validate checks that every address is valid and assigned to the resource
+func (r *Resource) validate(ctx context.Context, move Move) error {
+	if move.TargetResourceID == r.resourceID {
+		return errors.New("ipambulk: source and target resources are the same")
+	}
+
+	addresses, err := r.list(ctx)
+	if err != nil {
+		return err
+	}
+	assigned := map[string]bool{}
+	for _, address := range addresses {
+		assigned[address.IP] = true
+	}
+
+	var errs []error
+	for _, ip := range move.IPs {
+		if _, err := ipVersion(ip); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !assigned[ip] {
+			errs = append(errs, fmt.Errorf("ipambulk: %s is not assigned to %s", ip, r.resourceID))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// action is a step of a move with the step undoing it, if any
+type action struct {
+	step Step
+	do   func(ctx context.Context) error
+	undo *action
+}
+
+// actions returns the steps moving an address, in order
+func (r *Resource) actions(move Move, ip string) []action {
+	source, target := r.resourceID, move.TargetResourceID
+	return []action{
+		{
+			step: Step{Kind: StepFreeze, IP: ip, ResourceID: source},
+			do:   func(ctx context.Context) error { return r.freeze(ctx, source, ip) },
+			undo: &action{
+				step: Step{Kind: StepUnfreeze, IP: ip, ResourceID: source, Compensation: true},
+				do:   func(ctx context.Context) error { return r.unfreeze(ctx, source, ip) },
+			},
+		},
+		{
+			step: Step{Kind: StepUnassign, IP: ip, ResourceID: source},
+			do:   func(ctx context.Context) error { return r.unassign(ctx, source, ip) },
+			undo: &action{
+				step: Step{Kind: StepAssign, IP: ip, ResourceID: source, Compensation: true},
+				do:   func(ctx context.Context) error { return r.assignIP(ctx, move.RegionID, source, ip) },
+			},
+		},
+		{
+			step: Step{Kind: StepAssign, IP: ip, ResourceID: target},
+			do:   func(ctx context.Context) error { return r.assignIP(ctx, move.RegionID, target, ip) },
+			undo: &action{
+				step: Step{Kind: StepUnassign, IP: ip, ResourceID: target, Compensation: true},
+				do:   func(ctx context.Context) error { return r.unassign(ctx, target, ip) },
+			},
+		},
+		{
+			step: Step{Kind: StepUnfreeze, IP: ip, ResourceID: target},
+			do:   func(ctx context.Context) error { return r.unfreeze(ctx, target, ip) },
+		},
+	}
+}
+
+// moveOne runs the steps moving an address, compensating them on failure
+func (r *Resource) moveOne(ctx context.Context, move Move, ip string, report *Report) error {
+	actions := r.actions(move, ip)
+	for i, a := range actions {
+		a.step.Err = a.do(ctx)
+		report.Steps = append(report.Steps, a.step)
+		if a.step.Err == nil {
+			continue
+		}
+
+		// a failed assign has already released any unexpected address
+		var undoErrs []error
+		for j := i - 1; j >= 0; j-- {
+			undo := actions[j].undo
+			if undo == nil {
+				continue
+			}
+			undo.step.Err = undo.do(ctx)
+			report.Steps = append(report.Steps, undo.step)
+			if undo.step.Err == nil {
+				continue
+			}
+			undoErrs = append(undoErrs, undo.step.Err)
+			if undo.step.Kind == StepAssign {
+				report.Lost = append(report.Lost, ip)
+			}
+		}
+
+		err := fmt.Errorf("ipambulk: %s %s on %s: %w", a.step.Kind, ip, a.step.ResourceID, a.step.Err)
+		if len(undoErrs) > 0 {
+			err = fmt.Errorf("%w; compensation failed: %w", err, errors.Join(undoErrs...))
+		}
+		return err
+	}
+	return nil
+}
+
+// assignIP assigns an address to a resource and checks it is the expected
+// one. An unexpected address is released at once.
+func (r *Resource) assignIP(ctx context.Context, regionID ids.RegionID, resourceID ids.ResourceID, ip string) error {
+	version, err := ipVersion(ip)
+	if err != nil {
+		return err
+	}
+	assigned, err := r.assign(ctx, regionID, resourceID, version)
+	if err != nil {
+		return err
+	}
+	if assigned.IP == ip {
+		return nil
+	}
+
+	err = fmt.Errorf("%w: got %s, want %s", ErrUnexpectedAddress, assigned.IP, ip)
+	if release := r.unassign(ctx, resourceID, assigned.IP); release != nil {
+		err = fmt.Errorf("%w; releasing %s failed: %w", err, assigned.IP, release)
+	}
+	return err
+}
+
+// ipVersion returns the IP version the assign endpoint expects for an address
+func ipVersion(ip string) (string, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return "", fmt.Errorf("ipambulk: invalid address %q: %w", ip, err)
+	}
+	if addr.Is4() || addr.Is4In6() {
+		return models.V4Type, nil
+	}
+	return models.V6Type, nil
+}
+
+func errorOf[T any](response client.Response[T]) error {
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package ipambulk
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeAddresses records which resource each address is assigned to and which
+// are frozen. The assign endpoint hands out the first address of pool.
+// failing addresses are rejected by freeze and unfreeze, and failingAssign
+// resources are rejected by assign.
+type fakeAddresses struct {
+	assigned      map[string]ids.ResourceID
+	frozen        map[string]bool
+	pool          []string
+	failing       map[string]bool
+	failingAssign map[ids.ResourceID]bool
+	calls         int
+}
+
+func newFakeAddresses(assigned map[string]ids.ResourceID) *fakeAddresses {
+	return &fakeAddresses{
+		assigned:      assigned,
+		frozen:        map[string]bool{},
+		failing:       map[string]bool{},
+		failingAssign: map[ids.ResourceID]bool{},
+	}
+}
+
+func (f *fakeAddresses) resource() *Resource {
+	r := New(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "res_1")
+	set := func(frozen bool) func(context.Context, ids.ResourceID, string) error {
+		return func(ctx context.Context, resourceID ids.ResourceID, ip string) error {
+			f.calls++
+			if f.failing[ip] || f.assigned[ip] != resourceID {
+				return errors.New("address not assigned to the resource")
+			}
+			f.frozen[ip] = frozen
+			return nil
+		}
+	}
+	r.freeze, r.unfreeze = set(true), set(false)
+	r.unassign = func(ctx context.Context, resourceID ids.ResourceID, ip string) error {
+		f.calls++
+		if f.assigned[ip] != resourceID {
+			return errors.New("address not assigned to the resource")
+		}
+		delete(f.assigned, ip)
+		f.pool = append(f.pool, ip)
+		return nil
+	}
+	r.assign = func(ctx context.Context, regionID ids.RegionID, resourceID ids.ResourceID, version string) (models.AssignedIpAddress, error) {
+		f.calls++
+		if f.failingAssign[resourceID] || len(f.pool) == 0 {
+			return models.AssignedIpAddress{}, errors.New("no address available")
+		}
+		ip := f.pool[0]
+		f.pool = f.pool[1:]
+		f.assigned[ip] = resourceID
+		return models.AssignedIpAddress{IP: ip, ResourceID: string(resourceID)}, nil
+	}
+	r.list = func(ctx context.Context) ([]models.AssignedIpAddress, error) {
+		var addresses []models.AssignedIpAddress
+		for ip, resourceID := range f.assigned {
+			if resourceID == "res_1" {
+				addresses = append(addresses, models.AssignedIpAddress{IP: ip, ResourceID: string(resourceID)})
+			}
+		}
+		return addresses, nil
+	}
+	return r
+}
+
+func TestFreeze(t *testing.T) {
+	f := newFakeAddresses(map[string]ids.ResourceID{"192.0.2.1": "res_1", "192.0.2.2": "res_1", "2001:db8::1": "res_1"})
+	f.failing["192.0.2.2"] = true
+	ips := []string{"192.0.2.1", "192.0.2.2", "2001:db8::1"}
+
+	results := f.resource().Freeze(context.Background(), ips)
+	var ok []string
+	for _, result := range results {
+		if result.Err == nil {
+			ok = append(ok, result.IP)
+		}
+	}
+	if !slices.Equal(ok, []string{"192.0.2.1", "2001:db8::1"}) {
+		t.Errorf("frozen %q, want every address but the failing one", ok)
+	}
+	if err := Errors(results); err == nil || !f.frozen["2001:db8::1"] {
+		t.Errorf("Errors = %v, frozen = %v: the failure must be reported without stopping the run", err, f.frozen)
+	}
+
+	results = f.resource().Unfreeze(context.Background(), []string{"192.0.2.1", "2001:db8::1"})
+	if err := Errors(results); err != nil || f.frozen["192.0.2.1"] || f.frozen["2001:db8::1"] {
+		t.Errorf("Unfreeze = %v, frozen = %v", err, f.frozen)
+	}
+}
+
+func TestPlanChangesNothing(t *testing.T) {
+	f := newFakeAddresses(map[string]ids.ResourceID{"192.0.2.1": "res_1"})
+	r := f.resource()
+	move := Move{RegionID: "par", TargetResourceID: "res_2", IPs: []string{"192.0.2.1"}}
+
+	report, err := r.Plan(context.Background(), move)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	var kinds []StepKind
+	for _, step := range report.Steps {
+		kinds = append(kinds, step.Kind)
+	}
+	if !report.DryRun || !slices.Equal(kinds, []StepKind{StepFreeze, StepUnassign, StepAssign, StepUnfreeze}) {
+		t.Errorf("planned %v, dry run %v", kinds, report.DryRun)
+	}
+	if f.calls != 0 || f.assigned["192.0.2.1"] != "res_1" {
+		t.Errorf("Plan made %d calls", f.calls)
+	}
+
+	move.IPs = []string{"192.0.2.9", "not an ip"}
+	if _, err := r.Plan(context.Background(), move); err == nil {
+		t.Errorf("Plan accepted addresses not assigned to the resource")
+	}
+}
+
+func TestMove(t *testing.T) {
+	f := newFakeAddresses(map[string]ids.ResourceID{"192.0.2.1": "res_1", "192.0.2.2": "res_1"})
+
+	report, err := f.resource().Move(context.Background(), Move{RegionID: "par", TargetResourceID: "res_2", IPs: []string{"192.0.2.1", "192.0.2.2"}})
+	if err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if !slices.Equal(report.Moved, []string{"192.0.2.1", "192.0.2.2"}) || f.assigned["192.0.2.1"] != "res_2" || f.assigned["192.0.2.2"] != "res_2" {
+		t.Errorf("moved %q, assigned %v", report.Moved, f.assigned)
+	}
+	if f.frozen["192.0.2.1"] || f.frozen["192.0.2.2"] {
+		t.Errorf("moved addresses left frozen: %v", f.frozen)
+	}
+}
+
+func TestMoveCompensates(t *testing.T) {
+	f := newFakeAddresses(map[string]ids.ResourceID{"192.0.2.1": "res_1"})
+	f.failingAssign["res_2"] = true
+
+	report, err := f.resource().Move(context.Background(), Move{RegionID: "par", TargetResourceID: "res_2", IPs: []string{"192.0.2.1"}})
+	if err == nil {
+		t.Fatalf("Move succeeded, want the assign failure")
+	}
+	var undone []StepKind
+	for _, step := range report.Steps {
+		if step.Compensation {
+			undone = append(undone, step.Kind)
+		}
+	}
+	if !slices.Equal(undone, []StepKind{StepAssign, StepUnfreeze}) {
+		t.Errorf("compensated %v, want the unassign then the freeze undone", undone)
+	}
+	if f.assigned["192.0.2.1"] != "res_1" || f.frozen["192.0.2.1"] || len(report.Moved) != 0 || len(report.Lost) != 0 {
+		t.Errorf("assigned %v, frozen %v, report %+v: the address must be back on the source", f.assigned, f.frozen, report)
+	}
+}
+
+func TestMoveUnexpectedAddress(t *testing.T) {
+	f := newFakeAddresses(map[string]ids.ResourceID{"192.0.2.1": "res_1"})
+	// other addresses are free: the assign endpoint hands them out first
+	f.pool = []string{"192.0.2.7", "192.0.2.8"}
+
+	report, err := f.resource().Move(context.Background(), Move{RegionID: "par", TargetResourceID: "res_2", IPs: []string{"192.0.2.1"}})
+	if !errors.Is(err, ErrUnexpectedAddress) {
+		t.Fatalf("Move = %v, want ErrUnexpectedAddress", err)
+	}
+	if len(f.assigned) != 0 {
+		t.Errorf("unexpected addresses kept: %v", f.assigned)
+	}
+	// compensating gets 192.0.2.8 instead of 192.0.2.1: the address is lost
+	if !slices.Equal(report.Lost, []string{"192.0.2.1"}) {
+		t.Errorf("lost %q, want the moved address reported", report.Lost)
+	}
+}
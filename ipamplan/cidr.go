@@ -0,0 +1,88 @@
+package ipamplan
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+// ErrNoFreeSubnet is returned when a supernet has no room left for the requested size
+var ErrNoFreeSubnet = errors.New("ipamplan: no free subnet")
+
+// ParseCIDR parses a CIDR string and rejects prefixes with host bits set,
+// since the API stores the network as given and 10.0.0.1/24 is almost always a typo.
+func ParseCIDR(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("ipamplan: invalid CIDR %q: %w", cidr, err)
+	}
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("ipamplan: CIDR %q has host bits set, did you mean %s?", cidr, prefix.Masked())
+	}
+	return prefix, nil
+}
+
+// Size returns the number of addresses in a prefix
+func Size(prefix netip.Prefix) *big.Int {
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	return new(big.Int).Lsh(big.NewInt(1), uint(hostBits))
+}
+
+// NextFree returns the first subnet of the given prefix length inside supernet
+// that does not overlap any of the used prefixes.
+func NextFree(supernet netip.Prefix, bits int, used []netip.Prefix) (netip.Prefix, error) {
+	supernet = supernet.Masked()
+	if bits < supernet.Bits() || bits > supernet.Addr().BitLen() {
+		return netip.Prefix{}, fmt.Errorf("ipamplan: cannot carve a /%d out of %s", bits, supernet)
+	}
+
+	step := Size(netip.PrefixFrom(supernet.Addr(), bits))
+	candidate := netip.PrefixFrom(supernet.Addr(), bits)
+
+	for supernet.Contains(candidate.Addr()) {
+		conflict, ok := firstOverlap(candidate, used)
+		if !ok {
+			return candidate, nil
+		}
+
+		// Jump past the conflicting prefix when it is larger than the candidate,
+		// otherwise move to the next aligned candidate.
+		next := addrAdd(candidate.Addr(), step)
+		if conflict.Bits() < bits {
+			next = addrAdd(conflict.Masked().Addr(), Size(conflict))
+		}
+		if !next.IsValid() || next.Less(candidate.Addr()) {
+			break
+		}
+		candidate = netip.PrefixFrom(next, bits).Masked()
+	}
+
+	return netip.Prefix{}, fmt.Errorf("%w of size /%d in %s", ErrNoFreeSubnet, bits, supernet)
+}
+
+func firstOverlap(prefix netip.Prefix, used []netip.Prefix) (netip.Prefix, bool) {
+	for _, u := range used {
+		if prefix.Overlaps(u) {
+			return u, true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// addrAdd returns addr+n, or the zero Addr on overflow
+func addrAdd(addr netip.Addr, n *big.Int) netip.Addr {
+	sum := new(big.Int).SetBytes(addr.AsSlice())
+	sum.Add(sum, n)
+
+	buf := sum.Bytes()
+	size := addr.BitLen() / 8
+	if len(buf) > size {
+		return netip.Addr{}
+	}
+
+	out := make([]byte, size)
+	copy(out[size-len(buf):], buf)
+	result, _ := netip.AddrFromSlice(out)
+	return result
+}
@@ -0,0 +1,103 @@
+package ipamplan
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestParseCIDRRejectsHostBits(t *testing.T) {
+	if _, err := ParseCIDR("10.0.0.1/24"); err == nil {
+		t.Fatalf("expected error for CIDR with host bits set")
+	}
+	prefix, err := ParseCIDR("10.0.0.0/24")
+	if err != nil {
+		t.Fatalf("ParseCIDR failed: %v", err)
+	}
+	if prefix.String() != "10.0.0.0/24" {
+		t.Errorf("prefix = %s, want 10.0.0.0/24", prefix)
+	}
+}
+
+func TestNextFree(t *testing.T) {
+	tests := []struct {
+		name     string
+		supernet string
+		bits     int
+		used     []string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "empty supernet",
+			supernet: "10.0.0.0/16",
+			bits:     24,
+			want:     "10.0.0.0/24",
+		},
+		{
+			name:     "skips used subnets",
+			supernet: "10.0.0.0/16",
+			bits:     24,
+			used:     []string{"10.0.0.0/24", "10.0.1.0/24"},
+			want:     "10.0.2.0/24",
+		},
+		{
+			name:     "jumps over larger prefix",
+			supernet: "10.0.0.0/16",
+			bits:     26,
+			used:     []string{"10.0.0.0/22"},
+			want:     "10.0.4.0/26",
+		},
+		{
+			name:     "fills hole between smaller prefixes",
+			supernet: "192.168.0.0/24",
+			bits:     26,
+			used:     []string{"192.168.0.0/26", "192.168.0.128/25"},
+			want:     "192.168.0.64/26",
+		},
+		{
+			name:     "ignores other address family",
+			supernet: "fd00::/48",
+			bits:     64,
+			used:     []string{"10.0.0.0/8", "fd00::/64"},
+			want:     "fd00:0:0:1::/64",
+		},
+		{
+			name:     "full supernet",
+			supernet: "10.0.0.0/24",
+			bits:     25,
+			used:     []string{"10.0.0.0/25", "10.0.0.128/25"},
+			wantErr:  ErrNoFreeSubnet,
+		},
+		{
+			name:     "full supernet at end of address space",
+			supernet: "255.255.255.0/24",
+			bits:     25,
+			used:     []string{"255.255.255.0/24"},
+			wantErr:  ErrNoFreeSubnet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var used []netip.Prefix
+			for _, u := range tt.used {
+				used = append(used, netip.MustParsePrefix(u))
+			}
+
+			got, err := NextFree(netip.MustParsePrefix(tt.supernet), tt.bits, used)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NextFree failed: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("NextFree = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+// Package ipamplan provides local CIDR arithmetic over IPAM networks.
+//
+// A Planner loads the networks of a tenant across regions, detects overlaps,
+// suggests free subnets and validates new networks before they are created.
+// Networks the API returns with an invalid CIDR are reported by Invalid
+// rather than failing the load.
+package ipamplan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	ipam "go.clever-cloud.dev/sdk/services/ipam"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Network is an IPAM network with its parsed prefix
+type Network struct {
+	models.Network
+	Prefix netip.Prefix
+}
+
+// Name returns the network name, or its ID when unnamed
+func (n Network) Name() string {
+	if n.Network.Name != nil {
+		return *n.Network.Name
+	}
+	return n.ID
+}
+
+// ParseNetwork parses the CIDR of an IPAM network
+func ParseNetwork(network models.Network) (Network, error) {
+	prefix, err := ParseCIDR(network.Cidr)
+	if err != nil {
+		return Network{}, fmt.Errorf("network %s: %w", network.ID, err)
+	}
+	return Network{Network: network, Prefix: prefix}, nil
+}
+
+// InvalidNetwork is a loaded network whose CIDR is not valid
+type InvalidNetwork struct {
+	Network models.Network
+	// Kept reports whether the network is still planned against, with its
+	// host bits cleared, or skipped as its CIDR cannot be parsed
+	Kept bool
+	Err  error
+}
+
+// Overlap describes two networks sharing addresses
+type Overlap struct {
+	A Network
+	B Network
+}
+
+// String returns a human readable description of the overlap
+func (o Overlap) String() string {
+	return fmt.Sprintf("%s (%s, %s) overlaps %s (%s, %s)",
+		o.A.Name(), o.A.Prefix, o.A.RegionID, o.B.Name(), o.B.Prefix, o.B.RegionID)
+}
+
+// Utilization reports how many addresses of a network are assigned
+type Utilization struct {
+	Network  Network
+	Assigned int
+	Usable   *big.Int
+}
+
+// Ratio returns the assigned fraction of usable addresses
+func (u Utilization) Ratio() float64 {
+	if u.Usable.Sign() == 0 {
+		return 0
+	}
+	ratio, _ := new(big.Rat).SetFrac(big.NewInt(int64(u.Assigned)), u.Usable).Float64()
+	return ratio
+}
+
+// Planner holds the networks of a tenant and plans new ones against them
+type Planner struct {
+	client   *client.Client
+	tracer   trace.Tracer
+	tenantID ids.TenantID
+
+	networks []Network
+	invalid  []InvalidNetwork
+
+	// API calls, replaced in tests
+	listRegions   func(ctx context.Context) ([]models.Region2, error)
+	listNetworks  func(ctx context.Context, regionID ids.RegionID) ([]models.Network, error)
+	create        func(ctx context.Context, regionID ids.RegionID, input *models.CreateNetworkInput) (models.Network, error)
+	listAddresses func(ctx context.Context, resourceID ids.ResourceID) ([]models.AssignedIpAddress, error)
+}
+
+// NewPlanner creates an empty Planner for a tenant
+func NewPlanner(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID) *Planner {
+	p := &Planner{client: c, tracer: tracer, tenantID: tenantID}
+	p.listRegions = func(ctx context.Context) ([]models.Region2, error) {
+		return payload(ipam.Listregionsforowner(ctx, p.client, p.tracer, p.tenantID))
+	}
+	p.listNetworks = func(ctx context.Context, regionID ids.RegionID) ([]models.Network, error) {
+		return payload(ipam.Listnetworksforregion(ctx, p.client, p.tracer, p.tenantID, regionID))
+	}
+	p.create = func(ctx context.Context, regionID ids.RegionID, input *models.CreateNetworkInput) (models.Network, error) {
+		return payload(ipam.Createnetworkforregion(ctx, p.client, p.tracer, p.tenantID, regionID, input))
+	}
+	p.listAddresses = func(ctx context.Context, resourceID ids.ResourceID) ([]models.AssignedIpAddress, error) {
+		return payload(ipam.Listipaddressesforresource(ctx, p.client, p.tracer, p.tenantID, resourceID))
+	}
+	return p
+}
+
+// Networks returns the networks known to the planner
+func (p *Planner) Networks() []Network {
+	return slices.Clone(p.networks)
+}
+
+// Invalid returns the networks of the last Load whose CIDR is not valid
+func (p *Planner) Invalid() []InvalidNetwork {
+	return slices.Clone(p.invalid)
+}
+
+// Add registers networks without going through the API
+func (p *Planner) Add(networks ...models.Network) error {
+	for _, network := range networks {
+		parsed, err := ParseNetwork(network)
+		if err != nil {
+			return err
+		}
+		p.networks = append(p.networks, parsed)
+	}
+	return nil
+}
+
+// Load fetches the networks of the given regions, or of every region of the tenant when none is given.
+// Previously loaded networks are replaced. A network whose CIDR has host
+// bits set is kept with them cleared, as it still uses those addresses, and
+// one whose CIDR cannot be parsed is skipped: both are reported by Invalid.
+func (p *Planner) Load(ctx context.Context, regionIDs ...ids.RegionID) error {
+	ctx, span := p.tracer.Start(ctx, "ipamplan.Load", trace.WithAttributes(attribute.String("tenantId", string(p.tenantID))))
+	defer span.End()
+
+	if len(regionIDs) == 0 {
+		regions, err := p.listRegions(ctx)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		for _, region := range regions {
+			regionIDs = append(regionIDs, ids.RegionID(region.ID))
+		}
+	}
+
+	p.networks, p.invalid = nil, nil
+	for _, regionID := range regionIDs {
+		networks, err := p.listNetworks(ctx, regionID)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		for _, network := range networks {
+			p.load(network)
+		}
+	}
+	span.SetAttributes(attribute.Int("invalid", len(p.invalid)))
+
+	return nil
+}
+
+// load registers a listed network, recording it as invalid when its CIDR is
+func (p *Planner) load(network models.Network) {
+	parsed, err := ParseNetwork(network)
+	if err == nil {
+		p.networks = append(p.networks, parsed)
+		return
+	}
+
+	invalid := InvalidNetwork{Network: network, Err: err}
+	if prefix, parseErr := netip.ParsePrefix(network.Cidr); parseErr == nil {
+		p.networks = append(p.networks, Network{Network: network, Prefix: prefix.Masked()})
+		invalid.Kept = true
+	}
+	p.invalid = append(p.invalid, invalid)
+}
+
+// Overlaps returns every pair of known networks sharing addresses, across all regions
+func (p *Planner) Overlaps() []Overlap {
+	var overlaps []Overlap
+	for i, a := range p.networks {
+		for _, b := range p.networks[i+1:] {
+			if a.Prefix.Overlaps(b.Prefix) {
+				overlaps = append(overlaps, Overlap{A: a, B: b})
+			}
+		}
+	}
+	return overlaps
+}
+
+// NextFree suggests the first /bits subnet of supernet not used by any known network
+func (p *Planner) NextFree(supernet netip.Prefix, bits int) (netip.Prefix, error) {
+	used := make([]netip.Prefix, 0, len(p.networks))
+	for _, network := range p.networks {
+		used = append(used, network.Prefix)
+	}
+	return NextFree(supernet, bits, used)
+}
+
+// Validate checks that a planned network is a valid CIDR and does not overlap a known network
+func (p *Planner) Validate(input models.CreateNetworkInput) error {
+	prefix, err := ParseCIDR(input.Cidr)
+	if err != nil {
+		return err
+	}
+
+	for _, network := range p.networks {
+		if prefix.Overlaps(network.Prefix) {
+			return fmt.Errorf("ipamplan: %s overlaps network %s (%s) in region %s",
+				prefix, network.Name(), network.Prefix, network.RegionID)
+		}
+	}
+	return nil
+}
+
+// Create validates a planned network then creates it in a region
+func (p *Planner) Create(ctx context.Context, regionID ids.RegionID, input models.CreateNetworkInput) (Network, error) {
+	if err := p.Validate(input); err != nil {
+		return Network{}, err
+	}
+
+	network, err := p.create(ctx, regionID, &input)
+	if err != nil {
+		return Network{}, err
+	}
+
+	created, err := ParseNetwork(network)
+	if err != nil {
+		return Network{}, err
+	}
+	p.networks = append(p.networks, created)
+	return created, nil
+}
+
+// Utilization counts the addresses assigned to the given resources in each known network.
+// Usable addresses exclude the NetworkOffset reserved at the start of each network.
+func (p *Planner) Utilization(ctx context.Context, resourceIDs ...ids.ResourceID) ([]Utilization, error) {
+	ctx, span := p.tracer.Start(ctx, "ipamplan.Utilization", trace.WithAttributes(attribute.String("tenantId", string(p.tenantID))))
+	defer span.End()
+
+	assigned := map[string]int{}
+	for _, resourceID := range resourceIDs {
+		addresses, err := p.listAddresses(ctx, resourceID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		for _, address := range addresses {
+			assigned[address.NetworkID]++
+		}
+	}
+
+	utilization := make([]Utilization, 0, len(p.networks))
+	for _, network := range p.networks {
+		usable := new(big.Int).Sub(Size(network.Prefix), big.NewInt(int64(network.NetworkOffset)))
+		if usable.Sign() < 0 {
+			usable.SetInt64(0)
+		}
+		utilization = append(utilization, Utilization{
+			Network:  network,
+			Assigned: assigned[network.ID],
+			Usable:   usable,
+		})
+	}
+	return utilization, nil
+}
+
+func payload[T any](response client.Response[T]) (T, error) {
+	if response.HasError() {
+		var zero T
+		return zero, response.Error()
+	}
+	return *response.Payload(), nil
+}
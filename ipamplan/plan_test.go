@@ -0,0 +1,104 @@
+package ipamplan
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"testing"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakePlanner returns a Planner listing the networks of each region and
+// creating networks in them
+func fakePlanner(networks map[ids.RegionID][]models.Network) *Planner {
+	p := NewPlanner(nil, noop.NewTracerProvider().Tracer("test"), "tenant_1")
+	p.listRegions = func(ctx context.Context) ([]models.Region2, error) {
+		var regions []models.Region2
+		for regionID := range networks {
+			regions = append(regions, models.Region2{ID: models.RegionId(regionID)})
+		}
+		slices.SortFunc(regions, func(a, b models.Region2) int { return strings.Compare(string(a.ID), string(b.ID)) })
+		return regions, nil
+	}
+	p.listNetworks = func(ctx context.Context, regionID ids.RegionID) ([]models.Network, error) {
+		return networks[regionID], nil
+	}
+	p.create = func(ctx context.Context, regionID ids.RegionID, input *models.CreateNetworkInput) (models.Network, error) {
+		network := models.Network{ID: fmt.Sprintf("network_%d", len(networks[regionID])+1), Cidr: input.Cidr, RegionID: models.RegionId(regionID)}
+		networks[regionID] = append(networks[regionID], network)
+		return network, nil
+	}
+	return p
+}
+
+func TestLoad(t *testing.T) {
+	p := fakePlanner(map[ids.RegionID][]models.Network{
+		"par": {{ID: "network_1", Cidr: "10.0.0.0/24"}, {ID: "network_2", Cidr: "10.0.1.1/24"}},
+		"rbx": {{ID: "network_3", Cidr: "not a cidr"}, {ID: "network_4", Cidr: "10.0.2.0/24"}},
+	})
+
+	if err := p.Load(context.Background()); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	var prefixes []string
+	for _, network := range p.Networks() {
+		prefixes = append(prefixes, network.Prefix.String())
+	}
+	if !slices.Equal(prefixes, []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}) {
+		t.Errorf("loaded %q, want every parsable network of every region, host bits cleared", prefixes)
+	}
+
+	invalid := p.Invalid()
+	if len(invalid) != 2 || invalid[0].Network.ID != "network_2" || !invalid[0].Kept || invalid[1].Network.ID != "network_3" || invalid[1].Kept {
+		t.Errorf("invalid = %+v, want network_2 kept and network_3 skipped", invalid)
+	}
+
+	// loading a region again forgets the others
+	if err := p.Load(context.Background(), "par"); err != nil || len(p.Networks()) != 2 || len(p.Invalid()) != 1 {
+		t.Errorf("Load(par) = %v, %d networks, %d invalid", err, len(p.Networks()), len(p.Invalid()))
+	}
+}
+
+func TestPlannerNextFree(t *testing.T) {
+	p := fakePlanner(map[ids.RegionID][]models.Network{
+		"par": {{ID: "network_1", Cidr: "10.0.0.0/24"}, {ID: "network_2", Cidr: "10.0.1.7/24"}},
+	})
+	if err := p.Load(context.Background()); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	next, err := p.NextFree(netip.MustParsePrefix("10.0.0.0/16"), 24)
+	if err != nil || next.String() != "10.0.2.0/24" {
+		t.Errorf("NextFree = %s, %v, want 10.0.2.0/24", next, err)
+	}
+}
+
+func TestValidateAndCreate(t *testing.T) {
+	networks := map[ids.RegionID][]models.Network{
+		"par": {{ID: "network_1", Cidr: "10.0.0.0/24"}},
+		"rbx": {{ID: "network_1", Cidr: "10.1.0.1/24"}},
+	}
+	p := fakePlanner(networks)
+	if err := p.Load(context.Background()); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	for _, cidr := range []string{"10.0.0.128/25", "10.1.0.0/16", "10.2.0.1/24", "not a cidr"} {
+		if err := p.Validate(models.CreateNetworkInput{Cidr: cidr}); err == nil {
+			t.Errorf("Validate(%s) accepted an overlapping or invalid network", cidr)
+		}
+	}
+
+	created, err := p.Create(context.Background(), "par", models.CreateNetworkInput{Cidr: "10.2.0.0/24"})
+	if err != nil || created.Prefix.String() != "10.2.0.0/24" || len(networks["par"]) != 2 {
+		t.Fatalf("Create = %+v, %v", created, err)
+	}
+	if _, err := p.Create(context.Background(), "rbx", models.CreateNetworkInput{Cidr: "10.2.0.0/25"}); err == nil || len(networks["rbx"]) != 1 {
+		t.Errorf("Create accepted a network overlapping the one just created: %v", err)
+	}
+}
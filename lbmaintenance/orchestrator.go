@@ -0,0 +1,478 @@
+// Package lbmaintenance orchestrates rolling maintenance of load balancer servers.
+//
+// Servers are processed in batches: each one is drained and its load balancer
+// capacity zeroed, the run waits until an evacuation check reports it carries
+// no more traffic, it is put into maintenance, a user hook runs, and the
+// server is then brought back with its original capacity. Progress is persisted
+// after every step so an interrupted run can be resumed, and cleared once the
+// run completes.
+package lbmaintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	loadbalancer "go.clever-cloud.dev/sdk/services/loadbalancer"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrAborted is returned by Run when Abort was called
+var ErrAborted = errors.New("lbmaintenance: run aborted")
+
+// ErrEvacuationTimeout is returned when a server is not evacuated in time
+var ErrEvacuationTimeout = errors.New("lbmaintenance: evacuation timed out")
+
+// ErrNoEvacuationCheck is returned by Run when the Orchestrator has no EvacuatedFunc
+var ErrNoEvacuationCheck = errors.New("lbmaintenance: no evacuation check")
+
+// Hook is the maintenance work run while a server is in maintenance
+type Hook func(ctx context.Context, server models.Server) error
+
+// EvacuatedFunc reports whether a drained server no longer carries traffic,
+// for instance from its connection count in a metrics backend. The API only
+// reports the drain flag and capacity, which draining sets right away, so
+// this is what the evacuation step waits on.
+type EvacuatedFunc func(ctx context.Context, server models.Server) (bool, error)
+
+// GracePeriod is an EvacuatedFunc for when no traffic metric is available:
+// a server counts as evacuated once d has elapsed since it was last updated,
+// which is when it was drained.
+func GracePeriod(d time.Duration) EvacuatedFunc {
+	return func(ctx context.Context, server models.Server) (bool, error) {
+		return time.Since(server.UpdatedAt) >= d, nil
+	}
+}
+
+// Orchestrator runs rolling maintenance over the servers of one region
+type Orchestrator struct {
+	client   *client.Client
+	tracer   trace.Tracer
+	tenantID ids.TenantID
+	regionID ids.RegionID
+
+	batchSize         int
+	concurrency       int
+	pollInterval      time.Duration
+	evacuationTimeout time.Duration
+	store             Store
+	hook              Hook
+	evacuated         EvacuatedFunc
+
+	// abort is closed by Abort and replaced by every Run
+	abortMu sync.Mutex
+	abort   chan struct{}
+
+	// mu guards the progress of a run: servers of a batch update their
+	// progress concurrently and every update is saved
+	mu sync.Mutex
+
+	// API calls, replaced in tests
+	listServers    func(ctx context.Context) ([]models.Server, error)
+	getServer      func(ctx context.Context, serverID ids.ServerID) (models.Server, error)
+	setDrain       func(ctx context.Context, serverID ids.ServerID, drain bool) error
+	setCapacity    func(ctx context.Context, serverID ids.ServerID, capacity int) error
+	setMaintenance func(ctx context.Context, serverID ids.ServerID, maintenance bool) error
+}
+
+// Option defines configuration options for the Orchestrator
+type Option func(*Orchestrator)
+
+// WithBatchSize sets how many servers are taken out of service at once
+func WithBatchSize(size int) Option {
+	return func(o *Orchestrator) {
+		o.batchSize = size
+	}
+}
+
+// WithConcurrency limits how many servers of a batch are processed in parallel
+func WithConcurrency(n int) Option {
+	return func(o *Orchestrator) {
+		o.concurrency = n
+	}
+}
+
+// WithPollInterval sets the delay between two evacuation checks
+func WithPollInterval(d time.Duration) Option {
+	return func(o *Orchestrator) {
+		o.pollInterval = d
+	}
+}
+
+// WithEvacuationTimeout sets how long to wait for a server to be evacuated
+func WithEvacuationTimeout(d time.Duration) Option {
+	return func(o *Orchestrator) {
+		o.evacuationTimeout = d
+	}
+}
+
+// WithStore sets where progress is persisted
+func WithStore(s Store) Option {
+	return func(o *Orchestrator) {
+		o.store = s
+	}
+}
+
+// WithHook sets the maintenance work to run on each server
+func WithHook(h Hook) Option {
+	return func(o *Orchestrator) {
+		o.hook = h
+	}
+}
+
+// New creates an Orchestrator for the servers of a tenant region. Drained
+// servers are put into maintenance once evacuated reports them evacuated.
+func New(c *client.Client, tracer trace.Tracer, tenantID ids.TenantID, regionID ids.RegionID, evacuated EvacuatedFunc, opts ...Option) *Orchestrator {
+	o := &Orchestrator{
+		client:            c,
+		tracer:            tracer,
+		tenantID:          tenantID,
+		regionID:          regionID,
+		evacuated:         evacuated,
+		batchSize:         1,
+		pollInterval:      10 * time.Second,
+		evacuationTimeout: 30 * time.Minute,
+		store:             &memoryStore{},
+		abort:             make(chan struct{}),
+	}
+	o.listServers = func(ctx context.Context) ([]models.Server, error) {
+		return payload(loadbalancer.Listserversforregion(ctx, o.client, o.tracer, o.tenantID, o.regionID))
+	}
+	o.getServer = func(ctx context.Context, serverID ids.ServerID) (models.Server, error) {
+		return payload(loadbalancer.Getserver(ctx, o.client, o.tracer, o.tenantID, o.regionID, serverID))
+	}
+	o.setDrain = func(ctx context.Context, serverID ids.ServerID, drain bool) error {
+		return errorOf(loadbalancer.Updateserverdrain(ctx, o.client, o.tracer, o.tenantID, o.regionID, serverID, &models.DrainInput{Drain: drain}))
+	}
+	o.setCapacity = func(ctx context.Context, serverID ids.ServerID, capacity int) error {
+		return errorOf(loadbalancer.Updateserverloadbalancercapacity(ctx, o.client, o.tracer, o.tenantID, o.regionID, serverID, &models.LoadBalancerCapacityInput{Capacity: capacity}))
+	}
+	o.setMaintenance = func(ctx context.Context, serverID ids.ServerID, maintenance bool) error {
+		return errorOf(loadbalancer.Updateservermaintenance(ctx, o.client, o.tracer, o.tenantID, o.regionID, serverID, &models.MaintenanceInput{Maintenance: maintenance}))
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.batchSize < 1 {
+		o.batchSize = 1
+	}
+	if o.concurrency < 1 || o.concurrency > o.batchSize {
+		o.concurrency = o.batchSize
+	}
+
+	return o
+}
+
+// Abort stops the current run once the in-flight steps complete.
+// Servers already out of service keep their recorded phase and are picked up
+// by the next Run, which the abort does not affect.
+func (o *Orchestrator) Abort() {
+	o.abortMu.Lock()
+	defer o.abortMu.Unlock()
+
+	select {
+	case <-o.abort:
+	default:
+		close(o.abort)
+	}
+}
+
+// aborting returns the channel closed when the current run is aborted
+func (o *Orchestrator) aborting() <-chan struct{} {
+	o.abortMu.Lock()
+	defer o.abortMu.Unlock()
+	return o.abort
+}
+
+func (o *Orchestrator) aborted() bool {
+	select {
+	case <-o.aborting():
+		return true
+	default:
+		return false
+	}
+}
+
+// Run performs maintenance on the given servers, or on every server of the region when serverIDs is empty.
+// Progress from an interrupted run of the same region is resumed; the
+// progress is cleared from the Store once every server is restored.
+func (o *Orchestrator) Run(ctx context.Context, serverIDs []ids.ServerID) (*Progress, error) {
+	ctx, span := o.tracer.Start(ctx, "lbmaintenance.Run", trace.WithAttributes(
+		attribute.String("tenantId", string(o.tenantID)),
+		attribute.String("regionId", string(o.regionID)),
+		attribute.Int("batchSize", o.batchSize),
+	))
+	defer span.End()
+
+	if o.evacuated == nil {
+		span.RecordError(ErrNoEvacuationCheck)
+		return nil, ErrNoEvacuationCheck
+	}
+
+	o.abortMu.Lock()
+	o.abort = make(chan struct{})
+	o.abortMu.Unlock()
+
+	progress, err := o.loadProgress()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if len(serverIDs) == 0 {
+		servers, err := o.listServers(ctx)
+		if err != nil {
+			span.RecordError(err)
+			return progress, err
+		}
+		for _, server := range servers {
+			serverIDs = append(serverIDs, ids.ServerID(server.ID))
+		}
+	}
+
+	var pending []ids.ServerID
+	for _, id := range serverIDs {
+		if !progress.Done(id) {
+			pending = append(pending, id)
+		}
+		if _, ok := progress.Servers[id]; !ok {
+			progress.Servers[id] = &ServerProgress{ServerID: id, Phase: PhasePending, UpdatedAt: time.Now()}
+		}
+	}
+	if err := o.store.Save(progress); err != nil {
+		span.RecordError(err)
+		return progress, err
+	}
+
+	for start := 0; start < len(pending); start += o.batchSize {
+		if o.aborted() {
+			return progress, ErrAborted
+		}
+		if err := ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		end := min(start+o.batchSize, len(pending))
+		if err := o.runBatch(ctx, progress, pending[start:end]); err != nil {
+			span.RecordError(err)
+			return progress, err
+		}
+	}
+
+	if err := o.store.Clear(); err != nil {
+		span.RecordError(err)
+		return progress, err
+	}
+	return progress, nil
+}
+
+func (o *Orchestrator) loadProgress() (*Progress, error) {
+	progress, err := o.store.Load()
+	if errors.Is(err, ErrNoProgress) {
+		return &Progress{
+			TenantID:  o.tenantID,
+			RegionID:  o.regionID,
+			StartedAt: time.Now(),
+			Servers:   map[ids.ServerID]*ServerProgress{},
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if progress.TenantID != o.tenantID || progress.RegionID != o.regionID {
+		return nil, fmt.Errorf("lbmaintenance: recorded progress is for %s/%s, not %s/%s",
+			progress.TenantID, progress.RegionID, o.tenantID, o.regionID)
+	}
+	return progress, nil
+}
+
+func (o *Orchestrator) runBatch(ctx context.Context, progress *Progress, serverIDs []ids.ServerID) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, o.concurrency)
+	)
+
+	for _, id := range serverIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(sp *ServerProgress) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := o.runServer(ctx, progress, sp); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("server %s: %w", sp.ServerID, err))
+				mu.Unlock()
+			}
+		}(progress.Servers[id])
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// runServer advances a server from its recorded phase to PhaseRestored
+func (o *Orchestrator) runServer(ctx context.Context, progress *Progress, sp *ServerProgress) error {
+	ctx, span := o.tracer.Start(ctx, "lbmaintenance.Server", trace.WithAttributes(attribute.String("serverId", string(sp.ServerID))))
+	defer span.End()
+
+	steps := []struct {
+		from Phase
+		to   Phase
+		run  func(context.Context, *Progress, *ServerProgress) error
+	}{
+		{PhasePending, PhaseDrained, o.drain},
+		{PhaseDrained, PhaseEvacuated, o.waitEvacuated},
+		{PhaseEvacuated, PhaseMaintenance, o.enterMaintenance},
+		{PhaseMaintenance, PhaseHookDone, o.runHook},
+		{PhaseHookDone, PhaseRestored, o.restore},
+	}
+
+	for _, step := range steps {
+		if sp.Phase != step.from {
+			continue
+		}
+		if o.aborted() {
+			return ErrAborted
+		}
+
+		err := step.run(ctx, progress, sp)
+		if err != nil {
+			span.RecordError(err)
+		}
+		saveErr := o.update(progress, func() {
+			if err == nil {
+				sp.Phase, sp.Error = step.to, ""
+			} else {
+				sp.Error = err.Error()
+			}
+			sp.UpdatedAt = time.Now()
+		})
+		if saveErr != nil {
+			return errors.Join(err, saveErr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// update changes the progress of a server and saves the progress of the run.
+// Only update writes to the progress once servers are being processed.
+func (o *Orchestrator) update(progress *Progress, change func()) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	change()
+	return o.store.Save(progress)
+}
+
+func (o *Orchestrator) drain(ctx context.Context, progress *Progress, sp *ServerProgress) error {
+	server, err := o.getServer(ctx, sp.ServerID)
+	if err != nil {
+		return err
+	}
+	// A server already at zero capacity was most likely zeroed by an earlier
+	// attempt, keep the capacity recorded at that time.
+	if server.LoadbalancerCapacity > 0 || sp.OriginalCapacity == 0 {
+		if err := o.update(progress, func() { sp.OriginalCapacity = server.LoadbalancerCapacity }); err != nil {
+			return err
+		}
+	}
+
+	if err := o.setDrain(ctx, sp.ServerID, true); err != nil {
+		return err
+	}
+	return o.setCapacity(ctx, sp.ServerID, 0)
+}
+
+func (o *Orchestrator) waitEvacuated(ctx context.Context, _ *Progress, sp *ServerProgress) error {
+	ctx, cancel := context.WithTimeout(ctx, o.evacuationTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		server, err := o.getServer(ctx, sp.ServerID)
+		if err != nil {
+			return err
+		}
+
+		// Draining is not repeated on resume, make sure it was not undone
+		if !server.Drain || server.LoadbalancerCapacity != 0 {
+			return fmt.Errorf("lbmaintenance: server %s is no longer drained", sp.ServerID)
+		}
+		done, err := o.evacuated(ctx, server)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrEvacuationTimeout
+			}
+			return ctx.Err()
+		case <-o.aborting():
+			return ErrAborted
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *Orchestrator) enterMaintenance(ctx context.Context, _ *Progress, sp *ServerProgress) error {
+	return o.setMaintenance(ctx, sp.ServerID, true)
+}
+
+func (o *Orchestrator) runHook(ctx context.Context, _ *Progress, sp *ServerProgress) error {
+	if o.hook == nil {
+		return nil
+	}
+
+	server, err := o.getServer(ctx, sp.ServerID)
+	if err != nil {
+		return err
+	}
+	return o.hook(ctx, server)
+}
+
+func (o *Orchestrator) restore(ctx context.Context, _ *Progress, sp *ServerProgress) error {
+	if err := o.setMaintenance(ctx, sp.ServerID, false); err != nil {
+		return err
+	}
+	if err := o.setCapacity(ctx, sp.ServerID, sp.OriginalCapacity); err != nil {
+		return err
+	}
+	return o.setDrain(ctx, sp.ServerID, false)
+}
+
+func payload[T any](response client.Response[T]) (T, error) {
+	if response.HasError() {
+		var zero T
+		return zero, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+func errorOf[T any](response client.Response[T]) error {
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
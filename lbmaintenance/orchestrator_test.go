@@ -0,0 +1,323 @@
+package lbmaintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeRegion keeps servers in memory and logs the calls made on them.
+// fail makes the next call of an action on a server fail.
+type fakeRegion struct {
+	mu      sync.Mutex
+	servers map[ids.ServerID]*models.Server
+	calls   []string
+	fail    map[string]error
+}
+
+func newFakeRegion(capacities map[ids.ServerID]int) *fakeRegion {
+	r := &fakeRegion{servers: map[ids.ServerID]*models.Server{}, fail: map[string]error{}}
+	for id, capacity := range capacities {
+		r.servers[id] = &models.Server{ID: string(id), LoadbalancerCapacity: capacity}
+	}
+	return r
+}
+
+func (r *fakeRegion) call(action string, serverID ids.ServerID, change func(*models.Server)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := action + " " + string(serverID)
+	if err, ok := r.fail[key]; ok {
+		delete(r.fail, key)
+		return err
+	}
+	server, ok := r.servers[serverID]
+	if !ok {
+		return fmt.Errorf("server %s not found", serverID)
+	}
+	r.calls = append(r.calls, key)
+	change(server)
+	return nil
+}
+
+// callsOf returns the actions run on a server, in order
+func (r *fakeRegion) callsOf(serverID ids.ServerID) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var actions []string
+	for _, call := range r.calls {
+		if action, id, _ := strings.Cut(call, " "); id == string(serverID) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+func (r *fakeRegion) orchestrator(store Store, opts ...Option) *Orchestrator {
+	evacuated := func(ctx context.Context, server models.Server) (bool, error) { return true, nil }
+	o := New(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "par", evacuated,
+		append([]Option{WithStore(store), WithPollInterval(time.Millisecond)}, opts...)...)
+	o.listServers = func(ctx context.Context) ([]models.Server, error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var servers []models.Server
+		for _, server := range r.servers {
+			servers = append(servers, *server)
+		}
+		return servers, nil
+	}
+	o.getServer = func(ctx context.Context, serverID ids.ServerID) (server models.Server, err error) {
+		err = r.call("get", serverID, func(s *models.Server) { server = *s })
+		return server, err
+	}
+	o.setDrain = func(ctx context.Context, serverID ids.ServerID, drain bool) error {
+		return r.call(fmt.Sprintf("drain=%v", drain), serverID, func(s *models.Server) { s.Drain = drain })
+	}
+	o.setCapacity = func(ctx context.Context, serverID ids.ServerID, capacity int) error {
+		return r.call(fmt.Sprintf("capacity=%d", capacity), serverID, func(s *models.Server) { s.LoadbalancerCapacity = capacity })
+	}
+	o.setMaintenance = func(ctx context.Context, serverID ids.ServerID, maintenance bool) error {
+		return r.call(fmt.Sprintf("maintenance=%v", maintenance), serverID, func(s *models.Server) { s.Maintenance = maintenance })
+	}
+	return o
+}
+
+func TestRun(t *testing.T) {
+	region := newFakeRegion(map[ids.ServerID]int{"srv-1": 12})
+	var hooked []string
+	o := region.orchestrator(&memoryStore{}, WithHook(func(ctx context.Context, server models.Server) error {
+		if !server.Maintenance || server.LoadbalancerCapacity != 0 {
+			t.Errorf("hook ran on %+v, want a drained server in maintenance", server)
+		}
+		hooked = append(hooked, server.ID)
+		return nil
+	}))
+
+	progress, err := o.Run(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	want := []string{"get", "drain=true", "capacity=0", "get", "maintenance=true", "get", "maintenance=false", "capacity=12", "drain=false"}
+	if got := region.callsOf("srv-1"); !slices.Equal(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+	if !progress.Done("srv-1") || len(hooked) != 1 {
+		t.Errorf("progress = %+v, hooked %q", progress.Servers["srv-1"], hooked)
+	}
+}
+
+func TestRunAgain(t *testing.T) {
+	region := newFakeRegion(map[ids.ServerID]int{"srv-1": 4})
+	o := region.orchestrator(NewFileStore(filepath.Join(t.TempDir(), "progress.json")))
+
+	for range 2 {
+		if progress, err := o.Run(context.Background(), nil); err != nil || !progress.Done("srv-1") {
+			t.Fatalf("Run = %v, %+v", err, progress)
+		}
+	}
+	if got := region.callsOf("srv-1"); len(got) != 16 {
+		t.Errorf("calls = %q, want the maintenance run twice", got)
+	}
+}
+
+func TestRunRequiresEvacuationCheck(t *testing.T) {
+	o := New(nil, noop.NewTracerProvider().Tracer("test"), "orga_1", "par", nil)
+	if _, err := o.Run(context.Background(), []ids.ServerID{"srv-1"}); !errors.Is(err, ErrNoEvacuationCheck) {
+		t.Errorf("Run = %v, want ErrNoEvacuationCheck", err)
+	}
+}
+
+func TestRunWaitsForEvacuation(t *testing.T) {
+	region := newFakeRegion(map[ids.ServerID]int{"srv-1": 4})
+	o := region.orchestrator(&memoryStore{})
+	checks := 0
+	o.evacuated = func(ctx context.Context, server models.Server) (bool, error) {
+		checks++
+		return checks == 3, nil
+	}
+
+	if _, err := o.Run(context.Background(), nil); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("evacuation checked %d times, want 3", checks)
+	}
+
+	region = newFakeRegion(map[ids.ServerID]int{"srv-1": 4})
+	o = region.orchestrator(&memoryStore{}, WithEvacuationTimeout(10*time.Millisecond))
+	o.evacuated = func(ctx context.Context, server models.Server) (bool, error) { return false, nil }
+	progress, err := o.Run(context.Background(), nil)
+	if !errors.Is(err, ErrEvacuationTimeout) || progress.Servers["srv-1"].Phase != PhaseDrained {
+		t.Errorf("Run = %v with %+v, want ErrEvacuationTimeout with the server drained", err, progress.Servers["srv-1"])
+	}
+}
+
+func TestResume(t *testing.T) {
+	remaining := map[Phase][]string{
+		PhasePending:     {"get", "drain=true", "capacity=0", "get", "maintenance=true", "get", "maintenance=false", "capacity=8", "drain=false"},
+		PhaseDrained:     {"get", "maintenance=true", "get", "maintenance=false", "capacity=8", "drain=false"},
+		PhaseEvacuated:   {"maintenance=true", "get", "maintenance=false", "capacity=8", "drain=false"},
+		PhaseMaintenance: {"get", "maintenance=false", "capacity=8", "drain=false"},
+		PhaseHookDone:    {"maintenance=false", "capacity=8", "drain=false"},
+		PhaseRestored:    nil,
+	}
+	hook := WithHook(func(ctx context.Context, server models.Server) error { return nil })
+
+	for phase, want := range remaining {
+		t.Run(phase.String(), func(t *testing.T) {
+			region := newFakeRegion(map[ids.ServerID]int{"srv-1": 8})
+			failure := "interrupted"
+			switch phase {
+			case PhasePending:
+			case PhaseRestored:
+				failure = ""
+			default:
+				// The server was drained by the interrupted run
+				*region.servers["srv-1"] = models.Server{ID: "srv-1", Drain: true}
+			}
+			store := NewFileStore(filepath.Join(t.TempDir(), "progress.json"))
+			recorded := &Progress{TenantID: "orga_1", RegionID: "par", Servers: map[ids.ServerID]*ServerProgress{
+				"srv-1": {ServerID: "srv-1", Phase: phase, OriginalCapacity: 8, Error: failure},
+			}}
+			if err := store.Save(recorded); err != nil {
+				t.Fatal(err)
+			}
+
+			progress, err := region.orchestrator(store, hook).Run(context.Background(), nil)
+			if err != nil {
+				t.Fatalf("Run failed: %v", err)
+			}
+			if got := region.callsOf("srv-1"); !slices.Equal(got, want) {
+				t.Errorf("calls = %q, want %q", got, want)
+			}
+			if sp := progress.Servers["srv-1"]; sp.Phase != PhaseRestored || sp.Error != "" {
+				t.Errorf("progress = %+v, want restored", sp)
+			}
+			if server := region.servers["srv-1"]; server.Drain || server.Maintenance || server.LoadbalancerCapacity != 8 {
+				t.Errorf("server = %+v, want back in service with capacity 8", server)
+			}
+		})
+	}
+}
+
+func TestResumeAfterFailure(t *testing.T) {
+	region := newFakeRegion(map[ids.ServerID]int{"srv-1": 8})
+	store := NewFileStore(filepath.Join(t.TempDir(), "progress.json"))
+	region.fail["maintenance=true srv-1"] = errors.New("unavailable")
+
+	progress, err := region.orchestrator(store).Run(context.Background(), nil)
+	if err == nil {
+		t.Fatal("Run succeeded despite the failed maintenance call")
+	}
+	if sp := progress.Servers["srv-1"]; sp.Phase != PhaseEvacuated || sp.Error != "unavailable" || sp.OriginalCapacity != 8 {
+		t.Fatalf("progress = %+v, want evacuated with the error recorded", sp)
+	}
+
+	progress, err = region.orchestrator(store).Run(context.Background(), nil)
+	if err != nil || !progress.Done("srv-1") {
+		t.Fatalf("resumed Run = %v, %+v", err, progress.Servers["srv-1"])
+	}
+	if server := region.servers["srv-1"]; server.LoadbalancerCapacity != 8 {
+		t.Errorf("capacity = %d, want the original 8", server.LoadbalancerCapacity)
+	}
+}
+
+func TestAbort(t *testing.T) {
+	region := newFakeRegion(map[ids.ServerID]int{"srv-1": 2, "srv-2": 2, "srv-3": 2})
+	store := NewFileStore(filepath.Join(t.TempDir(), "progress.json"))
+	var o *Orchestrator
+	abort := true
+	o = region.orchestrator(store, WithHook(func(ctx context.Context, server models.Server) error {
+		if abort {
+			o.Abort()
+		}
+		return nil
+	}))
+
+	serverIDs := []ids.ServerID{"srv-1", "srv-2", "srv-3"}
+	progress, err := o.Run(context.Background(), serverIDs)
+	if !errors.Is(err, ErrAborted) {
+		t.Fatalf("Run = %v, want ErrAborted", err)
+	}
+	// The hook of the first server completed, its restoration was not started
+	if sp := progress.Servers["srv-1"]; sp.Phase != PhaseHookDone {
+		t.Errorf("srv-1 = %+v, want hook done", sp)
+	}
+	for _, id := range serverIDs[1:] {
+		if sp := progress.Servers[id]; sp.Phase != PhasePending || len(region.callsOf(id)) != 0 {
+			t.Errorf("%s = %+v with calls %q, want untouched", id, sp, region.callsOf(id))
+		}
+	}
+
+	// the same Orchestrator resumes the aborted run
+	abort = false
+	progress, err = o.Run(context.Background(), serverIDs)
+	if err != nil {
+		t.Fatalf("resumed Run failed: %v", err)
+	}
+	for _, id := range serverIDs {
+		if !progress.Done(id) || region.servers[id].Maintenance {
+			t.Errorf("%s = %+v, want restored", id, progress.Servers[id])
+		}
+	}
+}
+
+func TestConcurrentBatches(t *testing.T) {
+	capacities := map[ids.ServerID]int{}
+	var serverIDs []ids.ServerID
+	for i := range 7 {
+		id := ids.ServerID(fmt.Sprintf("srv-%d", i))
+		capacities[id] = i + 1
+		serverIDs = append(serverIDs, id)
+	}
+	region := newFakeRegion(capacities)
+	store := NewFileStore(filepath.Join(t.TempDir(), "progress.json"))
+
+	var mu sync.Mutex
+	inMaintenance, most := 0, 0
+	hook := func(ctx context.Context, server models.Server) error {
+		mu.Lock()
+		inMaintenance++
+		most = max(most, inMaintenance)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inMaintenance--
+		mu.Unlock()
+		return nil
+	}
+
+	progress, err := region.orchestrator(store, WithBatchSize(3), WithConcurrency(3), WithHook(hook)).Run(context.Background(), serverIDs)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if most > 3 {
+		t.Errorf("%d servers in maintenance at once, want at most the batch size", most)
+	}
+
+	if _, err := store.Load(); !errors.Is(err, ErrNoProgress) {
+		t.Errorf("Load = %v, want the progress of the completed run cleared", err)
+	}
+	for _, id := range serverIDs {
+		if !progress.Done(id) {
+			t.Errorf("%s not restored: %+v", id, progress.Servers[id])
+		}
+		if server := region.servers[id]; server.LoadbalancerCapacity != capacities[id] {
+			t.Errorf("%s capacity = %d, want %d", id, server.LoadbalancerCapacity, capacities[id])
+		}
+	}
+}
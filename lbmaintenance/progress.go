@@ -0,0 +1,167 @@
+package lbmaintenance
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+)
+
+// Phase is the last maintenance step completed for a server
+type Phase string
+
+const (
+	PhasePending     Phase = "pending"
+	PhaseDrained     Phase = "drained"
+	PhaseEvacuated   Phase = "evacuated"
+	PhaseMaintenance Phase = "maintenance"
+	PhaseHookDone    Phase = "hook_done"
+	PhaseRestored    Phase = "restored"
+)
+
+// String returns the underlying string value
+func (p Phase) String() string {
+	return string(p)
+}
+
+// ServerProgress records where a single server is in the maintenance cycle
+type ServerProgress struct {
+	ServerID         ids.ServerID `json:"serverId"`
+	Phase            Phase        `json:"phase"`
+	OriginalCapacity int          `json:"originalCapacity"`
+	Error            string       `json:"error,omitempty"`
+	UpdatedAt        time.Time    `json:"updatedAt"`
+}
+
+// Progress is the persisted state of a maintenance run
+type Progress struct {
+	TenantID  ids.TenantID                     `json:"tenantId"`
+	RegionID  ids.RegionID                     `json:"regionId"`
+	StartedAt time.Time                        `json:"startedAt"`
+	Servers   map[ids.ServerID]*ServerProgress `json:"servers"`
+}
+
+// Done reports whether the server has been brought back into service
+func (p *Progress) Done(serverID ids.ServerID) bool {
+	sp, ok := p.Servers[serverID]
+	return ok && sp.Phase == PhaseRestored
+}
+
+// Store persists maintenance progress so an interrupted run can resume.
+// The progress is cleared once a run completes.
+type Store interface {
+	Load() (*Progress, error)
+	Save(*Progress) error
+	Clear() error
+}
+
+// ErrNoProgress is returned by Store.Load when no previous run was recorded
+var ErrNoProgress = errors.New("lbmaintenance: no recorded progress")
+
+// FileStore is a Store backed by a JSON file
+type FileStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileStore creates a Store that writes progress to path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{Path: path}
+}
+
+// Load reads the progress file, returning ErrNoProgress if it does not exist
+func (s *FileStore) Load() (*Progress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoProgress
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &Progress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, err
+	}
+	if progress.Servers == nil {
+		progress.Servers = map[ids.ServerID]*ServerProgress{}
+	}
+	return progress, nil
+}
+
+// Save atomically replaces the progress file
+func (s *FileStore) Save(progress *Progress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// Clear removes the progress file
+func (s *FileStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// memoryStore keeps progress in memory when no Store is configured
+type memoryStore struct {
+	mu       sync.Mutex
+	progress *Progress
+}
+
+func (s *memoryStore) Load() (*Progress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.progress == nil {
+		return nil, ErrNoProgress
+	}
+	return s.progress, nil
+}
+
+func (s *memoryStore) Save(progress *Progress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress = progress
+	return nil
+}
+
+func (s *memoryStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress = nil
+	return nil
+}
@@ -0,0 +1,48 @@
+package lbmaintenance
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+)
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "progress.json"))
+
+	if _, err := store.Load(); !errors.Is(err, ErrNoProgress) {
+		t.Fatalf("Load on missing file = %v, want ErrNoProgress", err)
+	}
+
+	progress := &Progress{
+		TenantID:  "orga_123",
+		RegionID:  "par",
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+		Servers: map[ids.ServerID]*ServerProgress{
+			"srv-1": {ServerID: "srv-1", Phase: PhaseRestored, OriginalCapacity: 12},
+			"srv-2": {ServerID: "srv-2", Phase: PhaseMaintenance, OriginalCapacity: 8, Error: "hook failed"},
+		},
+	}
+	if err := store.Save(progress); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !loaded.Done("srv-1") {
+		t.Errorf("srv-1 should be done")
+	}
+	if loaded.Done("srv-2") {
+		t.Errorf("srv-2 should not be done")
+	}
+	if got := loaded.Servers["srv-2"]; got.Phase != PhaseMaintenance || got.OriginalCapacity != 8 || got.Error != "hook failed" {
+		t.Errorf("srv-2 = %+v, want phase maintenance, capacity 8 and recorded error", got)
+	}
+	if !loaded.StartedAt.Equal(progress.StartedAt) {
+		t.Errorf("StartedAt = %v, want %v", loaded.StartedAt, progress.StartedAt)
+	}
+}
@@ -0,0 +1,462 @@
+// Package logdrain configures log drains across applications and resources.
+//
+// Recipients are built with validated builders such as Datadog or SyslogTCP.
+// A Manager ensures a drain exists without creating duplicates, replicates
+// the drains of one application to others, and fetches and runs the test
+// command of a drain.
+//
+// A Monitor polls drains, tracks the growth of their backlog and flags those
+// that fall behind, are stuck or fail. It alerts through a callback and can
+// reset cursors or restart drains according to a Policy.
+package logdrain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	log "go.clever-cloud.dev/sdk/services/log"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrInvalidTarget is returned for targets with both or none of an
+// application and a resource
+var ErrInvalidTarget = errors.New("logdrain: target must be either an application or a resource")
+
+// Target is an application or another resource, such as an add-on, whose
+// logs are drained. Applications use the application endpoints, other
+// resources the resource ones: exactly one of ApplicationID and ResourceID
+// is set.
+type Target struct {
+	OwnerID       ids.OwnerID
+	ApplicationID ids.ApplicationID
+	ResourceID    ids.ResourceID
+}
+
+// ApplicationTarget returns the target of an application, checking both
+// identifiers
+func ApplicationTarget(ownerID, applicationID string) (Target, error) {
+	owner, err := ids.Parse[ids.OwnerID](ownerID)
+	if err != nil {
+		return Target{}, err
+	}
+	application, err := ids.Parse[ids.ApplicationID](applicationID)
+	if err != nil {
+		return Target{}, err
+	}
+	return Target{OwnerID: owner, ApplicationID: application}, nil
+}
+
+// ResourceTarget returns the target of an add-on or another resource that is
+// not an application, checking both identifiers
+func ResourceTarget(ownerID, resourceID string) (Target, error) {
+	owner, err := ids.Parse[ids.OwnerID](ownerID)
+	if err != nil {
+		return Target{}, err
+	}
+	resource, err := ids.Parse[ids.ResourceID](resourceID)
+	if err != nil {
+		return Target{}, err
+	}
+	return Target{OwnerID: owner, ResourceID: resource}, nil
+}
+
+// Validate checks the identifiers of the target
+func (t Target) Validate() error {
+	if err := t.OwnerID.Validate(); err != nil {
+		return err
+	}
+	switch {
+	case t.ApplicationID != "" && t.ResourceID != "":
+		return ErrInvalidTarget
+	case t.ApplicationID != "":
+		return t.ApplicationID.Validate()
+	case t.ResourceID != "":
+		return t.ResourceID.Validate()
+	}
+	return ErrInvalidTarget
+}
+
+func (t Target) application() bool {
+	return t.ApplicationID != ""
+}
+
+// id returns the application or the resource identifier
+func (t Target) id() string {
+	if t.application() {
+		return string(t.ApplicationID)
+	}
+	return string(t.ResourceID)
+}
+
+func (t Target) String() string {
+	return string(t.OwnerID) + "/" + t.id()
+}
+
+// Runner runs a test command and returns its combined output
+type Runner func(ctx context.Context, command string) ([]byte, error)
+
+// ShellRunner runs test commands with sh, killing them after timeout
+func ShellRunner(timeout time.Duration) Runner {
+	return func(ctx context.Context, command string) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return exec.CommandContext(ctx, "sh", "-c", command).CombinedOutput()
+	}
+}
+
+// Manager manages the drains of an owner's applications and resources
+type Manager struct {
+	client *client.Client
+	tracer trace.Tracer
+
+	secrets     Secrets
+	runner      Runner
+	concurrency int
+}
+
+// Option defines configuration options for the Manager
+type Option func(*Manager)
+
+// WithSecrets sets how Replicate gets the secrets the API masks in recipients.
+// Without it, drains with a password, API key or token are not replicated.
+func WithSecrets(secrets Secrets) Option {
+	return func(m *Manager) {
+		m.secrets = secrets
+	}
+}
+
+// WithRunner sets how Test runs test commands. Without it, Test only
+// fetches them.
+func WithRunner(runner Runner) Option {
+	return func(m *Manager) {
+		m.runner = runner
+	}
+}
+
+// WithConcurrency sets how many destinations Replicate configures at once
+func WithConcurrency(n int) Option {
+	return func(m *Manager) {
+		m.concurrency = n
+	}
+}
+
+// New creates a Manager
+func New(c *client.Client, tracer trace.Tracer, opts ...Option) *Manager {
+	m := &Manager{
+		client:      c,
+		tracer:      tracer,
+		concurrency: 4,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.concurrency = max(m.concurrency, 1)
+	return m
+}
+
+// List returns the drains of a target, filtered by the options
+func (m *Manager) List(ctx context.Context, target Target, opts ...log.Option) ([]models.Drain, error) {
+	if err := target.Validate(); err != nil {
+		return nil, err
+	}
+	var response client.Response[[]models.Drain]
+	if target.application() {
+		response = log.Listdrains(ctx, m.client, m.tracer, target.OwnerID, target.ApplicationID, opts...)
+	} else {
+		response = log.Listdrainsbyresource(ctx, m.client, m.tracer, target.OwnerID, target.ResourceID, opts...)
+	}
+	if response.HasError() {
+		return nil, response.Error()
+	}
+	if response.Payload() == nil {
+		return nil, nil
+	}
+	return *response.Payload(), nil
+}
+
+func (m *Manager) create(ctx context.Context, target Target, drain *models.WannabeDrain) (models.Drain, error) {
+	if err := target.Validate(); err != nil {
+		return models.Drain{}, err
+	}
+	var response client.Response[models.Drain]
+	if target.application() {
+		response = log.Createdrain(ctx, m.client, m.tracer, target.OwnerID, target.ApplicationID, drain)
+	} else {
+		response = log.Createdrainbyresource(ctx, m.client, m.tracer, target.OwnerID, target.ResourceID, drain)
+	}
+	if response.HasError() {
+		return models.Drain{}, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+// Get returns a drain
+func (m *Manager) Get(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+	return m.drainAction(ctx, target, drainID, log.Getdrain, log.Getdrainbyresource)
+}
+
+// Enable enables a drain
+func (m *Manager) Enable(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+	return m.drainAction(ctx, target, drainID, log.Enabledrain, log.Enabledrainbyresource)
+}
+
+// Disable disables a drain
+func (m *Manager) Disable(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+	return m.drainAction(ctx, target, drainID, log.Disabledrain, log.Disabledrainbyresource)
+}
+
+// ResetCursor moves the cursor of a drain to the latest logs, dropping its backlog
+func (m *Manager) ResetCursor(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+	return m.drainAction(ctx, target, drainID, log.Resetdraincursor, log.Resetdraincursorbyresource)
+}
+
+type (
+	applicationAction func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, applicationId ids.ApplicationID, drainId ids.DrainID) client.Response[models.Drain]
+	resourceAction    func(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID, drainId ids.DrainID) client.Response[models.Drain]
+)
+
+// drainAction calls the application or the resource variant of an operation on a drain
+func (m *Manager) drainAction(ctx context.Context, target Target, drainID ids.DrainID, onApplication applicationAction, onResource resourceAction) (models.Drain, error) {
+	if err := target.Validate(); err != nil {
+		return models.Drain{}, err
+	}
+	var response client.Response[models.Drain]
+	if target.application() {
+		response = onApplication(ctx, m.client, m.tracer, target.OwnerID, target.ApplicationID, drainID)
+	} else {
+		response = onResource(ctx, m.client, m.tracer, target.OwnerID, target.ResourceID, drainID)
+	}
+	if response.HasError() {
+		return models.Drain{}, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+// Ensure returns the drain of a target with the kind and recipient, creating
+// it when there is none. Recipients match on their type and settings, as the
+// API masks their secrets. A matching drain that is disabled is enabled.
+// The boolean reports whether the drain was created.
+func (m *Manager) Ensure(ctx context.Context, target Target, kind models.DrainKind, recipient Recipient) (models.Drain, bool, error) {
+	built, err := recipient.Build()
+	if err != nil {
+		return models.Drain{}, false, err
+	}
+	return m.ensure(ctx, target, kind, built)
+}
+
+func (m *Manager) ensure(ctx context.Context, target Target, kind models.DrainKind, recipient models.DrainRecipient1) (models.Drain, bool, error) {
+	ctx, span := m.tracer.Start(ctx, "logdrain.Ensure", trace.WithAttributes(
+		attribute.String("ownerId", string(target.OwnerID)),
+		attribute.String("resourceId", target.id()),
+		attribute.String("kind", string(kind)),
+		attribute.String("recipient", recipient.Type()),
+	))
+	defer span.End()
+
+	drain, created, err := m.ensureDrain(ctx, target, kind, recipient)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return drain, created, err
+}
+
+func (m *Manager) ensureDrain(ctx context.Context, target Target, kind models.DrainKind, recipient models.DrainRecipient1) (models.Drain, bool, error) {
+	key, err := fingerprint(recipient)
+	if err != nil {
+		return models.Drain{}, false, err
+	}
+	drains, err := m.List(ctx, target)
+	if err != nil {
+		return models.Drain{}, false, err
+	}
+
+	existing, ok := find(drains, kind, key)
+	if !ok {
+		drain, err := m.create(ctx, target, &models.WannabeDrain{Kind: kind, Recipient: recipient})
+		return drain, err == nil, err
+	}
+	switch existing.Status.Status {
+	case models.DrainStatusTypeDISABLED, models.DrainStatusTypeDISABLING:
+		existing, err = m.Enable(ctx, target, ids.DrainID(existing.ID))
+	}
+	return existing, false, err
+}
+
+// find returns the drain with a kind and a recipient fingerprint, ignoring deleted drains
+func find(drains []models.Drain, kind models.DrainKind, key string) (models.Drain, bool) {
+	for _, drain := range drains {
+		if drain.Kind != kind || drain.Status.Status == models.DrainStatusTypeDELETED {
+			continue
+		}
+		if k, err := fingerprint(drain.Recipient); err == nil && k == key {
+			return drain, true
+		}
+	}
+	return models.Drain{}, false
+}
+
+// Replication is the outcome of copying a drain to a destination
+type Replication struct {
+	SourceDrainID string
+	Destination   Target
+	Drain         models.Drain
+	Created       bool
+	Err           error
+}
+
+// Replicate ensures every drain of source also exists on each destination,
+// with the same kind and recipient. Deleted drains are not copied. Failures
+// are reported in the replications and joined in the error.
+func (m *Manager) Replicate(ctx context.Context, source Target, destinations []Target) ([]Replication, error) {
+	ctx, span := m.tracer.Start(ctx, "logdrain.Replicate", trace.WithAttributes(
+		attribute.String("ownerId", string(source.OwnerID)),
+		attribute.String("resourceId", source.id()),
+		attribute.Int("destinations", len(destinations)),
+	))
+	defer span.End()
+
+	drains, err := m.List(ctx, source)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	type copied struct {
+		drain     models.Drain
+		recipient models.DrainRecipient1
+		err       error
+	}
+	var sources []copied
+	for _, drain := range drains {
+		if drain.Status.Status == models.DrainStatusTypeDELETED {
+			continue
+		}
+		recipient, err := copyRecipient(drain, m.secrets)
+		sources = append(sources, copied{drain: drain, recipient: recipient, err: err})
+	}
+
+	replications := make([]Replication, len(destinations)*len(sources))
+	var (
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, m.concurrency)
+	)
+	for d, destination := range destinations {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			for s, source := range sources {
+				r := Replication{SourceDrainID: source.drain.ID, Destination: destination, Err: source.err}
+				if r.Err == nil {
+					r.Drain, r.Created, r.Err = m.ensure(ctx, destination, source.drain.Kind, source.recipient)
+				}
+				replications[d*len(sources)+s] = r
+			}
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range replications {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("logdrain: drain %s to %s: %w", r.SourceDrainID, r.Destination, r.Err))
+		}
+	}
+	err = errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return replications, err
+}
+
+// TestResult is the outcome of the test command of a drain
+type TestResult struct {
+	DrainID string
+	Command string
+	// Ran reports whether the command was run, which needs a Runner
+	Ran    bool
+	Output string
+	Err    error
+}
+
+// OK reports whether the command ran successfully
+func (r TestResult) OK() bool {
+	return r.Ran && r.Err == nil
+}
+
+// Test fetches the command sending a test message through a drain and runs
+// it with the Runner of the Manager, if any. The error is about fetching the
+// command; the outcome of running it is in the result.
+func (m *Manager) Test(ctx context.Context, target Target, drainID ids.DrainID) (TestResult, error) {
+	ctx, span := m.tracer.Start(ctx, "logdrain.Test", trace.WithAttributes(
+		attribute.String("ownerId", string(target.OwnerID)),
+		attribute.String("resourceId", target.id()),
+		attribute.String("drainId", string(drainID)),
+	))
+	defer span.End()
+
+	result := TestResult{DrainID: string(drainID)}
+	command, err := m.testCommand(ctx, target, drainID)
+	if err != nil {
+		span.RecordError(err)
+		return result, err
+	}
+	result.Command = command
+	if m.runner == nil {
+		return result, nil
+	}
+
+	output, err := m.runner(ctx, command)
+	result.Ran, result.Output, result.Err = true, string(output), err
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, nil
+}
+
+// testCommand gets the test command of a drain
+func (m *Manager) testCommand(ctx context.Context, target Target, drainID ids.DrainID) (string, error) {
+	if err := target.Validate(); err != nil {
+		return "", err
+	}
+	var response client.Response[json.RawMessage]
+	if target.application() {
+		response = log.Getdraintestcommand(ctx, m.client, m.tracer, target.OwnerID, target.ApplicationID, drainID)
+	} else {
+		response = log.Getdraintestcommandbyresource(ctx, m.client, m.tracer, target.OwnerID, target.ResourceID, drainID)
+	}
+	if response.HasError() {
+		return "", response.Error()
+	}
+	if response.Payload() == nil {
+		return "", nil
+	}
+	return decodeCommand(*response.Payload())
+}
+
+// decodeCommand reads a test command sent as a JSON string or as an object
+// with a command field
+func decodeCommand(raw json.RawMessage) (string, error) {
+	var command string
+	if err := json.Unmarshal(raw, &command); err == nil {
+		return command, nil
+	}
+	var object struct {
+		Command string `json:"command"`
+	}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return "", fmt.Errorf("logdrain: unexpected test command %s: %w", raw, err)
+	}
+	return object.Command, nil
+}
@@ -0,0 +1,608 @@
+package logdrain
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	log "go.clever-cloud.dev/sdk/services/log"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Problem is what is wrong with a drain. Problems are ordered from the
+// least to the most severe.
+type Problem int
+
+const (
+	// Healthy drains deliver their logs
+	Healthy Problem = iota
+	// BacklogGrowing drains receive logs faster than they deliver them
+	BacklogGrowing
+	// Stuck drains do not deliver their backlog, or stay in a transitional status
+	Stuck
+	// Failing drains retry deliveries the recipient rejects
+	Failing
+	// Disabled drains were disabled by a restart of the monitor and not
+	// enabled again: they deliver nothing until they are
+	Disabled
+)
+
+func (p Problem) String() string {
+	switch p {
+	case Healthy:
+		return "healthy"
+	case BacklogGrowing:
+		return "backlog growing"
+	case Stuck:
+		return "stuck"
+	case Failing:
+		return "failing"
+	case Disabled:
+		return "disabled"
+	default:
+		return "unknown"
+	}
+}
+
+// Action is a remediation of a drain problem
+type Action int
+
+const (
+	// NoAction leaves the drain as it is
+	NoAction Action = iota
+	// ResetCursor moves the drain to the latest logs, dropping its backlog
+	ResetCursor
+	// Restart disables the drain, waits for it to be disabled and enables it
+	Restart
+)
+
+func (a Action) String() string {
+	switch a {
+	case ResetCursor:
+		return "reset cursor"
+	case Restart:
+		return "restart"
+	default:
+		return "none"
+	}
+}
+
+// Policy tells the Monitor how to remediate problems. The zero Policy only
+// alerts, except for drains a restart left disabled: those are always
+// restarted again.
+type Policy struct {
+	Actions map[Problem]Action
+	// Cooldown is the least time between two remediations of a drain. When
+	// set, problems that last are remediated again after it.
+	Cooldown time.Duration
+}
+
+// BacklogSample is the backlog of a drain at a poll
+type BacklogSample struct {
+	At      time.Time
+	Backlog int
+	RateOut float64
+}
+
+// Health is the state of a drain at the latest poll
+type Health struct {
+	Target  Target
+	Drain   models.Drain
+	Problem Problem
+	Reason  string
+	// Growth is the backlog growth over the history window, in messages per second
+	Growth float64
+	// History spans the longer of the backlog window and the stuck delay
+	History []BacklogSample
+}
+
+// Key identifies the drain of a health
+func (h Health) Key() string {
+	return h.Target.String() + "/" + h.Drain.ID
+}
+
+// Alert is raised when the problem of a drain changes, including when it
+// is resolved, and when a drain is remediated
+type Alert struct {
+	Health   Health
+	Previous Problem
+	// Action is the remediation applied, if any
+	Action Action
+	// ActionErr is the error of the remediation
+	ActionErr error
+}
+
+// Resolved reports whether the drain became healthy
+func (a Alert) Resolved() bool {
+	return a.Health.Problem == Healthy
+}
+
+// AlertFunc is called for every alert raised by a poll
+type AlertFunc func(ctx context.Context, alert Alert)
+
+// Monitor watches the delivery of the drains of a set of targets
+type Monitor struct {
+	manager *Manager
+	targets []Target
+
+	interval    time.Duration
+	window      time.Duration
+	growth      float64
+	minBacklog  int
+	stuckAfter  time.Duration
+	policy      Policy
+	alert       AlertFunc
+	now         func() time.Time
+	settle      time.Duration
+	remediation func(ctx context.Context, target Target, drainID ids.DrainID, action Action) error
+
+	// API calls, replaced in tests
+	list    func(ctx context.Context, target Target, opts ...log.Option) ([]models.Drain, error)
+	get     func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error)
+	enable  func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error)
+	disable func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error)
+
+	mu         sync.Mutex
+	health     map[string]Health
+	remediated map[string]time.Time
+	// disabled holds the drains a restart disabled until they are enabled again
+	disabled map[string]restarted
+}
+
+// restarted is a drain disabled by a restart
+type restarted struct {
+	target  Target
+	drainID ids.DrainID
+}
+
+// MonitorOption defines configuration options for the Monitor
+type MonitorOption func(*Monitor)
+
+// WithPollInterval sets the delay between two polls of Run
+func WithPollInterval(d time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.interval = d
+	}
+}
+
+// WithBacklogWindow sets how long the backlog history of a drain is kept
+// to measure its growth
+func WithBacklogWindow(d time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.window = d
+	}
+}
+
+// WithBacklogGrowth flags drains whose backlog is at least minBacklog
+// messages and grows by more than perSecond messages per second
+func WithBacklogGrowth(perSecond float64, minBacklog int) MonitorOption {
+	return func(m *Monitor) {
+		m.growth = perSecond
+		m.minBacklog = minBacklog
+	}
+}
+
+// WithStuckAfter sets how long a drain may retry, stay in a transitional
+// status or not deliver its backlog before it is flagged. It is also how
+// long a restart waits for the drain to be disabled.
+func WithStuckAfter(d time.Duration) MonitorOption {
+	return func(m *Monitor) {
+		m.stuckAfter = d
+	}
+}
+
+// WithPolicy sets how problems are remediated
+func WithPolicy(policy Policy) MonitorOption {
+	return func(m *Monitor) {
+		m.policy = policy
+	}
+}
+
+// WithAlert sets the function called for alerts
+func WithAlert(f AlertFunc) MonitorOption {
+	return func(m *Monitor) {
+		m.alert = f
+	}
+}
+
+// NewMonitor creates a Monitor of the drains of targets
+func (m *Manager) NewMonitor(targets []Target, opts ...MonitorOption) *Monitor {
+	monitor := &Monitor{
+		manager:    m,
+		targets:    targets,
+		interval:   time.Minute,
+		window:     15 * time.Minute,
+		minBacklog: 1000,
+		stuckAfter: 10 * time.Minute,
+		now:        time.Now,
+		settle:     5 * time.Second,
+		health:     map[string]Health{},
+		remediated: map[string]time.Time{},
+		disabled:   map[string]restarted{},
+		list:       m.List,
+		get:        m.Get,
+		enable:     m.Enable,
+		disable:    m.Disable,
+	}
+	monitor.remediation = monitor.remediate
+	for _, opt := range opts {
+		opt(monitor)
+	}
+	return monitor
+}
+
+// Run polls the drains until the context is canceled.
+// Poll errors are recorded on the trace and do not stop the monitor.
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = m.Poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// monitoredStatuses are the drain statuses polled; disabled and deleted
+// drains are not expected to deliver, unless a restart disabled them
+var monitoredStatuses = []string{
+	string(models.DrainStatusTypeCREATED),
+	string(models.DrainStatusTypeENABLED),
+	string(models.DrainStatusTypeENABLING),
+	string(models.DrainStatusTypeDISABLING),
+}
+
+// Poll reads the drains of every target once, remediates their problems
+// according to the policy and raises alerts for those whose problem changed
+// or that were remediated. Drains a restart left disabled are read one by
+// one and reported as Disabled. Drains of targets that could not be read are
+// missing from the result and their errors are joined.
+func (m *Monitor) Poll(ctx context.Context) ([]Health, error) {
+	ctx, span := m.manager.tracer.Start(ctx, "logdrain.Poll", trace.WithAttributes(attribute.Int("targets", len(m.targets))))
+	defer span.End()
+
+	var (
+		healths []Health
+		polled  = map[string]bool{}
+		errs    []error
+	)
+	for _, target := range m.targets {
+		drains, err := m.list(ctx, target, log.WithStatus(monitoredStatuses))
+		if err != nil {
+			span.RecordError(err)
+			errs = append(errs, fmt.Errorf("logdrain: %s: %w", target, err))
+			continue
+		}
+		polled[target.String()] = true
+		listed := map[ids.DrainID]bool{}
+		for _, drain := range drains {
+			listed[ids.DrainID(drain.ID)] = true
+			m.enabled(target, drain)
+			healths = append(healths, m.observe(target, drain))
+		}
+
+		for _, r := range m.restarted(target) {
+			if listed[r.drainID] {
+				continue
+			}
+			health, ok, err := m.observeDisabled(ctx, r)
+			if err != nil {
+				span.RecordError(err)
+				errs = append(errs, fmt.Errorf("logdrain: %s: %w", target, err))
+			}
+			if ok {
+				healths = append(healths, health)
+			}
+		}
+	}
+	m.forget(healths, polled)
+
+	for _, health := range healths {
+		previous := m.swap(health)
+		changed := previous != health.Problem
+		alert := Alert{Health: health, Previous: previous}
+		alert.Action, alert.ActionErr = m.apply(ctx, health, changed)
+		if alert.ActionErr != nil {
+			span.RecordError(alert.ActionErr)
+		}
+		if (changed || alert.Action != NoAction) && m.alert != nil {
+			m.alert(ctx, alert)
+		}
+	}
+	return healths, errors.Join(errs...)
+}
+
+// Healths returns the latest health of every drain
+func (m *Monitor) Healths() []Health {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	healths := make([]Health, 0, len(m.health))
+	for _, health := range m.health {
+		healths = append(healths, health)
+	}
+	slices.SortFunc(healths, func(a, b Health) int { return cmp.Compare(a.Key(), b.Key()) })
+	return healths
+}
+
+// observe adds a backlog sample to the history of a drain and evaluates its health
+func (m *Monitor) observe(target Target, drain models.Drain) Health {
+	now := m.now()
+	sample := BacklogSample{At: now}
+	if drain.Backlog != nil {
+		sample.Backlog, sample.RateOut = drain.Backlog.MsgBacklog, drain.Backlog.MsgRateOut
+	}
+
+	health := Health{Target: target, Drain: drain}
+	m.mu.Lock()
+	if previous, ok := m.health[health.Key()]; ok {
+		health.History = since(previous.History, now, max(m.window, m.stuckAfter))
+	}
+	m.mu.Unlock()
+	health.History = append(health.History, sample)
+
+	health.Growth, _ = growth(since(health.History, now, m.window))
+	health.Problem, health.Reason = m.evaluate(drain, health.History, now)
+	if health.Problem == BacklogGrowing {
+		health.Reason = fmt.Sprintf("backlog of %d messages growing by %.1f/s", sample.Backlog, health.Growth)
+	}
+	return health
+}
+
+// evaluate returns the most severe problem of a drain
+func (m *Monitor) evaluate(drain models.Drain, history []BacklogSample, now time.Time) (Problem, string) {
+	execution := drain.Execution
+	if execution.Status == models.DrainExecutionStatusRETRYING {
+		reason := "retrying"
+		if execution.LastError != nil {
+			reason = *execution.LastError
+		}
+		if execution.Attempt != nil && execution.MaxAttempt != nil && *execution.Attempt >= *execution.MaxAttempt {
+			return Failing, fmt.Sprintf("%s (attempt %d of %d)", reason, *execution.Attempt, *execution.MaxAttempt)
+		}
+		if execution.RetryingSince != nil && now.Sub(*execution.RetryingSince) >= m.stuckAfter {
+			return Failing, fmt.Sprintf("%s (retrying since %s)", reason, execution.RetryingSince.Format(time.RFC3339))
+		}
+	}
+
+	switch drain.Status.Status {
+	case models.DrainStatusTypeENABLING, models.DrainStatusTypeDISABLING:
+		if now.Sub(drain.Status.Date) >= m.stuckAfter {
+			return Stuck, fmt.Sprintf("%s since %s", drain.Status.Status, drain.Status.Date.Format(time.RFC3339))
+		}
+	}
+
+	// A backlog not delivered at all during stuckAfter
+	if first := history[0]; now.Sub(first.At) >= m.stuckAfter {
+		stalled := true
+		for _, s := range history {
+			if now.Sub(s.At) <= m.stuckAfter && (s.Backlog == 0 || s.RateOut > 0) {
+				stalled = false
+				break
+			}
+		}
+		if stalled {
+			return Stuck, fmt.Sprintf("backlog of %d messages not delivered", history[len(history)-1].Backlog)
+		}
+	}
+
+	// Growth is only measured over at least half of the window
+	rate, elapsed := growth(since(history, now, m.window))
+	if elapsed > 0 && elapsed >= m.window/2 && history[len(history)-1].Backlog >= m.minBacklog && rate > m.growth {
+		return BacklogGrowing, ""
+	}
+	return Healthy, ""
+}
+
+// since returns the samples of a history taken at most d before now
+func since(history []BacklogSample, now time.Time, d time.Duration) []BacklogSample {
+	i := slices.IndexFunc(history, func(s BacklogSample) bool { return now.Sub(s.At) <= d })
+	if i < 0 {
+		return nil
+	}
+	return slices.Clone(history[i:])
+}
+
+// growth returns the backlog growth over a history, in messages per second,
+// and the time it spans
+func growth(history []BacklogSample) (float64, time.Duration) {
+	if len(history) < 2 {
+		return 0, 0
+	}
+	first, last := history[0], history[len(history)-1]
+	elapsed := last.At.Sub(first.At)
+	if elapsed <= 0 {
+		return 0, 0
+	}
+	return float64(last.Backlog-first.Backlog) / elapsed.Seconds(), elapsed
+}
+
+// swap records the health of a drain and returns its previous problem
+func (m *Monitor) swap(health Health) Problem {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.health[health.Key()].Problem
+	m.health[health.Key()] = health
+	return previous
+}
+
+// forget drops the drains of the polled targets that were not listed, such
+// as disabled or deleted ones, unless a restart disabled them
+func (m *Monitor) forget(healths []Health, polled map[string]bool) {
+	listed := map[string]bool{}
+	for _, health := range healths {
+		listed[health.Key()] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, health := range m.health {
+		if _, ok := m.disabled[key]; !ok && !listed[key] && polled[health.Target.String()] {
+			delete(m.health, key)
+			delete(m.remediated, key)
+		}
+	}
+}
+
+// apply remediates the problem of a drain according to the policy when it
+// appears, and again every cooldown while it lasts if the cooldown is set.
+// A drain is remediated at most once per cooldown. Drains a restart left
+// disabled are restarted again at every poll, or every cooldown when set.
+func (m *Monitor) apply(ctx context.Context, health Health, changed bool) (Action, error) {
+	action := m.policy.Actions[health.Problem]
+	if health.Problem == Disabled {
+		action = Restart
+	}
+	if action == NoAction {
+		return NoAction, nil
+	}
+
+	now := m.now()
+	m.mu.Lock()
+	last, ok := m.remediated[health.Key()]
+	cooled := !ok || now.Sub(last) >= m.policy.Cooldown
+	retried := changed || health.Problem == Disabled
+	if !cooled || (!retried && m.policy.Cooldown <= 0) {
+		m.mu.Unlock()
+		return NoAction, nil
+	}
+	m.remediated[health.Key()] = now
+	m.mu.Unlock()
+
+	return action, m.remediation(ctx, health.Target, ids.DrainID(health.Drain.ID), action)
+}
+
+func (m *Monitor) remediate(ctx context.Context, target Target, drainID ids.DrainID, action Action) error {
+	switch action {
+	case ResetCursor:
+		_, err := m.manager.ResetCursor(ctx, target, drainID)
+		return err
+	case Restart:
+		return m.restart(ctx, target, drainID)
+	default:
+		return nil
+	}
+}
+
+func drainKey(target Target, drainID ids.DrainID) string {
+	return Health{Target: target, Drain: models.Drain{ID: string(drainID)}}.Key()
+}
+
+// restart disables a drain, waits for it to be DISABLED and enables it. The
+// drain is tracked from the moment it is disabled until it is enabled again,
+// so that a restart failing halfway is reported and retried by later polls.
+func (m *Monitor) restart(ctx context.Context, target Target, drainID ids.DrainID) error {
+	drain, err := m.get(ctx, target, drainID)
+	if err != nil {
+		return err
+	}
+
+	key := drainKey(target, drainID)
+	if drain.Status.Status != models.DrainStatusTypeDISABLED {
+		m.mu.Lock()
+		m.disabled[key] = restarted{target: target, drainID: drainID}
+		m.mu.Unlock()
+
+		if _, err := m.disable(ctx, target, drainID); err != nil {
+			return err
+		}
+		if err := m.waitDisabled(ctx, target, drainID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := m.enable(ctx, target, drainID); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	delete(m.disabled, key)
+	m.mu.Unlock()
+	return nil
+}
+
+// waitDisabled polls a drain until it is DISABLED, for at most stuckAfter
+func (m *Monitor) waitDisabled(ctx context.Context, target Target, drainID ids.DrainID) error {
+	ctx, cancel := context.WithTimeout(ctx, m.stuckAfter)
+	defer cancel()
+
+	ticker := time.NewTicker(m.settle)
+	defer ticker.Stop()
+
+	for {
+		drain, err := m.get(ctx, target, drainID)
+		if err != nil {
+			return err
+		}
+		if drain.Status.Status == models.DrainStatusTypeDISABLED {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("logdrain: drain %s still %s after %s", drainID, drain.Status.Status, m.stuckAfter)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// restarted returns the drains of a target a restart left disabled
+func (m *Monitor) restarted(target Target) []restarted {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var drains []restarted
+	for _, r := range m.disabled {
+		if r.target == target {
+			drains = append(drains, r)
+		}
+	}
+	slices.SortFunc(drains, func(a, b restarted) int { return cmp.Compare(a.drainID, b.drainID) })
+	return drains
+}
+
+// enabled stops tracking a drain a restart disabled once it is enabled again
+// by other means
+func (m *Monitor) enabled(target Target, drain models.Drain) {
+	switch drain.Status.Status {
+	case models.DrainStatusTypeCREATED, models.DrainStatusTypeENABLED, models.DrainStatusTypeENABLING:
+		m.mu.Lock()
+		delete(m.disabled, drainKey(target, ids.DrainID(drain.ID)))
+		m.mu.Unlock()
+	}
+}
+
+// observeDisabled reads a drain a restart disabled and reports it as
+// Disabled. Deleted drains are no longer tracked.
+func (m *Monitor) observeDisabled(ctx context.Context, r restarted) (Health, bool, error) {
+	drain, err := m.get(ctx, r.target, r.drainID)
+	if err != nil {
+		return Health{}, false, err
+	}
+	if drain.Status.Status == models.DrainStatusTypeDELETED {
+		m.mu.Lock()
+		delete(m.disabled, drainKey(r.target, r.drainID))
+		m.mu.Unlock()
+		return Health{}, false, nil
+	}
+
+	health := m.observe(r.target, drain)
+	health.Problem = Disabled
+	health.Reason = fmt.Sprintf("%s by a restart since %s", drain.Status.Status, drain.Status.Date.Format(time.RFC3339))
+	return health, true, nil
+}
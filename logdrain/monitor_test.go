@@ -0,0 +1,250 @@
+package logdrain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	log "go.clever-cloud.dev/sdk/services/log"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var t0 = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func enabled(id string, backlog int, rateOut float64) models.Drain {
+	return models.Drain{
+		ID:        id,
+		Kind:      models.DrainKindLOG,
+		Status:    models.DrainStatus{Status: models.DrainStatusTypeENABLED, Date: t0.Add(-time.Hour)},
+		Execution: models.DrainExecution{Status: models.DrainExecutionStatusRUNNING},
+		Backlog:   &models.SubscriptionStats{MsgBacklog: backlog, MsgRateOut: rateOut},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	m := New(nil, noop.NewTracerProvider().Tracer("test")).NewMonitor(nil, WithStuckAfter(10*time.Minute), WithBacklogWindow(10*time.Minute), WithBacklogGrowth(1, 100))
+	now := t0
+
+	attempt, maxAttempt, lastError := 5, 5, "403 Forbidden"
+	failing := enabled("d", 0, 0)
+	failing.Execution = models.DrainExecution{Status: models.DrainExecutionStatusRETRYING, Attempt: &attempt, MaxAttempt: &maxAttempt, LastError: &lastError}
+
+	since := now.Add(-20 * time.Minute)
+	retrying := enabled("d", 0, 0)
+	retrying.Execution = models.DrainExecution{Status: models.DrainExecutionStatusRETRYING, RetryingSince: &since}
+
+	enabling := enabled("d", 0, 0)
+	enabling.Status = models.DrainStatus{Status: models.DrainStatusTypeENABLING, Date: now.Add(-15 * time.Minute)}
+
+	sample := func(minutes, backlog int, rateOut float64) BacklogSample {
+		return BacklogSample{At: now.Add(time.Duration(minutes) * time.Minute), Backlog: backlog, RateOut: rateOut}
+	}
+	tests := []struct {
+		name    string
+		drain   models.Drain
+		history []BacklogSample
+		want    Problem
+	}{
+		{name: "healthy", drain: enabled("d", 10, 5), history: []BacklogSample{sample(-10, 10, 5), sample(0, 10, 5)}, want: Healthy},
+		{name: "failing", drain: failing, history: []BacklogSample{sample(0, 0, 0)}, want: Failing},
+		{name: "retrying for long", drain: retrying, history: []BacklogSample{sample(0, 0, 0)}, want: Failing},
+		{name: "enabling for long", drain: enabling, history: []BacklogSample{sample(0, 0, 0)}, want: Stuck},
+		{name: "stalled", drain: enabled("d", 500, 0), history: []BacklogSample{sample(-10, 400, 0), sample(-5, 450, 0), sample(0, 500, 0)}, want: Stuck},
+		{name: "growing", drain: enabled("d", 2000, 10), history: []BacklogSample{sample(-6, 200, 10), sample(0, 2000, 10)}, want: BacklogGrowing},
+		{name: "growing below the minimum", drain: enabled("d", 90, 10), history: []BacklogSample{sample(-6, 0, 10), sample(0, 90, 10)}, want: Healthy},
+		{name: "growing too shortly", drain: enabled("d", 2000, 10), history: []BacklogSample{sample(-1, 200, 10), sample(0, 2000, 10)}, want: Healthy},
+	}
+	for _, tt := range tests {
+		if got, reason := m.evaluate(tt.drain, tt.history, now); got != tt.want {
+			t.Errorf("%s: problem = %v (%s), want %v", tt.name, got, reason, tt.want)
+		}
+	}
+}
+
+type action struct {
+	drainID ids.DrainID
+	action  Action
+}
+
+func TestPoll(t *testing.T) {
+	now := t0
+	target := Target{OwnerID: "orga_1", ApplicationID: "app_1"}
+	backlog := 0
+	var listErr error
+	var alerts []Alert
+	var actions []action
+
+	m := New(nil, noop.NewTracerProvider().Tracer("test")).NewMonitor([]Target{target},
+		WithBacklogWindow(10*time.Minute),
+		WithBacklogGrowth(1, 100),
+		WithPolicy(Policy{Actions: map[Problem]Action{BacklogGrowing: ResetCursor}, Cooldown: 5 * time.Minute}),
+		WithAlert(func(ctx context.Context, alert Alert) { alerts = append(alerts, alert) }),
+	)
+	m.now = func() time.Time { return now }
+	m.list = func(ctx context.Context, target Target, opts ...log.Option) ([]models.Drain, error) {
+		var options log.Options
+		for _, opt := range opts {
+			opt(&options)
+		}
+		if options.Status == nil || len(*options.Status) == 0 {
+			t.Error("drains listed without a status filter")
+		}
+		return []models.Drain{enabled("drain_1", backlog, 10)}, listErr
+	}
+	m.remediation = func(ctx context.Context, target Target, drainID ids.DrainID, a Action) error {
+		actions = append(actions, action{drainID, a})
+		return nil
+	}
+
+	poll := func(minutes int, newBacklog int) Health {
+		t.Helper()
+		now, backlog = t0.Add(time.Duration(minutes)*time.Minute), newBacklog
+		healths, err := m.Poll(context.Background())
+		if err != nil || len(healths) != 1 {
+			t.Fatalf("Poll = %v, %v", healths, err)
+		}
+		return healths[0]
+	}
+
+	poll(0, 0)
+	poll(3, 1000)
+	if len(alerts) != 0 {
+		t.Fatalf("alerts before the growth is measured over half the window: %+v", alerts)
+	}
+
+	health := poll(6, 2000)
+	if health.Problem != BacklogGrowing || health.Growth <= 1 {
+		t.Fatalf("health = %+v", health)
+	}
+	if len(alerts) != 1 || alerts[0].Action != ResetCursor || alerts[0].Previous != Healthy {
+		t.Fatalf("alerts = %+v", alerts)
+	}
+	if len(actions) != 1 || actions[0] != (action{"drain_1", ResetCursor}) {
+		t.Fatalf("actions = %+v", actions)
+	}
+
+	poll(8, 3000)
+	if len(alerts) != 1 || len(actions) != 1 {
+		t.Errorf("drain remediated again during the cooldown: %+v", actions)
+	}
+	poll(12, 4000)
+	if len(alerts) != 2 || len(actions) != 2 {
+		t.Errorf("lasting problem not remediated after the cooldown: %+v", actions)
+	}
+
+	health = poll(30, 0)
+	if health.Problem != Healthy || len(alerts) != 3 || !alerts[2].Resolved() {
+		t.Errorf("health = %v, alerts = %+v", health.Problem, alerts)
+	}
+	if healths := m.Healths(); len(healths) != 1 || len(healths[0].History) != 1 {
+		t.Errorf("history outside the window kept: %+v", healths)
+	}
+
+	listErr = errors.New("unavailable")
+	if _, err := m.Poll(context.Background()); err == nil {
+		t.Error("Poll ignored a list error")
+	}
+}
+
+func TestRestart(t *testing.T) {
+	target := Target{OwnerID: "orga_1", ApplicationID: "app_1"}
+	drain := enabled("drain_1", 0, 0)
+	drain.Execution = models.DrainExecution{Status: models.DrainExecutionStatusRETRYING}
+	disabling := 0
+	var calls []string
+	var enableErr error
+	var alerts []Alert
+
+	m := New(nil, noop.NewTracerProvider().Tracer("test")).NewMonitor([]Target{target},
+		WithPolicy(Policy{Actions: map[Problem]Action{Stuck: Restart}}),
+		WithStuckAfter(10*time.Minute),
+		WithAlert(func(ctx context.Context, alert Alert) { alerts = append(alerts, alert) }),
+	)
+	m.settle = time.Millisecond
+	m.now = func() time.Time { return t0 }
+	m.list = func(ctx context.Context, target Target, opts ...log.Option) ([]models.Drain, error) {
+		if drain.Status.Status == models.DrainStatusTypeDISABLED {
+			return nil, nil
+		}
+		return []models.Drain{drain}, nil
+	}
+	m.get = func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+		// the drain stays DISABLING for two reads
+		if drain.Status.Status == models.DrainStatusTypeDISABLING {
+			if disabling++; disabling > 2 {
+				drain.Status.Status = models.DrainStatusTypeDISABLED
+			}
+		}
+		return drain, nil
+	}
+	m.disable = func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+		calls = append(calls, "disable")
+		drain.Status.Status = models.DrainStatusTypeDISABLING
+		return drain, nil
+	}
+	m.enable = func(ctx context.Context, target Target, drainID ids.DrainID) (models.Drain, error) {
+		calls = append(calls, "enable from "+string(drain.Status.Status))
+		if enableErr != nil {
+			return models.Drain{}, enableErr
+		}
+		drain.Status.Status, drain.Execution.Status = models.DrainStatusTypeENABLED, models.DrainExecutionStatusRUNNING
+		return drain, nil
+	}
+
+	// stuck in ENABLING: the restart fails to enable the drain again
+	drain.Status = models.DrainStatus{Status: models.DrainStatusTypeENABLING, Date: t0.Add(-time.Hour)}
+	enableErr = errors.New("unavailable")
+	if _, err := m.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if len(calls) != 2 || calls[1] != "enable from DISABLED" {
+		t.Fatalf("calls = %q, want the drain enabled once DISABLED", calls)
+	}
+	if len(alerts) != 1 || alerts[0].Action != Restart || alerts[0].ActionErr == nil {
+		t.Fatalf("alerts = %+v", alerts)
+	}
+
+	// the drain left disabled is still reported, and restarted again
+	enableErr = nil
+	healths, err := m.Poll(context.Background())
+	if err != nil || len(healths) != 1 || healths[0].Problem != Disabled {
+		t.Fatalf("Poll = %+v, %v, want the drain reported as disabled", healths, err)
+	}
+	if len(alerts) != 2 || alerts[1].Health.Problem != Disabled || alerts[1].Action != Restart || alerts[1].ActionErr != nil {
+		t.Fatalf("alerts = %+v", alerts)
+	}
+
+	healths, _ = m.Poll(context.Background())
+	if len(healths) != 1 || healths[0].Problem != Healthy || len(alerts) != 3 || !alerts[2].Resolved() {
+		t.Errorf("healths = %+v, alerts = %+v, want the drain enabled again", healths, alerts)
+	}
+}
+
+func TestStuckBeyondWindow(t *testing.T) {
+	now := t0
+	target := Target{OwnerID: "orga_1", ApplicationID: "app_1"}
+	m := New(nil, noop.NewTracerProvider().Tracer("test")).NewMonitor([]Target{target},
+		WithBacklogWindow(5*time.Minute),
+		WithStuckAfter(20*time.Minute),
+	)
+	m.now = func() time.Time { return now }
+	m.list = func(ctx context.Context, target Target, opts ...log.Option) ([]models.Drain, error) {
+		return []models.Drain{enabled("drain_1", 500, 0)}, nil
+	}
+
+	var health Health
+	for minutes := 0; minutes <= 20; minutes += 5 {
+		now = t0.Add(time.Duration(minutes) * time.Minute)
+		healths, err := m.Poll(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		health = healths[0]
+	}
+	if health.Problem != Stuck {
+		t.Errorf("problem = %v, want a backlog not delivered for longer than the window flagged", health.Problem)
+	}
+}
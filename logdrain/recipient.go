@@ -0,0 +1,341 @@
+package logdrain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// ErrInvalidRecipient is returned by Build for recipients the API would reject
+var ErrInvalidRecipient = errors.New("logdrain: invalid recipient")
+
+// Recipient is a drain destination validated locally before it is sent
+type Recipient interface {
+	Build() (models.DrainRecipient1, error)
+}
+
+// endpoint is the URL of a recipient and the schemes it accepts
+type endpoint struct {
+	url     string
+	schemes []string
+	// port requires an explicit port, for TCP and UDP recipients
+	port bool
+}
+
+// check validates the URL. Credentials are rejected in URLs of recipients
+// that take them separately, so that they are not stored in clear.
+func (e endpoint) check(credentials bool) []error {
+	u, err := url.Parse(e.url)
+	if err != nil {
+		return []error{fmt.Errorf("url: %w", err)}
+	}
+
+	var errs []error
+	if !slices.Contains(e.schemes, u.Scheme) {
+		errs = append(errs, fmt.Errorf("url %q: scheme must be one of %s", e.url, strings.Join(e.schemes, ", ")))
+	}
+	if u.Hostname() == "" {
+		errs = append(errs, fmt.Errorf("url %q: missing host", e.url))
+	}
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			errs = append(errs, fmt.Errorf("url %q: invalid port %s", e.url, port))
+		}
+	} else if e.port {
+		errs = append(errs, fmt.Errorf("url %q: missing port", e.url))
+	}
+	if credentials && u.User != nil {
+		errs = append(errs, fmt.Errorf("url %q: pass credentials with WithBasicAuth rather than in the URL", e.url))
+	}
+	return errs
+}
+
+func invalid(kind string, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s: %w", ErrInvalidRecipient, kind, errors.Join(errs...))
+}
+
+// basicAuth is an optional pair of credentials, set together
+type basicAuth struct {
+	username, password *string
+}
+
+func (b basicAuth) check() []error {
+	if (b.username == nil) != (b.password == nil) {
+		return []error{errors.New("username and password must be set together")}
+	}
+	if b.username != nil && (*b.username == "" || *b.password == "") {
+		return []error{errors.New("empty username or password")}
+	}
+	return nil
+}
+
+// DatadogRecipient sends logs to the Datadog HTTP intake
+type DatadogRecipient struct {
+	url string
+}
+
+// Datadog builds a Datadog recipient. The URL is the HTTPS intake including
+// the API key, as given by Datadog.
+func Datadog(intakeURL string) DatadogRecipient {
+	return DatadogRecipient{url: intakeURL}
+}
+
+// Build validates the recipient
+func (r DatadogRecipient) Build() (models.DrainRecipient1, error) {
+	if err := invalid("datadog", endpoint{url: r.url, schemes: []string{"https"}}.check(false)); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.DatadogRecipient{URL: r.url}.ToDrainRecipient1(), nil
+}
+
+// ElasticsearchRecipient sends logs to an Elasticsearch index
+type ElasticsearchRecipient struct {
+	url   string
+	index string
+	auth  basicAuth
+	tls   *models.TLSMode
+}
+
+// Elasticsearch builds an Elasticsearch recipient writing to index
+func Elasticsearch(clusterURL string, index string) ElasticsearchRecipient {
+	return ElasticsearchRecipient{url: clusterURL, index: index}
+}
+
+// WithBasicAuth authenticates to the cluster
+func (r ElasticsearchRecipient) WithBasicAuth(username string, password string) ElasticsearchRecipient {
+	r.auth = basicAuth{&username, &password}
+	return r
+}
+
+// WithTLSVerification sets how the certificate of the cluster is verified
+func (r ElasticsearchRecipient) WithTLSVerification(mode models.TLSMode) ElasticsearchRecipient {
+	r.tls = &mode
+	return r
+}
+
+// Build validates the recipient. Index names follow the rules of
+// Elasticsearch: lower case, without spaces or some punctuation, and not
+// starting with -, _ or +.
+func (r ElasticsearchRecipient) Build() (models.DrainRecipient1, error) {
+	errs := endpoint{url: r.url, schemes: []string{"http", "https"}}.check(true)
+	errs = append(errs, r.auth.check()...)
+	switch {
+	case r.index == "":
+		errs = append(errs, errors.New("empty index"))
+	case r.index != strings.ToLower(r.index), strings.ContainsAny(r.index, ` "*\<|,>/?#:`), strings.ContainsAny(r.index[:1], "-_+"):
+		errs = append(errs, fmt.Errorf("invalid index name %q", r.index))
+	}
+	if r.tls != nil && *r.tls != models.TLSModeDEFAULT && *r.tls != models.TLSModeTRUSTFUL {
+		errs = append(errs, fmt.Errorf("unknown TLS verification %q", *r.tls))
+	}
+	if err := invalid("elasticsearch", errs); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.ElasticsearchRecipient{
+		URL:             r.url,
+		Index:           r.index,
+		Username:        r.auth.username,
+		Password:        r.auth.password,
+		TLSVerification: r.tls,
+	}.ToDrainRecipient1(), nil
+}
+
+// NewRelicRecipient sends logs to the New Relic log API
+type NewRelicRecipient struct {
+	url    string
+	apiKey string
+}
+
+// NewRelic builds a New Relic recipient authenticated with a license or API key
+func NewRelic(apiURL string, apiKey string) NewRelicRecipient {
+	return NewRelicRecipient{url: apiURL, apiKey: apiKey}
+}
+
+// Build validates the recipient
+func (r NewRelicRecipient) Build() (models.DrainRecipient1, error) {
+	errs := endpoint{url: r.url, schemes: []string{"https"}}.check(true)
+	if strings.TrimSpace(r.apiKey) == "" {
+		errs = append(errs, errors.New("empty API key"))
+	}
+	if err := invalid("newrelic", errs); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.NewRelicRecipient{URL: r.url, APIKey: r.apiKey}.ToDrainRecipient1(), nil
+}
+
+// RawHTTPRecipient posts logs to an HTTP endpoint
+type RawHTTPRecipient struct {
+	url  string
+	auth basicAuth
+}
+
+// RawHTTP builds a recipient posting logs to an HTTP endpoint
+func RawHTTP(endpointURL string) RawHTTPRecipient {
+	return RawHTTPRecipient{url: endpointURL}
+}
+
+// WithBasicAuth authenticates to the endpoint
+func (r RawHTTPRecipient) WithBasicAuth(username string, password string) RawHTTPRecipient {
+	r.auth = basicAuth{&username, &password}
+	return r
+}
+
+// Build validates the recipient
+func (r RawHTTPRecipient) Build() (models.DrainRecipient1, error) {
+	errs := endpoint{url: r.url, schemes: []string{"http", "https"}}.check(true)
+	errs = append(errs, r.auth.check()...)
+	if err := invalid("raw http", errs); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.RawRecipient{URL: r.url, Username: r.auth.username, Password: r.auth.password}.ToDrainRecipient1(), nil
+}
+
+// SyslogRecipient sends logs to a syslog server over TCP or UDP
+type SyslogRecipient struct {
+	url            string
+	udp            bool
+	structuredData *string
+}
+
+// SyslogTCP builds a syslog recipient such as tcp://logs.example.com:514
+func SyslogTCP(serverURL string) SyslogRecipient {
+	return SyslogRecipient{url: serverURL}
+}
+
+// SyslogUDP builds a syslog recipient such as udp://logs.example.com:514
+func SyslogUDP(serverURL string) SyslogRecipient {
+	return SyslogRecipient{url: serverURL, udp: true}
+}
+
+// WithStructuredData sets the RFC 5424 structured data parameters added to
+// every message, such as a token expected by the server
+func (r SyslogRecipient) WithStructuredData(parameters string) SyslogRecipient {
+	r.structuredData = &parameters
+	return r
+}
+
+// Build validates the recipient
+func (r SyslogRecipient) Build() (models.DrainRecipient1, error) {
+	if r.udp {
+		if err := invalid("syslog udp", endpoint{url: r.url, schemes: []string{"udp"}, port: true}.check(false)); err != nil {
+			return models.DrainRecipient1{}, err
+		}
+		return models.SyslogUDPRecipient{URL: r.url, Rfc5424StructuredDataParameters: r.structuredData}.ToDrainRecipient1(), nil
+	}
+	if err := invalid("syslog tcp", endpoint{url: r.url, schemes: []string{"tcp"}, port: true}.check(false)); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.SyslogTCPRecipient{URL: r.url, Rfc5424StructuredDataParameters: r.structuredData}.ToDrainRecipient1(), nil
+}
+
+// OVHTCPRecipient sends logs to OVHcloud Logs Data Platform
+type OVHTCPRecipient struct {
+	url            string
+	token          *string
+	structuredData *string
+}
+
+// OVHTCP builds an OVHcloud Logs Data Platform recipient, such as
+// tcp://gra1.logs.ovh.com:6514
+func OVHTCP(serverURL string) OVHTCPRecipient {
+	return OVHTCPRecipient{url: serverURL}
+}
+
+// WithToken sets the X-OVH-TOKEN of the stream
+func (r OVHTCPRecipient) WithToken(token string) OVHTCPRecipient {
+	r.token = &token
+	return r
+}
+
+// WithStructuredData sets the RFC 5424 structured data parameters added to every message
+func (r OVHTCPRecipient) WithStructuredData(parameters string) OVHTCPRecipient {
+	r.structuredData = &parameters
+	return r
+}
+
+// Build validates the recipient
+func (r OVHTCPRecipient) Build() (models.DrainRecipient1, error) {
+	errs := endpoint{url: r.url, schemes: []string{"tcp"}, port: true}.check(false)
+	if r.token != nil && strings.TrimSpace(*r.token) == "" {
+		errs = append(errs, errors.New("empty token"))
+	}
+	if err := invalid("ovh tcp", errs); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	return models.OVHTCPRecipient{URL: r.url, Token: r.token, Rfc5424StructuredDataParameters: r.structuredData}.ToDrainRecipient1(), nil
+}
+
+// secretFields are the recipient fields the API masks in responses
+var secretFields = []string{"password", "apiKey", "token"}
+
+// fingerprint identifies a recipient by its type and settings without its
+// secrets, so that a recipient to create compares equal to the masked one
+// of an existing drain
+func fingerprint(recipient json.Marshaler) (string, error) {
+	raw, err := recipient.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return "", err
+	}
+	for _, field := range secretFields {
+		delete(fields, field)
+	}
+	// Maps are encoded with sorted keys
+	key, err := json.Marshal(fields)
+	return string(key), err
+}
+
+// Secrets returns the secret of a drain recipient, masked by the API, to
+// recreate it elsewhere. It is called with the drain and the masked field,
+// one of password, apiKey or token.
+type Secrets func(drain models.Drain, field string) (string, error)
+
+// ErrMaskedSecret is returned when a recipient with a masked secret is
+// copied without a way to get the secret
+var ErrMaskedSecret = errors.New("logdrain: recipient secret is masked")
+
+// copyRecipient returns the recipient of an existing drain to create
+// another drain with, its masked secrets replaced by those of secrets
+func copyRecipient(drain models.Drain, secrets Secrets) (models.DrainRecipient1, error) {
+	raw, err := drain.Recipient.MarshalJSON()
+	if err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	for _, field := range secretFields {
+		if value, ok := fields[field]; !ok || value == nil || value == "" {
+			continue
+		}
+		if secrets == nil {
+			return models.DrainRecipient1{}, fmt.Errorf("%w: %s of drain %s", ErrMaskedSecret, field, drain.ID)
+		}
+		secret, err := secrets(drain, field)
+		if err != nil {
+			return models.DrainRecipient1{}, fmt.Errorf("%s of drain %s: %w", field, drain.ID, err)
+		}
+		fields[field] = secret
+	}
+
+	raw, err = json.Marshal(fields)
+	if err != nil {
+		return models.DrainRecipient1{}, err
+	}
+	var recipient models.DrainRecipient1
+	err = recipient.UnmarshalJSON(raw)
+	return recipient, err
+}
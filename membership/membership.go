@@ -0,0 +1,255 @@
+// Package membership onboards and offboards the members of tenants.
+//
+// Onboarding invites a user by email, completes, labels and verifies their
+// identity and adds them to a tenant with a role. Offboarding revokes the IAM
+// biscuits and API tokens an identity created and removes it from every
+// tenant. ResetPassword sends a password recovery email to a user who lost
+// theirs. Each step is recorded in a Report for audit.
+//
+// Some steps have no API operation to rely on:
+//   - adding a member to a tenant: onboarding requires WithAddMember and
+//     fails with ErrNoMemberAdder before inviting anyone without it;
+//   - adding an email address to another identity: Createemailaddress only
+//     adds one to the calling identity, so invitations use the address the
+//     identity is created with;
+//   - completing a password recovery: Updatepasswordrecovery needs the token
+//     emailed to the user, so the user completes it.
+package membership
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	base "go.clever-cloud.dev/sdk/services/base"
+	tokens "go.clever-cloud.dev/sdk/services/tokens"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrNoMemberAdder is returned when onboarding needs to add a member but no
+// AddMemberFunc is configured: the API has no operation for it yet.
+// Onboard returns it before inviting the user.
+var ErrNoMemberAdder = errors.New("membership: no way to add a tenant member, see WithAddMember")
+
+// ErrVerificationTimeout is returned when an email address is not verified in time
+var ErrVerificationTimeout = errors.New("membership: email address not verified in time")
+
+// StepStatus is the outcome of a step
+type StepStatus string
+
+const (
+	StepDone    StepStatus = "done"
+	StepSkipped StepStatus = "skipped"
+	StepFailed  StepStatus = "failed"
+	// StepPlanned is recorded instead of StepDone in dry runs
+	StepPlanned StepStatus = "planned"
+)
+
+// Actions recorded in reports
+const (
+	ActionInvite          = "invite"
+	ActionComplete        = "complete-identity"
+	ActionLabelEmail      = "label-email"
+	ActionVerifyEmail     = "verify-email"
+	ActionAddMember       = "add-member"
+	ActionGetIdentity     = "get-identity"
+	ActionRevokeBiscuit   = "revoke-biscuit"
+	ActionRevokeToken     = "revoke-token"
+	ActionRemoveMember    = "remove-member"
+	ActionListCredential  = "list-credentials"
+	ActionRecoverPassword = "recover-password"
+)
+
+// Step is one audited action
+type Step struct {
+	At       time.Time    `json:"at"`
+	Action   string       `json:"action"`
+	TenantID ids.TenantID `json:"tenantId,omitempty"`
+	Target   string       `json:"target,omitempty"`
+	Status   StepStatus   `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+}
+
+// Report is the audit trail of an onboarding or offboarding
+type Report struct {
+	Workflow   string         `json:"workflow"`
+	IdentityID ids.IdentityID `json:"identityId,omitempty"`
+	Email      string         `json:"email,omitempty"`
+	DryRun     bool           `json:"dryRun,omitempty"`
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt time.Time      `json:"finishedAt"`
+	Steps      []Step         `json:"steps"`
+}
+
+// Failed returns the failed steps
+func (r Report) Failed() []Step {
+	var failed []Step
+	for _, step := range r.Steps {
+		if step.Status == StepFailed {
+			failed = append(failed, step)
+		}
+	}
+	return failed
+}
+
+// WriteJSON writes the report as an indented JSON document
+func (r Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// AddMemberFunc adds an identity to a tenant with a role
+type AddMemberFunc func(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID, roleID string) error
+
+// Admin runs membership workflows
+type Admin struct {
+	client *client.Client
+	tracer trace.Tracer
+
+	tenantIDs     []ids.TenantID
+	addMember     AddMemberFunc
+	recorder      func(Step)
+	dryRun        bool
+	pollInterval  time.Duration
+	verifyTimeout time.Duration
+
+	// API calls, replaced in tests
+	invite          func(ctx context.Context, email string) (models.PartialIdentity, error)
+	complete        func(ctx context.Context, identityID ids.IdentityID, identity *models.WannabeIdentity) error
+	labelEmail      func(ctx context.Context, emailAddressID ids.EmailAddressID, label string) error
+	getEmail        func(ctx context.Context, emailAddressID ids.EmailAddressID) (models.EmailAddress, error)
+	recoverPassword func(ctx context.Context, email string) error
+	getIdentity     func(ctx context.Context, identityID ids.IdentityID) (models.Identity, error)
+	listTenants     func(ctx context.Context) ([]models.Tenant1, error)
+	getTenant       func(ctx context.Context, tenantID ids.TenantID) (models.Tenant1, error)
+	listBiscuits    func(ctx context.Context, tenantID ids.TenantID) ([]models.IAMBiscuit, error)
+	deleteBiscuit   func(ctx context.Context, tenantID ids.TenantID, biscuitID ids.TokenID) error
+	listTokens      func(ctx context.Context, tenantID ids.TenantID) ([]models.Token1, error)
+	deleteToken     func(ctx context.Context, tenantID ids.TenantID, tokenID ids.TokenID) error
+	removeMember    func(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID) error
+}
+
+// Option defines configuration options for the Admin
+type Option func(*Admin)
+
+// WithTenants restricts offboarding to some tenants instead of every listed one
+func WithTenants(tenantIDs ...ids.TenantID) Option {
+	return func(a *Admin) {
+		a.tenantIDs = tenantIDs
+	}
+}
+
+// WithAddMember sets how identities are added to tenants during onboarding.
+// The API has no operation for it, so onboarding requires this option.
+func WithAddMember(f AddMemberFunc) Option {
+	return func(a *Admin) {
+		a.addMember = f
+	}
+}
+
+// WithRecorder sets a function called with every step as soon as it is recorded,
+// for instance to forward it to an audit log
+func WithRecorder(f func(Step)) Option {
+	return func(a *Admin) {
+		a.recorder = f
+	}
+}
+
+// WithDryRun reports what offboarding would revoke and remove without doing it
+func WithDryRun() Option {
+	return func(a *Admin) {
+		a.dryRun = true
+	}
+}
+
+// WithVerification waits up to timeout for invited users to verify their email address.
+// Without it the verification step is skipped.
+func WithVerification(timeout time.Duration, pollInterval time.Duration) Option {
+	return func(a *Admin) {
+		a.verifyTimeout = timeout
+		a.pollInterval = pollInterval
+	}
+}
+
+// New creates an Admin
+func New(c *client.Client, tracer trace.Tracer, opts ...Option) *Admin {
+	a := &Admin{
+		client:       c,
+		tracer:       tracer,
+		pollInterval: 10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	a.invite = func(ctx context.Context, email string) (models.PartialIdentity, error) {
+		return payload(base.Createpartialidentity(ctx, a.client, a.tracer, &models.WannabeEmailAddress{EmailAddress: email}))
+	}
+	a.complete = func(ctx context.Context, identityID ids.IdentityID, identity *models.WannabeIdentity) error {
+		return errorOf(base.Updatepartialidentity(ctx, a.client, a.tracer, identityID, identity))
+	}
+	a.labelEmail = func(ctx context.Context, emailAddressID ids.EmailAddressID, label string) error {
+		return errorOf(base.Updateemailaddress(ctx, a.client, a.tracer, emailAddressID, &models.EmailAddressPatch{Label: &label}))
+	}
+	a.getEmail = func(ctx context.Context, emailAddressID ids.EmailAddressID) (models.EmailAddress, error) {
+		return payload(base.Getemailaddress(ctx, a.client, a.tracer, emailAddressID))
+	}
+	a.recoverPassword = func(ctx context.Context, email string) error {
+		return errorOf(base.Createpasswordrecovery(ctx, a.client, a.tracer, &models.WannabePasswordRecovery{EmailAddress: email}))
+	}
+	a.getIdentity = func(ctx context.Context, identityID ids.IdentityID) (models.Identity, error) {
+		return payload(base.Getidentity(ctx, a.client, a.tracer, identityID))
+	}
+	a.listTenants = func(ctx context.Context) ([]models.Tenant1, error) {
+		return payload(base.Listtenants(ctx, a.client, a.tracer))
+	}
+	a.getTenant = func(ctx context.Context, tenantID ids.TenantID) (models.Tenant1, error) {
+		return payload(base.Gettenant(ctx, a.client, a.tracer, tenantID))
+	}
+	a.listBiscuits = func(ctx context.Context, tenantID ids.TenantID) ([]models.IAMBiscuit, error) {
+		return payload(base.Listbiscuits(ctx, a.client, a.tracer, ids.OwnerID(tenantID)))
+	}
+	a.deleteBiscuit = func(ctx context.Context, tenantID ids.TenantID, biscuitID ids.TokenID) error {
+		return errorOf(base.Deletebiscuit(ctx, a.client, a.tracer, ids.OwnerID(tenantID), biscuitID))
+	}
+	a.listTokens = func(ctx context.Context, tenantID ids.TenantID) ([]models.Token1, error) {
+		return payload(tokens.Listtokens(ctx, a.client, a.tracer, tenantID))
+	}
+	a.deleteToken = func(ctx context.Context, tenantID ids.TenantID, tokenID ids.TokenID) error {
+		return errorOf(tokens.Deletetoken(ctx, a.client, a.tracer, tenantID, tokenID))
+	}
+	a.removeMember = func(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID) error {
+		return errorOf(base.Deletememberfromtenant(ctx, a.client, a.tracer, tenantID, identityID))
+	}
+	return a
+}
+
+// recorder appends steps to a report
+type recorder struct {
+	report *Report
+	notify func(Step)
+}
+
+func (r *recorder) record(step Step) {
+	step.At = time.Now()
+	r.report.Steps = append(r.report.Steps, step)
+	if r.notify != nil {
+		r.notify(step)
+	}
+}
+
+// outcome records a step done or failed depending on err, and returns err
+func (r *recorder) outcome(step Step, err error) error {
+	step.Status = StepDone
+	if err != nil {
+		step.Status, step.Detail = StepFailed, err.Error()
+	}
+	r.record(step)
+	return err
+}
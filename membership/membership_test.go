@@ -0,0 +1,208 @@
+package membership
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRecorder(t *testing.T) {
+	report := Report{Workflow: "offboarding"}
+	var streamed []Step
+	rec := &recorder{report: &report, notify: func(step Step) { streamed = append(streamed, step) }}
+
+	rec.outcome(Step{Action: ActionRevokeBiscuit, Target: "b1"}, nil)
+	err := rec.outcome(Step{Action: ActionRevokeToken, Target: "t1", Detail: "ci"}, errors.New("forbidden"))
+	if err == nil {
+		t.Errorf("outcome should return the step error")
+	}
+
+	if len(streamed) != 2 || streamed[0].At.IsZero() {
+		t.Errorf("streamed steps = %+v", streamed)
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].Target != "t1" || failed[0].Detail != "forbidden" {
+		t.Errorf("failed steps = %+v", failed)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded.Steps) != 2 || decoded.Steps[0].Status != StepDone {
+		t.Errorf("report does not round trip: %v\n%s", err, buf.String())
+	}
+}
+
+func TestMembershipChecks(t *testing.T) {
+	tenant := models.Tenant1{ID: "tenant_a", Members: []models.Membership{{Identity: models.Identity{ID: "id_1"}}}}
+	if !isMember(tenant, "id_1") || isMember(tenant, "id_2") {
+		t.Errorf("isMember is wrong for %+v", tenant.Members)
+	}
+
+	if verified([]models.DefaultIdentifiedStatus{{State: models.IdentifiedStateTypePENDING}}) {
+		t.Errorf("a pending address is not verified")
+	}
+	if !verified([]models.DefaultIdentifiedStatus{{State: models.IdentifiedStateTypePENDING}, {State: models.IdentifiedStateTypeVALIDATED}}) {
+		t.Errorf("a validated address is verified")
+	}
+}
+
+func TestOnboardWithoutMemberAdder(t *testing.T) {
+	a := New(nil, noop.NewTracerProvider().Tracer("test"))
+	report, err := a.Onboard(context.Background(), Invitation{Email: "user@example.com", TenantID: "tenant_a", RoleID: "developer"})
+	if !errors.Is(err, ErrNoMemberAdder) {
+		t.Fatalf("Onboard = %v, want ErrNoMemberAdder", err)
+	}
+	if report.IdentityID != "" || len(report.Steps) != 1 || report.Steps[0].Action != ActionAddMember {
+		t.Errorf("report = %+v, nobody must be invited", report)
+	}
+}
+
+// fakeTenants answers the API calls of an Admin and records the calls
+// changing something, in order
+type fakeTenants struct {
+	tenants  []models.Tenant1
+	biscuits map[ids.TenantID][]models.IAMBiscuit
+	tokens   map[ids.TenantID][]models.Token1
+	calls    []string
+}
+
+func (f *fakeTenants) admin(opts ...Option) *Admin {
+	a := New(nil, noop.NewTracerProvider().Tracer("test"), opts...)
+	record := func(format string, args ...any) error {
+		f.calls = append(f.calls, fmt.Sprintf(format, args...))
+		return nil
+	}
+	a.invite = func(ctx context.Context, email string) (models.PartialIdentity, error) {
+		record("invite %s", email)
+		return models.PartialIdentity{ID: "id_1", Token: "token", EmailAddress: models.EmailAddress{ID: "email_1"}}, nil
+	}
+	a.complete = func(ctx context.Context, identityID ids.IdentityID, identity *models.WannabeIdentity) error {
+		return record("complete %s", identityID)
+	}
+	a.labelEmail = func(ctx context.Context, emailAddressID ids.EmailAddressID, label string) error {
+		return record("label %s %s", emailAddressID, label)
+	}
+	a.getEmail = func(ctx context.Context, emailAddressID ids.EmailAddressID) (models.EmailAddress, error) {
+		return models.EmailAddress{ID: string(emailAddressID), Statuses: []models.DefaultIdentifiedStatus{{State: models.IdentifiedStateTypeVALIDATED}}}, nil
+	}
+	a.getIdentity = func(ctx context.Context, identityID ids.IdentityID) (models.Identity, error) {
+		return models.Identity{ID: string(identityID)}, nil
+	}
+	a.listTenants = func(ctx context.Context) ([]models.Tenant1, error) {
+		return f.tenants, nil
+	}
+	a.getTenant = func(ctx context.Context, tenantID ids.TenantID) (models.Tenant1, error) {
+		for _, tenant := range f.tenants {
+			if ids.TenantID(tenant.ID) == tenantID {
+				return tenant, nil
+			}
+		}
+		return models.Tenant1{}, errors.New("not found")
+	}
+	a.listBiscuits = func(ctx context.Context, tenantID ids.TenantID) ([]models.IAMBiscuit, error) {
+		return f.biscuits[tenantID], nil
+	}
+	a.deleteBiscuit = func(ctx context.Context, tenantID ids.TenantID, biscuitID ids.TokenID) error {
+		return record("revoke-biscuit %s %s", tenantID, biscuitID)
+	}
+	a.listTokens = func(ctx context.Context, tenantID ids.TenantID) ([]models.Token1, error) {
+		return f.tokens[tenantID], nil
+	}
+	a.deleteToken = func(ctx context.Context, tenantID ids.TenantID, tokenID ids.TokenID) error {
+		return record("revoke-token %s %s", tenantID, tokenID)
+	}
+	a.removeMember = func(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID) error {
+		return record("remove-member %s %s", tenantID, identityID)
+	}
+	return a
+}
+
+func TestOnboard(t *testing.T) {
+	f := &fakeTenants{}
+	addMember := func(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID, roleID string) error {
+		f.calls = append(f.calls, fmt.Sprintf("add-member %s %s %s", tenantID, identityID, roleID))
+		f.tenants = append(f.tenants, models.Tenant1{ID: string(tenantID), Members: []models.Membership{{Identity: models.Identity{ID: string(identityID)}, Role: models.Role{ID: roleID}}}})
+		return nil
+	}
+	a := f.admin(WithAddMember(addMember), WithVerification(time.Second, time.Millisecond))
+
+	report, err := a.Onboard(context.Background(), Invitation{Email: "user@example.com", TenantID: "tenant_a", RoleID: "developer", Password: "s3cret", Label: "work"})
+	if err != nil {
+		t.Fatalf("Onboard: %v", err)
+	}
+	want := []string{"invite user@example.com", "complete id_1", "label email_1 work", "add-member tenant_a id_1 developer"}
+	if !slices.Equal(f.calls, want) {
+		t.Errorf("calls %q, want %q", f.calls, want)
+	}
+	var actions []string
+	for _, step := range report.Steps {
+		actions = append(actions, step.Action)
+	}
+	if report.IdentityID != "id_1" || !slices.Equal(actions, []string{ActionInvite, ActionComplete, ActionLabelEmail, ActionVerifyEmail, ActionAddMember}) || len(report.Failed()) != 0 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestOffboard(t *testing.T) {
+	member := models.Membership{Identity: models.Identity{ID: "id_1"}}
+	f := &fakeTenants{
+		tenants: []models.Tenant1{
+			{ID: "tenant_a", Members: []models.Membership{member}},
+			{ID: "tenant_b", Members: []models.Membership{member}},
+		},
+		biscuits: map[ids.TenantID][]models.IAMBiscuit{
+			"tenant_a": {
+				{ID: "biscuit_1", Labels: models.IAMBiscuitLabels{Creator: "id_1"}, Status: models.IAMBiscuitStatusACTIVE},
+				{ID: "biscuit_2", Labels: models.IAMBiscuitLabels{Creator: "id_2"}, Status: models.IAMBiscuitStatusACTIVE},
+			},
+		},
+		tokens: map[ids.TenantID][]models.Token1{
+			"tenant_b": {{ID: "token_1", InstigatorID: "id_1", Status: models.TokenStateTypeACTIVE}},
+		},
+	}
+
+	report, err := f.admin().Offboard(context.Background(), "id_1")
+	if err != nil {
+		t.Fatalf("Offboard: %v", err)
+	}
+	want := []string{
+		"revoke-biscuit tenant_a biscuit_1",
+		"revoke-token tenant_b token_1",
+		"remove-member tenant_a id_1",
+		"remove-member tenant_b id_1",
+	}
+	if !slices.Equal(f.calls, want) {
+		t.Errorf("calls %q, want credentials of every tenant revoked before any membership is removed", f.calls)
+	}
+	if len(report.Steps) != 4 || len(report.Failed()) != 0 {
+		t.Errorf("report = %+v", report)
+	}
+
+	// a dry run records the same steps as planned and changes nothing
+	f.calls = nil
+	report, err = f.admin(WithDryRun()).Offboard(context.Background(), "id_1")
+	if err != nil || len(f.calls) != 0 {
+		t.Fatalf("dry run = %v, calls %q", err, f.calls)
+	}
+	var planned []string
+	for _, step := range report.Steps {
+		if step.Status == StepPlanned {
+			planned = append(planned, step.Action+" "+string(step.TenantID)+" "+step.Target)
+		}
+	}
+	if !report.DryRun || !slices.Equal(planned, want) {
+		t.Errorf("planned %q, want %q", planned, want)
+	}
+}
@@ -0,0 +1,162 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Offboard revokes the biscuits and API tokens created by an identity in
+// every tenant, then removes the identity from the tenants it belongs to.
+// Credentials are revoked before memberships are removed so that an
+// interrupted run never leaves a former member with working credentials.
+// Failed steps do not stop the run; their errors are joined.
+func (a *Admin) Offboard(ctx context.Context, identityID ids.IdentityID) (Report, error) {
+	ctx, span := a.tracer.Start(ctx, "membership.Offboard", trace.WithAttributes(attribute.String("identityId", string(identityID))))
+	defer span.End()
+
+	report := Report{Workflow: "offboarding", IdentityID: identityID, DryRun: a.dryRun, StartedAt: time.Now()}
+	rec := &recorder{report: &report, notify: a.recorder}
+
+	// credentials are revoked even when the identity cannot be read
+	var errs []error
+	identity, err := a.getIdentity(ctx, identityID)
+	if err != nil {
+		errs = append(errs, rec.outcome(Step{Action: ActionGetIdentity, Target: string(identityID)}, err))
+	} else if len(identity.EmailAddresses) > 0 {
+		report.Email = identity.EmailAddresses[0].Address
+	}
+
+	tenants, err := a.tenants(ctx)
+	if err != nil {
+		err = errors.Join(append(errs, err)...)
+		span.RecordError(err)
+		report.FinishedAt = time.Now()
+		return report, err
+	}
+
+	for _, tenant := range tenants {
+		errs = append(errs, a.revokeBiscuits(ctx, rec, ids.TenantID(tenant.ID), identityID))
+		errs = append(errs, a.revokeTokens(ctx, rec, ids.TenantID(tenant.ID), identityID))
+	}
+	for _, tenant := range tenants {
+		if isMember(tenant, identityID) {
+			errs = append(errs, a.leave(ctx, rec, ids.TenantID(tenant.ID), identityID))
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	err = errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return report, err
+}
+
+// tenants returns the tenants to offboard from, with their members
+func (a *Admin) tenants(ctx context.Context) ([]models.Tenant1, error) {
+	if len(a.tenantIDs) == 0 {
+		return a.listTenants(ctx)
+	}
+
+	tenants := make([]models.Tenant1, 0, len(a.tenantIDs))
+	for _, tenantID := range a.tenantIDs {
+		tenant, err := a.getTenant(ctx, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("membership: tenant %s: %w", tenantID, err)
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, nil
+}
+
+func isMember(tenant models.Tenant1, identityID ids.IdentityID) bool {
+	return slices.ContainsFunc(tenant.Members, func(m models.Membership) bool {
+		return ids.IdentityID(m.Identity.ID) == identityID
+	})
+}
+
+// revokeBiscuits revokes the active biscuits of a tenant created by the identity
+func (a *Admin) revokeBiscuits(ctx context.Context, rec *recorder, tenantID ids.TenantID, identityID ids.IdentityID) error {
+	biscuits, err := a.listBiscuits(ctx, tenantID)
+	if err != nil {
+		return rec.outcome(Step{Action: ActionListCredential, TenantID: tenantID, Detail: "biscuits"}, err)
+	}
+
+	var errs []error
+	for _, biscuit := range biscuits {
+		if ids.IdentityID(biscuit.Labels.Creator) != identityID || biscuit.Status != models.IAMBiscuitStatusACTIVE {
+			continue
+		}
+		step := Step{Action: ActionRevokeBiscuit, TenantID: tenantID, Target: biscuit.ID, Detail: biscuit.Name}
+		if a.dryRun {
+			step.Status = StepPlanned
+			rec.record(step)
+			continue
+		}
+		errs = append(errs, rec.outcome(step, a.deleteBiscuit(ctx, tenantID, ids.TokenID(biscuit.ID))))
+	}
+	return errors.Join(errs...)
+}
+
+// revokeTokens revokes the active API tokens of a tenant created by the identity
+func (a *Admin) revokeTokens(ctx context.Context, rec *recorder, tenantID ids.TenantID, identityID ids.IdentityID) error {
+	tokens, err := a.listTokens(ctx, tenantID)
+	if err != nil {
+		return rec.outcome(Step{Action: ActionListCredential, TenantID: tenantID, Detail: "tokens"}, err)
+	}
+
+	var errs []error
+	for _, token := range tokens {
+		if ids.IdentityID(token.InstigatorID) != identityID || token.Status != models.TokenStateTypeACTIVE {
+			continue
+		}
+		step := Step{Action: ActionRevokeToken, TenantID: tenantID, Target: token.ID}
+		if token.Name != nil {
+			step.Detail = *token.Name
+		}
+		if a.dryRun {
+			step.Status = StepPlanned
+			rec.record(step)
+			continue
+		}
+		errs = append(errs, rec.outcome(step, a.deleteToken(ctx, tenantID, ids.TokenID(token.ID))))
+	}
+	return errors.Join(errs...)
+}
+
+// leave removes the identity from a tenant
+func (a *Admin) leave(ctx context.Context, rec *recorder, tenantID ids.TenantID, identityID ids.IdentityID) error {
+	step := Step{Action: ActionRemoveMember, TenantID: tenantID, Target: string(identityID)}
+	if a.dryRun {
+		step.Status = StepPlanned
+		rec.record(step)
+		return nil
+	}
+	return rec.outcome(step, a.removeMember(ctx, tenantID, identityID))
+}
+
+// payload returns the payload of a response, or its error
+func payload[T any](response client.Response[T]) (T, error) {
+	if response.HasError() {
+		var zero T
+		return zero, response.Error()
+	}
+	return *response.Payload(), nil
+}
+
+// errorOf returns the error of a response, nil when it succeeded
+func errorOf[T any](response client.Response[T]) error {
+	if response.HasError() {
+		return response.Error()
+	}
+	return nil
+}
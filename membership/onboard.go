@@ -0,0 +1,157 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Invitation describes a user to onboard
+type Invitation struct {
+	Email    string
+	TenantID ids.TenantID
+	RoleID   string
+	// Password completes the identity right away. When empty the user
+	// completes it from the invitation email.
+	Password string
+	// Label names the invited email address, such as "work"
+	Label string
+}
+
+// Onboard invites a user, completes, labels and verifies their identity and
+// adds it to the tenant. It stops at the first failed step, and returns
+// ErrNoMemberAdder without inviting anyone when WithAddMember is not set.
+func (a *Admin) Onboard(ctx context.Context, invitation Invitation) (Report, error) {
+	ctx, span := a.tracer.Start(ctx, "membership.Onboard", trace.WithAttributes(
+		attribute.String("tenantId", string(invitation.TenantID)),
+		attribute.String("roleId", invitation.RoleID),
+	))
+	defer span.End()
+
+	report := Report{Workflow: "onboarding", Email: invitation.Email, StartedAt: time.Now()}
+	rec := &recorder{report: &report, notify: a.recorder}
+	var err error
+	if a.addMember == nil {
+		err = rec.outcome(Step{Action: ActionAddMember, TenantID: invitation.TenantID, Target: invitation.Email, Detail: "role " + invitation.RoleID}, ErrNoMemberAdder)
+	} else {
+		err = a.onboard(ctx, rec, invitation)
+	}
+	report.FinishedAt = time.Now()
+	if err != nil {
+		span.RecordError(err)
+	}
+	return report, err
+}
+
+func (a *Admin) onboard(ctx context.Context, rec *recorder, invitation Invitation) error {
+	partial, err := a.invite(ctx, invitation.Email)
+	if err != nil {
+		return rec.outcome(Step{Action: ActionInvite, Target: invitation.Email}, err)
+	}
+	identityID := ids.IdentityID(partial.ID)
+	rec.report.IdentityID = identityID
+	rec.outcome(Step{Action: ActionInvite, Target: invitation.Email, Detail: "identity " + partial.ID}, nil)
+
+	if invitation.Password == "" {
+		rec.record(Step{Action: ActionComplete, Target: partial.ID, Status: StepSkipped, Detail: "completed by the user from the invitation email"})
+	} else {
+		completed := a.complete(ctx, identityID, &models.WannabeIdentity{Password: invitation.Password, Token: partial.Token})
+		if err := rec.outcome(Step{Action: ActionComplete, Target: partial.ID}, completed); err != nil {
+			return err
+		}
+	}
+
+	if invitation.Label != "" {
+		labelled := a.labelEmail(ctx, ids.EmailAddressID(partial.EmailAddress.ID), invitation.Label)
+		if err := rec.outcome(Step{Action: ActionLabelEmail, Target: partial.EmailAddress.ID, Detail: invitation.Label}, labelled); err != nil {
+			return err
+		}
+	}
+
+	if a.verifyTimeout <= 0 {
+		rec.record(Step{Action: ActionVerifyEmail, Target: partial.EmailAddress.ID, Status: StepSkipped, Detail: "verification not awaited"})
+	} else if err := rec.outcome(Step{Action: ActionVerifyEmail, Target: partial.EmailAddress.ID}, a.waitVerified(ctx, ids.EmailAddressID(partial.EmailAddress.ID))); err != nil {
+		return err
+	}
+
+	step := Step{Action: ActionAddMember, TenantID: invitation.TenantID, Target: partial.ID, Detail: "role " + invitation.RoleID}
+	if err := a.addMember(ctx, invitation.TenantID, identityID, invitation.RoleID); err != nil {
+		return rec.outcome(step, err)
+	}
+	return rec.outcome(step, a.checkMember(ctx, invitation.TenantID, identityID, invitation.RoleID))
+}
+
+// ResetPassword sends a password recovery email to a user, who completes
+// the recovery with the token it contains
+func (a *Admin) ResetPassword(ctx context.Context, email string) (Report, error) {
+	ctx, span := a.tracer.Start(ctx, "membership.ResetPassword")
+	defer span.End()
+
+	report := Report{Workflow: "password-recovery", Email: email, StartedAt: time.Now()}
+	rec := &recorder{report: &report, notify: a.recorder}
+	err := rec.outcome(Step{Action: ActionRecoverPassword, Target: email}, a.recoverPassword(ctx, email))
+	report.FinishedAt = time.Now()
+	if err != nil {
+		span.RecordError(err)
+	}
+	return report, err
+}
+
+// waitVerified polls an email address until it is validated
+func (a *Admin) waitVerified(ctx context.Context, emailAddressID ids.EmailAddressID) error {
+	ctx, cancel := context.WithTimeout(ctx, a.verifyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(a.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		address, err := a.getEmail(ctx, emailAddressID)
+		if err != nil {
+			return err
+		}
+		if verified(address.Statuses) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrVerificationTimeout
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func verified(statuses []models.DefaultIdentifiedStatus) bool {
+	return slices.ContainsFunc(statuses, func(s models.DefaultIdentifiedStatus) bool {
+		return s.State == models.IdentifiedStateTypeVALIDATED
+	})
+}
+
+// checkMember confirms that the tenant lists the identity with the role
+func (a *Admin) checkMember(ctx context.Context, tenantID ids.TenantID, identityID ids.IdentityID, roleID string) error {
+	tenant, err := a.getTenant(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	for _, member := range tenant.Members {
+		if ids.IdentityID(member.Identity.ID) != identityID {
+			continue
+		}
+		if member.Role.ID != roleID {
+			return fmt.Errorf("membership: %s joined %s with role %s instead of %s", identityID, tenantID, member.Role.ID, roleID)
+		}
+		return nil
+	}
+	return fmt.Errorf("membership: %s is not listed as a member of %s", identityID, tenantID)
+}
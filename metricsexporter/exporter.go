@@ -0,0 +1,319 @@
+// Package metricsexporter exposes Clever Cloud metrics to Prometheus.
+//
+// An Exporter scrapes the metrics, HTTP status codes and request counts of a
+// set of resources on an interval and caches them. It is an http.Handler
+// serving the cached samples in the Prometheus text exposition format, all
+// labelled with owner, region and resource so that dashboards can filter and
+// join them the same way.
+package metricsexporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	metricsquery "go.clever-cloud.dev/sdk/metricsquery"
+	models "go.clever-cloud.dev/sdk/models"
+	metrics "go.clever-cloud.dev/sdk/services/metrics"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Target is a set of resources of an owner to scrape
+type Target struct {
+	OwnerID   ids.OwnerID
+	Resources []ids.ResourceID
+	// Region labels the samples of the resources, as the metrics do not carry it
+	Region string
+}
+
+// ReadClientFunc returns a client authenticated with a stats read token
+type ReadClientFunc func(token string) *client.Client
+
+// Exporter scrapes metrics and serves them to Prometheus
+type Exporter struct {
+	client  *client.Client
+	tracer  trace.Tracer
+	targets []Target
+
+	interval    time.Duration
+	span        time.Duration
+	metrics     []metricsquery.Metric
+	namespace   string
+	concurrency int
+	tokenTTL    time.Duration
+	readClient  ReadClientFunc
+	issue       func(ctx context.Context, ownerID ids.OwnerID) (models.StatsReadToken, error)
+	now         func() time.Time
+
+	tokensMu sync.Mutex
+	tokens   map[ids.OwnerID]*readToken
+
+	mu   sync.RWMutex
+	page []byte
+}
+
+// Option defines configuration options for the Exporter
+type Option func(*Exporter)
+
+// WithInterval sets the delay between two scrapes of Run
+func WithInterval(d time.Duration) Option {
+	return func(e *Exporter) {
+		e.interval = d
+	}
+}
+
+// WithSpan sets how far back each scrape reads. Status codes and requests
+// are counted over the span; metrics expose their latest value in it.
+func WithSpan(d time.Duration) Option {
+	return func(e *Exporter) {
+		e.span = d
+	}
+}
+
+// WithMetrics restricts the metrics scraped, all of them by default
+func WithMetrics(metrics ...metricsquery.Metric) Option {
+	return func(e *Exporter) {
+		e.metrics = metrics
+	}
+}
+
+// WithNamespace sets the prefix of the sample names, clevercloud by default
+func WithNamespace(namespace string) Option {
+	return func(e *Exporter) {
+		e.namespace = namespace
+	}
+}
+
+// WithConcurrency sets how many resources are scraped at once
+func WithConcurrency(n int) Option {
+	return func(e *Exporter) {
+		e.concurrency = n
+	}
+}
+
+// WithReadClient reads metrics with stats read tokens rather than with the
+// credentials of the Exporter client. A token is created for each owner and
+// renewed before it expires; f builds the client using it.
+func WithReadClient(f ReadClientFunc) Option {
+	return func(e *Exporter) {
+		e.readClient = f
+	}
+}
+
+// WithTokenTTL sets the lifetime of the stats read tokens
+func WithTokenTTL(d time.Duration) Option {
+	return func(e *Exporter) {
+		e.tokenTTL = d
+	}
+}
+
+// New creates an Exporter for targets
+func New(c *client.Client, tracer trace.Tracer, targets []Target, opts ...Option) *Exporter {
+	e := &Exporter{
+		client:      c,
+		tracer:      tracer,
+		targets:     targets,
+		interval:    time.Minute,
+		span:        5 * time.Minute,
+		namespace:   "clevercloud",
+		concurrency: 8,
+		tokenTTL:    time.Hour,
+		now:         time.Now,
+		tokens:      map[ids.OwnerID]*readToken{},
+	}
+	e.issue = e.issueToken
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.concurrency = max(e.concurrency, 1)
+	return e
+}
+
+// Run scrapes the targets until the context is canceled.
+// Scrape errors are recorded on the trace and exposed as samples.
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		_ = e.Scrape(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scrape reads every resource of the targets once and replaces the cached
+// samples. Resources that could not be read are exposed as down, and their
+// errors are joined.
+func (e *Exporter) Scrape(ctx context.Context) error {
+	ctx, span := e.tracer.Start(ctx, "metricsexporter.Scrape", trace.WithAttributes(attribute.Int("targets", len(e.targets))))
+	defer span.End()
+
+	start := e.now()
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		samples []metricsquery.Sample
+		errs    []error
+		slots   = make(chan struct{}, e.concurrency)
+	)
+	for _, target := range e.targets {
+		c, err := e.clientFor(ctx, target.OwnerID)
+		if err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("metricsexporter: %s: %w", target.OwnerID, err))
+			for _, resourceID := range target.Resources {
+				samples = append(samples, e.up(target, resourceID, false))
+			}
+			mu.Unlock()
+			continue
+		}
+
+		for _, resourceID := range target.Resources {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				scraped, err := e.scrapeResource(ctx, c, target, resourceID)
+				mu.Lock()
+				defer mu.Unlock()
+				samples = append(samples, scraped...)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("metricsexporter: %s: %w", resourceID, err))
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	samples = append(samples,
+		metricsquery.Sample{Name: e.name("exporter_last_scrape_timestamp_seconds"), Help: "When the last scrape started.", Value: float64(start.Unix())},
+		metricsquery.Sample{Name: e.name("exporter_scrape_duration_seconds"), Help: "How long the last scrape took.", Value: e.now().Sub(start).Seconds()},
+		metricsquery.Sample{Name: e.name("exporter_scrape_errors"), Help: "Errors during the last scrape.", Value: float64(len(errs))},
+	)
+	var page bytes.Buffer
+	if err := metricsquery.WriteSamples(&page, samples); err != nil {
+		errs = append(errs, fmt.Errorf("metricsexporter: %w", err))
+	}
+
+	e.mu.Lock()
+	e.page = page.Bytes()
+	e.mu.Unlock()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+// scrapeResource reads the metrics, status codes and requests of a resource
+func (e *Exporter) scrapeResource(ctx context.Context, c *client.Client, target Target, resourceID ids.ResourceID) ([]metricsquery.Sample, error) {
+	result, metricsErr := metricsquery.New(target.OwnerID, resourceID).Last(e.span).Metrics(e.metrics...).Run(ctx, c, e.tracer)
+
+	opts := []metrics.Option{metrics.WithApplicationid(string(resourceID)), metrics.WithSpan(metricsquery.FormatDuration(e.span))}
+	statuses := metrics.Liststatuscodedistribution(ctx, c, e.tracer, target.OwnerID, opts...)
+	requests := metrics.Listheatmap(ctx, c, e.tracer, target.OwnerID, opts...)
+
+	var s scraped
+	s.metrics = result
+	if !statuses.HasError() && statuses.Payload() != nil {
+		s.statuses = *statuses.Payload()
+	}
+	if !requests.HasError() && requests.Payload() != nil {
+		s.requests = *requests.Payload()
+	}
+
+	var errs []error
+	if metricsErr != nil {
+		errs = append(errs, metricsErr)
+	}
+	if statuses.HasError() {
+		errs = append(errs, statuses.Error())
+	}
+	if requests.HasError() {
+		errs = append(errs, requests.Error())
+	}
+
+	samples := e.samples(target, resourceID, s)
+	samples = append(samples, e.up(target, resourceID, len(errs) == 0))
+	return samples, errors.Join(errs...)
+}
+
+// ServeHTTP serves the samples of the latest scrape. It answers 503 until
+// the first scrape has completed.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.RLock()
+	page := e.page
+	e.mu.RUnlock()
+
+	if page == nil {
+		http.Error(w, "metricsexporter: no scrape completed yet", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = w.Write(page)
+}
+
+// readToken is a stats read token of an owner and the client using it
+type readToken struct {
+	token  models.StatsReadToken
+	client *client.Client
+}
+
+// clientFor returns the client reading the metrics of an owner. With a read
+// client function, it issues a stats read token for the owner when there is
+// none or the current one is in the last quarter of its lifetime. When
+// renewal fails the current token is used until it expires.
+func (e *Exporter) clientFor(ctx context.Context, ownerID ids.OwnerID) (*client.Client, error) {
+	if e.readClient == nil {
+		return e.client, nil
+	}
+
+	e.tokensMu.Lock()
+	defer e.tokensMu.Unlock()
+
+	now := e.now()
+	current := e.tokens[ownerID]
+	if current != nil && now.Before(current.token.ExpiresAt.Add(-e.tokenTTL/4)) {
+		return current.client, nil
+	}
+
+	token, err := e.issue(ctx, ownerID)
+	if err != nil {
+		if current != nil && now.Before(current.token.ExpiresAt) {
+			return current.client, nil
+		}
+		return nil, err
+	}
+	if token.ExpiresAt.IsZero() {
+		token.ExpiresAt = now.Add(e.tokenTTL)
+	}
+	renewed := &readToken{token: token, client: e.readClient(token.Token)}
+	e.tokens[ownerID] = renewed
+	return renewed.client, nil
+}
+
+func (e *Exporter) issueToken(ctx context.Context, ownerID ids.OwnerID) (models.StatsReadToken, error) {
+	response := metrics.Createorganizationstatsreadtoken(ctx, e.client, e.tracer, ownerID, &models.WannabeStatsReadToken{
+		Applications: []models.PlatformApplication{models.PlatformApplicationMETRICS, models.PlatformApplicationMetricsAccesslogs},
+		TTL:          metricsquery.FormatDuration(e.tokenTTL),
+	})
+	if response.HasError() {
+		return models.StatsReadToken{}, response.Error()
+	}
+	return *response.Payload(), nil
+}
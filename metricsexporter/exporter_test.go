@@ -0,0 +1,167 @@
+package metricsexporter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	metricsquery "go.clever-cloud.dev/sdk/metricsquery"
+	models "go.clever-cloud.dev/sdk/models"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+var t0 = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func TestStatusClass(t *testing.T) {
+	for code, want := range map[int]string{200: "2xx", 204: "2xx", 301: "3xx", 404: "4xx", 503: "5xx", 0: "unknown", 600: "unknown"} {
+		if got := StatusClass(code); got != want {
+			t.Errorf("StatusClass(%d) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestSamples(t *testing.T) {
+	e := New(nil, noop.NewTracerProvider().Tracer("test"), nil)
+	target := Target{OwnerID: "orga_1", Region: "par"}
+
+	ms := int(t0.UnixMilli())
+	cpu, mem := "12.5", "1024"
+	result, err := metricsquery.Decode([]models.MetricsDataResponse{
+		{Name: "cpu", Resource: "app_1", Unit: "%", Data: []models.MetricsDataValues{{Timestamp: &ms, Value: cpu}}},
+		{Name: "mem", Resource: "app_1", Unit: "B", Data: []models.MetricsDataValues{{Timestamp: &ms, Value: mem}}},
+		{Name: "disk", Resource: "app_1", Unit: "B"},
+	})
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	samples := e.samples(target, "app_1", scraped{
+		metrics: result,
+		statuses: []models.GetStatusCodeDistributionResponse{
+			{Date: t0, Statuses: []models.StatusCodeDistribution{{Code: 200, Count: 10}, {Code: 404, Count: 2}}},
+			{Date: t0.Add(time.Minute), Statuses: []models.StatusCodeDistribution{{Code: 201, Count: 5}}},
+		},
+		requests: []models.MetricsHeatmapResponse{{AccessCount: 7}, {AccessCount: 3}},
+	})
+	samples = append(samples, e.up(target, "app_1", true), e.up(Target{OwnerID: "orga_2"}, `app_"2"`, false))
+
+	var page strings.Builder
+	if err := metricsquery.WriteSamples(&page, samples); err != nil {
+		t.Fatal(err)
+	}
+	got := page.String()
+	labels := `owner="orga_1",region="par",resource="app_1"`
+	want := strings.Join([]string{
+		"# HELP clevercloud_cpu Latest value of the cpu metric.",
+		"# TYPE clevercloud_cpu gauge",
+		"clevercloud_cpu{" + labels + `,unit="%"} 12.5 ` + "1714557600000",
+		"# HELP clevercloud_exporter_up Whether the last scrape of the resource succeeded.",
+		"# TYPE clevercloud_exporter_up gauge",
+		"clevercloud_exporter_up{" + labels + "} 1",
+		`clevercloud_exporter_up{owner="orga_2",region="",resource="app_\"2\""} 0`,
+		"# HELP clevercloud_http_requests HTTP requests during the scrape span.",
+		"# TYPE clevercloud_http_requests gauge",
+		"clevercloud_http_requests{" + labels + "} 10",
+		"# HELP clevercloud_http_responses HTTP responses by status class during the scrape span.",
+		"# TYPE clevercloud_http_responses gauge",
+		"clevercloud_http_responses{" + labels + `,status_class="2xx"} 15`,
+		"clevercloud_http_responses{" + labels + `,status_class="4xx"} 2`,
+		"# HELP clevercloud_mem Latest value of the mem metric.",
+		"# TYPE clevercloud_mem gauge",
+		"clevercloud_mem{" + labels + `,unit="B"} 1024 ` + "1714557600000",
+	}, "\n") + "\n"
+	if got != want {
+		t.Errorf("exposition =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestClientFor(t *testing.T) {
+	now := t0
+	issued := 0
+	var issueErr error
+	readClients := map[*client.Client]string{}
+
+	e := New(nil, noop.NewTracerProvider().Tracer("test"), nil,
+		WithTokenTTL(time.Hour),
+		WithReadClient(func(token string) *client.Client {
+			c := &client.Client{}
+			readClients[c] = token
+			return c
+		}),
+	)
+	e.now = func() time.Time { return now }
+	e.issue = func(ctx context.Context, ownerID ids.OwnerID) (models.StatsReadToken, error) {
+		if issueErr != nil {
+			return models.StatsReadToken{}, issueErr
+		}
+		issued++
+		return models.StatsReadToken{Token: string(ownerID) + "-" + string(rune('0'+issued)), CreatedAt: now, ExpiresAt: now.Add(time.Hour)}, nil
+	}
+
+	ctx := context.Background()
+	first, err := e.clientFor(ctx, "orga_1")
+	if err != nil || readClients[first] != "orga_1-1" {
+		t.Fatalf("clientFor = %v, %v", readClients[first], err)
+	}
+
+	now = t0.Add(30 * time.Minute)
+	if c, _ := e.clientFor(ctx, "orga_1"); c != first || issued != 1 {
+		t.Errorf("token renewed %d times before the last quarter of its lifetime", issued-1)
+	}
+
+	now = t0.Add(50 * time.Minute)
+	issueErr = errors.New("unavailable")
+	if c, err := e.clientFor(ctx, "orga_1"); err != nil || c != first {
+		t.Errorf("failed renewal = %v, %v, want the current token", readClients[c], err)
+	}
+
+	now = t0.Add(61 * time.Minute)
+	if _, err := e.clientFor(ctx, "orga_1"); err == nil {
+		t.Error("expired token used after a failed renewal")
+	}
+
+	issueErr = nil
+	if c, err := e.clientFor(ctx, "orga_1"); err != nil || readClients[c] != "orga_1-2" {
+		t.Errorf("renewed token = %v, %v", readClients[c], err)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	e := New(nil, noop.NewTracerProvider().Tracer("test"), []Target{{OwnerID: "orga_1", Resources: []ids.ResourceID{"app_1"}}},
+		WithNamespace("cc"),
+		WithReadClient(func(string) *client.Client { return &client.Client{} }),
+	)
+	e.issue = func(context.Context, ids.OwnerID) (models.StatsReadToken, error) {
+		return models.StatsReadToken{}, errors.New("forbidden")
+	}
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("status before the first scrape = %d", recorder.Code)
+	}
+
+	if err := e.Scrape(context.Background()); err == nil {
+		t.Error("Scrape succeeded without a token")
+	}
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := recorder.Body.String()
+	if recorder.Code != http.StatusOK || !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("status = %d, content type = %q", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	for _, want := range []string{
+		`cc_exporter_up{owner="orga_1",region="",resource="app_1"} 0`,
+		"cc_exporter_scrape_errors 1",
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("exposition is missing %q:\n%s", want, body)
+		}
+	}
+}
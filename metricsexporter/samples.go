@@ -0,0 +1,96 @@
+package metricsexporter
+
+import (
+	"strconv"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	metricsquery "go.clever-cloud.dev/sdk/metricsquery"
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+// scraped holds what was read for a resource
+type scraped struct {
+	metrics  metricsquery.Result
+	statuses []models.GetStatusCodeDistributionResponse
+	requests []models.MetricsHeatmapResponse
+}
+
+func (e *Exporter) name(suffix string) string {
+	return metricsquery.PrometheusName(e.namespace, metricsquery.Metric(suffix))
+}
+
+// targetLabels label the samples of every resource of a target
+func targetLabels(target Target) []metricsquery.Label {
+	return []metricsquery.Label{
+		{Name: "owner", Value: string(target.OwnerID)},
+		{Name: "region", Value: target.Region},
+	}
+}
+
+// resourceLabels are the first labels of every sample of a resource
+func resourceLabels(target Target, resourceID ids.ResourceID) []metricsquery.Label {
+	return append(targetLabels(target), metricsquery.Label{Name: "resource", Value: string(resourceID)})
+}
+
+// up reports whether a resource was read without error
+func (e *Exporter) up(target Target, resourceID ids.ResourceID, ok bool) metricsquery.Sample {
+	s := metricsquery.Sample{
+		Name:   e.name("exporter_up"),
+		Help:   "Whether the last scrape of the resource succeeded.",
+		Labels: resourceLabels(target, resourceID),
+	}
+	if ok {
+		s.Value = 1
+	}
+	return s
+}
+
+// StatusClass returns the class of an HTTP status code, such as 2xx
+func StatusClass(code int) string {
+	if code < 100 || code > 599 {
+		return "unknown"
+	}
+	return strconv.Itoa(code/100) + "xx"
+}
+
+// samples turns what was read for a resource into samples. Metrics expose
+// their latest value with its timestamp; status codes and requests are
+// counted over the span of the scrape.
+func (e *Exporter) samples(target Target, resourceID ids.ResourceID, s scraped) []metricsquery.Sample {
+	// the series carry the resource label
+	samples := metricsquery.PrometheusSamples(s.metrics, e.namespace,
+		metricsquery.WithLabels(targetLabels(target)...),
+		metricsquery.WithHelp(func(metric metricsquery.Metric) string {
+			return "Latest value of the " + string(metric) + " metric."
+		}),
+	)
+
+	classes := map[string]int{}
+	for _, response := range s.statuses {
+		for _, status := range response.Statuses {
+			classes[StatusClass(status.Code)] += status.Count
+		}
+	}
+	for class, count := range classes {
+		samples = append(samples, metricsquery.Sample{
+			Name:   e.name("http_responses"),
+			Help:   "HTTP responses by status class during the scrape span.",
+			Labels: append(resourceLabels(target, resourceID), metricsquery.Label{Name: "status_class", Value: class}),
+			Value:  float64(count),
+		})
+	}
+
+	if s.requests != nil {
+		var count int
+		for _, location := range s.requests {
+			count += location.AccessCount
+		}
+		samples = append(samples, metricsquery.Sample{
+			Name:   e.name("http_requests"),
+			Help:   "HTTP requests during the scrape span.",
+			Labels: resourceLabels(target, resourceID),
+			Value:  float64(count),
+		})
+	}
+	return samples
+}
@@ -0,0 +1,265 @@
+package metricsquery
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	attribute "go.opentelemetry.io/otel/attribute"
+)
+
+// PrometheusName returns the name of a metric in the Prometheus exposition
+// format: the namespace and the metric joined by an underscore, with the
+// characters Prometheus does not allow replaced by underscores
+func PrometheusName(namespace string, metric Metric) string {
+	name := string(metric)
+	if namespace != "" {
+		name = namespace + "_" + name
+	}
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// Label is a label of a Prometheus sample
+type Label struct {
+	Name, Value string
+}
+
+// Sample is a gauge value of the Prometheus text exposition format
+type Sample struct {
+	Name string
+	// Help describes the gauge, it is written once per name when set
+	Help   string
+	Labels []Label
+	Value  float64
+	// Time is when the value was measured, zero for the time of the scrape
+	Time time.Time
+}
+
+// PrometheusOption defines options for the Prometheus exposition of a Result
+type PrometheusOption func(*prometheusOptions)
+
+type prometheusOptions struct {
+	labels []Label
+	help   func(Metric) string
+}
+
+// WithLabels adds labels to every sample, before the resource and the unit
+func WithLabels(labels ...Label) PrometheusOption {
+	return func(o *prometheusOptions) {
+		o.labels = append(o.labels, labels...)
+	}
+}
+
+// WithHelp sets the HELP line of each metric
+func WithHelp(help func(Metric) string) PrometheusOption {
+	return func(o *prometheusOptions) {
+		o.help = help
+	}
+}
+
+// PrometheusSamples returns the latest value of each series as a sample
+// with its timestamp, labelled with the resource and the unit. Series
+// without any value are left out.
+func PrometheusSamples(r Result, namespace string, opts ...PrometheusOption) []Sample {
+	var o prometheusOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var samples []Sample
+	for _, s := range r.Series {
+		k, value, ok := s.Latest()
+		if !ok {
+			continue
+		}
+		sample := Sample{
+			Name:   PrometheusName(namespace, s.Metric),
+			Labels: append(slices.Clone(o.labels), Label{Name: "resource", Value: s.Resource}, Label{Name: "unit", Value: s.Unit}),
+			Value:  value,
+			Time:   r.Times[k],
+		}
+		if o.help != nil {
+			sample.Help = o.help(s.Metric)
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// WritePrometheus writes the latest value of each series as a gauge in the
+// Prometheus text exposition format, as returned by PrometheusSamples
+func WritePrometheus(w io.Writer, r Result, namespace string, opts ...PrometheusOption) error {
+	return WriteSamples(w, PrometheusSamples(r, namespace, opts...))
+}
+
+// WriteSamples writes samples in the Prometheus text exposition format,
+// grouped by name with one HELP and TYPE line per name, then ordered by
+// labels. Every sample is a gauge.
+func WriteSamples(w io.Writer, samples []Sample) error {
+	type line struct {
+		Sample
+		labels string
+	}
+	lines := make([]line, len(samples))
+	for i, s := range samples {
+		lines[i] = line{Sample: s, labels: formatLabels(s.Labels)}
+	}
+	slices.SortStableFunc(lines, func(a, b line) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.labels, b.labels))
+	})
+
+	bw := bufio.NewWriter(w)
+	for i, l := range lines {
+		if i == 0 || lines[i-1].Name != l.Name {
+			if l.Help != "" {
+				bw.WriteString("# HELP " + l.Name + " " + l.Help + "\n")
+			}
+			bw.WriteString("# TYPE " + l.Name + " gauge\n")
+		}
+		bw.WriteString(l.Name + l.labels + " " + strconv.FormatFloat(l.Value, 'g', -1, 64))
+		if !l.Time.IsZero() {
+			bw.WriteString(" " + strconv.FormatInt(l.Time.UnixMilli(), 10))
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = l.Name + `="` + labelEscaper.Replace(l.Value) + `"`
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// The OTLP JSON encoding, limited to gauges of doubles. 64-bit integers are
+// strings, as the protobuf JSON mapping requires.
+type (
+	otlpMetricsData struct {
+		ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+	}
+	otlpResourceMetrics struct {
+		Resource     otlpResource       `json:"resource"`
+		ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+	}
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes,omitempty"`
+	}
+	otlpScopeMetrics struct {
+		Scope   otlpScope    `json:"scope"`
+		Metrics []otlpMetric `json:"metrics"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpMetric struct {
+		Name  string    `json:"name"`
+		Unit  string    `json:"unit,omitempty"`
+		Gauge otlpGauge `json:"gauge"`
+	}
+	otlpGauge struct {
+		DataPoints []otlpDataPoint `json:"dataPoints"`
+	}
+	otlpDataPoint struct {
+		Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+		TimeUnixNano string         `json:"timeUnixNano"`
+		AsDouble     float64        `json:"asDouble"`
+	}
+	otlpKeyValue struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+	otlpAnyValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+)
+
+// scopeName is the instrumentation scope of the exported metrics
+const scopeName = "go.clever-cloud.dev/sdk/metricsquery"
+
+func otlpAttribute(kv attribute.KeyValue) otlpKeyValue {
+	var value otlpAnyValue
+	switch kv.Value.Type() {
+	case attribute.BOOL:
+		b := kv.Value.AsBool()
+		value.BoolValue = &b
+	case attribute.INT64:
+		i := strconv.FormatInt(kv.Value.AsInt64(), 10)
+		value.IntValue = &i
+	case attribute.FLOAT64:
+		f := kv.Value.AsFloat64()
+		value.DoubleValue = &f
+	default:
+		s := kv.Value.Emit()
+		value.StringValue = &s
+	}
+	return otlpKeyValue{Key: string(kv.Key), Value: value}
+}
+
+// WriteOTLP writes the series as OTLP gauges in the JSON encoding, as
+// accepted by the /v1/metrics endpoint of OTLP/HTTP collectors. Every
+// series of a metric is a set of data points labelled with its resource;
+// missing values are left out. The attributes describe the resource the
+// metrics are exported for, such as its service.name.
+func WriteOTLP(w io.Writer, r Result, attrs ...attribute.KeyValue) error {
+	resource := otlpResource{}
+	for _, kv := range attrs {
+		resource.Attributes = append(resource.Attributes, otlpAttribute(kv))
+	}
+
+	var metrics []otlpMetric
+	index := map[[2]string]int{}
+	for _, s := range r.Series {
+		key := [2]string{string(s.Metric), s.Unit}
+		i, ok := index[key]
+		if !ok {
+			i = len(metrics)
+			index[key] = i
+			metrics = append(metrics, otlpMetric{Name: string(s.Metric), Unit: s.Unit, Gauge: otlpGauge{DataPoints: []otlpDataPoint{}}})
+		}
+		labels := []otlpKeyValue{otlpAttribute(attribute.String("resource", s.Resource))}
+		for k, v := range s.Values {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			metrics[i].Gauge.DataPoints = append(metrics[i].Gauge.DataPoints, otlpDataPoint{
+				Attributes:   labels,
+				TimeUnixNano: strconv.FormatInt(r.Times[k].UnixNano(), 10),
+				AsDouble:     v,
+			})
+		}
+	}
+	if metrics == nil {
+		metrics = []otlpMetric{}
+	}
+
+	return json.NewEncoder(w).Encode(otlpMetricsData{ResourceMetrics: []otlpResourceMetrics{{
+		Resource:     resource,
+		ScopeMetrics: []otlpScopeMetrics{{Scope: otlpScope{Name: scopeName}, Metrics: metrics}},
+	}}})
+}
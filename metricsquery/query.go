@@ -0,0 +1,172 @@
+// Package metricsquery queries resource metrics with typed parameters.
+//
+// A Query is built from times, durations and metric names rather than
+// preformatted strings, and its Result holds series aligned on the same
+// timestamps. Series convert units and downsample, and results export to the
+// Prometheus exposition format and to OTLP JSON.
+package metricsquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	metrics "go.clever-cloud.dev/sdk/services/metrics"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// Metric is the name of a metric. The constants are the metrics every
+// resource reports; other names are passed through as they are.
+type Metric string
+
+const (
+	CPU        Metric = "cpu"
+	Memory     Metric = "mem"
+	Disk       Metric = "disk"
+	NetworkIn  Metric = "net_in"
+	NetworkOut Metric = "net_out"
+)
+
+// ErrInvalidQuery is returned for queries that cannot be sent
+var ErrInvalidQuery = errors.New("metricsquery: invalid query")
+
+// Query describes the metrics to fetch for a resource. Its methods return
+// modified copies, so a base query can be shared.
+type Query struct {
+	ownerID    ids.OwnerID
+	resourceID ids.ResourceID
+
+	metrics  []Metric
+	from, to time.Time
+	span     time.Duration
+	interval time.Duration
+	fill     *bool
+}
+
+// New creates a query for the metrics of a resource
+func New(ownerID ids.OwnerID, resourceID ids.ResourceID) Query {
+	return Query{ownerID: ownerID, resourceID: resourceID}
+}
+
+// Metrics restricts the query to some metrics instead of all of them
+func (q Query) Metrics(metrics ...Metric) Query {
+	q.metrics = append([]Metric(nil), metrics...)
+	return q
+}
+
+// Between queries the metrics from one time to another
+func (q Query) Between(from time.Time, to time.Time) Query {
+	q.from, q.to, q.span = from, to, 0
+	return q
+}
+
+// Last queries the metrics of the last span of time
+func (q Query) Last(span time.Duration) Query {
+	q.from, q.to, q.span = time.Time{}, time.Time{}, span
+	return q
+}
+
+// Every sets the interval between the points of the series
+func (q Query) Every(interval time.Duration) Query {
+	q.interval = interval
+	return q
+}
+
+// Fill asks for points without data to be filled rather than left out
+func (q Query) Fill(fill bool) Query {
+	q.fill = &fill
+	return q
+}
+
+// Options validates the query and returns its options for metrics.Listmetrics
+func (q Query) Options() ([]metrics.Option, error) {
+	var opts []metrics.Option
+
+	switch {
+	case !q.from.IsZero() || !q.to.IsZero():
+		if q.from.IsZero() || q.to.IsZero() || !q.from.Before(q.to) {
+			return nil, fmt.Errorf("%w: from %s must be before to %s", ErrInvalidQuery, q.from, q.to)
+		}
+		opts = append(opts, metrics.WithFrom(formatTime(q.from)), metrics.WithTo(formatTime(q.to)))
+	case q.span < 0:
+		return nil, fmt.Errorf("%w: negative span %s", ErrInvalidQuery, q.span)
+	case q.span > 0:
+		opts = append(opts, metrics.WithSpan(FormatDuration(q.span)))
+	}
+
+	if q.interval < 0 {
+		return nil, fmt.Errorf("%w: negative interval %s", ErrInvalidQuery, q.interval)
+	}
+	if q.interval > 0 {
+		opts = append(opts, metrics.WithInterval(FormatDuration(q.interval)))
+	}
+
+	if len(q.metrics) > 0 {
+		only := make([]string, len(q.metrics))
+		for i, metric := range q.metrics {
+			only[i] = string(metric)
+		}
+		opts = append(opts, metrics.WithOnly(only))
+	}
+	if q.fill != nil {
+		opts = append(opts, metrics.WithFill(*q.fill))
+	}
+	return opts, nil
+}
+
+// Run fetches the metrics and aligns them. Values that cannot be decoded
+// are left missing and reported in the joined error along with the result.
+func (q Query) Run(ctx context.Context, c *client.Client, tracer trace.Tracer) (Result, error) {
+	ctx, span := tracer.Start(ctx, "metricsquery.Run", trace.WithAttributes(
+		attribute.String("ownerId", string(q.ownerID)),
+		attribute.String("resourceId", string(q.resourceID)),
+	))
+	defer span.End()
+
+	opts, err := q.Options()
+	if err != nil {
+		span.RecordError(err)
+		return Result{}, err
+	}
+
+	response := metrics.Listmetrics(ctx, c, tracer, q.ownerID, q.resourceID, opts...)
+	if response.HasError() {
+		return Result{}, response.Error()
+	}
+	if response.Payload() == nil {
+		return Result{}, nil
+	}
+
+	result, err := Decode(*response.Payload())
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// FormatDuration formats a duration in ISO 8601, such as PT1H30M, as the
+// metrics API expects for spans and intervals
+func FormatDuration(d time.Duration) string {
+	var b strings.Builder
+	b.WriteString("PT")
+	if h := d / time.Hour; h != 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+	}
+	if m := d % time.Hour / time.Minute; m != 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+	}
+	if s := d % time.Minute; s != 0 || d == 0 {
+		b.WriteString(strconv.FormatFloat(s.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
@@ -0,0 +1,246 @@
+package metricsquery
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+	metrics "go.clever-cloud.dev/sdk/services/metrics"
+	attribute "go.opentelemetry.io/otel/attribute"
+)
+
+func apply(t *testing.T, q Query) metrics.Options {
+	t.Helper()
+	opts, err := q.Options()
+	if err != nil {
+		t.Fatalf("Options failed: %v", err)
+	}
+	var options metrics.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+func TestOptions(t *testing.T) {
+	base := New("orga_1", "app_1").Metrics(CPU, Memory).Every(5 * time.Minute)
+
+	last := apply(t, base.Last(90*time.Minute).Fill(true))
+	if last.Span == nil || *last.Span != "PT1H30M" || last.From != nil {
+		t.Errorf("span = %v, from = %v", last.Span, last.From)
+	}
+	if last.Interval == nil || *last.Interval != "PT5M" {
+		t.Errorf("interval = %v", last.Interval)
+	}
+	if last.Only == nil || !slices.Equal(*last.Only, []string{"cpu", "mem"}) {
+		t.Errorf("only = %v", last.Only)
+	}
+	if last.Fill == nil || !*last.Fill {
+		t.Errorf("fill = %v", last.Fill)
+	}
+
+	from := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+	between := apply(t, base.Between(from, from.Add(time.Hour)))
+	if between.From == nil || *between.From != "2024-05-01T10:00:00Z" || between.To == nil || *between.To != "2024-05-01T11:00:00Z" {
+		t.Errorf("from = %v, to = %v", between.From, between.To)
+	}
+	if between.Span != nil || between.Fill != nil {
+		t.Errorf("span = %v, fill = %v", between.Span, between.Fill)
+	}
+
+	for _, q := range []Query{base.Between(from, from), base.Last(-time.Hour), base.Every(-time.Second)} {
+		if _, err := q.Options(); !errors.Is(err, ErrInvalidQuery) {
+			t.Errorf("Options() error = %v, want ErrInvalidQuery", err)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                       "PT0S",
+		30 * time.Second:        "PT30S",
+		1500 * time.Millisecond: "PT1.5S",
+		26 * time.Hour:          "PT26H",
+		time.Hour + time.Second: "PT1H1S",
+	} {
+		if got := FormatDuration(d); got != want {
+			t.Errorf("FormatDuration(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func point(ms int, value string) models.MetricsDataValues {
+	return models.MetricsDataValues{Timestamp: &ms, Value: value}
+}
+
+var t0 = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func testResult(t *testing.T) Result {
+	t.Helper()
+	ms := int(t0.UnixMilli())
+	result, err := Decode([]models.MetricsDataResponse{
+		{Name: "cpu", Resource: "app_1", Unit: "%", Data: []models.MetricsDataValues{
+			point(ms, "10"), point(ms+60_000, "20"), point(ms+120_000, "30"), point(ms+180_000, "40"),
+		}},
+		{Name: "net_in", Resource: "app_1", Unit: "B/s", Data: []models.MetricsDataValues{
+			// Seconds rather than milliseconds
+			point(ms/1000, "1048576"), point(ms/1000+120, "2097152"),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	return result
+}
+
+func TestDecode(t *testing.T) {
+	result := testResult(t)
+	if len(result.Times) != 4 || !result.Times[3].Equal(t0.Add(3*time.Minute)) {
+		t.Fatalf("times = %v", result.Times)
+	}
+	net, ok := result.Get(NetworkIn, "app_1")
+	if !ok {
+		t.Fatal("net_in series not found")
+	}
+	if net.Values[0] != 1<<20 || !math.IsNaN(net.Values[1]) || net.Values[2] != 2<<20 || !math.IsNaN(net.Values[3]) {
+		t.Errorf("net_in values = %v", net.Values)
+	}
+	if k, v, ok := net.Latest(); !ok || k != 2 || v != 2<<20 {
+		t.Errorf("Latest() = %d, %v, %v", k, v, ok)
+	}
+
+	_, err := Decode([]models.MetricsDataResponse{{Name: "cpu", Data: []models.MetricsDataValues{point(1, "n/a"), {Value: "1"}}}})
+	if !errors.Is(err, ErrValue) {
+		t.Errorf("error = %v, want ErrValue", err)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	result := testResult(t)
+	cpu, _ := result.Get(CPU, "")
+	ratio, err := cpu.Convert("ratio")
+	if err != nil || ratio.Values[0] != 0.1 {
+		t.Errorf("ratio = %v, %v", ratio.Values, err)
+	}
+	if _, err := cpu.Convert("MiB"); !errors.Is(err, ErrUnit) {
+		t.Errorf("error = %v, want ErrUnit", err)
+	}
+
+	converted := result.Convert("Mbps")
+	net, _ := converted.Get(NetworkIn, "")
+	if net.Unit != "Mbps" || math.Abs(net.Values[0]-8.388608) > 1e-9 {
+		t.Errorf("net_in = %v %s", net.Values, net.Unit)
+	}
+	if cpu, _ := converted.Get(CPU, ""); cpu.Unit != "%" {
+		t.Errorf("cpu converted to %s", cpu.Unit)
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	result := testResult(t)
+	tests := []struct {
+		aggregate Aggregator
+		want      []float64
+	}{
+		{aggregate: Avg, want: []float64{15, 35}},
+		{aggregate: Max, want: []float64{20, 40}},
+		{aggregate: Min, want: []float64{10, 30}},
+		{aggregate: Sum, want: []float64{30, 70}},
+		{aggregate: Percentile(50), want: []float64{15, 35}},
+		{aggregate: Percentile(100), want: []float64{20, 40}},
+	}
+	for i, tt := range tests {
+		downsampled := result.Downsample(2*time.Minute, tt.aggregate)
+		if len(downsampled.Times) != 2 || !downsampled.Times[1].Equal(t0.Add(2*time.Minute)) {
+			t.Fatalf("times = %v", downsampled.Times)
+		}
+		cpu, _ := downsampled.Get(CPU, "")
+		if !slices.Equal(cpu.Values, tt.want) {
+			t.Errorf("aggregator %d: values = %v, want %v", i, cpu.Values, tt.want)
+		}
+	}
+
+	net, _ := result.Downsample(time.Minute, Avg).Get(NetworkIn, "")
+	if !math.IsNaN(net.Values[1]) {
+		t.Errorf("missing bucket = %v", net.Values[1])
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	result := testResult(t)
+	result.Series = append(result.Series, Series{Metric: "disk", Resource: "app_1", Values: []float64{math.NaN(), math.NaN(), math.NaN(), math.NaN()}})
+
+	var buf bytes.Buffer
+	if err := WritePrometheus(&buf, result, "clevercloud"); err != nil {
+		t.Fatalf("WritePrometheus failed: %v", err)
+	}
+	ms := t0.UnixMilli()
+	want := strings.Join([]string{
+		"# TYPE clevercloud_cpu gauge",
+		`clevercloud_cpu{resource="app_1",unit="%"} 40 ` + itoa(ms+180_000),
+		"# TYPE clevercloud_net_in gauge",
+		`clevercloud_net_in{resource="app_1",unit="B/s"} 2.097152e+06 ` + itoa(ms+120_000),
+	}, "\n") + "\n"
+	if buf.String() != want {
+		t.Errorf("exposition =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	help := func(m Metric) string { return "Latest " + string(m) + "." }
+	if err := WritePrometheus(&buf, result, "", WithLabels(Label{Name: "owner", Value: `orga_"1"`}), WithHelp(help)); err != nil {
+		t.Fatalf("WritePrometheus failed: %v", err)
+	}
+	want = strings.Join([]string{
+		"# HELP cpu Latest cpu.",
+		"# TYPE cpu gauge",
+		`cpu{owner="orga_\"1\"",resource="app_1",unit="%"} 40 ` + itoa(ms+180_000),
+		"# HELP net_in Latest net_in.",
+		"# TYPE net_in gauge",
+		`net_in{owner="orga_\"1\"",resource="app_1",unit="B/s"} 2.097152e+06 ` + itoa(ms+120_000),
+	}, "\n") + "\n"
+	if buf.String() != want {
+		t.Errorf("exposition with labels and help =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	if got := PrometheusName("", "1st-metric.rate"); got != "_st_metric_rate" {
+		t.Errorf("PrometheusName = %q", got)
+	}
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+func TestWriteOTLP(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteOTLP(&buf, testResult(t), attribute.String("service.name", "app")); err != nil {
+		t.Fatalf("WriteOTLP failed: %v", err)
+	}
+
+	var data otlpMetricsData
+	if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	rm := data.ResourceMetrics[0]
+	if attr := rm.Resource.Attributes[0]; attr.Key != "service.name" || *attr.Value.StringValue != "app" {
+		t.Errorf("resource attribute = %+v", attr)
+	}
+	metrics := rm.ScopeMetrics[0].Metrics
+	if len(metrics) != 2 || metrics[1].Name != "net_in" || metrics[1].Unit != "B/s" {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+	points := metrics[1].Gauge.DataPoints
+	if len(points) != 2 || points[1].AsDouble != 2<<20 || points[1].TimeUnixNano != itoa(t0.Add(2*time.Minute).UnixNano()) {
+		t.Errorf("points = %+v", points)
+	}
+	if !strings.Contains(buf.String(), `"timeUnixNano":"`) {
+		t.Error("timeUnixNano is not encoded as a string")
+	}
+}
@@ -0,0 +1,293 @@
+package metricsquery
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	models "go.clever-cloud.dev/sdk/models"
+)
+
+var (
+	// ErrValue is returned for values of a response that cannot be decoded
+	ErrValue = errors.New("metricsquery: invalid value")
+	// ErrUnit is returned when converting between units that do not measure the same thing
+	ErrUnit = errors.New("metricsquery: incompatible units")
+)
+
+// Result holds series aligned on the same timestamps
+type Result struct {
+	Times  []time.Time
+	Series []Series
+}
+
+// Series holds the values of a metric of a resource. Values are aligned on
+// the times of the Result, with NaN where the metric has no point.
+type Series struct {
+	Metric   Metric
+	Resource string
+	Unit     string
+	Values   []float64
+}
+
+// secondsBefore tells timestamps in seconds from those in milliseconds:
+// as milliseconds it is in 1973, as seconds in 5138.
+const secondsBefore = 1e11
+
+// Decode aligns the series of a response on the union of their timestamps,
+// in order. Points without a timestamp or with a value that cannot be parsed
+// are left missing and reported in the joined error.
+func Decode(responses []models.MetricsDataResponse) (Result, error) {
+	var errs []error
+	seen := map[int64]bool{}
+	points := make([]map[int64]float64, len(responses))
+	for i, response := range responses {
+		points[i] = map[int64]float64{}
+		for _, data := range response.Data {
+			if data.Timestamp == nil {
+				errs = append(errs, fmt.Errorf("%w: %s %s: point without timestamp", ErrValue, response.Resource, response.Name))
+				continue
+			}
+			ms := int64(*data.Timestamp)
+			if ms < secondsBefore {
+				ms *= 1000
+			}
+			value, err := strconv.ParseFloat(strings.TrimSpace(data.Value), 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w: %s %s at %d: %q", ErrValue, response.Resource, response.Name, ms, data.Value))
+				continue
+			}
+			points[i][ms] = value
+			seen[ms] = true
+		}
+	}
+
+	stamps := make([]int64, 0, len(seen))
+	for ms := range seen {
+		stamps = append(stamps, ms)
+	}
+	slices.Sort(stamps)
+
+	result := Result{Times: make([]time.Time, len(stamps))}
+	for k, ms := range stamps {
+		result.Times[k] = time.UnixMilli(ms).UTC()
+	}
+	for i, response := range responses {
+		s := Series{
+			Metric:   Metric(response.Name),
+			Resource: response.Resource,
+			Unit:     response.Unit,
+			Values:   make([]float64, len(stamps)),
+		}
+		for k, ms := range stamps {
+			value, ok := points[i][ms]
+			if !ok {
+				value = math.NaN()
+			}
+			s.Values[k] = value
+		}
+		result.Series = append(result.Series, s)
+	}
+	return result, errors.Join(errs...)
+}
+
+// Get returns the series of a metric for a resource. An empty resource
+// matches any.
+func (r Result) Get(metric Metric, resource string) (Series, bool) {
+	for _, s := range r.Series {
+		if s.Metric == metric && (resource == "" || s.Resource == resource) {
+			return s, true
+		}
+	}
+	return Series{}, false
+}
+
+// Latest returns the last value of a series that is not missing, and its index
+func (s Series) Latest() (int, float64, bool) {
+	for k := len(s.Values) - 1; k >= 0; k-- {
+		if !math.IsNaN(s.Values[k]) {
+			return k, s.Values[k], true
+		}
+	}
+	return 0, 0, false
+}
+
+type unitScale struct {
+	dimension string
+	factor    float64
+}
+
+// units maps the units metrics are reported in, lower case, to what they
+// measure and their factor to the base unit of that dimension
+var units = map[string]unitScale{
+	"b":       {"data", 1},
+	"byte":    {"data", 1},
+	"bytes":   {"data", 1},
+	"kb":      {"data", 1e3},
+	"kib":     {"data", 1 << 10},
+	"mb":      {"data", 1e6},
+	"mib":     {"data", 1 << 20},
+	"gb":      {"data", 1e9},
+	"gib":     {"data", 1 << 30},
+	"tb":      {"data", 1e12},
+	"tib":     {"data", 1 << 40},
+	"bit":     {"data", 1.0 / 8},
+	"bits":    {"data", 1.0 / 8},
+	"kbit":    {"data", 1e3 / 8},
+	"mbit":    {"data", 1e6 / 8},
+	"gbit":    {"data", 1e9 / 8},
+	"%":       {"ratio", 0.01},
+	"percent": {"ratio", 0.01},
+	"ratio":   {"ratio", 1},
+	"1":       {"ratio", 1},
+	"ns":      {"time", 1e-9},
+	"us":      {"time", 1e-6},
+	"ms":      {"time", 1e-3},
+	"s":       {"time", 1},
+	"min":     {"time", 60},
+	"h":       {"time", 3600},
+}
+
+// scaleOf returns the scale of a unit. Rates such as MiB/s divide a unit by
+// a time unit, and bps, kbps... are bits per second.
+func scaleOf(unit string) (unitScale, bool) {
+	key := strings.ToLower(strings.TrimSpace(unit))
+	if scale, ok := units[key]; ok {
+		return scale, true
+	}
+	if base, ok := strings.CutSuffix(key, "ps"); ok {
+		if scale, ok := units[base+"it"]; ok {
+			return unitScale{"data/time", scale.factor}, true
+		}
+	}
+	if num, den, ok := strings.Cut(key, "/"); ok {
+		n, nok := units[num]
+		d, dok := units[den]
+		if nok && dok && d.dimension == "time" {
+			return unitScale{n.dimension + "/time", n.factor / d.factor}, true
+		}
+	}
+	return unitScale{}, false
+}
+
+// Convert returns the series in another unit of the same dimension, such as
+// bytes to MiB, kB/s to Mbit/s or percent to ratio
+func (s Series) Convert(unit string) (Series, error) {
+	if strings.EqualFold(s.Unit, unit) {
+		return s, nil
+	}
+	from, fok := scaleOf(s.Unit)
+	to, tok := scaleOf(unit)
+	if !fok || !tok || from.dimension != to.dimension {
+		return s, fmt.Errorf("%w: %q to %q", ErrUnit, s.Unit, unit)
+	}
+
+	factor := from.factor / to.factor
+	converted := s
+	converted.Unit = unit
+	converted.Values = make([]float64, len(s.Values))
+	for k, v := range s.Values {
+		converted.Values[k] = v * factor
+	}
+	return converted, nil
+}
+
+// Convert converts the series whose unit measures the same thing as unit,
+// leaving the others as they are
+func (r Result) Convert(unit string) Result {
+	converted := r
+	converted.Series = make([]Series, len(r.Series))
+	for i, s := range r.Series {
+		if c, err := s.Convert(unit); err == nil {
+			s = c
+		}
+		converted.Series[i] = s
+	}
+	return converted
+}
+
+// Aggregator reduces the values of a bucket to one. Missing values are
+// removed beforehand and buckets without values stay missing.
+type Aggregator func(values []float64) float64
+
+// Avg is the mean of the values
+func Avg(values []float64) float64 {
+	return Sum(values) / float64(len(values))
+}
+
+// Sum is the sum of the values
+func Sum(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// Max is the largest value
+func Max(values []float64) float64 {
+	return slices.Max(values)
+}
+
+// Min is the smallest value
+func Min(values []float64) float64 {
+	return slices.Min(values)
+}
+
+// Percentile returns an aggregator of the p-th percentile of the values, p
+// between 0 and 100, interpolating between the closest ranks
+func Percentile(p float64) Aggregator {
+	return func(values []float64) float64 {
+		sorted := slices.Clone(values)
+		slices.Sort(sorted)
+		rank := math.Max(0, math.Min(1, p/100)) * float64(len(sorted)-1)
+		lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+		return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+	}
+}
+
+// Downsample aggregates the series into buckets of step, aligned on
+// multiples of step since the Unix epoch so that the buckets of separate
+// queries line up. Each bucket is timed at its start.
+func (r Result) Downsample(step time.Duration, aggregate Aggregator) Result {
+	if step <= 0 || len(r.Times) == 0 {
+		return r
+	}
+
+	var (
+		times  []time.Time
+		bounds []int
+	)
+	for k, t := range r.Times {
+		bucket := t.Truncate(step)
+		if n := len(times); n == 0 || !times[n-1].Equal(bucket) {
+			times = append(times, bucket)
+			bounds = append(bounds, k)
+		}
+	}
+	bounds = append(bounds, len(r.Times))
+
+	downsampled := Result{Times: times, Series: make([]Series, len(r.Series))}
+	for i, s := range r.Series {
+		d := s
+		d.Values = make([]float64, len(times))
+		for b := range times {
+			var values []float64
+			for _, v := range s.Values[bounds[b]:bounds[b+1]] {
+				if !math.IsNaN(v) {
+					values = append(values, v)
+				}
+			}
+			d.Values[b] = math.NaN()
+			if len(values) > 0 {
+				d.Values[b] = aggregate(values)
+			}
+		}
+		downsampled.Series[i] = d
+	}
+	return downsampled
+}
@@ -0,0 +1,172 @@
+package registrycred
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DockerConfig is a Docker config.json file.
+// Keys other than "auths" are preserved as is.
+type DockerConfig struct {
+	path  string
+	raw   map[string]json.RawMessage
+	auths map[string]dockerAuth
+}
+
+type dockerAuth struct {
+	Auth string
+	// Extra keeps other fields such as identitytoken untouched
+	Extra map[string]json.RawMessage
+}
+
+// DefaultDockerConfigPath returns $DOCKER_CONFIG/config.json or ~/.docker/config.json
+func DefaultDockerConfigPath() (string, error) {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".docker", "config.json"), nil
+}
+
+// LoadDockerConfig reads a Docker config file, returning an empty config if it does not exist
+func LoadDockerConfig(path string) (*DockerConfig, error) {
+	cfg := &DockerConfig{
+		path:  path,
+		raw:   map[string]json.RawMessage{},
+		auths: map[string]dockerAuth{},
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &cfg.raw); err != nil {
+		return nil, fmt.Errorf("registrycred: parse %s: %w", path, err)
+	}
+	if auths, ok := cfg.raw["auths"]; ok {
+		entries := map[string]map[string]json.RawMessage{}
+		if err := json.Unmarshal(auths, &entries); err != nil {
+			return nil, fmt.Errorf("registrycred: parse auths of %s: %w", path, err)
+		}
+		for server, fields := range entries {
+			entry := dockerAuth{Extra: fields}
+			if auth, ok := fields["auth"]; ok {
+				_ = json.Unmarshal(auth, &entry.Auth)
+				delete(fields, "auth")
+			}
+			cfg.auths[server] = entry
+		}
+	}
+	return cfg, nil
+}
+
+// SetAuth records a user name and secret for a registry
+func (c *DockerConfig) SetAuth(serverURL string, username string, secret string) {
+	entry := c.auths[serverURL]
+	entry.Auth = base64.StdEncoding.EncodeToString([]byte(username + ":" + secret))
+	c.auths[serverURL] = entry
+}
+
+// Auth returns the user name and secret recorded for a registry
+func (c *DockerConfig) Auth(serverURL string) (username string, secret string, ok bool) {
+	entry, found := c.auths[serverURL]
+	if !found || entry.Auth == "" {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+	if err != nil {
+		return "", "", false
+	}
+	username, secret, ok = strings.Cut(string(decoded), ":")
+	return username, secret, ok
+}
+
+// DeleteAuth removes the entry of a registry
+func (c *DockerConfig) DeleteAuth(serverURL string) {
+	delete(c.auths, serverURL)
+}
+
+// SetCredentialHelper configures Docker to use the named helper for a registry.
+// Docker then runs docker-credential-<helper>.
+func (c *DockerConfig) SetCredentialHelper(serverURL string, helper string) error {
+	helpers := map[string]string{}
+	if raw, ok := c.raw["credHelpers"]; ok {
+		if err := json.Unmarshal(raw, &helpers); err != nil {
+			return err
+		}
+	}
+	helpers[serverURL] = helper
+
+	raw, err := json.Marshal(helpers)
+	if err != nil {
+		return err
+	}
+	c.raw["credHelpers"] = raw
+	return nil
+}
+
+// Save writes the config file with owner only permissions
+func (c *DockerConfig) Save() error {
+	entries := map[string]map[string]json.RawMessage{}
+	for server, entry := range c.auths {
+		fields := map[string]json.RawMessage{}
+		for k, v := range entry.Extra {
+			fields[k] = v
+		}
+		if entry.Auth != "" {
+			auth, _ := json.Marshal(entry.Auth)
+			fields["auth"] = auth
+		}
+		entries[server] = fields
+	}
+
+	auths, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	c.raw["auths"] = auths
+
+	data, err := json.MarshalIndent(c.raw, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	return writeFile(c.path, data)
+}
+
+// writeFile atomically replaces a file readable by its owner only
+func writeFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,200 @@
+package registrycred
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// notFoundMessage is the error Docker recognizes as "no credentials for this registry"
+const notFoundMessage = "credentials not found in native keychain"
+
+// helperCredential is the JSON document exchanged with Docker
+type helperCredential struct {
+	ServerURL string `json:"ServerURL,omitempty"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// Helper implements the Docker credential helper protocol on top of a Store.
+// Registries listed in Registries get a token issued through the SDK when
+// none is stored, and rotated by Rotator when the stored one is about to
+// expire.
+type Helper struct {
+	store Store
+
+	Registries map[string]Registry
+	// Rotator issues and rotates tokens. Its Window is the shortest remaining
+	// lifetime of a token handed to Docker; its Overlap keeps the replaced
+	// token valid for pulls and pushes still using it.
+	Rotator *Rotator
+}
+
+// NewHelper creates a credential helper for the given registries. New tokens
+// are checked with verify before being handed to Docker: without it, tokens
+// of the registries are never issued nor rotated.
+func NewHelper(c *client.Client, tracer trace.Tracer, store Store, verify Verifier, registries ...Registry) *Helper {
+	h := &Helper{
+		store:      store,
+		Registries: map[string]Registry{},
+		Rotator:    NewRotator(c, tracer, store, time.Hour, verify),
+	}
+	h.Rotator.Overlap = time.Hour
+	for _, registry := range registries {
+		h.Registries[registry.ServerURL] = registry
+	}
+	return h
+}
+
+// Main runs the helper action named in args[0] and returns the process exit code.
+// Errors are written to stdout, as the protocol expects.
+func (h *Helper) Main(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer) int {
+	if len(args) != 1 {
+		fmt.Fprintln(stdout, "usage: docker-credential-<name> get|store|erase|list")
+		return 1
+	}
+
+	if err := h.Serve(ctx, args[0], stdin, stdout); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			fmt.Fprintln(stdout, notFoundMessage)
+		} else {
+			fmt.Fprintln(stdout, err)
+		}
+		return 1
+	}
+	return 0
+}
+
+// Serve runs one helper action, reading its input from in and writing its result to out
+func (h *Helper) Serve(ctx context.Context, action string, in io.Reader, out io.Writer) error {
+	switch action {
+	case "get":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		credential, err := h.Get(ctx, serverURL)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(out).Encode(helperCredential{
+			ServerURL: credential.ServerURL,
+			Username:  credential.Username,
+			Secret:    credential.Secret,
+		})
+
+	case "store":
+		var payload helperCredential
+		if err := json.NewDecoder(in).Decode(&payload); err != nil {
+			return fmt.Errorf("registrycred: invalid store payload: %w", err)
+		}
+		if payload.ServerURL == "" {
+			return errors.New("registrycred: missing ServerURL")
+		}
+		return h.store.Put(Credential{ServerURL: payload.ServerURL, Username: payload.Username, Secret: payload.Secret})
+
+	case "erase":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		return h.Erase(ctx, serverURL)
+
+	case "list":
+		credentials, err := h.store.List()
+		if err != nil {
+			return err
+		}
+		list := map[string]string{}
+		for _, credential := range credentials {
+			list[credential.ServerURL] = credential.Username
+		}
+		return json.NewEncoder(out).Encode(list)
+
+	default:
+		return fmt.Errorf("registrycred: unknown action %q", action)
+	}
+}
+
+// Get returns a valid credential for a registry, issuing or rotating its token when needed
+func (h *Helper) Get(ctx context.Context, serverURL string) (Credential, error) {
+	registry, ok := h.Registries[serverURL]
+	if !ok {
+		// Stored by Docker, we cannot renew it
+		return h.store.Get(serverURL)
+	}
+
+	credential, _, err := h.Rotator.Rotate(ctx, registry)
+	return credential, err
+}
+
+// Erase revokes the token of a registry when it was issued by the SDK, along
+// with the tokens it replaced, and forgets the credential
+func (h *Helper) Erase(ctx context.Context, serverURL string) error {
+	credential, err := h.store.Get(serverURL)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, c := range append(credential.Retired, credential) {
+		if err := h.Rotator.revoke(ctx, c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	return h.store.Delete(serverURL)
+}
+
+func readServerURL(in io.Reader) (string, error) {
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	serverURL := strings.TrimSpace(line)
+	if serverURL == "" {
+		return "", errors.New("registrycred: missing server URL")
+	}
+	return serverURL, nil
+}
+
+// HTTPVerifier checks a credential against the registry /v2/ endpoint
+func HTTPVerifier(httpClient *http.Client) Verifier {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return func(ctx context.Context, credential Credential) error {
+		base := credential.ServerURL
+		if !strings.Contains(base, "://") {
+			base = "https://" + base
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(base, "/")+"/v2/", nil)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(credential.Username, credential.Secret)
+
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("registrycred: %s rejected the new token: %s", credential.ServerURL, res.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package registrycred
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHelperProtocol(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "credentials.json"))
+	helper := NewHelper(nil, nil, store, nil)
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	if code := helper.Main(ctx, []string{"get"}, strings.NewReader("registry.example.com\n"), &out); code != 1 {
+		t.Fatalf("get on empty store exit code = %d, want 1", code)
+	}
+	if strings.TrimSpace(out.String()) != notFoundMessage {
+		t.Errorf("get on empty store output = %q", out.String())
+	}
+
+	out.Reset()
+	payload := `{"ServerURL":"registry.example.com","Username":"ci","Secret":"s3cret"}`
+	if code := helper.Main(ctx, []string{"store"}, strings.NewReader(payload), &out); code != 0 {
+		t.Fatalf("store exit code = %d: %s", code, out.String())
+	}
+
+	out.Reset()
+	if code := helper.Main(ctx, []string{"get"}, strings.NewReader("registry.example.com"), &out); code != 0 {
+		t.Fatalf("get exit code = %d: %s", code, out.String())
+	}
+	var got helperCredential
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("get output is not JSON: %v", err)
+	}
+	if got.Username != "ci" || got.Secret != "s3cret" {
+		t.Errorf("get = %+v", got)
+	}
+
+	out.Reset()
+	if code := helper.Main(ctx, []string{"list"}, strings.NewReader(""), &out); code != 0 {
+		t.Fatalf("list exit code = %d", code)
+	}
+	if strings.TrimSpace(out.String()) != `{"registry.example.com":"ci"}` {
+		t.Errorf("list = %s", out.String())
+	}
+
+	out.Reset()
+	if code := helper.Main(ctx, []string{"erase"}, strings.NewReader("registry.example.com\n"), &out); code != 0 {
+		t.Fatalf("erase exit code = %d: %s", code, out.String())
+	}
+	if _, err := store.Get("registry.example.com"); err != ErrNotFound {
+		t.Errorf("credential still stored after erase: %v", err)
+	}
+}
+
+func TestDockerConfigPreservesUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	original := `{"auths":{"other.io":{"auth":"dXNlcjpwYXNz","identitytoken":"tok"}},"credsStore":"desktop"}`
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteDockerConfig(path, Credential{ServerURL: "registry.example.com", Username: "ci", Secret: "s3cret"}); err != nil {
+		t.Fatalf("WriteDockerConfig failed: %v", err)
+	}
+
+	cfg, err := LoadDockerConfig(path)
+	if err != nil {
+		t.Fatalf("LoadDockerConfig failed: %v", err)
+	}
+	if user, secret, ok := cfg.Auth("registry.example.com"); !ok || user != "ci" || secret != "s3cret" {
+		t.Errorf("Auth = %q, %q, %v", user, secret, ok)
+	}
+	if user, secret, ok := cfg.Auth("other.io"); !ok || user != "user" || secret != "pass" {
+		t.Errorf("existing auth lost: %q, %q, %v", user, secret, ok)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"identitytoken": "tok"`) || !strings.Contains(string(data), `"credsStore": "desktop"`) {
+		t.Errorf("unknown fields not preserved:\n%s", data)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("config permissions = %v, want 0600", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,362 @@
+// Package registrycred manages container registry credentials.
+//
+// Registry tokens are issued through the SDK and stored locally. They can be
+// written into a Docker config.json, served to Docker through the credential
+// helper protocol, and rotated before they expire.
+package registrycred
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
+	models "go.clever-cloud.dev/sdk/models"
+	containerregistry "go.clever-cloud.dev/sdk/services/container_registry"
+	attribute "go.opentelemetry.io/otel/attribute"
+	trace "go.opentelemetry.io/otel/trace"
+)
+
+// ErrNotFound is returned when no credential is stored for a registry
+var ErrNotFound = errors.New("registrycred: credentials not found")
+
+// ErrNoVerifier is returned by Rotate when the Rotator has no Verifier
+var ErrNoVerifier = errors.New("registrycred: no verifier to check the new credential")
+
+// Credential is a registry token with the secret returned at creation
+type Credential struct {
+	ServerURL  string         `json:"serverUrl"`
+	Username   string         `json:"username"`
+	Secret     string         `json:"secret"`
+	TenantID   ids.TenantID   `json:"tenantId,omitempty"`
+	RegistryID ids.RegistryID `json:"registryId,omitempty"`
+	TokenID    ids.TokenID    `json:"tokenId,omitempty"`
+	ExpiresAt  *time.Time     `json:"expiresAt,omitempty"`
+	// Retired are the credentials this one replaced, each kept valid until its RetiredUntil
+	Retired      []Credential `json:"retired,omitempty"`
+	RetiredUntil *time.Time   `json:"retiredUntil,omitempty"`
+}
+
+// ExpiresWithin reports whether the credential expires in less than d
+func (c Credential) ExpiresWithin(d time.Duration) bool {
+	return c.ExpiresAt != nil && time.Until(*c.ExpiresAt) < d
+}
+
+// Store persists credentials by registry server URL
+type Store interface {
+	Get(serverURL string) (Credential, error)
+	Put(Credential) error
+	Delete(serverURL string) error
+	List() ([]Credential, error)
+}
+
+// FileStore is a Store backed by a JSON file readable by its owner only
+type FileStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileStore creates a Store writing credentials to path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{Path: path}
+}
+
+func (s *FileStore) load() (map[string]Credential, error) {
+	credentials := map[string]Credential{}
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return credentials, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+func (s *FileStore) save(credentials map[string]Credential) error {
+	data, err := json.MarshalIndent(credentials, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o700); err != nil {
+		return err
+	}
+	return writeFile(s.Path, data)
+}
+
+// Get returns the credential of a registry
+func (s *FileStore) Get(serverURL string) (Credential, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials, err := s.load()
+	if err != nil {
+		return Credential{}, err
+	}
+	credential, ok := credentials[serverURL]
+	if !ok {
+		return Credential{}, ErrNotFound
+	}
+	return credential, nil
+}
+
+// Put stores the credential of a registry, replacing any previous one
+func (s *FileStore) Put(credential Credential) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials, err := s.load()
+	if err != nil {
+		return err
+	}
+	credentials[credential.ServerURL] = credential
+	return s.save(credentials)
+}
+
+// Delete removes the credential of a registry
+func (s *FileStore) Delete(serverURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := credentials[serverURL]; !ok {
+		return ErrNotFound
+	}
+	delete(credentials, serverURL)
+	return s.save(credentials)
+}
+
+// List returns every stored credential
+func (s *FileStore) List() ([]Credential, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Credential, 0, len(credentials))
+	for _, credential := range credentials {
+		list = append(list, credential)
+	}
+	return list, nil
+}
+
+// Registry describes a container registry and the tokens issued for it
+type Registry struct {
+	ServerURL  string
+	TenantID   ids.TenantID
+	RegistryID ids.RegistryID
+	// Username is presented along with the token, which alone authenticates the client
+	Username      string
+	Rights        models.ContainerRegistryTokenRights
+	Scope         []string
+	ExpiresInDays int
+}
+
+// Issue creates a new registry token and returns it as a credential
+func Issue(ctx context.Context, c *client.Client, tracer trace.Tracer, registry Registry) (Credential, error) {
+	request := &models.WannabeContainerRegistryToken{
+		Rights: registry.Rights,
+		Scope:  registry.Scope,
+	}
+	if registry.ExpiresInDays > 0 {
+		request.ExpiresInDays = &registry.ExpiresInDays
+	}
+
+	response := containerregistry.Createregistrytoken(ctx, c, tracer, registry.TenantID, registry.RegistryID, request)
+	if response.HasError() {
+		return Credential{}, response.Error()
+	}
+
+	created := response.Payload()
+	return Credential{
+		ServerURL:  registry.ServerURL,
+		Username:   registry.Username,
+		Secret:     created.BiscuitToken,
+		TenantID:   registry.TenantID,
+		RegistryID: registry.RegistryID,
+		TokenID:    ids.TokenID(created.Token.ID),
+		ExpiresAt:  created.Token.ExpiresAt,
+	}, nil
+}
+
+// Revoke deletes the registry token behind a credential
+func Revoke(ctx context.Context, c *client.Client, tracer trace.Tracer, credential Credential) error {
+	if credential.TokenID == "" {
+		return nil
+	}
+	response := containerregistry.Deleteregistrytoken(ctx, c, tracer, credential.TenantID, credential.RegistryID, credential.TokenID)
+	if response.HasError() && !response.IsNotFoundError() {
+		return response.Error()
+	}
+	return nil
+}
+
+// WriteDockerConfig records a credential in a Docker config file
+func WriteDockerConfig(path string, credential Credential) error {
+	cfg, err := LoadDockerConfig(path)
+	if err != nil {
+		return err
+	}
+	cfg.SetAuth(credential.ServerURL, credential.Username, credential.Secret)
+	return cfg.Save()
+}
+
+// Verifier checks that a credential is accepted by the registry
+type Verifier func(ctx context.Context, credential Credential) error
+
+// Rotator replaces credentials before they expire
+type Rotator struct {
+	client *client.Client
+	tracer trace.Tracer
+	store  Store
+
+	// Window is how long before expiry a credential is rotated
+	Window time.Duration
+	// Overlap keeps the replaced token valid for jobs still using it; it is
+	// revoked by the first Rotate call after the overlap has elapsed
+	Overlap time.Duration
+	// Verify confirms the new credential before the old one is revoked. It is
+	// required: Rotate fails with ErrNoVerifier without it.
+	Verify Verifier
+	// OnRotate is called once the new credential is stored, for instance to update a Docker config
+	OnRotate func(Credential) error
+
+	// API calls, replaced in tests
+	issue  func(ctx context.Context, registry Registry) (Credential, error)
+	revoke func(ctx context.Context, credential Credential) error
+}
+
+// NewRotator creates a Rotator for the credentials of a store, checking new
+// credentials with verify
+func NewRotator(c *client.Client, tracer trace.Tracer, store Store, window time.Duration, verify Verifier) *Rotator {
+	return &Rotator{
+		client: c,
+		tracer: tracer,
+		store:  store,
+		Window: window,
+		Verify: verify,
+		issue: func(ctx context.Context, registry Registry) (Credential, error) {
+			return Issue(ctx, c, tracer, registry)
+		},
+		revoke: func(ctx context.Context, credential Credential) error {
+			return Revoke(ctx, c, tracer, credential)
+		},
+	}
+}
+
+// Rotate replaces the credential of a registry if it expires within the window.
+// The new token is issued with the rights, scope and lifetime of registry,
+// verified and stored; only then is the old token revoked, immediately or
+// once the overlap has elapsed. Tokens replaced by successive rotations
+// within the overlap are all kept until their own overlap has elapsed. If
+// verification fails the new token is revoked and the old credential is kept.
+func (r *Rotator) Rotate(ctx context.Context, registry Registry) (Credential, bool, error) {
+	ctx, span := r.tracer.Start(ctx, "registrycred.Rotate", trace.WithAttributes(
+		attribute.String("tenantId", string(registry.TenantID)),
+		attribute.String("registryId", string(registry.RegistryID)),
+	))
+	defer span.End()
+
+	if r.Verify == nil {
+		span.RecordError(ErrNoVerifier)
+		return Credential{}, false, ErrNoVerifier
+	}
+
+	current, err := r.store.Get(registry.ServerURL)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		span.RecordError(err)
+		return Credential{}, false, err
+	}
+	if err == nil {
+		if current, err = r.revokeRetired(ctx, current); err != nil {
+			span.RecordError(err)
+			return current, false, err
+		}
+		if !current.ExpiresWithin(r.Window) {
+			return current, false, nil
+		}
+	}
+
+	next, err := r.issue(ctx, registry)
+	if err != nil {
+		span.RecordError(err)
+		return current, false, err
+	}
+
+	if err := r.Verify(ctx, next); err != nil {
+		span.RecordError(err)
+		if revokeErr := r.revoke(ctx, next); revokeErr != nil {
+			err = errors.Join(err, revokeErr)
+		}
+		return current, false, err
+	}
+
+	retire := current.TokenID != "" && current.TokenID != next.TokenID
+	next.Retired = current.Retired
+	if retire && r.Overlap > 0 {
+		until := time.Now().Add(r.Overlap)
+		retired := current
+		retired.Retired, retired.RetiredUntil = nil, &until
+		next.Retired = append(slices.Clone(next.Retired), retired)
+	}
+
+	if err := r.store.Put(next); err != nil {
+		span.RecordError(err)
+		return current, false, err
+	}
+	if r.OnRotate != nil {
+		if err := r.OnRotate(next); err != nil {
+			span.RecordError(err)
+			return next, true, err
+		}
+	}
+
+	if retire && r.Overlap <= 0 {
+		if err := r.revoke(ctx, current); err != nil {
+			span.RecordError(err)
+			return next, true, err
+		}
+	}
+	return next, true, nil
+}
+
+// revokeRetired revokes the retired tokens of a credential whose overlap has elapsed
+func (r *Rotator) revokeRetired(ctx context.Context, credential Credential) (Credential, error) {
+	var kept []Credential
+	var errs []error
+	for _, retired := range credential.Retired {
+		if retired.RetiredUntil != nil && time.Now().Before(*retired.RetiredUntil) {
+			kept = append(kept, retired)
+			continue
+		}
+		if err := r.revoke(ctx, retired); err != nil {
+			// Kept to be revoked by the next call
+			kept = append(kept, retired)
+			errs = append(errs, err)
+		}
+	}
+	if len(kept) == len(credential.Retired) {
+		return credential, errors.Join(errs...)
+	}
+
+	credential.Retired = kept
+	if err := r.store.Put(credential); err != nil {
+		errs = append(errs, err)
+	}
+	return credential, errors.Join(errs...)
+}
@@ -0,0 +1,144 @@
+package registrycred
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	ids "go.clever-cloud.dev/sdk/ids"
+	noop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeRegistry issues tokens expiring within the hour and records revocations
+type fakeRegistry struct {
+	issued  int
+	revoked []ids.TokenID
+}
+
+func (f *fakeRegistry) rotator(t *testing.T, verify Verifier) *Rotator {
+	store := NewFileStore(filepath.Join(t.TempDir(), "credentials.json"))
+	r := NewRotator(nil, noop.NewTracerProvider().Tracer("test"), store, 2*time.Hour, verify)
+	r.issue = func(ctx context.Context, registry Registry) (Credential, error) {
+		f.issued++
+		expiresAt := time.Now().Add(time.Hour)
+		return Credential{
+			ServerURL: registry.ServerURL,
+			Secret:    fmt.Sprintf("secret_%d", f.issued),
+			TokenID:   ids.TokenID(fmt.Sprintf("token_%d", f.issued)),
+			ExpiresAt: &expiresAt,
+		}, nil
+	}
+	r.revoke = func(ctx context.Context, credential Credential) error {
+		f.revoked = append(f.revoked, credential.TokenID)
+		return nil
+	}
+	return r
+}
+
+var registry = Registry{ServerURL: "registry.example.com"}
+
+func accept(ctx context.Context, credential Credential) error {
+	return nil
+}
+
+func TestRotateOverlap(t *testing.T) {
+	f := &fakeRegistry{}
+	r := f.rotator(t, accept)
+	r.Overlap = time.Hour
+	ctx := context.Background()
+
+	// every token expires within the window, each call rotates
+	for range 3 {
+		if _, rotated, err := r.Rotate(ctx, registry); err != nil || !rotated {
+			t.Fatalf("Rotate = %v, %v", rotated, err)
+		}
+	}
+	current, err := r.store.Get(registry.ServerURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var retired []ids.TokenID
+	for _, c := range current.Retired {
+		retired = append(retired, c.TokenID)
+	}
+	if current.TokenID != "token_3" || !slices.Equal(retired, []ids.TokenID{"token_1", "token_2"}) || len(f.revoked) != 0 {
+		t.Errorf("current %s retiring %q, revoked %q: tokens replaced within the overlap must all be kept", current.TokenID, retired, f.revoked)
+	}
+
+	// once the overlap of token_1 has elapsed, it alone is revoked
+	elapsed := time.Now().Add(-time.Minute)
+	current.Retired[0].RetiredUntil = &elapsed
+	if err := r.store.Put(current); err != nil {
+		t.Fatal(err)
+	}
+	r.Window = 0
+	if _, rotated, err := r.Rotate(ctx, registry); err != nil || rotated {
+		t.Fatalf("Rotate = %v, %v", rotated, err)
+	}
+	current, _ = r.store.Get(registry.ServerURL)
+	if !slices.Equal(f.revoked, []ids.TokenID{"token_1"}) || len(current.Retired) != 1 || current.Retired[0].TokenID != "token_2" {
+		t.Errorf("revoked %q, still retiring %d tokens", f.revoked, len(current.Retired))
+	}
+}
+
+func TestHelperRotates(t *testing.T) {
+	f := &fakeRegistry{}
+	rejected := errors.New("rejected")
+	verify := func(ctx context.Context, credential Credential) error {
+		if credential.TokenID == "token_2" {
+			return rejected
+		}
+		return nil
+	}
+
+	store := NewFileStore(filepath.Join(t.TempDir(), "credentials.json"))
+	h := NewHelper(nil, noop.NewTracerProvider().Tracer("test"), store, verify, registry)
+	h.Rotator = f.rotator(t, verify)
+	h.Rotator.store, h.Rotator.Overlap = store, time.Hour
+	ctx := context.Background()
+
+	first, err := h.Get(ctx, registry.ServerURL)
+	if err != nil || first.TokenID != "token_1" {
+		t.Fatalf("Get = %s, %v", first.TokenID, err)
+	}
+
+	// token_1 expires within the window: token_2 is rejected, revoked, and token_1 kept
+	if _, err := h.Get(ctx, registry.ServerURL); !errors.Is(err, rejected) {
+		t.Fatalf("Get = %v, want the verification error", err)
+	}
+	if stored, _ := store.Get(registry.ServerURL); stored.TokenID != "token_1" || !slices.Equal(f.revoked, []ids.TokenID{"token_2"}) {
+		t.Errorf("stored %s, revoked %q", stored.TokenID, f.revoked)
+	}
+
+	// token_3 is accepted, token_1 stays valid during the overlap
+	third, err := h.Get(ctx, registry.ServerURL)
+	if err != nil || third.TokenID != "token_3" || len(third.Retired) != 1 || third.Retired[0].TokenID != "token_1" {
+		t.Errorf("Get = %s retiring %v, %v", third.TokenID, third.Retired, err)
+	}
+	if !slices.Equal(f.revoked, []ids.TokenID{"token_2"}) {
+		t.Errorf("revoked %q, token_1 must outlive the rotation", f.revoked)
+	}
+
+	if err := h.Erase(ctx, registry.ServerURL); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(f.revoked, []ids.TokenID{"token_2", "token_1", "token_3"}) {
+		t.Errorf("revoked %q after erase", f.revoked)
+	}
+}
+
+func TestRotateWithoutVerifier(t *testing.T) {
+	f := &fakeRegistry{}
+	r := f.rotator(t, nil)
+
+	if _, rotated, err := r.Rotate(context.Background(), registry); !errors.Is(err, ErrNoVerifier) || rotated {
+		t.Fatalf("Rotate = %v, %v, want ErrNoVerifier", rotated, err)
+	}
+	if _, err := r.store.Get(registry.ServerURL); f.issued != 0 || !errors.Is(err, ErrNotFound) {
+		t.Errorf("issued %d tokens, stored %v: an unverified token must not be issued", f.issued, err)
+	}
+}
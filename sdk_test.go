@@ -5,13 +5,14 @@ import (
 
 	"go.clever-cloud.dev/client"
 	sdk "go.clever-cloud.dev/sdk"
+	"go.clever-cloud.dev/sdk/ids"
 )
 
 func TestGetNetworkGroup(t *testing.T) {
 	s := sdk.NewSDK(sdk.WithClient(client.New(client.WithAutoOauthConfig())))
 
 	ctx := t.Context()
-	orgID := "orga_xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" // Replace with actual org ID
+	orgID := ids.OwnerID("orga_xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx") // Replace with actual org ID
 
 	// Use builder pattern to get a network group
 	response := s.V4().Kubernetes().Organisations().Ownerid(orgID).Clusters().Getclusters(ctx)
@@ -5,6 +5,7 @@ package ai
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: ai
 operationId: createEndpoint
 */
-func Createendpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, aiId string, requestBody *models.CreateEndpointRequest) client.Response[models.AICreationResponse] {
-	ctx, span := tracer.Start(ctx, "createEndpoint", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("aiId", aiId)))
+func Createendpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, aiId ids.AIID, requestBody *models.CreateEndpointRequest) client.Response[models.AICreationResponse] {
+	ctx, span := tracer.Start(ctx, "createEndpoint", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("aiId", string(aiId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ai/organisations/%s/ai/%s/endpoints", ownerId, aiId)
@@ -5,6 +5,7 @@ package ai
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: ai
 operationId: deleteAI
 */
-func Deleteai(ctx context.Context, c *client.Client, tracer trace.Tracer, aiId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteAI", trace.WithAttributes(attribute.String("aiId", aiId)))
+func Deleteai(ctx context.Context, c *client.Client, tracer trace.Tracer, aiId ids.AIID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteAI", trace.WithAttributes(attribute.String("aiId", string(aiId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/addon-ai/resources/%s", aiId)
@@ -5,6 +5,7 @@ package ai
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: ai
 operationId: deleteAiEndpoint
 */
-func Deleteaiendpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, aiId string, endpointId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteAiEndpoint", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("aiId", aiId), attribute.String("endpointId", endpointId)))
+func Deleteaiendpoint(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, aiId ids.AIID, endpointId ids.EndpointID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteAiEndpoint", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("aiId", string(aiId)), attribute.String("endpointId", string(endpointId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ai/organisations/%s/ai/%s/endpoints/%s", ownerId, aiId, endpointId)
@@ -5,6 +5,7 @@ package ai
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: ai
 operationId: deleteOtoroshiApiKey
 */
-func Deleteotoroshiapikey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, aiId string, endpointId string, apikeyId string) client.Response[models.ApiKeyDeletionResult] {
-	ctx, span := tracer.Start(ctx, "deleteOtoroshiApiKey", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("aiId", aiId), attribute.String("endpointId", endpointId), attribute.String("apikeyId", apikeyId)))
+func Deleteotoroshiapikey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, aiId ids.AIID, endpointId ids.EndpointID, apikeyId ids.ApikeyID) client.Response[models.ApiKeyDeletionResult] {
+	ctx, span := tracer.Start(ctx, "deleteOtoroshiApiKey", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("aiId", string(aiId)), attribute.String("endpointId", string(endpointId)), attribute.String("apikeyId", string(apikeyId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ai/organisations/%s/ai/%s/endpoints/%s/apikeys/%s", ownerId, aiId, endpointId, apikeyId)
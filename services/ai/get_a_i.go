@@ -5,6 +5,7 @@ package ai
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: ai
 operationId: getAI
 */
-func Getai(ctx context.Context, c *client.Client, tracer trace.Tracer, aiId string) client.Response[models.AI] {
-	ctx, span := tracer.Start(ctx, "getAI", trace.WithAttributes(attribute.String("aiId", aiId)))
+func Getai(ctx context.Context, c *client.Client, tracer trace.Tracer, aiId ids.AIID) client.Response[models.AI] {
+	ctx, span := tracer.Start(ctx, "getAI", trace.WithAttributes(attribute.String("aiId", string(aiId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-ai/addons/%s", aiId)
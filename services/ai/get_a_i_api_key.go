@@ -4,7 +4,9 @@ package ai
 
 import (
 	"context"
+	"encoding/json"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -37,14 +39,14 @@ Example:
 x-service: ai
 operationId: getAIApiKey
 */
-func Getaiapikey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, aiId string, endpointId string, apikeyId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getAIApiKey", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("aiId", aiId), attribute.String("endpointId", endpointId), attribute.String("apikeyId", apikeyId)))
+func Getaiapikey(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, aiId ids.AIID, endpointId ids.EndpointID, apikeyId ids.ApikeyID) client.Response[json.RawMessage] {
+	ctx, span := tracer.Start(ctx, "getAIApiKey", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("aiId", string(aiId)), attribute.String("endpointId", string(endpointId)), attribute.String("apikeyId", string(apikeyId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ai/organisations/%s/ai/%s/endpoints/%s/apikeys/%s", ownerId, aiId, endpointId, apikeyId)
 
 	// Make API call
-	response := client.Get[client.Nothing](ctx, c, path)
+	response := client.Get[json.RawMessage](ctx, c, path)
 
 	if response.HasError() {
 		span.RecordError(response.Error())
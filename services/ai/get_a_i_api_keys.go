@@ -4,7 +4,9 @@ package ai
 
 import (
 	"context"
+	"encoding/json"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,14 +38,14 @@ Example:
 x-service: ai
 operationId: getAIApiKeys
 */
-func Getaiapikeys(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, aiId string, endpointId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getAIApiKeys", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("aiId", aiId), attribute.String("endpointId", endpointId)))
+func Getaiapikeys(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, aiId ids.AIID, endpointId ids.EndpointID) client.Response[json.RawMessage] {
+	ctx, span := tracer.Start(ctx, "getAIApiKeys", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("aiId", string(aiId)), attribute.String("endpointId", string(endpointId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ai/organisations/%s/ai/%s/endpoints/%s/apikeys", ownerId, aiId, endpointId)
 
 	// Make API call
-	response := client.Get[client.Nothing](ctx, c, path)
+	response := client.Get[json.RawMessage](ctx, c, path)
 
 	if response.HasError() {
 		span.RecordError(response.Error())
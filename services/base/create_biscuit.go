@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: base
 operationId: createBiscuit
 */
-func Createbiscuit(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, kvId string, requestBody *models.IAMUserBiscuitBody) client.Response[models.IAMBiscuit] {
-	ctx, span := tracer.Start(ctx, "createBiscuit", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("kvId", kvId)))
+func Createbiscuit(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, kvId ids.KVID, requestBody *models.IAMUserBiscuitBody) client.Response[models.IAMBiscuit] {
+	ctx, span := tracer.Start(ctx, "createBiscuit", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("kvId", string(kvId))))
 	defer span.End()
 
 	path := utils.Path("/v4/iam/organisations/%s/iam/materia-db-kv/%s/tokens", ownerId, kvId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: base
 operationId: deleteEmailAddress
 */
-func Deleteemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", emailAddressId)))
+func Deleteemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId ids.EmailAddressID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", string(emailAddressId))))
 	defer span.End()
 
 	path := utils.Path("/v4/emails/%s", emailAddressId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: base
 operationId: deleteIdentity
 */
-func Deleteidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteIdentity", trace.WithAttributes(attribute.String("identityId", identityId)))
+func Deleteidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId ids.IdentityID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteIdentity", trace.WithAttributes(attribute.String("identityId", string(identityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/identities/%s", identityId)
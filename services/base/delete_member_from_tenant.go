@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: base
 operationId: deleteMemberFromTenant
 */
-func Deletememberfromtenant(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, identityId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteMemberFromTenant", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("identityId", identityId)))
+func Deletememberfromtenant(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, identityId ids.IdentityID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteMemberFromTenant", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("identityId", string(identityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/members/%s", tenantId, identityId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: base
 operationId: deleteProduct
 */
-func Deleteproduct(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteProduct", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId)))
+func Deleteproduct(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteProduct", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s", tenantId, productId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,13 +22,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - p1:
+  - tokenId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := base.Getbiscuit(ctx, client, tracer, ownerId, p1)
+	response := base.Getbiscuit(ctx, client, tracer, ownerId, tokenId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: base
 operationId: getBiscuit
 */
-func Getbiscuit(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, p1 string) client.Response[models.IAMBiscuit] {
-	ctx, span := tracer.Start(ctx, "getBiscuit", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("p1", p1)))
+func Getbiscuit(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, tokenId ids.TokenID) client.Response[models.IAMBiscuit] {
+	ctx, span := tracer.Start(ctx, "getBiscuit", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("tokenId", string(tokenId))))
 	defer span.End()
 
-	path := utils.Path("/v4/iam/organisations/%s/iam/tokens/%s", ownerId, p1)
+	path := utils.Path("/v4/iam/organisations/%s/iam/tokens/%s", ownerId, tokenId)
 
 	// Make API call
 	response := client.Get[models.IAMBiscuit](ctx, c, path)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: base
 operationId: getEmailAddress
 */
-func Getemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId string) client.Response[models.EmailAddress] {
-	ctx, span := tracer.Start(ctx, "getEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", emailAddressId)))
+func Getemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId ids.EmailAddressID) client.Response[models.EmailAddress] {
+	ctx, span := tracer.Start(ctx, "getEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", string(emailAddressId))))
 	defer span.End()
 
 	path := utils.Path("/v4/emails/%s", emailAddressId)
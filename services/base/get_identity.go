@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: base
 operationId: getIdentity
 */
-func Getidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId string) client.Response[models.Identity] {
-	ctx, span := tracer.Start(ctx, "getIdentity", trace.WithAttributes(attribute.String("identityId", identityId)))
+func Getidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId ids.IdentityID) client.Response[models.Identity] {
+	ctx, span := tracer.Start(ctx, "getIdentity", trace.WithAttributes(attribute.String("identityId", string(identityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/identities/%s", identityId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: base
 operationId: getProduct
 */
-func Getproduct(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string) client.Response[models.Product] {
-	ctx, span := tracer.Start(ctx, "getProduct", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId)))
+func Getproduct(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID) client.Response[models.Product] {
+	ctx, span := tracer.Start(ctx, "getProduct", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s", tenantId, productId)
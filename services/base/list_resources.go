@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: base
 operationId: listResources
 */
-func Listresources(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string) client.Response[[]models.Resource] {
-	ctx, span := tracer.Start(ctx, "listResources", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId)))
+func Listresources(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID) client.Response[[]models.Resource] {
+	ctx, span := tracer.Start(ctx, "listResources", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s/resources", tenantId, productId)
@@ -73,7 +73,9 @@ func buildQueryString(opts ...Option) string {
 		params = append(params, fmt.Sprintf("size=%d", *options.Size))
 	}
 	if options.Tag != nil {
-		params = append(params, fmt.Sprintf("tag=%v", *options.Tag))
+		for _, v := range *options.Tag {
+			params = append(params, fmt.Sprintf("tag=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Tenantid != nil {
 		params = append(params, fmt.Sprintf("tenantId=%s", url.QueryEscape(*options.Tenantid)))
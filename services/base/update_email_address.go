@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: base
 operationId: updateEmailAddress
 */
-func Updateemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId string, requestBody *models.EmailAddressPatch) client.Response[models.EmailAddress] {
-	ctx, span := tracer.Start(ctx, "updateEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", emailAddressId)))
+func Updateemailaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, emailAddressId ids.EmailAddressID, requestBody *models.EmailAddressPatch) client.Response[models.EmailAddress] {
+	ctx, span := tracer.Start(ctx, "updateEmailAddress", trace.WithAttributes(attribute.String("emailAddressId", string(emailAddressId))))
 	defer span.End()
 
 	path := utils.Path("/v4/emails/%s", emailAddressId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: base
 operationId: updatePartialIdentity
 */
-func Updatepartialidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId string, requestBody *models.WannabeIdentity) client.Response[models.Identity] {
-	ctx, span := tracer.Start(ctx, "updatePartialIdentity", trace.WithAttributes(attribute.String("identityId", identityId)))
+func Updatepartialidentity(ctx context.Context, c *client.Client, tracer trace.Tracer, identityId ids.IdentityID, requestBody *models.WannabeIdentity) client.Response[models.Identity] {
+	ctx, span := tracer.Start(ctx, "updatePartialIdentity", trace.WithAttributes(attribute.String("identityId", string(identityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/identities/%s/complete", identityId)
@@ -5,6 +5,7 @@ package base
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: base
 operationId: updateResource
 */
-func Updateresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string, resourceId string, requestBody *models.ResourcePatch) client.Response[models.Resource] {
-	ctx, span := tracer.Start(ctx, "updateResource", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId), attribute.String("resourceId", resourceId)))
+func Updateresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID, resourceId ids.ResourceID, requestBody *models.ResourcePatch) client.Response[models.Resource] {
+	ctx, span := tracer.Start(ctx, "updateResource", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s/resources/%s", tenantId, productId, resourceId)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Createcellarbucket(ctx, client, tracer, ownerId, CellarId, requestBody)
+	response := cellar.Createcellarbucket(ctx, client, tracer, ownerId, cellarId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: cellar
 operationId: createCellarBucket
 */
-func Createcellarbucket(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, requestBody *models.WannabeBucket) client.Response[models.Bucket] {
-	ctx, span := tracer.Start(ctx, "createCellarBucket", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId)))
+func Createcellarbucket(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, requestBody *models.WannabeBucket) client.Response[models.Bucket] {
+	ctx, span := tracer.Start(ctx, "createCellarBucket", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets", ownerId, CellarId)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets", ownerId, cellarId)
 
 	// Make API call
 	response := client.Post[models.Bucket](ctx, c, path, requestBody)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: cellar
 operationId: createCluster
 */
-func Createcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, requestBody *models.CreateClusterRequest) client.Response[models.CellarCluster1] {
-	ctx, span := tracer.Start(ctx, "createCluster", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Createcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, requestBody *models.CreateClusterRequest) client.Response[models.CellarCluster1] {
+	ctx, span := tracer.Start(ctx, "createCluster", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/cellar/organisations/%s/clusters", ownerId)
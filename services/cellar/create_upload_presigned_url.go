@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
   - objectKey:
 
@@ -29,7 +30,7 @@ Parameters:
 
 Example:
 
-	response := cellar.Createuploadpresignedurl(ctx, client, tracer, ownerId, CellarId, bucketName, objectKey)
+	response := cellar.Createuploadpresignedurl(ctx, client, tracer, ownerId, cellarId, bucketName, objectKey)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: cellar
 operationId: createUploadPresignedUrl
 */
-func Createuploadpresignedurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string, objectKey string) client.Response[models.PresignedURL] {
-	ctx, span := tracer.Start(ctx, "createUploadPresignedUrl", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
+func Createuploadpresignedurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string, objectKey string) client.Response[models.PresignedURL] {
+	ctx, span := tracer.Start(ctx, "createUploadPresignedUrl", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s/presigned-url", ownerId, CellarId, bucketName, objectKey)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s/presigned-url", ownerId, cellarId, bucketName, objectKey)
 
 	// Make API call
 	response := client.Post[models.PresignedURL](ctx, c, path, nil)
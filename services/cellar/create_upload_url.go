@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
   - requestBody: the request payload
 
@@ -29,7 +30,7 @@ Parameters:
 
 Example:
 
-	response := cellar.Createuploadurl(ctx, client, tracer, ownerId, CellarId, bucketName, requestBody)
+	response := cellar.Createuploadurl(ctx, client, tracer, ownerId, cellarId, bucketName, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: cellar
 operationId: createUploadUrl
 */
-func Createuploadurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string, requestBody *models.SignedUrlRequest) client.Response[models.SignedUrlResponse] {
-	ctx, span := tracer.Start(ctx, "createUploadUrl", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName)))
+func Createuploadurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string, requestBody *models.SignedUrlRequest) client.Response[models.SignedUrlResponse] {
+	ctx, span := tracer.Start(ctx, "createUploadUrl", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/upload-url", ownerId, CellarId, bucketName)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/upload-url", ownerId, cellarId, bucketName)
 
 	// Make API call
 	response := client.Post[models.SignedUrlResponse](ctx, c, path, requestBody)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: cellar
 operationId: deleteCellar
 */
-func Deletecellar(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteCellar", trace.WithAttributes(attribute.String("addonId", addonId)))
+func Deletecellar(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId ids.AddonID, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteCellar", trace.WithAttributes(attribute.String("addonId", string(addonId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-cellar/%s", addonId)
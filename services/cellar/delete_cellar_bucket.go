@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
   - opts: optional query parameters
 
@@ -29,7 +30,7 @@ Parameters:
 
 Example:
 
-	response := cellar.Deletecellarbucket(ctx, client, tracer, ownerId, CellarId, bucketName, opts...)
+	response := cellar.Deletecellarbucket(ctx, client, tracer, ownerId, cellarId, bucketName, opts...)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: cellar
 operationId: deleteCellarBucket
 */
-func Deletecellarbucket(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteCellarBucket", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName)))
+func Deletecellarbucket(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteCellarBucket", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s", ownerId, CellarId, bucketName)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s", ownerId, cellarId, bucketName)
 
 	// Build query parameters
 	query := buildQueryString(opts...)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -20,7 +21,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
   - objectKey:
 
@@ -28,7 +29,7 @@ Parameters:
 
 Example:
 
-	response := cellar.Deletecellarbucketobject(ctx, client, tracer, ownerId, CellarId, bucketName, objectKey)
+	response := cellar.Deletecellarbucketobject(ctx, client, tracer, ownerId, cellarId, bucketName, objectKey)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: cellar
 operationId: deleteCellarBucketObject
 */
-func Deletecellarbucketobject(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string, objectKey string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteCellarBucketObject", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
+func Deletecellarbucketobject(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string, objectKey string) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteCellarBucketObject", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s", ownerId, CellarId, bucketName, objectKey)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s", ownerId, cellarId, bucketName, objectKey)
 
 	// Make API call
 	response := client.Delete[client.Nothing](ctx, c, path)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -19,13 +20,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - CellarId:
+  - cellarId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Deletecellarv2(ctx, client, tracer, CellarId)
+	response := cellar.Deletecellarv2(ctx, client, tracer, cellarId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -34,11 +35,11 @@ Example:
 x-service: cellar
 operationId: deleteCellarV2
 */
-func Deletecellarv2(ctx context.Context, c *client.Client, tracer trace.Tracer, CellarId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteCellarV2", trace.WithAttributes(attribute.String("CellarId", CellarId)))
+func Deletecellarv2(ctx context.Context, c *client.Client, tracer trace.Tracer, cellarId ids.CellarID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteCellarV2", trace.WithAttributes(attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v2/providers/addon-cellar/%s", CellarId)
+	path := utils.Path("/v2/providers/addon-cellar/%s", cellarId)
 
 	// Make API call
 	response := client.Delete[client.Nothing](ctx, c, path)
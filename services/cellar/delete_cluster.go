@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,13 +22,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - ClusterIndex:
+  - clusterIndex:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Deletecluster(ctx, client, tracer, ownerId, ClusterIndex)
+	response := cellar.Deletecluster(ctx, client, tracer, ownerId, clusterIndex)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: cellar
 operationId: deleteCluster
 */
-func Deletecluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, ClusterIndex int64) client.Response[models.CellarCluster1] {
-	ctx, span := tracer.Start(ctx, "deleteCluster", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.Int64("ClusterIndex", ClusterIndex)))
+func Deletecluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterIndex int64) client.Response[models.CellarCluster1] {
+	ctx, span := tracer.Start(ctx, "deleteCluster", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.Int64("clusterIndex", clusterIndex)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/clusters/%s", ownerId, ClusterIndex)
+	path := utils.Path("/v4/cellar/organisations/%s/clusters/%s", ownerId, clusterIndex)
 
 	// Make API call
 	response := client.Delete[models.CellarCluster1](ctx, c, path)
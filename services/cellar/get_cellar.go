@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,13 +21,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - CellarId:
+  - cellarId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Getcellar(ctx, client, tracer, CellarId)
+	response := cellar.Getcellar(ctx, client, tracer, cellarId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: cellar
 operationId: getCellar
 */
-func Getcellar(ctx context.Context, c *client.Client, tracer trace.Tracer, CellarId string) client.Response[models.Cellar1] {
-	ctx, span := tracer.Start(ctx, "getCellar", trace.WithAttributes(attribute.String("CellarId", CellarId)))
+func Getcellar(ctx context.Context, c *client.Client, tracer trace.Tracer, cellarId ids.CellarID) client.Response[models.Cellar1] {
+	ctx, span := tracer.Start(ctx, "getCellar", trace.WithAttributes(attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v4/providers/addon-cellar/%s", CellarId)
+	path := utils.Path("/v4/providers/addon-cellar/%s", cellarId)
 
 	// Make API call
 	response := client.Get[models.Cellar1](ctx, c, path)
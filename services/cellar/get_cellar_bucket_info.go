@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Getcellarbucketinfo(ctx, client, tracer, ownerId, CellarId, bucketName)
+	response := cellar.Getcellarbucketinfo(ctx, client, tracer, ownerId, cellarId, bucketName)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: cellar
 operationId: getCellarBucketInfo
 */
-func Getcellarbucketinfo(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string) client.Response[models.Bucket] {
-	ctx, span := tracer.Start(ctx, "getCellarBucketInfo", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName)))
+func Getcellarbucketinfo(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string) client.Response[models.Bucket] {
+	ctx, span := tracer.Start(ctx, "getCellarBucketInfo", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s", ownerId, CellarId, bucketName)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s", ownerId, cellarId, bucketName)
 
 	// Make API call
 	response := client.Get[models.Bucket](ctx, c, path)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
   - bucketName:
   - objectKey:
 
@@ -29,7 +30,7 @@ Parameters:
 
 Example:
 
-	response := cellar.Getcellarbucketobject(ctx, client, tracer, ownerId, CellarId, bucketName, objectKey)
+	response := cellar.Getcellarbucketobject(ctx, client, tracer, ownerId, cellarId, bucketName, objectKey)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: cellar
 operationId: getCellarBucketObject
 */
-func Getcellarbucketobject(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string, bucketName string, objectKey string) client.Response[models.CellarObjectDetails] {
-	ctx, span := tracer.Start(ctx, "getCellarBucketObject", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
+func Getcellarbucketobject(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID, bucketName string, objectKey string) client.Response[models.CellarObjectDetails] {
+	ctx, span := tracer.Start(ctx, "getCellarBucketObject", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId)), attribute.String("bucketName", bucketName), attribute.String("objectKey", objectKey)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s", ownerId, CellarId, bucketName, objectKey)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets/%s/objects/%s", ownerId, cellarId, bucketName, objectKey)
 
 	// Make API call
 	response := client.Get[models.CellarObjectDetails](ctx, c, path)
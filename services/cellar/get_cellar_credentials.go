@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,13 +22,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Getcellarcredentials(ctx, client, tracer, ownerId, CellarId)
+	response := cellar.Getcellarcredentials(ctx, client, tracer, ownerId, cellarId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: cellar
 operationId: getCellarCredentials
 */
-func Getcellarcredentials(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string) client.Response[models.CellarCredentials] {
-	ctx, span := tracer.Start(ctx, "getCellarCredentials", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId)))
+func Getcellarcredentials(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID) client.Response[models.CellarCredentials] {
+	ctx, span := tracer.Start(ctx, "getCellarCredentials", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/credentials", ownerId, CellarId)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/credentials", ownerId, cellarId)
 
 	// Make API call
 	response := client.Get[models.CellarCredentials](ctx, c, path)
@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -20,13 +21,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Getcellarcredentialsfile(ctx, client, tracer, ownerId, CellarId)
+	response := cellar.Getcellarcredentialsfile(ctx, client, tracer, ownerId, cellarId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: cellar
 operationId: getCellarCredentialsFile
 */
-func Getcellarcredentialsfile(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getCellarCredentialsFile", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId)))
+func Getcellarcredentialsfile(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getCellarCredentialsFile", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/credentials.cfg", ownerId, CellarId)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/credentials.cfg", ownerId, cellarId)
 
 	// Make API call
 	response := client.Get[client.Nothing](ctx, c, path)
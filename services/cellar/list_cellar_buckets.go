@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,13 +22,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - CellarId:
+  - cellarId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Listcellarbuckets(ctx, client, tracer, ownerId, CellarId)
+	response := cellar.Listcellarbuckets(ctx, client, tracer, ownerId, cellarId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: cellar
 operationId: listCellarBuckets
 */
-func Listcellarbuckets(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, CellarId string) client.Response[models.BucketsListResponse] {
-	ctx, span := tracer.Start(ctx, "listCellarBuckets", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("CellarId", CellarId)))
+func Listcellarbuckets(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, cellarId ids.CellarID) client.Response[models.BucketsListResponse] {
+	ctx, span := tracer.Start(ctx, "listCellarBuckets", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("cellarId", string(cellarId))))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets", ownerId, CellarId)
+	path := utils.Path("/v4/cellar/organisations/%s/cellar/%s/buckets", ownerId, cellarId)
 
 	// Make API call
 	response := client.Get[models.BucketsListResponse](ctx, c, path)
@@ -122,7 +122,9 @@ func buildQueryString(opts ...Option) string {
 		params = append(params, fmt.Sprintf("purgeObjects=%t", *options.Purgeobjects))
 	}
 	if options.Resourceid != nil {
-		params = append(params, fmt.Sprintf("resourceId=%v", *options.Resourceid))
+		for _, v := range *options.Resourceid {
+			params = append(params, fmt.Sprintf("resourceId=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Since != nil {
 		params = append(params, fmt.Sprintf("since=%s", url.QueryEscape(*options.Since)))
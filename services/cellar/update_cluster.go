@@ -5,6 +5,7 @@ package cellar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - ClusterIndex:
+  - clusterIndex:
   - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := cellar.Updatecluster(ctx, client, tracer, ownerId, ClusterIndex, requestBody)
+	response := cellar.Updatecluster(ctx, client, tracer, ownerId, clusterIndex, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: cellar
 operationId: updateCluster
 */
-func Updatecluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, ClusterIndex int64, requestBody *models.UpdateClusterRequest) client.Response[models.CellarCluster1] {
-	ctx, span := tracer.Start(ctx, "updateCluster", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.Int64("ClusterIndex", ClusterIndex)))
+func Updatecluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterIndex int64, requestBody *models.UpdateClusterRequest) client.Response[models.CellarCluster1] {
+	ctx, span := tracer.Start(ctx, "updateCluster", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.Int64("clusterIndex", clusterIndex)))
 	defer span.End()
 
-	path := utils.Path("/v4/cellar/organisations/%s/clusters/%s", ownerId, ClusterIndex)
+	path := utils.Path("/v4/cellar/organisations/%s/clusters/%s", ownerId, clusterIndex)
 
 	// Make API call
 	response := client.Put[models.CellarCluster1](ctx, c, path, requestBody)
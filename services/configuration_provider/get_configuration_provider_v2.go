@@ -5,6 +5,7 @@ package configuration_provider
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -33,8 +34,8 @@ Example:
 x-service: configuration_provider
 operationId: getConfigurationProviderV2
 */
-func Getconfigurationproviderv2(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId string) client.Response[models.ConfigProvider] {
-	ctx, span := tracer.Start(ctx, "getConfigurationProviderV2", trace.WithAttributes(attribute.String("addonId", addonId)))
+func Getconfigurationproviderv2(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId ids.AddonID) client.Response[models.ConfigProvider] {
+	ctx, span := tracer.Start(ctx, "getConfigurationProviderV2", trace.WithAttributes(attribute.String("addonId", string(addonId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/config-provider/resources/%s", addonId)
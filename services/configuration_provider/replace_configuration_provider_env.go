@@ -5,6 +5,7 @@ package configuration_provider
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -34,8 +35,8 @@ Example:
 x-service: configuration_provider
 operationId: replaceConfigurationProviderEnv
 */
-func Replaceconfigurationproviderenv(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId string, requestBody []*models.WannabeEnvVar) client.Response[[]models.EnvVar] {
-	ctx, span := tracer.Start(ctx, "replaceConfigurationProviderEnv", trace.WithAttributes(attribute.String("addonId", addonId)))
+func Replaceconfigurationproviderenv(ctx context.Context, c *client.Client, tracer trace.Tracer, addonId ids.AddonID, requestBody []*models.WannabeEnvVar) client.Response[[]models.EnvVar] {
+	ctx, span := tracer.Start(ctx, "replaceConfigurationProviderEnv", trace.WithAttributes(attribute.String("addonId", string(addonId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/config-provider/addons/%s/env", addonId)
@@ -5,6 +5,7 @@ package container_registry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: container_registry
 operationId: createRegistry
 */
-func Createregistry(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, requestBody *models.WannabeContainerRegistry) client.Response[models.ContainerRegistryWithTokens] {
-	ctx, span := tracer.Start(ctx, "createRegistry", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Createregistry(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, requestBody *models.WannabeContainerRegistry) client.Response[models.ContainerRegistryWithTokens] {
+	ctx, span := tracer.Start(ctx, "createRegistry", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/container-registry", tenantId)
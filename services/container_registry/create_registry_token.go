@@ -5,6 +5,7 @@ package container_registry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: container_registry
 operationId: createRegistryToken
 */
-func Createregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, registryId string, requestBody *models.WannabeContainerRegistryToken) client.Response[models.ContainerRegistryTokenWithBiscuit] {
-	ctx, span := tracer.Start(ctx, "createRegistryToken", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("registryId", registryId)))
+func Createregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, registryId ids.RegistryID, requestBody *models.WannabeContainerRegistryToken) client.Response[models.ContainerRegistryTokenWithBiscuit] {
+	ctx, span := tracer.Start(ctx, "createRegistryToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("registryId", string(registryId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/container-registry/%s/tokens", tenantId, registryId)
@@ -5,6 +5,7 @@ package container_registry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: container_registry
 operationId: deleteRegistryToken
 */
-func Deleteregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, registryId string, tokenId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteRegistryToken", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("registryId", registryId), attribute.String("tokenId", tokenId)))
+func Deleteregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, registryId ids.RegistryID, tokenId ids.TokenID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteRegistryToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("registryId", string(registryId)), attribute.String("tokenId", string(tokenId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/container-registry/%s/tokens/%s", tenantId, registryId, tokenId)
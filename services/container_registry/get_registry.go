@@ -5,6 +5,7 @@ package container_registry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: container_registry
 operationId: getRegistry
 */
-func Getregistry(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, registryId string) client.Response[models.ContainerRegistry] {
-	ctx, span := tracer.Start(ctx, "getRegistry", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("registryId", registryId)))
+func Getregistry(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, registryId ids.RegistryID) client.Response[models.ContainerRegistry] {
+	ctx, span := tracer.Start(ctx, "getRegistry", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("registryId", string(registryId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/container-registry/%s", tenantId, registryId)
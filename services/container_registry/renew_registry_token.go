@@ -5,6 +5,7 @@ package container_registry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: container_registry
 operationId: renewRegistryToken
 */
-func Renewregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, registryId string, tokenId string, requestBody *models.RenewContainerRegistryTokenRequest) client.Response[models.ContainerRegistryTokenWithBiscuit] {
-	ctx, span := tracer.Start(ctx, "renewRegistryToken", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("registryId", registryId), attribute.String("tokenId", tokenId)))
+func Renewregistrytoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, registryId ids.RegistryID, tokenId ids.TokenID, requestBody *models.RenewContainerRegistryTokenRequest) client.Response[models.ContainerRegistryTokenWithBiscuit] {
+	ctx, span := tracer.Start(ctx, "renewRegistryToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("registryId", string(registryId)), attribute.String("tokenId", string(tokenId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/container-registry/%s/tokens/%s/renew", tenantId, registryId, tokenId)
@@ -5,6 +5,7 @@ package cumulocity
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: cumulocity
 operationId: deleteCumulocity
 */
-func Deletecumulocity(ctx context.Context, c *client.Client, tracer trace.Tracer, addonCumulocityId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteCumulocity", trace.WithAttributes(attribute.String("addonCumulocityId", addonCumulocityId)))
+func Deletecumulocity(ctx context.Context, c *client.Client, tracer trace.Tracer, addonCumulocityId ids.AddonCumulocityID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteCumulocity", trace.WithAttributes(attribute.String("addonCumulocityId", string(addonCumulocityId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/addon-cumulocity/resources/%s", addonCumulocityId)
@@ -5,6 +5,7 @@ package cumulocity
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: cumulocity
 operationId: getCumulocity
 */
-func Getcumulocity(ctx context.Context, c *client.Client, tracer trace.Tracer, addonCumulocityId string) client.Response[models.Cumulocity] {
-	ctx, span := tracer.Start(ctx, "getCumulocity", trace.WithAttributes(attribute.String("addonCumulocityId", addonCumulocityId)))
+func Getcumulocity(ctx context.Context, c *client.Client, tracer trace.Tracer, addonCumulocityId ids.AddonCumulocityID) client.Response[models.Cumulocity] {
+	ctx, span := tracer.Start(ctx, "getCumulocity", trace.WithAttributes(attribute.String("addonCumulocityId", string(addonCumulocityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-cumulocity/addons/%s", addonCumulocityId)
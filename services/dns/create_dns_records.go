@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -22,12 +23,13 @@ Parameters:
   - tracer: OpenTelemetry tracer for observability
   - tenantId:
   - resourceId: Resource ID
+  - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := dns.Creatednsrecords(ctx, client, tracer, tenantId, resourceId)
+	response := dns.Creatednsrecords(ctx, client, tracer, tenantId, resourceId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,14 +38,14 @@ Example:
 x-service: dns
 operationId: createDnsRecords
 */
-func Creatednsrecords(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string) client.Response[[]models.DnsRecordIdResponse] {
-	ctx, span := tracer.Start(ctx, "createDnsRecords", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Creatednsrecords(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, requestBody any) client.Response[[]models.DnsRecordIdResponse] {
+	ctx, span := tracer.Start(ctx, "createDnsRecords", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/records", tenantId, resourceId)
 
 	// Make API call
-	response := client.Post[[]models.DnsRecordIdResponse](ctx, c, path, nil)
+	response := client.Post[[]models.DnsRecordIdResponse](ctx, c, path, requestBody)
 
 	if response.HasError() {
 		span.RecordError(response.Error())
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: dns
 operationId: deleteDnsRecord
 */
-func Deletednsrecord(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, recordId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDnsRecord", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId), attribute.String("recordId", recordId)))
+func Deletednsrecord(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, recordId ids.RecordID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDnsRecord", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId)), attribute.String("recordId", string(recordId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/records/%s", tenantId, resourceId, recordId)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: dns
 operationId: deleteDnsRecordsForOwner
 */
-func Deletednsrecordsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForOwner", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Deletednsrecordsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForOwner", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/records", tenantId)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: dns
 operationId: deleteDnsRecordsForResource
 */
-func Deletednsrecordsforresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForResource", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Deletednsrecordsforresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForResource", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/records", tenantId, resourceId)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -37,8 +38,8 @@ Example:
 x-service: dns
 operationId: deleteDnsRecordsForTypeAndName
 */
-func Deletednsrecordsfortypeandname(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, typeParam string, recordName string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForTypeAndName", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId), attribute.String("typeParam", typeParam), attribute.String("recordName", recordName)))
+func Deletednsrecordsfortypeandname(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, typeParam string, recordName string) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDnsRecordsForTypeAndName", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId)), attribute.String("typeParam", typeParam), attribute.String("recordName", recordName)))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/records/type/%s/name/%s", tenantId, resourceId, typeParam, recordName)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: dns
 operationId: listDnsAudit
 */
-func Listdnsaudit(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, recordId string) client.Response[[]models.DnsAudit] {
-	ctx, span := tracer.Start(ctx, "listDnsAudit", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId), attribute.String("recordId", recordId)))
+func Listdnsaudit(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, recordId ids.RecordID) client.Response[[]models.DnsAudit] {
+	ctx, span := tracer.Start(ctx, "listDnsAudit", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId)), attribute.String("recordId", string(recordId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/audit/%s", tenantId, resourceId, recordId)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: dns
 operationId: listDnsRecordsForOwner
 */
-func Listdnsrecordsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string) client.Response[[]models.DnsRecord1] {
-	ctx, span := tracer.Start(ctx, "listDnsRecordsForOwner", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Listdnsrecordsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID) client.Response[[]models.DnsRecord1] {
+	ctx, span := tracer.Start(ctx, "listDnsRecordsForOwner", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/records", tenantId)
@@ -5,6 +5,7 @@ package dns
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: dns
 operationId: listDnsRecordsForTypeAndName
 */
-func Listdnsrecordsfortypeandname(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, typeParam string, recordName string) client.Response[[]models.DnsRecord1] {
-	ctx, span := tracer.Start(ctx, "listDnsRecordsForTypeAndName", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId), attribute.String("typeParam", typeParam), attribute.String("recordName", recordName)))
+func Listdnsrecordsfortypeandname(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, typeParam string, recordName string) client.Response[[]models.DnsRecord1] {
+	ctx, span := tracer.Start(ctx, "listDnsRecordsForTypeAndName", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId)), attribute.String("typeParam", typeParam), attribute.String("recordName", recordName)))
 	defer span.End()
 
 	path := utils.Path("/v4/dns/organisations/%s/resources/%s/records/type/%s/name/%s", tenantId, resourceId, typeParam, recordName)
@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: function
 operationId: deleteDeployment
 */
-func Deletedeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string, deploymentId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDeployment", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId), attribute.String("deploymentId", deploymentId)))
+func Deletedeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID, deploymentId ids.DeploymentID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDeployment", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId)), attribute.String("deploymentId", string(deploymentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s/deployments/%s", ownerId, functionId, deploymentId)
@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: function
 operationId: deleteFunction
 */
-func Deletefunction(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteFunction", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId)))
+func Deletefunction(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteFunction", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s", ownerId, functionId)
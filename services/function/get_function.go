@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: function
 operationId: getFunction
 */
-func Getfunction(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string) client.Response[models.FunctionResponse] {
-	ctx, span := tracer.Start(ctx, "getFunction", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId)))
+func Getfunction(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID) client.Response[models.FunctionResponse] {
+	ctx, span := tracer.Start(ctx, "getFunction", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s", ownerId, functionId)
@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: function
 operationId: getFunctionDeployment
 */
-func Getfunctiondeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string, deploymentId string) client.Response[models.Deployment1] {
-	ctx, span := tracer.Start(ctx, "getFunctionDeployment", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId), attribute.String("deploymentId", deploymentId)))
+func Getfunctiondeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID, deploymentId ids.DeploymentID) client.Response[models.Deployment1] {
+	ctx, span := tracer.Start(ctx, "getFunctionDeployment", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId)), attribute.String("deploymentId", string(deploymentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s/deployments/%s", ownerId, functionId, deploymentId)
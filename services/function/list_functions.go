@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: function
 operationId: listFunctions
 */
-func Listfunctions(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string) client.Response[[]models.FunctionResponse] {
-	ctx, span := tracer.Start(ctx, "listFunctions", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Listfunctions(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID) client.Response[[]models.FunctionResponse] {
+	ctx, span := tracer.Start(ctx, "listFunctions", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions", ownerId)
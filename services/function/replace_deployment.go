@@ -5,6 +5,7 @@ package function
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: function
 operationId: replaceDeployment
 */
-func Replacedeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string, deploymentId string, requestBody *models.DeploymentUpdateOpts) client.Response[models.Deployment1] {
-	ctx, span := tracer.Start(ctx, "replaceDeployment", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId), attribute.String("deploymentId", deploymentId)))
+func Replacedeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID, deploymentId ids.DeploymentID, requestBody *models.DeploymentUpdateOpts) client.Response[models.Deployment1] {
+	ctx, span := tracer.Start(ctx, "replaceDeployment", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId)), attribute.String("deploymentId", string(deploymentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s/deployments/%s", ownerId, functionId, deploymentId)
@@ -5,6 +5,7 @@ package image
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -32,8 +33,8 @@ Example:
 x-service: image
 operationId: deleteImage
 */
-func Deleteimage(ctx context.Context, c *client.Client, tracer trace.Tracer, imageId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteImage", trace.WithAttributes(attribute.String("imageId", imageId)))
+func Deleteimage(ctx context.Context, c *client.Client, tracer trace.Tracer, imageId ids.ImageID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteImage", trace.WithAttributes(attribute.String("imageId", string(imageId))))
 	defer span.End()
 
 	path := utils.Path("/v4/images/%s", imageId)
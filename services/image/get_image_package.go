@@ -36,7 +36,7 @@ x-service: image
 operationId: getImagePackage
 */
 func Getimagepackage(ctx context.Context, c *client.Client, tracer trace.Tracer, image string, version string, packageParam string) client.Response[models.ExherboPackage] {
-	ctx, span := tracer.Start(ctx, "getImagePackage", trace.WithAttributes(attribute.String("image", image), attribute.String("version", version), attribute.String("packageParam", packageParam)))
+	ctx, span := tracer.Start(ctx, "getImagePackage", trace.WithAttributes(attribute.String("image", image), attribute.String("version", version), attribute.String("package", packageParam)))
 	defer span.End()
 
 	path := utils.Path("/v4/images/%s/versions/%s/packages/%s", image, version, packageParam)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: image
 operationId: listImagePackages
 */
-func Listimagepackages(ctx context.Context, c *client.Client, tracer trace.Tracer, imageId string, opts ...Option) client.Response[[]models.ExherboPackage] {
-	ctx, span := tracer.Start(ctx, "listImagePackages", trace.WithAttributes(attribute.String("imageId", imageId)))
+func Listimagepackages(ctx context.Context, c *client.Client, tracer trace.Tracer, imageId ids.ImageID, opts ...Option) client.Response[[]models.ExherboPackage] {
+	ctx, span := tracer.Start(ctx, "listImagePackages", trace.WithAttributes(attribute.String("imageId", string(imageId))))
 	defer span.End()
 
 	path := utils.Path("/v4/images/%s", imageId)
@@ -65,7 +65,9 @@ func buildQueryString(opts ...Option) string {
 		params = append(params, fmt.Sprintf("runtime=%s", url.QueryEscape(*options.Runtime)))
 	}
 	if options.Tag != nil {
-		params = append(params, fmt.Sprintf("tag=%v", *options.Tag))
+		for _, v := range *options.Tag {
+			params = append(params, fmt.Sprintf("tag=%s", url.QueryEscape(v)))
+		}
 	}
 
 	if len(params) == 0 {
@@ -5,6 +5,7 @@ package infrastructure
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: compute
 operationId: getDeployment
 */
-func Getdeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, deploymentId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getDeployment", trace.WithAttributes(attribute.String("deploymentId", deploymentId)))
+func Getdeployment(ctx context.Context, c *client.Client, tracer trace.Tracer, deploymentId ids.DeploymentID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getDeployment", trace.WithAttributes(attribute.String("deploymentId", string(deploymentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/infrastructure/deployments/%s", deploymentId)
@@ -19,13 +19,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - hypervisor_name:
+  - hypervisorName:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := infrastructure.Gethypervisor(ctx, client, tracer, hypervisor_name)
+	response := infrastructure.Gethypervisor(ctx, client, tracer, hypervisorName)
 	if response.HasError() {
 		// Handle error
 	}
@@ -34,11 +34,11 @@ Example:
 x-service: compute
 operationId: getHypervisor
 */
-func Gethypervisor(ctx context.Context, c *client.Client, tracer trace.Tracer, hypervisor_name string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getHypervisor", trace.WithAttributes(attribute.String("hypervisor_name", hypervisor_name)))
+func Gethypervisor(ctx context.Context, c *client.Client, tracer trace.Tracer, hypervisorName string) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getHypervisor", trace.WithAttributes(attribute.String("hypervisorName", hypervisorName)))
 	defer span.End()
 
-	path := utils.Path("/v4/compute/hypervisors/%s", hypervisor_name)
+	path := utils.Path("/v4/compute/hypervisors/%s", hypervisorName)
 
 	// Make API call
 	response := client.Get[client.Nothing](ctx, c, path)
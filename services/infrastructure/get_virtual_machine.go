@@ -5,6 +5,7 @@ package infrastructure
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: compute
 operationId: getVirtualMachine
 */
-func Getvirtualmachine(ctx context.Context, c *client.Client, tracer trace.Tracer, virtualMachineId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getVirtualMachine", trace.WithAttributes(attribute.String("virtualMachineId", virtualMachineId)))
+func Getvirtualmachine(ctx context.Context, c *client.Client, tracer trace.Tracer, virtualMachineId ids.VirtualMachineID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getVirtualMachine", trace.WithAttributes(attribute.String("virtualMachineId", string(virtualMachineId))))
 	defer span.End()
 
 	path := utils.Path("/v4/compute/virtual-machines/%s", virtualMachineId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -39,8 +40,8 @@ Example:
 x-service: ipam
 operationId: assignMultipleIpAddressesToResource
 */
-func Assignmultipleipaddressestoresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, resourceId string, ipVersion string, requestBody *models.NumberOfIPs) client.Response[[]models.AssignedIpAddress] {
-	ctx, span := tracer.Start(ctx, "assignMultipleIpAddressesToResource", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("resourceId", resourceId), attribute.String("ipVersion", ipVersion)))
+func Assignmultipleipaddressestoresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, resourceId ids.ResourceID, ipVersion string, requestBody *models.NumberOfIPs) client.Response[[]models.AssignedIpAddress] {
+	ctx, span := tracer.Start(ctx, "assignMultipleIpAddressesToResource", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("resourceId", string(resourceId)), attribute.String("ipVersion", ipVersion)))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/regions/%s/resources/%s/bulk/assign/%s", tenantId, regionId, resourceId, ipVersion)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: ipam
 operationId: createOrUpdateOwnerAcl
 */
-func Createorupdateowneracl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, requestBody *models.AccessControlList) client.Response[models.OwnerACL] {
-	ctx, span := tracer.Start(ctx, "createOrUpdateOwnerAcl", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Createorupdateowneracl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, requestBody *models.AccessControlList) client.Response[models.OwnerACL] {
+	ctx, span := tracer.Start(ctx, "createOrUpdateOwnerAcl", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/acl", tenantId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: ipam
 operationId: createOrUpdateResourceAcl
 */
-func Createorupdateresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, requestBody *models.AccessControlList) client.Response[models.ResourceACL] {
-	ctx, span := tracer.Start(ctx, "createOrUpdateResourceAcl", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Createorupdateresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, requestBody *models.AccessControlList) client.Response[models.ResourceACL] {
+	ctx, span := tracer.Start(ctx, "createOrUpdateResourceAcl", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/acl/resources/%s", tenantId, resourceId)
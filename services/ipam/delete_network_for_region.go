@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: ipam
 operationId: deleteNetworkForRegion
 */
-func Deletenetworkforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, networkId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNetworkForRegion", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("networkId", networkId)))
+func Deletenetworkforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, networkId ids.NetworkID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNetworkForRegion", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("networkId", string(networkId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/regions/%s/networks/%s", tenantId, regionId, networkId)
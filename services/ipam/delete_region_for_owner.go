@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: ipam
 operationId: deleteRegionForOwner
 */
-func Deleteregionforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteRegionForOwner", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId)))
+func Deleteregionforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteRegionForOwner", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/regions/%s", tenantId, regionId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: ipam
 operationId: deleteResourceAcl
 */
-func Deleteresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteResourceAcl", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Deleteresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteResourceAcl", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/acl/resources/%s", tenantId, resourceId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: ipam
 operationId: freezeIpAddress
 */
-func Freezeipaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, ipAddress string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "freezeIpAddress", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId), attribute.String("ipAddress", ipAddress)))
+func Freezeipaddress(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, ipAddress string) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "freezeIpAddress", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId)), attribute.String("ipAddress", ipAddress)))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/resources/%s/ip/%s/freeze", tenantId, resourceId, ipAddress)
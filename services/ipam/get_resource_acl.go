@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: ipam
 operationId: getResourceAcl
 */
-func Getresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string) client.Response[models.ResourceACL] {
-	ctx, span := tracer.Start(ctx, "getResourceAcl", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Getresourceacl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID) client.Response[models.ResourceACL] {
+	ctx, span := tracer.Start(ctx, "getResourceAcl", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/acl/resources/%s", tenantId, resourceId)
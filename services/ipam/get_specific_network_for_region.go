@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: ipam
 operationId: getSpecificNetworkForRegion
 */
-func Getspecificnetworkforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, networkId string) client.Response[models.Network] {
-	ctx, span := tracer.Start(ctx, "getSpecificNetworkForRegion", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("networkId", networkId)))
+func Getspecificnetworkforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, networkId ids.NetworkID) client.Response[models.Network] {
+	ctx, span := tracer.Start(ctx, "getSpecificNetworkForRegion", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("networkId", string(networkId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/regions/%s/networks/%s", tenantId, regionId, networkId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: ipam
 operationId: listAuditsForOwner
 */
-func Listauditsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string) client.Response[[]models.IpamAudit1] {
-	ctx, span := tracer.Start(ctx, "listAuditsForOwner", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Listauditsforowner(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID) client.Response[[]models.IpamAudit1] {
+	ctx, span := tracer.Start(ctx, "listAuditsForOwner", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/audit", tenantId)
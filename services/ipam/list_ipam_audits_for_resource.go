@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: ipam
 operationId: listIpamAuditsForResource
 */
-func Listipamauditsforresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, auditIpamResourceId string) client.Response[[]models.IpamAudit1] {
-	ctx, span := tracer.Start(ctx, "listIpamAuditsForResource", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("auditIpamResourceId", auditIpamResourceId)))
+func Listipamauditsforresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, auditIpamResourceId ids.AuditIPAMResourceID) client.Response[[]models.IpamAudit1] {
+	ctx, span := tracer.Start(ctx, "listIpamAuditsForResource", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("auditIpamResourceId", string(auditIpamResourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/audit/%s", tenantId, auditIpamResourceId)
@@ -5,6 +5,7 @@ package ipam
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: ipam
 operationId: unassignMultipleAddressesFromResource
 */
-func Unassignmultipleaddressesfromresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, resourceId string, requestBody *models.IpAddressesInput) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "unassignMultipleAddressesFromResource", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("resourceId", resourceId)))
+func Unassignmultipleaddressesfromresource(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, resourceId ids.ResourceID, requestBody *models.IpAddressesInput) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "unassignMultipleAddressesFromResource", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/ipam/organisations/%s/resources/%s/bulk/unassign", tenantId, resourceId)
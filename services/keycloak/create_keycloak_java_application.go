@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: keycloak
 operationId: createKeycloakJavaApplication
 */
-func Createkeycloakjavaapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId string, requestBody *models.ProvisionRequest) client.Response[models.ProvisionResponse] {
-	ctx, span := tracer.Start(ctx, "createKeycloakJavaApplication", trace.WithAttributes(attribute.String("addonKeycloakId", addonKeycloakId)))
+func Createkeycloakjavaapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId ids.AddonKeycloakID, requestBody *models.ProvisionRequest) client.Response[models.ProvisionResponse] {
+	ctx, span := tracer.Start(ctx, "createKeycloakJavaApplication", trace.WithAttributes(attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-keycloak/addons/%s/application", addonKeycloakId)
@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: keycloak
 operationId: createVersionUpdateKeycloak
 */
-func Createversionupdatekeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId string, requestBody *models.KeycloakPatchRequest) client.Response[models.Keycloak] {
-	ctx, span := tracer.Start(ctx, "createVersionUpdateKeycloak", trace.WithAttributes(attribute.String("addonKeycloakId", addonKeycloakId)))
+func Createversionupdatekeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId ids.AddonKeycloakID, requestBody *models.KeycloakPatchRequest) client.Response[models.Keycloak] {
+	ctx, span := tracer.Start(ctx, "createVersionUpdateKeycloak", trace.WithAttributes(attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-keycloak/addons/%s/version/update", addonKeycloakId)
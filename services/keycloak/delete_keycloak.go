@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: keycloak
 operationId: deleteKeycloak
 */
-func Deletekeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteKeycloak", trace.WithAttributes(attribute.String("addonKeycloakId", addonKeycloakId)))
+func Deletekeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId ids.AddonKeycloakID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteKeycloak", trace.WithAttributes(attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/addon-keycloak/resources/%s", addonKeycloakId)
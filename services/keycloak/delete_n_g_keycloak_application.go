@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: keycloak
 operationId: deleteNGKeycloakApplication
 */
-func Deletengkeycloakapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNGKeycloakApplication", trace.WithAttributes(attribute.String("addonKeycloakId", addonKeycloakId)))
+func Deletengkeycloakapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId ids.AddonKeycloakID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNGKeycloakApplication", trace.WithAttributes(attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-keycloak/addons/%s/networkgroup", addonKeycloakId)
@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: keycloak
 operationId: getKeycloak
 */
-func Getkeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, addonKeycloakId string) client.Response[models.Keycloak] {
-	ctx, span := tracer.Start(ctx, "getKeycloak", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("addonKeycloakId", addonKeycloakId)))
+func Getkeycloak(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, addonKeycloakId ids.AddonKeycloakID) client.Response[models.Keycloak] {
+	ctx, span := tracer.Start(ctx, "getKeycloak", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/keycloaks/organisations/%s/keycloaks/%s", ownerId, addonKeycloakId)
@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: keycloak
 operationId: getKeycloakConsumption
 */
-func Getkeycloakconsumption(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, addonKeycloakId string, requestBody *models.KeycloakConsumptionQuery) client.Response[models.ResourceConsumption] {
-	ctx, span := tracer.Start(ctx, "getKeycloakConsumption", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("addonKeycloakId", addonKeycloakId)))
+func Getkeycloakconsumption(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, addonKeycloakId ids.AddonKeycloakID, requestBody *models.KeycloakConsumptionQuery) client.Response[models.ResourceConsumption] {
+	ctx, span := tracer.Start(ctx, "getKeycloakConsumption", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/keycloak/organisations/%s/keycloak/%s/consumption", ownerId, addonKeycloakId)
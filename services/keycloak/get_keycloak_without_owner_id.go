@@ -5,6 +5,7 @@ package keycloak
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: keycloak
 operationId: getKeycloakWithoutOwnerId
 */
-func Getkeycloakwithoutownerid(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId string) client.Response[models.Keycloak] {
-	ctx, span := tracer.Start(ctx, "getKeycloakWithoutOwnerId", trace.WithAttributes(attribute.String("addonKeycloakId", addonKeycloakId)))
+func Getkeycloakwithoutownerid(ctx context.Context, c *client.Client, tracer trace.Tracer, addonKeycloakId ids.AddonKeycloakID) client.Response[models.Keycloak] {
+	ctx, span := tracer.Start(ctx, "getKeycloakWithoutOwnerId", trace.WithAttributes(attribute.String("addonKeycloakId", string(addonKeycloakId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-keycloak/addons/%s", addonKeycloakId)
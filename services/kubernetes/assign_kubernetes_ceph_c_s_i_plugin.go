@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -34,8 +35,8 @@ Example:
 x-service: kubernetes
 operationId: assignKubernetesCephCSIPlugin
 */
-func Assignkubernetescephcsiplugin(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, clusterId string) client.Response[models.Cluster1] {
-	ctx, span := tracer.Start(ctx, "assignKubernetesCephCSIPlugin", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("clusterId", clusterId)))
+func Assignkubernetescephcsiplugin(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterId ids.ClusterID) client.Response[models.Cluster1] {
+	ctx, span := tracer.Start(ctx, "assignKubernetesCephCSIPlugin", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/clusters/%s/csi/ceph", ownerId, clusterId)
@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -34,8 +35,8 @@ Example:
 x-service: kubernetes
 operationId: createKubernetesCluster
 */
-func Createkubernetescluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, requestBody *models.ClusterCreationPayload) client.Response[models.Cluster1] {
-	ctx, span := tracer.Start(ctx, "createKubernetesCluster", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Createkubernetescluster(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, requestBody *models.ClusterCreationPayload) client.Response[models.Cluster1] {
+	ctx, span := tracer.Start(ctx, "createKubernetesCluster", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/clusters", ownerId)
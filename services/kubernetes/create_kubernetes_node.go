@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: kubernetes
 operationId: createKubernetesNode
 */
-func Createkubernetesnode(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, clusterId string, requestBody *models.WannabeStandaloneNode) client.Response[models.StandaloneNode] {
-	ctx, span := tracer.Start(ctx, "createKubernetesNode", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("clusterId", clusterId)))
+func Createkubernetesnode(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterId ids.ClusterID, requestBody *models.WannabeStandaloneNode) client.Response[models.StandaloneNode] {
+	ctx, span := tracer.Start(ctx, "createKubernetesNode", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/clusters/%s/nodes", ownerId, clusterId)
@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -32,8 +33,8 @@ Example:
 x-service: kubernetes
 operationId: deleteDeploymentProfile
 */
-func Deletedeploymentprofile(ctx context.Context, c *client.Client, tracer trace.Tracer, locationId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteDeploymentProfile", trace.WithAttributes(attribute.String("locationId", locationId)))
+func Deletedeploymentprofile(ctx context.Context, c *client.Client, tracer trace.Tracer, locationId ids.LocationID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteDeploymentProfile", trace.WithAttributes(attribute.String("locationId", string(locationId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/admin/deployment-profiles/%s", locationId)
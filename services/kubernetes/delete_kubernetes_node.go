@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: kubernetes
 operationId: deleteKubernetesNode
 */
-func Deletekubernetesnode(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, clusterId string, nodeId string) client.Response[models.StandaloneNode] {
-	ctx, span := tracer.Start(ctx, "deleteKubernetesNode", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("clusterId", clusterId), attribute.String("nodeId", nodeId)))
+func Deletekubernetesnode(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterId ids.ClusterID, nodeId ids.NodeID) client.Response[models.StandaloneNode] {
+	ctx, span := tracer.Start(ctx, "deleteKubernetesNode", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("clusterId", string(clusterId)), attribute.String("nodeId", string(nodeId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/clusters/%s/nodes/%s", ownerId, clusterId, nodeId)
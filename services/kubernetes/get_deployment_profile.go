@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -33,8 +34,8 @@ Example:
 x-service: kubernetes
 operationId: getDeploymentProfile
 */
-func Getdeploymentprofile(ctx context.Context, c *client.Client, tracer trace.Tracer, locationId string) client.Response[models.DeploymentProfile] {
-	ctx, span := tracer.Start(ctx, "getDeploymentProfile", trace.WithAttributes(attribute.String("locationId", locationId)))
+func Getdeploymentprofile(ctx context.Context, c *client.Client, tracer trace.Tracer, locationId ids.LocationID) client.Response[models.DeploymentProfile] {
+	ctx, span := tracer.Start(ctx, "getDeploymentProfile", trace.WithAttributes(attribute.String("locationId", string(locationId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/admin/deployment-profiles/%s", locationId)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: kubernetes
 operationId: getKubeConfig
 */
-func Getkubeconfig(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, clusterId string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getKubeConfig", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("clusterId", clusterId)))
+func Getkubeconfig(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, clusterId ids.ClusterID, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getKubeConfig", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/clusters/%s/kubeconfig.yaml", ownerId, clusterId)
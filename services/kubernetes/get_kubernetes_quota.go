@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -33,8 +34,8 @@ Example:
 x-service: kubernetes
 operationId: getKubernetesQuota
 */
-func Getkubernetesquota(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string) client.Response[models.Quota1] {
-	ctx, span := tracer.Start(ctx, "getKubernetesQuota", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Getkubernetesquota(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID) client.Response[models.Quota1] {
+	ctx, span := tracer.Start(ctx, "getKubernetesQuota", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/kubernetes/organisations/%s/quota", ownerId)
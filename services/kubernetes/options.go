@@ -75,13 +75,17 @@ func buildQueryString(opts ...Option) string {
 		params = append(params, fmt.Sprintf("limit=%d", *options.Limit))
 	}
 	if options.Resourceid != nil {
-		params = append(params, fmt.Sprintf("resourceId=%v", *options.Resourceid))
+		for _, v := range *options.Resourceid {
+			params = append(params, fmt.Sprintf("resourceId=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Since != nil {
 		params = append(params, fmt.Sprintf("since=%s", url.QueryEscape(*options.Since)))
 	}
 	if options.Status != nil {
-		params = append(params, fmt.Sprintf("status=%v", *options.Status))
+		for _, v := range *options.Status {
+			params = append(params, fmt.Sprintf("status=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Typeparam != nil {
 		params = append(params, fmt.Sprintf("typeParam=%s", url.QueryEscape(*options.Typeparam)))
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: loadbalancer
 operationId: assignLoadBalancerListeners
 */
-func Assignloadbalancerlisteners(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string, requestBody []*models.Listener) client.Response[models.LoadBalancer] {
-	ctx, span := tracer.Start(ctx, "assignLoadBalancerListeners", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId)))
+func Assignloadbalancerlisteners(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID, requestBody []*models.Listener) client.Response[models.LoadBalancer] {
+	ctx, span := tracer.Start(ctx, "assignLoadBalancerListeners", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/listeners", tenantId, loadbalancerId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: loadbalancer
 operationId: createLoadBalancerAcl
 */
-func Createloadbalanceracl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, loadbalancerId string, requestBody *models.AccessControlList) client.Response[models.ResourceACL] {
-	ctx, span := tracer.Start(ctx, "createLoadBalancerAcl", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("loadbalancerId", loadbalancerId)))
+func Createloadbalanceracl(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, loadbalancerId ids.LoadbalancerID, requestBody *models.AccessControlList) client.Response[models.ResourceACL] {
+	ctx, span := tracer.Start(ctx, "createLoadBalancerAcl", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("loadbalancerId", string(loadbalancerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/regions/%s/loadbalancers/%s/acl", tenantId, regionId, loadbalancerId)
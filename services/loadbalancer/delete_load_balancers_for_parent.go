@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: loadbalancer
 operationId: deleteLoadBalancersForParent
 */
-func Deleteloadbalancersforparent(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, parentId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteLoadBalancersForParent", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("parentId", parentId)))
+func Deleteloadbalancersforparent(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, parentId ids.ParentID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteLoadBalancersForParent", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("parentId", string(parentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/parents/%s", tenantId, parentId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: loadbalancer
 operationId: deleteNetwork
 */
-func Deletenetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, networkId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNetwork", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("networkId", networkId)))
+func Deletenetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, networkId ids.NetworkID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNetwork", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("networkId", string(networkId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/networks/%s", tenantId, networkId)
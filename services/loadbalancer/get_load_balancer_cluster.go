@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: loadbalancer
 operationId: getLoadBalancerCluster
 */
-func Getloadbalancercluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string, clusterId string) client.Response[models.Cluster] {
-	ctx, span := tracer.Start(ctx, "getLoadBalancerCluster", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId), attribute.String("clusterId", clusterId)))
+func Getloadbalancercluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID, clusterId ids.ClusterID) client.Response[models.Cluster] {
+	ctx, span := tracer.Start(ctx, "getLoadBalancerCluster", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/clusters/%s", tenantId, loadbalancerId, clusterId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: loadbalancer
 operationId: getLoadBalancerListener
 */
-func Getloadbalancerlistener(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string, listenerId string) client.Response[models.Listener] {
-	ctx, span := tracer.Start(ctx, "getLoadBalancerListener", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId), attribute.String("listenerId", listenerId)))
+func Getloadbalancerlistener(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID, listenerId ids.ListenerID) client.Response[models.Listener] {
+	ctx, span := tracer.Start(ctx, "getLoadBalancerListener", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId)), attribute.String("listenerId", string(listenerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/listeners/%s", tenantId, loadbalancerId, listenerId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: getNetwork
 */
-func Getnetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, networkId string) client.Response[models.Network3] {
-	ctx, span := tracer.Start(ctx, "getNetwork", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("networkId", networkId)))
+func Getnetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, networkId ids.NetworkID) client.Response[models.Network3] {
+	ctx, span := tracer.Start(ctx, "getNetwork", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("networkId", string(networkId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/networks/%s", tenantId, networkId)
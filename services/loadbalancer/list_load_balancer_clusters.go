@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: listLoadBalancerClusters
 */
-func Listloadbalancerclusters(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string) client.Response[[]models.Cluster] {
-	ctx, span := tracer.Start(ctx, "listLoadBalancerClusters", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId)))
+func Listloadbalancerclusters(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID) client.Response[[]models.Cluster] {
+	ctx, span := tracer.Start(ctx, "listLoadBalancerClusters", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/clusters", tenantId, loadbalancerId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: listLoadBalancerListeners
 */
-func Listloadbalancerlisteners(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string) client.Response[[]models.Listener] {
-	ctx, span := tracer.Start(ctx, "listLoadBalancerListeners", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId)))
+func Listloadbalancerlisteners(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID) client.Response[[]models.Listener] {
+	ctx, span := tracer.Start(ctx, "listLoadBalancerListeners", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/listeners", tenantId, loadbalancerId)
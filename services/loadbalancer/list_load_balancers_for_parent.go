@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: listLoadBalancersForParent
 */
-func Listloadbalancersforparent(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, parentId string) client.Response[[]models.LoadBalancerOutput] {
-	ctx, span := tracer.Start(ctx, "listLoadBalancersForParent", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("parentId", parentId)))
+func Listloadbalancersforparent(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, parentId ids.ParentID) client.Response[[]models.LoadBalancerOutput] {
+	ctx, span := tracer.Start(ctx, "listLoadBalancersForParent", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("parentId", string(parentId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/parents/%s", tenantId, parentId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: listLoadBalancersForRegion
 */
-func Listloadbalancersforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string) client.Response[[]models.LoadBalancerOutput] {
-	ctx, span := tracer.Start(ctx, "listLoadBalancersForRegion", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId)))
+func Listloadbalancersforregion(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID) client.Response[[]models.LoadBalancerOutput] {
+	ctx, span := tracer.Start(ctx, "listLoadBalancersForRegion", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/regions/%s/loadbalancers", tenantId, regionId)
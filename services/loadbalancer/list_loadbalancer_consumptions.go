@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: loadbalancer
 operationId: listLoadbalancerConsumptions
 */
-func Listloadbalancerconsumptions(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, opts ...Option) client.Response[[]models.ResourceConsumption] {
-	ctx, span := tracer.Start(ctx, "listLoadbalancerConsumptions", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Listloadbalancerconsumptions(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, opts ...Option) client.Response[[]models.ResourceConsumption] {
+	ctx, span := tracer.Start(ctx, "listLoadbalancerConsumptions", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancer/organisations/%s/loadbalancer/consumptions", ownerId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: unassignLoadBalancerCluster
 */
-func Unassignloadbalancercluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string, clusterId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "unassignLoadBalancerCluster", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId), attribute.String("clusterId", clusterId)))
+func Unassignloadbalancercluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID, clusterId ids.ClusterID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "unassignLoadBalancerCluster", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/clusters/%s", tenantId, loadbalancerId, clusterId)
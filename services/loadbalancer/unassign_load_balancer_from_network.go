@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -37,8 +38,8 @@ Example:
 x-service: loadbalancer
 operationId: unassignLoadBalancerFromNetwork
 */
-func Unassignloadbalancerfromnetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, loadbalancerId string, networkId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "unassignLoadBalancerFromNetwork", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("loadbalancerId", loadbalancerId), attribute.String("networkId", networkId)))
+func Unassignloadbalancerfromnetwork(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, loadbalancerId ids.LoadbalancerID, networkId ids.NetworkID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "unassignLoadBalancerFromNetwork", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("loadbalancerId", string(loadbalancerId)), attribute.String("networkId", string(networkId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/regions/%s/loadbalancers/%s/detach/%s", tenantId, regionId, loadbalancerId, networkId)
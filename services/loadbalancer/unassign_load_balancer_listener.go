@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: loadbalancer
 operationId: unassignLoadBalancerListener
 */
-func Unassignloadbalancerlistener(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, loadbalancerId string, listenerId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "unassignLoadBalancerListener", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("loadbalancerId", loadbalancerId), attribute.String("listenerId", listenerId)))
+func Unassignloadbalancerlistener(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, loadbalancerId ids.LoadbalancerID, listenerId ids.ListenerID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "unassignLoadBalancerListener", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("loadbalancerId", string(loadbalancerId)), attribute.String("listenerId", string(listenerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/loadbalancers/%s/listeners/%s", tenantId, loadbalancerId, listenerId)
@@ -5,6 +5,7 @@ package loadbalancer
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: loadbalancer
 operationId: updateServerLoadBalancerCapacity
 */
-func Updateserverloadbalancercapacity(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, regionId string, serverId string, requestBody *models.LoadBalancerCapacityInput) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "updateServerLoadBalancerCapacity", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("regionId", regionId), attribute.String("serverId", serverId)))
+func Updateserverloadbalancercapacity(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, regionId ids.RegionID, serverId ids.ServerID, requestBody *models.LoadBalancerCapacityInput) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "updateServerLoadBalancerCapacity", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("regionId", string(regionId)), attribute.String("serverId", string(serverId))))
 	defer span.End()
 
 	path := utils.Path("/v4/loadbalancers/organisations/%s/regions/%s/servers/%s/loadbalancer-capacity", tenantId, regionId, serverId)
@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: log
 operationId: createDrain
 */
-func Createdrain(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, applicationId string, requestBody *models.WannabeDrain) client.Response[models.Drain] {
-	ctx, span := tracer.Start(ctx, "createDrain", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("applicationId", applicationId)))
+func Createdrain(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, applicationId ids.ApplicationID, requestBody *models.WannabeDrain) client.Response[models.Drain] {
+	ctx, span := tracer.Start(ctx, "createDrain", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("applicationId", string(applicationId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/applications/%s/drains", ownerId, applicationId)
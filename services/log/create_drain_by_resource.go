@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: log
 operationId: createDrainByResource
 */
-func Createdrainbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, resourceId string, requestBody *models.WannabeDrain) client.Response[models.Drain] {
-	ctx, span := tracer.Start(ctx, "createDrainByResource", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("resourceId", resourceId)))
+func Createdrainbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID, requestBody *models.WannabeDrain) client.Response[models.Drain] {
+	ctx, span := tracer.Start(ctx, "createDrainByResource", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/resources/%s/drains", ownerId, resourceId)
@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: log
 operationId: deleteOwnerDrains
 */
-func Deleteownerdrains(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string) client.Response[[]models.Drain] {
-	ctx, span := tracer.Start(ctx, "deleteOwnerDrains", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Deleteownerdrains(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID) client.Response[[]models.Drain] {
+	ctx, span := tracer.Start(ctx, "deleteOwnerDrains", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/drains", ownerId)
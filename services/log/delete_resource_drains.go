@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: log
 operationId: deleteResourceDrains
 */
-func Deleteresourcedrains(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, applicationId string) client.Response[[]models.Drain] {
-	ctx, span := tracer.Start(ctx, "deleteResourceDrains", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("applicationId", applicationId)))
+func Deleteresourcedrains(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, applicationId ids.ApplicationID) client.Response[[]models.Drain] {
+	ctx, span := tracer.Start(ctx, "deleteResourceDrains", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("applicationId", string(applicationId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/applications/%s/drains", ownerId, applicationId)
@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: log
 operationId: deleteResourceDrainsByResource
 */
-func Deleteresourcedrainsbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, resourceId string) client.Response[[]models.Drain] {
-	ctx, span := tracer.Start(ctx, "deleteResourceDrainsByResource", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("resourceId", resourceId)))
+func Deleteresourcedrainsbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID) client.Response[[]models.Drain] {
+	ctx, span := tracer.Start(ctx, "deleteResourceDrainsByResource", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/resources/%s/drains", ownerId, resourceId)
@@ -5,6 +5,7 @@ package log
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: log
 operationId: disableDrain
 */
-func Disabledrain(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, applicationId string, drainId string) client.Response[models.Drain] {
-	ctx, span := tracer.Start(ctx, "disableDrain", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("applicationId", applicationId), attribute.String("drainId", drainId)))
+func Disabledrain(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, applicationId ids.ApplicationID, drainId ids.DrainID) client.Response[models.Drain] {
+	ctx, span := tracer.Start(ctx, "disableDrain", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("applicationId", string(applicationId)), attribute.String("drainId", string(drainId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/applications/%s/drains/%s/disable", ownerId, applicationId, drainId)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: log
 operationId: listDrainsByResource
 */
-func Listdrainsbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, resourceId string, opts ...Option) client.Response[[]models.Drain] {
-	ctx, span := tracer.Start(ctx, "listDrainsByResource", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("resourceId", resourceId)))
+func Listdrainsbyresource(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID, opts ...Option) client.Response[[]models.Drain] {
+	ctx, span := tracer.Start(ctx, "listDrainsByResource", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/drains/organisations/%s/resources/%s/drains", ownerId, resourceId)
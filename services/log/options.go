@@ -4,6 +4,7 @@ package log
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -47,13 +48,19 @@ func buildQueryString(opts ...Option) string {
 
 	var params []string
 	if options.Executionstatus != nil {
-		params = append(params, fmt.Sprintf("executionStatus=%v", *options.Executionstatus))
+		for _, v := range *options.Executionstatus {
+			params = append(params, fmt.Sprintf("executionStatus=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Executionstatusnotin != nil {
-		params = append(params, fmt.Sprintf("executionStatusNotIn=%v", *options.Executionstatusnotin))
+		for _, v := range *options.Executionstatusnotin {
+			params = append(params, fmt.Sprintf("executionStatusNotIn=%s", url.QueryEscape(v)))
+		}
 	}
 	if options.Status != nil {
-		params = append(params, fmt.Sprintf("status=%v", *options.Status))
+		for _, v := range *options.Status {
+			params = append(params, fmt.Sprintf("status=%s", url.QueryEscape(v)))
+		}
 	}
 
 	if len(params) == 0 {
@@ -5,6 +5,7 @@ package materia_kv
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: materia_kv
 operationId: deleteMateriaKv
 */
-func Deletemateriakv(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, kvId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteMateriaKv", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("kvId", kvId)))
+func Deletemateriakv(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, kvId ids.KVID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteMateriaKv", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("kvId", string(kvId))))
 	defer span.End()
 
 	path := utils.Path("/v4/materia/organisations/%s/materia/databases/%s", ownerId, kvId)
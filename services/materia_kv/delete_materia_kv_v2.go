@@ -5,6 +5,7 @@ package materia_kv
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: materia_kv
 operationId: deleteMateriaKvV2
 */
-func Deletemateriakvv2(ctx context.Context, c *client.Client, tracer trace.Tracer, kvId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteMateriaKvV2", trace.WithAttributes(attribute.String("kvId", kvId)))
+func Deletemateriakvv2(ctx context.Context, c *client.Client, tracer trace.Tracer, kvId ids.KVID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteMateriaKvV2", trace.WithAttributes(attribute.String("kvId", string(kvId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/kv/resources/%s", kvId)
@@ -5,6 +5,7 @@ package materia_kv
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: materia_kv
 operationId: getMateriaKv
 */
-func Getmateriakv(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, kvId string) client.Response[models.MateriaDB1] {
-	ctx, span := tracer.Start(ctx, "getMateriaKv", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("kvId", kvId)))
+func Getmateriakv(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, kvId ids.KVID) client.Response[models.MateriaDB1] {
+	ctx, span := tracer.Start(ctx, "getMateriaKv", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("kvId", string(kvId))))
 	defer span.End()
 
 	path := utils.Path("/v4/materia/organisations/%s/materia/databases/%s", ownerId, kvId)
@@ -5,6 +5,7 @@ package materia_kv
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: materia_kv
 operationId: getMateriaKvV2
 */
-func Getmateriakvv2(ctx context.Context, c *client.Client, tracer trace.Tracer, kvId string) client.Response[models.MateriaDB1] {
-	ctx, span := tracer.Start(ctx, "getMateriaKvV2", trace.WithAttributes(attribute.String("kvId", kvId)))
+func Getmateriakvv2(ctx context.Context, c *client.Client, tracer trace.Tracer, kvId ids.KVID) client.Response[models.MateriaDB1] {
+	ctx, span := tracer.Start(ctx, "getMateriaKvV2", trace.WithAttributes(attribute.String("kvId", string(kvId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/kv/resources/%s", kvId)
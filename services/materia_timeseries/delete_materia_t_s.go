@@ -5,6 +5,7 @@ package materia_timeseries
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: materia_timeseries
 operationId: deleteMateriaTS
 */
-func Deletemateriats(ctx context.Context, c *client.Client, tracer trace.Tracer, addonTsId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteMateriaTS", trace.WithAttributes(attribute.String("addonTsId", addonTsId)))
+func Deletemateriats(ctx context.Context, c *client.Client, tracer trace.Tracer, addonTsId ids.AddonTsID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteMateriaTS", trace.WithAttributes(attribute.String("addonTsId", string(addonTsId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/addon-ts/resources/%s", addonTsId)
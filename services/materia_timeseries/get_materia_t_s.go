@@ -5,6 +5,7 @@ package materia_timeseries
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: materia_timeseries
 operationId: getMateriaTS
 */
-func Getmateriats(ctx context.Context, c *client.Client, tracer trace.Tracer, addonTsId string) client.Response[models.TS] {
-	ctx, span := tracer.Start(ctx, "getMateriaTS", trace.WithAttributes(attribute.String("addonTsId", addonTsId)))
+func Getmateriats(ctx context.Context, c *client.Client, tracer trace.Tracer, addonTsId ids.AddonTsID) client.Response[models.TS] {
+	ctx, span := tracer.Start(ctx, "getMateriaTS", trace.WithAttributes(attribute.String("addonTsId", string(addonTsId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-ts/addons/%s", addonTsId)
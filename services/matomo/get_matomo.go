@@ -5,6 +5,7 @@ package matomo
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: matomo
 operationId: getMatomo
 */
-func Getmatomo(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMatomoId string) client.Response[models.Matomo] {
-	ctx, span := tracer.Start(ctx, "getMatomo", trace.WithAttributes(attribute.String("addonMatomoId", addonMatomoId)))
+func Getmatomo(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMatomoId ids.AddonMatomoID) client.Response[models.Matomo] {
+	ctx, span := tracer.Start(ctx, "getMatomo", trace.WithAttributes(attribute.String("addonMatomoId", string(addonMatomoId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-matomo/addons/%s", addonMatomoId)
@@ -5,6 +5,7 @@ package matomo
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: matomo
 operationId: rebootMatomo
 */
-func Rebootmatomo(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMatomoId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "rebootMatomo", trace.WithAttributes(attribute.String("addonMatomoId", addonMatomoId)))
+func Rebootmatomo(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMatomoId ids.AddonMatomoID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "rebootMatomo", trace.WithAttributes(attribute.String("addonMatomoId", string(addonMatomoId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-matomo/addons/%s/reboot", addonMatomoId)
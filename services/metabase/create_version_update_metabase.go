@@ -5,6 +5,7 @@ package metabase
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: metabase
 operationId: createVersionUpdateMetabase
 */
-func Createversionupdatemetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId string, requestBody *models.MetabasePatchRequest) client.Response[models.Metabase] {
-	ctx, span := tracer.Start(ctx, "createVersionUpdateMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", addonMetabaseId)))
+func Createversionupdatemetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId ids.AddonMetabaseID, requestBody *models.MetabasePatchRequest) client.Response[models.Metabase] {
+	ctx, span := tracer.Start(ctx, "createVersionUpdateMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", string(addonMetabaseId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-metabase/addons/%s/version/update", addonMetabaseId)
@@ -5,6 +5,7 @@ package metabase
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: metabase
 operationId: deleteMetabase
 */
-func Deletemetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", addonMetabaseId)))
+func Deletemetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId ids.AddonMetabaseID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", string(addonMetabaseId))))
 	defer span.End()
 
 	path := utils.Path("/v2/providers/addon-metabase/resources/%s", addonMetabaseId)
@@ -5,6 +5,7 @@ package metabase
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: metabase
 operationId: getMetabase
 */
-func Getmetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId string) client.Response[models.Metabase] {
-	ctx, span := tracer.Start(ctx, "getMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", addonMetabaseId)))
+func Getmetabase(ctx context.Context, c *client.Client, tracer trace.Tracer, addonMetabaseId ids.AddonMetabaseID) client.Response[models.Metabase] {
+	ctx, span := tracer.Start(ctx, "getMetabase", trace.WithAttributes(attribute.String("addonMetabaseId", string(addonMetabaseId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-metabase/addons/%s", addonMetabaseId)
@@ -5,6 +5,7 @@ package metabase
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: metabase
 operationId: getMetabaseProductConsole
 */
-func Getmetabaseproductconsole(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, addonMetabaseId string, requestBody *models.MetabaseConsumptionQuery) client.Response[models.ResourceConsumption] {
-	ctx, span := tracer.Start(ctx, "getMetabaseProductConsole", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("addonMetabaseId", addonMetabaseId)))
+func Getmetabaseproductconsole(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, addonMetabaseId ids.AddonMetabaseID, requestBody *models.MetabaseConsumptionQuery) client.Response[models.ResourceConsumption] {
+	ctx, span := tracer.Start(ctx, "getMetabaseProductConsole", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("addonMetabaseId", string(addonMetabaseId))))
 	defer span.End()
 
 	path := utils.Path("/v4/metabase/organisations/%s/metabase/%s/consumption", ownerId, addonMetabaseId)
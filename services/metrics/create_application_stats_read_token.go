@@ -5,6 +5,7 @@ package metrics
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: metrics
 operationId: createApplicationStatsReadToken
 */
-func Createapplicationstatsreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, resourceId string, requestBody *models.WannabeStatsReadToken) client.Response[models.StatsReadToken] {
-	ctx, span := tracer.Start(ctx, "createApplicationStatsReadToken", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("resourceId", resourceId)))
+func Createapplicationstatsreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID, requestBody *models.WannabeStatsReadToken) client.Response[models.StatsReadToken] {
+	ctx, span := tracer.Start(ctx, "createApplicationStatsReadToken", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/stats/organisations/%s/resources/%s/tokens/read", ownerId, resourceId)
@@ -5,6 +5,7 @@ package metrics
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: metrics
 operationId: createOrganizationStatsReadToken
 */
-func Createorganizationstatsreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, requestBody *models.WannabeStatsReadToken) client.Response[models.StatsReadToken] {
-	ctx, span := tracer.Start(ctx, "createOrganizationStatsReadToken", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Createorganizationstatsreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, requestBody *models.WannabeStatsReadToken) client.Response[models.StatsReadToken] {
+	ctx, span := tracer.Start(ctx, "createOrganizationStatsReadToken", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/stats/organisations/%s/tokens/read", ownerId)
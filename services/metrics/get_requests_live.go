@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: metrics
 operationId: getRequestsLive
 */
-func Getrequestslive(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "getRequestsLive", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Getrequestslive(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "getRequestsLive", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/stats/organisations/%s/requests-live", ownerId)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: metrics
 operationId: listHeatmap
 */
-func Listheatmap(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, opts ...Option) client.Response[[]models.MetricsHeatmapResponse] {
-	ctx, span := tracer.Start(ctx, "listHeatmap", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Listheatmap(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, opts ...Option) client.Response[[]models.MetricsHeatmapResponse] {
+	ctx, span := tracer.Start(ctx, "listHeatmap", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/stats/organisations/%s/requests", ownerId)
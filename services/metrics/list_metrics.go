@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: metrics
 operationId: listMetrics
 */
-func Listmetrics(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, resourceId string, opts ...Option) client.Response[[]models.MetricsDataResponse] {
-	ctx, span := tracer.Start(ctx, "listMetrics", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("resourceId", resourceId)))
+func Listmetrics(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, resourceId ids.ResourceID, opts ...Option) client.Response[[]models.MetricsDataResponse] {
+	ctx, span := tracer.Start(ctx, "listMetrics", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("resourceId", string(resourceId))))
 	defer span.End()
 
 	path := utils.Path("/v4/stats/organisations/%s/resources/%s/metrics", ownerId, resourceId)
@@ -5,6 +5,7 @@ package network_group
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: network_group
 operationId: createNetworkGroup
 */
-func Createnetworkgroup(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, requestBody *models.WannabeNetworkGroup) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "createNetworkGroup", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Createnetworkgroup(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, requestBody *models.WannabeNetworkGroup) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "createNetworkGroup", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/networkgroups/organisations/%s/networkgroups", ownerId)
@@ -5,6 +5,7 @@ package network_group
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: network_group
 operationId: createNetworkGroupExternalPeer
 */
-func Createnetworkgroupexternalpeer(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, networkGroupId string, requestBody *models.WannabeExternalPeer) client.Response[models.PeerCreated] {
-	ctx, span := tracer.Start(ctx, "createNetworkGroupExternalPeer", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("networkGroupId", networkGroupId)))
+func Createnetworkgroupexternalpeer(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, networkGroupId ids.NetworkGroupID, requestBody *models.WannabeExternalPeer) client.Response[models.PeerCreated] {
+	ctx, span := tracer.Start(ctx, "createNetworkGroupExternalPeer", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("networkGroupId", string(networkGroupId))))
 	defer span.End()
 
 	path := utils.Path("/v4/networkgroups/organisations/%s/networkgroups/%s/external-peers", ownerId, networkGroupId)
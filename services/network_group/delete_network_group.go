@@ -5,6 +5,7 @@ package network_group
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: network_group
 operationId: deleteNetworkGroup
 */
-func Deletenetworkgroup(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, networkGroupId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNetworkGroup", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("networkGroupId", networkGroupId)))
+func Deletenetworkgroup(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, networkGroupId ids.NetworkGroupID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNetworkGroup", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("networkGroupId", string(networkGroupId))))
 	defer span.End()
 
 	path := utils.Path("/v4/networkgroups/organisations/%s/networkgroups/%s", ownerId, networkGroupId)
@@ -5,6 +5,7 @@ package network_group
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: network_group
 operationId: deleteNetworkGroupMember
 */
-func Deletenetworkgroupmember(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, networkGroupId string, memberId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNetworkGroupMember", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("networkGroupId", networkGroupId), attribute.String("memberId", memberId)))
+func Deletenetworkgroupmember(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, networkGroupId ids.NetworkGroupID, memberId ids.MemberID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNetworkGroupMember", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("networkGroupId", string(networkGroupId)), attribute.String("memberId", string(memberId))))
 	defer span.End()
 
 	path := utils.Path("/v4/networkgroups/organisations/%s/networkgroups/%s/members/%s", ownerId, networkGroupId, memberId)
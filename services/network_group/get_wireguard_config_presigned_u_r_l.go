@@ -5,6 +5,7 @@ package network_group
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: network_group
 operationId: getWireguardConfigPresignedURL
 */
-func Getwireguardconfigpresignedurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, networkGroupId string, peerId string) client.Response[models.PresignedURL] {
-	ctx, span := tracer.Start(ctx, "getWireguardConfigPresignedURL", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("networkGroupId", networkGroupId), attribute.String("peerId", peerId)))
+func Getwireguardconfigpresignedurl(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, networkGroupId ids.NetworkGroupID, peerId ids.PeerID) client.Response[models.PresignedURL] {
+	ctx, span := tracer.Start(ctx, "getWireguardConfigPresignedURL", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("networkGroupId", string(networkGroupId)), attribute.String("peerId", string(peerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/networkgroups/organisations/%s/networkgroups/%s/peers/%s/wireguard/configuration/presigned-url", ownerId, networkGroupId, peerId)
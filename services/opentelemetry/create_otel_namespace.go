@@ -5,6 +5,7 @@ package opentelemetry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: opentelemetry
 operationId: createOtelNamespace
 */
-func Createotelnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, requestBody *models.WannabeNamespace) client.Response[models.OtelNamespace] {
-	ctx, span := tracer.Start(ctx, "createOtelNamespace", trace.WithAttributes(attribute.String("ownerId", ownerId)))
+func Createotelnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, requestBody *models.WannabeNamespace) client.Response[models.OtelNamespace] {
+	ctx, span := tracer.Start(ctx, "createOtelNamespace", trace.WithAttributes(attribute.String("ownerId", string(ownerId))))
 	defer span.End()
 
 	path := utils.Path("/v4/otel/organisations/%s/namespaces", ownerId)
@@ -5,6 +5,7 @@ package opentelemetry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: opentelemetry
 operationId: createOtelService
 */
-func Createotelservice(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, otelId string, requestBody *models.WannabeService) client.Response[models.OtelService] {
-	ctx, span := tracer.Start(ctx, "createOtelService", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("otelId", otelId)))
+func Createotelservice(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, otelId ids.OtelID, requestBody *models.WannabeService) client.Response[models.OtelService] {
+	ctx, span := tracer.Start(ctx, "createOtelService", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("otelId", string(otelId))))
 	defer span.End()
 
 	path := utils.Path("/v4/otel/organisations/%s/namespaces/%s/services", ownerId, otelId)
@@ -5,6 +5,7 @@ package opentelemetry
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: opentelemetry
 operationId: deleteOtelNamespace
 */
-func Deleteotelnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, otelId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteOtelNamespace", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("otelId", otelId)))
+func Deleteotelnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, otelId ids.OtelID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteOtelNamespace", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("otelId", string(otelId))))
 	defer span.End()
 
 	path := utils.Path("/v4/otel/organisations/%s/namespaces/%s", ownerId, otelId)
@@ -5,6 +5,7 @@ package otoroshi
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,13 +21,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - OtoroshiId:
+  - otoroshiId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := otoroshi.Createngotoroshiapplication(ctx, client, tracer, OtoroshiId)
+	response := otoroshi.Createngotoroshiapplication(ctx, client, tracer, otoroshiId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: otoroshi
 operationId: createNGOtoroshiApplication
 */
-func Createngotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, OtoroshiId string) client.Response[models.Otoroshi1] {
-	ctx, span := tracer.Start(ctx, "createNGOtoroshiApplication", trace.WithAttributes(attribute.String("OtoroshiId", OtoroshiId)))
+func Createngotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, otoroshiId ids.OtoroshiID) client.Response[models.Otoroshi1] {
+	ctx, span := tracer.Start(ctx, "createNGOtoroshiApplication", trace.WithAttributes(attribute.String("otoroshiId", string(otoroshiId))))
 	defer span.End()
 
-	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/networkgroup", OtoroshiId)
+	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/networkgroup", otoroshiId)
 
 	// Make API call
 	response := client.Post[models.Otoroshi1](ctx, c, path, nil)
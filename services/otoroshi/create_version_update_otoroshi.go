@@ -5,6 +5,7 @@ package otoroshi
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,14 +21,14 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - OtoroshiId:
+  - otoroshiId:
   - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := otoroshi.Createversionupdateotoroshi(ctx, client, tracer, OtoroshiId, requestBody)
+	response := otoroshi.Createversionupdateotoroshi(ctx, client, tracer, otoroshiId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: otoroshi
 operationId: createVersionUpdateOtoroshi
 */
-func Createversionupdateotoroshi(ctx context.Context, c *client.Client, tracer trace.Tracer, OtoroshiId string, requestBody *models.OtoroshiPatchRequest) client.Response[models.Otoroshi1] {
-	ctx, span := tracer.Start(ctx, "createVersionUpdateOtoroshi", trace.WithAttributes(attribute.String("OtoroshiId", OtoroshiId)))
+func Createversionupdateotoroshi(ctx context.Context, c *client.Client, tracer trace.Tracer, otoroshiId ids.OtoroshiID, requestBody *models.OtoroshiPatchRequest) client.Response[models.Otoroshi1] {
+	ctx, span := tracer.Start(ctx, "createVersionUpdateOtoroshi", trace.WithAttributes(attribute.String("otoroshiId", string(otoroshiId))))
 	defer span.End()
 
-	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/version/update", OtoroshiId)
+	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/version/update", otoroshiId)
 
 	// Make API call
 	response := client.Post[models.Otoroshi1](ctx, c, path, requestBody)
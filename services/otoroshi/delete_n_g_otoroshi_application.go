@@ -5,6 +5,7 @@ package otoroshi
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -19,13 +20,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - OtoroshiId:
+  - otoroshiId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := otoroshi.Deletengotoroshiapplication(ctx, client, tracer, OtoroshiId)
+	response := otoroshi.Deletengotoroshiapplication(ctx, client, tracer, otoroshiId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -34,11 +35,11 @@ Example:
 x-service: otoroshi
 operationId: deleteNGOtoroshiApplication
 */
-func Deletengotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, OtoroshiId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteNGOtoroshiApplication", trace.WithAttributes(attribute.String("OtoroshiId", OtoroshiId)))
+func Deletengotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, otoroshiId ids.OtoroshiID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteNGOtoroshiApplication", trace.WithAttributes(attribute.String("otoroshiId", string(otoroshiId))))
 	defer span.End()
 
-	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/networkgroup", OtoroshiId)
+	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/networkgroup", otoroshiId)
 
 	// Make API call
 	response := client.Delete[client.Nothing](ctx, c, path)
@@ -5,6 +5,7 @@ package otoroshi
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,13 +21,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - OtoroshiId:
+  - otoroshiId:
 
 # Returns the operation result or an error
 
 Example:
 
-	response := otoroshi.Getcheckversionotoroshiapplication(ctx, client, tracer, OtoroshiId)
+	response := otoroshi.Getcheckversionotoroshiapplication(ctx, client, tracer, otoroshiId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: otoroshi
 operationId: getCheckVersionOtoroshiApplication
 */
-func Getcheckversionotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, OtoroshiId string) client.Response[models.OtoroshiVersionChecker] {
-	ctx, span := tracer.Start(ctx, "getCheckVersionOtoroshiApplication", trace.WithAttributes(attribute.String("OtoroshiId", OtoroshiId)))
+func Getcheckversionotoroshiapplication(ctx context.Context, c *client.Client, tracer trace.Tracer, otoroshiId ids.OtoroshiID) client.Response[models.OtoroshiVersionChecker] {
+	ctx, span := tracer.Start(ctx, "getCheckVersionOtoroshiApplication", trace.WithAttributes(attribute.String("otoroshiId", string(otoroshiId))))
 	defer span.End()
 
-	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/version/check", OtoroshiId)
+	path := utils.Path("/v4/addon-providers/addon-otoroshi/addons/%s/version/check", otoroshiId)
 
 	// Make API call
 	response := client.Get[models.OtoroshiVersionChecker](ctx, c, path)
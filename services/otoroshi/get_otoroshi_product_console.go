@@ -5,6 +5,7 @@ package otoroshi
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - OtoroshiId:
+  - otoroshiId:
   - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := otoroshi.Getotoroshiproductconsole(ctx, client, tracer, ownerId, OtoroshiId, requestBody)
+	response := otoroshi.Getotoroshiproductconsole(ctx, client, tracer, ownerId, otoroshiId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: otoroshi
 operationId: getOtoroshiProductConsole
 */
-func Getotoroshiproductconsole(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, OtoroshiId string, requestBody *models.OtoroshiConsumptionQuery) client.Response[models.ResourceConsumption] {
-	ctx, span := tracer.Start(ctx, "getOtoroshiProductConsole", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("OtoroshiId", OtoroshiId)))
+func Getotoroshiproductconsole(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, otoroshiId ids.OtoroshiID, requestBody *models.OtoroshiConsumptionQuery) client.Response[models.ResourceConsumption] {
+	ctx, span := tracer.Start(ctx, "getOtoroshiProductConsole", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("otoroshiId", string(otoroshiId))))
 	defer span.End()
 
-	path := utils.Path("/v4/otoroshi/organisations/%s/otoroshi/%s/consumption", ownerId, OtoroshiId)
+	path := utils.Path("/v4/otoroshi/organisations/%s/otoroshi/%s/consumption", ownerId, otoroshiId)
 
 	// Make API call
 	response := client.Post[models.ResourceConsumption](ctx, c, path, requestBody)
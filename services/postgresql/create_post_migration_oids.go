@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,14 +21,14 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - requestBody: the request payload
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Createpostmigrationoids(ctx, client, tracer, postgreSQLId, requestBody)
+	response := postgresql.Createpostmigrationoids(ctx, client, tracer, postgresqlId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: postgresql
 operationId: createPostMigrationOids
 */
-func Createpostmigrationoids(ctx context.Context, c *client.Client, tracer trace.Tracer, postgreSQLId string, requestBody []*models.OIdObjectPair) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "createPostMigrationOids", trace.WithAttributes(attribute.String("postgreSQLId", postgreSQLId)))
+func Createpostmigrationoids(ctx context.Context, c *client.Client, tracer trace.Tracer, postgresqlId ids.PostgresqlID, requestBody []*models.OIdObjectPair) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "createPostMigrationOids", trace.WithAttributes(attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/%s/migration/post", postgreSQLId)
+	path := utils.Path("/v4/postgresql/%s/migration/post", postgresqlId)
 
 	// Make API call
 	response := client.Post[client.Nothing](ctx, c, path, requestBody)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - pgUserId: PostgreSQL User ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Createrotateuserpassword(ctx, client, tracer, ownerId, postgreSQLId, pgUserId)
+	response := postgresql.Createrotateuserpassword(ctx, client, tracer, ownerId, postgresqlId, pgUserId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: postgresql
 operationId: createRotateUserPassword
 */
-func Createrotateuserpassword(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, pgUserId string) client.Response[models.PgUserData] {
-	ctx, span := tracer.Start(ctx, "createRotateUserPassword", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("pgUserId", pgUserId)))
+func Createrotateuserpassword(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, pgUserId ids.PGUserID) client.Response[models.PgUserData] {
+	ctx, span := tracer.Start(ctx, "createRotateUserPassword", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("pgUserId", string(pgUserId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s/rotate-password", ownerId, postgreSQLId, pgUserId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s/rotate-password", ownerId, postgresqlId, pgUserId)
 
 	// Make API call
 	response := client.Post[models.PgUserData](ctx, c, path, nil)
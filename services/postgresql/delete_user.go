@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -20,14 +21,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - pgUserId: PostgreSQL User ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Deleteuser(ctx, client, tracer, ownerId, postgreSQLId, pgUserId)
+	response := postgresql.Deleteuser(ctx, client, tracer, ownerId, postgresqlId, pgUserId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: postgresql
 operationId: deleteUser
 */
-func Deleteuser(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, pgUserId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteUser", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("pgUserId", pgUserId)))
+func Deleteuser(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, pgUserId ids.PGUserID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteUser", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("pgUserId", string(pgUserId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s", ownerId, postgreSQLId, pgUserId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s", ownerId, postgresqlId, pgUserId)
 
 	// Make API call
 	response := client.Delete[client.Nothing](ctx, c, path)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,14 +22,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - databaseId: Database ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Getdatabase(ctx, client, tracer, ownerId, postgreSQLId, databaseId)
+	response := postgresql.Getdatabase(ctx, client, tracer, ownerId, postgresqlId, databaseId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -37,11 +38,11 @@ Example:
 x-service: postgresql
 operationId: getDatabase
 */
-func Getdatabase(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, databaseId string) client.Response[models.PostgreSQLDatabase1] {
-	ctx, span := tracer.Start(ctx, "getDatabase", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("databaseId", databaseId)))
+func Getdatabase(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, databaseId ids.DatabaseID) client.Response[models.PostgreSQLDatabase1] {
+	ctx, span := tracer.Start(ctx, "getDatabase", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("databaseId", string(databaseId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases/%s", ownerId, postgreSQLId, databaseId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases/%s", ownerId, postgresqlId, databaseId)
 
 	// Make API call
 	response := client.Get[models.PostgreSQLDatabase1](ctx, c, path)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,13 +22,13 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Listdatabases(ctx, client, tracer, ownerId, postgreSQLId)
+	response := postgresql.Listdatabases(ctx, client, tracer, ownerId, postgresqlId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -36,11 +37,11 @@ Example:
 x-service: postgresql
 operationId: listDatabases
 */
-func Listdatabases(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string) client.Response[[]models.PostgreSQLDatabase1] {
-	ctx, span := tracer.Start(ctx, "listDatabases", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId)))
+func Listdatabases(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID) client.Response[[]models.PostgreSQLDatabase1] {
+	ctx, span := tracer.Start(ctx, "listDatabases", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases", ownerId, postgreSQLId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases", ownerId, postgresqlId)
 
 	// Make API call
 	response := client.Get[[]models.PostgreSQLDatabase1](ctx, c, path)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -22,14 +23,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - opts: optional query parameters
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Listdatabasesprivileges(ctx, client, tracer, ownerId, postgreSQLId, opts...)
+	response := postgresql.Listdatabasesprivileges(ctx, client, tracer, ownerId, postgresqlId, opts...)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: postgresql
 operationId: listDatabasesPrivileges
 */
-func Listdatabasesprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, opts ...Option) client.Response[[]models.PgDatabasePrivileges] {
-	ctx, span := tracer.Start(ctx, "listDatabasesPrivileges", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId)))
+func Listdatabasesprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, opts ...Option) client.Response[[]models.PgDatabasePrivileges] {
+	ctx, span := tracer.Start(ctx, "listDatabasesPrivileges", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/privileges/databases", ownerId, postgreSQLId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/privileges/databases", ownerId, postgresqlId)
 
 	// Build query parameters
 	query := buildQueryString(opts...)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,13 +21,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Listpostgresqlmigrationprivileges(ctx, client, tracer, postgreSQLId)
+	response := postgresql.Listpostgresqlmigrationprivileges(ctx, client, tracer, postgresqlId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: postgresql
 operationId: listPostgreSQLMigrationPrivileges
 */
-func Listpostgresqlmigrationprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, postgreSQLId string) client.Response[[]models.PostgreSQLDatabasePrivileges] {
-	ctx, span := tracer.Start(ctx, "listPostgreSQLMigrationPrivileges", trace.WithAttributes(attribute.String("postgreSQLId", postgreSQLId)))
+func Listpostgresqlmigrationprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, postgresqlId ids.PostgresqlID) client.Response[[]models.PostgreSQLDatabasePrivileges] {
+	ctx, span := tracer.Start(ctx, "listPostgreSQLMigrationPrivileges", trace.WithAttributes(attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/%s/migration/privileges", postgreSQLId)
+	path := utils.Path("/v4/postgresql/%s/migration/privileges", postgresqlId)
 
 	// Make API call
 	response := client.Get[[]models.PostgreSQLDatabasePrivileges](ctx, c, path)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -20,13 +21,13 @@ Parameters:
   - ctx: context for the request
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Listpostgresqlmigrationusers(ctx, client, tracer, postgreSQLId)
+	response := postgresql.Listpostgresqlmigrationusers(ctx, client, tracer, postgresqlId)
 	if response.HasError() {
 		// Handle error
 	}
@@ -35,11 +36,11 @@ Example:
 x-service: postgresql
 operationId: listPostgreSQLMigrationUsers
 */
-func Listpostgresqlmigrationusers(ctx context.Context, c *client.Client, tracer trace.Tracer, postgreSQLId string) client.Response[[]models.PgUserData] {
-	ctx, span := tracer.Start(ctx, "listPostgreSQLMigrationUsers", trace.WithAttributes(attribute.String("postgreSQLId", postgreSQLId)))
+func Listpostgresqlmigrationusers(ctx context.Context, c *client.Client, tracer trace.Tracer, postgresqlId ids.PostgresqlID) client.Response[[]models.PgUserData] {
+	ctx, span := tracer.Start(ctx, "listPostgreSQLMigrationUsers", trace.WithAttributes(attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/%s/migration/users", postgreSQLId)
+	path := utils.Path("/v4/postgresql/%s/migration/users", postgresqlId)
 
 	// Make API call
 	response := client.Get[[]models.PgUserData](ctx, c, path)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -22,14 +23,14 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - opts: optional query parameters
 
 # Returns the operation result or an error
 
 Example:
 
-	response := postgresql.Listtablesprivileges(ctx, client, tracer, ownerId, postgreSQLId, opts...)
+	response := postgresql.Listtablesprivileges(ctx, client, tracer, ownerId, postgresqlId, opts...)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: postgresql
 operationId: listTablesPrivileges
 */
-func Listtablesprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, opts ...Option) client.Response[[]models.PgTablePrivileges] {
-	ctx, span := tracer.Start(ctx, "listTablesPrivileges", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId)))
+func Listtablesprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, opts ...Option) client.Response[[]models.PgTablePrivileges] {
+	ctx, span := tracer.Start(ctx, "listTablesPrivileges", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/privileges/tables", ownerId, postgreSQLId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/privileges/tables", ownerId, postgresqlId)
 
 	// Build query parameters
 	query := buildQueryString(opts...)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -22,7 +23,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - databaseId: Database ID
   - requestBody: the request payload
   - opts: optional query parameters
@@ -31,7 +32,7 @@ Parameters:
 
 Example:
 
-	response := postgresql.Updatedatabase(ctx, client, tracer, ownerId, postgreSQLId, databaseId, requestBody, opts...)
+	response := postgresql.Updatedatabase(ctx, client, tracer, ownerId, postgresqlId, databaseId, requestBody, opts...)
 	if response.HasError() {
 		// Handle error
 	}
@@ -40,11 +41,11 @@ Example:
 x-service: postgresql
 operationId: updateDatabase
 */
-func Updatedatabase(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, databaseId string, requestBody *models.WannaPatchPostgreSQLDatabase, opts ...Option) client.Response[models.PostgreSQLDatabase1] {
-	ctx, span := tracer.Start(ctx, "updateDatabase", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("databaseId", databaseId)))
+func Updatedatabase(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, databaseId ids.DatabaseID, requestBody *models.WannaPatchPostgreSQLDatabase, opts ...Option) client.Response[models.PostgreSQLDatabase1] {
+	ctx, span := tracer.Start(ctx, "updateDatabase", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("databaseId", string(databaseId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases/%s", ownerId, postgreSQLId, databaseId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/databases/%s", ownerId, postgresqlId, databaseId)
 
 	// Build query parameters
 	query := buildQueryString(opts...)
@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - pgUserId: PostgreSQL User ID
   - objectId: PostgreSQL Object ID
   - requestBody: the request payload
@@ -30,7 +31,7 @@ Parameters:
 
 Example:
 
-	response := postgresql.Updateschemasprivileges(ctx, client, tracer, ownerId, postgreSQLId, pgUserId, objectId, requestBody)
+	response := postgresql.Updateschemasprivileges(ctx, client, tracer, ownerId, postgresqlId, pgUserId, objectId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -39,11 +40,11 @@ Example:
 x-service: postgresql
 operationId: updateSchemasPrivileges
 */
-func Updateschemasprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, pgUserId string, objectId int, requestBody *models.SchemaPrivilegePatch) client.Response[models.PgSchemaPrivileges] {
-	ctx, span := tracer.Start(ctx, "updateSchemasPrivileges", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("pgUserId", pgUserId), attribute.Int("objectId", objectId)))
+func Updateschemasprivileges(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, pgUserId ids.PGUserID, objectId int, requestBody *models.SchemaPrivilegePatch) client.Response[models.PgSchemaPrivileges] {
+	ctx, span := tracer.Start(ctx, "updateSchemasPrivileges", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("pgUserId", string(pgUserId)), attribute.Int("objectId", objectId)))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s/privileges/databases/%s/schemas/%s", ownerId, postgreSQLId, pgUserId, objectId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s/privileges/databases/%s/schemas/%s", ownerId, postgresqlId, pgUserId, objectId)
 
 	// Make API call
 	response := client.Patch[models.PgSchemaPrivileges](ctx, c, path, requestBody)
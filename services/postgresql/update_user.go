@@ -5,6 +5,7 @@ package postgresql
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -21,7 +22,7 @@ Parameters:
   - client: the Clever Cloud client
   - tracer: OpenTelemetry tracer for observability
   - ownerId:
-  - postgreSQLId: PostgreSQL ID
+  - postgresqlId: PostgreSQL ID
   - pgUserId: PostgreSQL User ID
   - requestBody: the request payload
 
@@ -29,7 +30,7 @@ Parameters:
 
 Example:
 
-	response := postgresql.Updateuser(ctx, client, tracer, ownerId, postgreSQLId, pgUserId, requestBody)
+	response := postgresql.Updateuser(ctx, client, tracer, ownerId, postgresqlId, pgUserId, requestBody)
 	if response.HasError() {
 		// Handle error
 	}
@@ -38,11 +39,11 @@ Example:
 x-service: postgresql
 operationId: updateUser
 */
-func Updateuser(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, postgreSQLId string, pgUserId string, requestBody *models.PatchUser) client.Response[models.PgUserData] {
-	ctx, span := tracer.Start(ctx, "updateUser", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("postgreSQLId", postgreSQLId), attribute.String("pgUserId", pgUserId)))
+func Updateuser(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, postgresqlId ids.PostgresqlID, pgUserId ids.PGUserID, requestBody *models.PatchUser) client.Response[models.PgUserData] {
+	ctx, span := tracer.Start(ctx, "updateUser", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("postgresqlId", string(postgresqlId)), attribute.String("pgUserId", string(pgUserId))))
 	defer span.End()
 
-	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s", ownerId, postgreSQLId, pgUserId)
+	path := utils.Path("/v4/postgresql/organisations/%s/postgresql/%s/users/%s", ownerId, postgresqlId, pgUserId)
 
 	// Make API call
 	response := client.Patch[models.PgUserData](ctx, c, path, requestBody)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -37,8 +38,8 @@ Example:
 x-service: pulsar
 operationId: createPulsarNonPersistentTopic
 */
-func Createpulsarnonpersistenttopic(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string, topic string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "createPulsarNonPersistentTopic", trace.WithAttributes(attribute.String("pulsarId", pulsarId), attribute.String("topic", topic)))
+func Createpulsarnonpersistenttopic(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID, topic string, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "createPulsarNonPersistentTopic", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId)), attribute.String("topic", topic)))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/non-persistent-topics/%s", pulsarId, topic)
@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: pulsar
 operationId: createPulsarPersistentTopicToken
 */
-func Createpulsarpersistenttopictoken(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string, topic string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "createPulsarPersistentTopicToken", trace.WithAttributes(attribute.String("pulsarId", pulsarId), attribute.String("topic", topic)))
+func Createpulsarpersistenttopictoken(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID, topic string) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "createPulsarPersistentTopicToken", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId)), attribute.String("topic", topic)))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/topics/%s/token", pulsarId, topic)
@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: pulsar
 operationId: createTriggerPulsar
 */
-func Createtriggerpulsar(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId string, functionId string, requestBody *models.FunctionTriggerPulsarWanabe) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "createTriggerPulsar", trace.WithAttributes(attribute.String("ownerId", ownerId), attribute.String("functionId", functionId)))
+func Createtriggerpulsar(ctx context.Context, c *client.Client, tracer trace.Tracer, ownerId ids.OwnerID, functionId ids.FunctionID, requestBody *models.FunctionTriggerPulsarWanabe) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "createTriggerPulsar", trace.WithAttributes(attribute.String("ownerId", string(ownerId)), attribute.String("functionId", string(functionId))))
 	defer span.End()
 
 	path := utils.Path("/v4/functions/organisations/%s/functions/%s/trigger-pulsar", ownerId, functionId)
@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: pulsar
 operationId: deletePulsarCluster
 */
-func Deletepulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deletePulsarCluster", trace.WithAttributes(attribute.String("clusterId", clusterId)))
+func Deletepulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deletePulsarCluster", trace.WithAttributes(attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/clusters/%s", clusterId)
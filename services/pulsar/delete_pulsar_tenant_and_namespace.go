@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -34,8 +35,8 @@ Example:
 x-service: pulsar
 operationId: deletePulsarTenantAndNamespace
 */
-func Deletepulsartenantandnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deletePulsarTenantAndNamespace", trace.WithAttributes(attribute.String("pulsarId", pulsarId)))
+func Deletepulsartenantandnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deletePulsarTenantAndNamespace", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/delete-tenant-and-namespace", pulsarId)
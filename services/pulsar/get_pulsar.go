@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: pulsar
 operationId: getPulsar
 */
-func Getpulsar(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string) client.Response[models.Pulsar] {
-	ctx, span := tracer.Start(ctx, "getPulsar", trace.WithAttributes(attribute.String("pulsarId", pulsarId)))
+func Getpulsar(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID) client.Response[models.Pulsar] {
+	ctx, span := tracer.Start(ctx, "getPulsar", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s", pulsarId)
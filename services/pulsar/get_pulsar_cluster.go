@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: pulsar
 operationId: getPulsarCluster
 */
-func Getpulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string) client.Response[models.PulsarCluster] {
-	ctx, span := tracer.Start(ctx, "getPulsarCluster", trace.WithAttributes(attribute.String("clusterId", clusterId)))
+func Getpulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID) client.Response[models.PulsarCluster] {
+	ctx, span := tracer.Start(ctx, "getPulsarCluster", trace.WithAttributes(attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/clusters/%s", clusterId)
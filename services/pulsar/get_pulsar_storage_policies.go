@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: pulsar
 operationId: getPulsarStoragePolicies
 */
-func Getpulsarstoragepolicies(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string) client.Response[models.StoragePolicies] {
-	ctx, span := tracer.Start(ctx, "getPulsarStoragePolicies", trace.WithAttributes(attribute.String("pulsarId", pulsarId)))
+func Getpulsarstoragepolicies(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID) client.Response[models.StoragePolicies] {
+	ctx, span := tracer.Start(ctx, "getPulsarStoragePolicies", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/storage-policies", pulsarId)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -36,8 +37,8 @@ Example:
 x-service: pulsar
 operationId: listPulsarNonPersistentTopics
 */
-func Listpulsarnonpersistenttopics(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string, opts ...Option) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "listPulsarNonPersistentTopics", trace.WithAttributes(attribute.String("pulsarId", pulsarId)))
+func Listpulsarnonpersistenttopics(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID, opts ...Option) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "listPulsarNonPersistentTopics", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/non-persistent-topics", pulsarId)
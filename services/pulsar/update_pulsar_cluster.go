@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: pulsar
 operationId: updatePulsarCluster
 */
-func Updatepulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string, requestBody *models.WannabePulsarCluster) client.Response[models.PulsarCluster] {
-	ctx, span := tracer.Start(ctx, "updatePulsarCluster", trace.WithAttributes(attribute.String("clusterId", clusterId)))
+func Updatepulsarcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID, requestBody *models.WannabePulsarCluster) client.Response[models.PulsarCluster] {
+	ctx, span := tracer.Start(ctx, "updatePulsarCluster", trace.WithAttributes(attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/clusters/%s", clusterId)
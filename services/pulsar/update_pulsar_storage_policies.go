@@ -5,6 +5,7 @@ package pulsar
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: pulsar
 operationId: updatePulsarStoragePolicies
 */
-func Updatepulsarstoragepolicies(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId string, requestBody *models.StoragePolicies) client.Response[models.StoragePolicies] {
-	ctx, span := tracer.Start(ctx, "updatePulsarStoragePolicies", trace.WithAttributes(attribute.String("pulsarId", pulsarId)))
+func Updatepulsarstoragepolicies(ctx context.Context, c *client.Client, tracer trace.Tracer, pulsarId ids.PulsarID, requestBody *models.StoragePolicies) client.Response[models.StoragePolicies] {
+	ctx, span := tracer.Start(ctx, "updatePulsarStoragePolicies", trace.WithAttributes(attribute.String("pulsarId", string(pulsarId))))
 	defer span.End()
 
 	path := utils.Path("/v4/addon-providers/addon-pulsar/addons/%s/storage-policies", pulsarId)
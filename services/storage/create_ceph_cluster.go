@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: storage
 operationId: createCephCluster
 */
-func Createcephcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, requestBody *models.WannabeCephCluster) client.Response[models.CephCluster] {
-	ctx, span := tracer.Start(ctx, "createCephCluster", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Createcephcluster(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, requestBody *models.WannabeCephCluster) client.Response[models.CephCluster] {
+	ctx, span := tracer.Start(ctx, "createCephCluster", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/ceph-clusters", tenantId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: storage
 operationId: createCephRBDNamespace
 */
-func Createcephrbdnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, clusterId string, requestBody *models.WannabeCephRBDNamespace) client.Response[models.CephRBDNamespace] {
-	ctx, span := tracer.Start(ctx, "createCephRBDNamespace", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("clusterId", clusterId)))
+func Createcephrbdnamespace(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, clusterId ids.ClusterID, requestBody *models.WannabeCephRBDNamespace) client.Response[models.CephRBDNamespace] {
+	ctx, span := tracer.Start(ctx, "createCephRBDNamespace", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/ceph-clusters/%s/ceph-rbd-namespaces", tenantId, clusterId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: storage
 operationId: createCephXUser
 */
-func Createcephxuser(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, clusterId string, requestBody *models.WannabeCephXUser) client.Response[models.JustCreatedCephXUser] {
-	ctx, span := tracer.Start(ctx, "createCephXUser", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("clusterId", clusterId)))
+func Createcephxuser(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, clusterId ids.ClusterID, requestBody *models.WannabeCephXUser) client.Response[models.JustCreatedCephXUser] {
+	ctx, span := tracer.Start(ctx, "createCephXUser", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/ceph-clusters/%s/ceph-x-users", tenantId, clusterId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: storage
 operationId: createStorage
 */
-func Createstorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, clusterId string, requestBody *models.WannabeStorage) client.Response[models.Storage1] {
-	ctx, span := tracer.Start(ctx, "createStorage", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("clusterId", clusterId)))
+func Createstorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, clusterId ids.ClusterID, requestBody *models.WannabeStorage) client.Response[models.Storage1] {
+	ctx, span := tracer.Start(ctx, "createStorage", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/ceph-clusters/%s/storages", tenantId, clusterId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: storage
 operationId: deleteStorage
 */
-func Deletestorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, storageId string) client.Response[models.Storage1] {
-	ctx, span := tracer.Start(ctx, "deleteStorage", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("storageId", storageId)))
+func Deletestorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, storageId ids.StorageID) client.Response[models.Storage1] {
+	ctx, span := tracer.Start(ctx, "deleteStorage", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("storageId", string(storageId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/storages/%s", tenantId, storageId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: storage
 operationId: getCephXUser
 */
-func Getcephxuser(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, clusterId string, entityId string) client.Response[models.CephXUser] {
-	ctx, span := tracer.Start(ctx, "getCephXUser", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("clusterId", clusterId), attribute.String("entityId", entityId)))
+func Getcephxuser(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, clusterId ids.ClusterID, entityId ids.EntityID) client.Response[models.CephXUser] {
+	ctx, span := tracer.Start(ctx, "getCephXUser", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("clusterId", string(clusterId)), attribute.String("entityId", string(entityId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/ceph-clusters/%s/ceph-x-users/%s", tenantId, clusterId, entityId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -35,8 +36,8 @@ Example:
 x-service: storage
 operationId: listStorages
 */
-func Liststorages(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string) client.Response[[]models.Storage1] {
-	ctx, span := tracer.Start(ctx, "listStorages", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Liststorages(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID) client.Response[[]models.Storage1] {
+	ctx, span := tracer.Start(ctx, "listStorages", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/storages", tenantId)
@@ -5,6 +5,7 @@ package storage
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: storage
 operationId: updateStorage
 */
-func Updatestorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, storageId string, requestBody *models.StoragePatch) client.Response[models.Storage1] {
-	ctx, span := tracer.Start(ctx, "updateStorage", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("storageId", storageId)))
+func Updatestorage(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, storageId ids.StorageID, requestBody *models.StoragePatch) client.Response[models.Storage1] {
+	ctx, span := tracer.Start(ctx, "updateStorage", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("storageId", string(storageId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/storages/%s", tenantId, storageId)
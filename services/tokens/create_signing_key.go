@@ -5,6 +5,7 @@ package tokens
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: tokens
 operationId: createSigningKey
 */
-func Createsigningkey(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string, requestBody *models.WannabeSigningKey) client.Response[models.SigningKey] {
-	ctx, span := tracer.Start(ctx, "createSigningKey", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId)))
+func Createsigningkey(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID, requestBody *models.WannabeSigningKey) client.Response[models.SigningKey] {
+	ctx, span := tracer.Start(ctx, "createSigningKey", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s/keys", tenantId, productId)
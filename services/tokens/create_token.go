@@ -5,6 +5,7 @@ package tokens
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: tokens
 operationId: createToken
 */
-func Createtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, requestBody *models.WannabeToken) client.Response[models.CreatedToken] {
-	ctx, span := tracer.Start(ctx, "createToken", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Createtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, requestBody *models.WannabeToken) client.Response[models.CreatedToken] {
+	ctx, span := tracer.Start(ctx, "createToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/tokens", tenantId)
@@ -5,6 +5,7 @@ package tokens
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
@@ -35,8 +36,8 @@ Example:
 x-service: tokens
 operationId: deleteToken
 */
-func Deletetoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, tokenId string) client.Response[client.Nothing] {
-	ctx, span := tracer.Start(ctx, "deleteToken", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("tokenId", tokenId)))
+func Deletetoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, tokenId ids.TokenID) client.Response[client.Nothing] {
+	ctx, span := tracer.Start(ctx, "deleteToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("tokenId", string(tokenId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/tokens/%s", tenantId, tokenId)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -38,8 +39,8 @@ Example:
 x-service: tokens
 operationId: listSigningKeys
 */
-func Listsigningkeys(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, productId string, opts ...Option) client.Response[[]models.SigningKey] {
-	ctx, span := tracer.Start(ctx, "listSigningKeys", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("productId", productId)))
+func Listsigningkeys(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, productId ids.ProductID, opts ...Option) client.Response[[]models.SigningKey] {
+	ctx, span := tracer.Start(ctx, "listSigningKeys", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("productId", string(productId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/products/%s/keys", tenantId, productId)
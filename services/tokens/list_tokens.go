@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: tokens
 operationId: listTokens
 */
-func Listtokens(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, opts ...Option) client.Response[[]models.Token1] {
-	ctx, span := tracer.Start(ctx, "listTokens", trace.WithAttributes(attribute.String("tenantId", tenantId)))
+func Listtokens(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, opts ...Option) client.Response[[]models.Token1] {
+	ctx, span := tracer.Start(ctx, "listTokens", trace.WithAttributes(attribute.String("tenantId", string(tenantId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/tokens", tenantId)
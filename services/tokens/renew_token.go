@@ -5,6 +5,7 @@ package tokens
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: tokens
 operationId: renewToken
 */
-func Renewtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId string, tokenId string) client.Response[models.CreatedToken] {
-	ctx, span := tracer.Start(ctx, "renewToken", trace.WithAttributes(attribute.String("tenantId", tenantId), attribute.String("tokenId", tokenId)))
+func Renewtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, tenantId ids.TenantID, tokenId ids.TokenID) client.Response[models.CreatedToken] {
+	ctx, span := tracer.Start(ctx, "renewToken", trace.WithAttributes(attribute.String("tenantId", string(tenantId)), attribute.String("tokenId", string(tokenId))))
 	defer span.End()
 
 	path := utils.Path("/v4/tenants/%s/tokens/%s/refresh", tenantId, tokenId)
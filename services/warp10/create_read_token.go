@@ -5,6 +5,7 @@ package warp10
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: warp10
 operationId: createReadToken
 */
-func Createreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string, requestBody *models.CreateReadTokenRequest) client.Response[models.TokenCreatedResponse] {
-	ctx, span := tracer.Start(ctx, "createReadToken", trace.WithAttributes(attribute.String("clusterId", clusterId)))
+func Createreadtoken(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID, requestBody *models.CreateReadTokenRequest) client.Response[models.TokenCreatedResponse] {
+	ctx, span := tracer.Start(ctx, "createReadToken", trace.WithAttributes(attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/warp10/cluster/%s/token/read", clusterId)
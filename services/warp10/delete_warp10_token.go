@@ -5,6 +5,7 @@ package warp10
 import (
 	"context"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -36,8 +37,8 @@ Example:
 x-service: warp10
 operationId: deleteWarp10Token
 */
-func Deletewarp10token(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string, revocationId string) client.Response[models.TokenRevokedResponse] {
-	ctx, span := tracer.Start(ctx, "deleteWarp10Token", trace.WithAttributes(attribute.String("clusterId", clusterId), attribute.String("revocationId", revocationId)))
+func Deletewarp10token(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID, revocationId ids.RevocationID) client.Response[models.TokenRevokedResponse] {
+	ctx, span := tracer.Start(ctx, "deleteWarp10Token", trace.WithAttributes(attribute.String("clusterId", string(clusterId)), attribute.String("revocationId", string(revocationId))))
 	defer span.End()
 
 	path := utils.Path("/v4/warp10/cluster/%s/token/%s", clusterId, revocationId)
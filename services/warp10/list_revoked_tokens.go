@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	client "go.clever-cloud.dev/client"
+	ids "go.clever-cloud.dev/sdk/ids"
 	utils "go.clever-cloud.dev/sdk/internal/utils"
 	models "go.clever-cloud.dev/sdk/models"
 	attribute "go.opentelemetry.io/otel/attribute"
@@ -37,8 +38,8 @@ Example:
 x-service: warp10
 operationId: listRevokedTokens
 */
-func Listrevokedtokens(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId string, opts ...Option) client.Response[models.RevokedTokensResponse] {
-	ctx, span := tracer.Start(ctx, "listRevokedTokens", trace.WithAttributes(attribute.String("clusterId", clusterId)))
+func Listrevokedtokens(ctx context.Context, c *client.Client, tracer trace.Tracer, clusterId ids.ClusterID, opts ...Option) client.Response[models.RevokedTokensResponse] {
+	ctx, span := tracer.Start(ctx, "listRevokedTokens", trace.WithAttributes(attribute.String("clusterId", string(clusterId))))
 	defer span.End()
 
 	path := utils.Path("/v4/warp10/cluster/%s/token/revocation", clusterId)